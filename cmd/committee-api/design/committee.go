@@ -101,6 +101,88 @@ var _ = dsl.Service("committee-service", func() {
 		})
 	})
 
+	dsl.Method("get-committee-with-members", func() {
+		dsl.Description("Get a committee with its settings and a page of its members")
+
+		dsl.Security(JWTAuth)
+
+		dsl.Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+			CommitteeUIDAttribute()
+			PageSizeAttribute()
+			PageTokenAttribute()
+		})
+
+		dsl.Result(func() {
+			dsl.Attribute("committee", CommitteeFullWithReadonlyAttributes, "Committee base and settings")
+			dsl.Attribute("members", dsl.ArrayOf(CommitteeMemberFullWithReadonlyAttributes), "Committee members of the page, ordered by last name and first name")
+			dsl.Attribute("total_members", dsl.Int, "Number of committee members across every page", func() {
+				dsl.Example(42)
+			})
+			NextPageTokenAttribute()
+			dsl.Required("committee", "members", "total_members")
+		})
+
+		dsl.Error("BadRequest", BadRequestError, "Bad request")
+		dsl.Error("NotFound", NotFoundError, "Resource not found")
+		dsl.Error("InternalServerError", InternalServerError, "Internal server error")
+		dsl.Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		dsl.HTTP(func() {
+			dsl.GET("/committees/{uid}/with-members")
+			dsl.Param("version:v")
+			dsl.Param("uid")
+			dsl.Param("page_size")
+			dsl.Param("page_token")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("NotFound", dsl.StatusNotFound)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
+	dsl.Method("list-committee-children", func() {
+		dsl.Description("List the direct sub-committees of a committee")
+
+		dsl.Security(JWTAuth)
+
+		dsl.Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+			CommitteeUIDAttribute()
+			PageSizeAttribute()
+			PageTokenAttribute()
+		})
+
+		dsl.Result(func() {
+			dsl.Attribute("children", dsl.ArrayOf(CommitteeBaseWithReadonlyAttributes), "Direct sub-committees, ordered by name")
+			NextPageTokenAttribute()
+			dsl.Required("children")
+		})
+
+		dsl.Error("BadRequest", BadRequestError, "Bad request")
+		dsl.Error("NotFound", NotFoundError, "Resource not found")
+		dsl.Error("InternalServerError", InternalServerError, "Internal server error")
+		dsl.Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		dsl.HTTP(func() {
+			dsl.GET("/committees/{uid}/children")
+			dsl.Param("version:v")
+			dsl.Param("uid")
+			dsl.Param("page_size")
+			dsl.Param("page_token")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("NotFound", dsl.StatusNotFound)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
 	dsl.Method("update-committee-base", func() {
 		dsl.Description("Update Committee")
 
@@ -333,6 +415,94 @@ var _ = dsl.Service("committee-service", func() {
 		})
 	})
 
+	dsl.Method("reassign-committee-members-organization", func() {
+		dsl.Description("Move the committee members of an organization to another one, or clear their organization")
+
+		dsl.Security(JWTAuth)
+
+		dsl.Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+			XSyncAttribute()
+			CommitteeUIDAttribute()
+			dsl.Attribute("from_organization", dsl.String, "The name of the organization the members are moved from", func() {
+				dsl.MaxLength(200)
+				dsl.Example("Departing Corp")
+			})
+			dsl.Attribute("to_organization", dsl.String, "The name of the organization the members are moved to, empty to clear it", func() {
+				dsl.MaxLength(200)
+				dsl.Example("The Linux Foundation")
+			})
+
+			dsl.Required("version", "uid", "from_organization")
+		})
+
+		dsl.Result(func() {
+			dsl.Attribute("results", dsl.ArrayOf(CommitteeMemberReassignResult), "The outcome for each member of the organization")
+			dsl.Required("results")
+		})
+
+		dsl.Error("BadRequest", BadRequestError, "Bad request")
+		dsl.Error("NotFound", NotFoundError, "Committee not found")
+		dsl.Error("InternalServerError", InternalServerError, "Internal server error")
+		dsl.Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		dsl.HTTP(func() {
+			dsl.POST("/committees/{uid}/members/reassign-organization")
+			dsl.Param("version:v")
+			dsl.Param("uid")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Header("x_sync:X-Sync")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("NotFound", dsl.StatusNotFound)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
+	dsl.Method("list-committee-members-by-organization", func() {
+		dsl.Description("List the committee members belonging to an organization")
+
+		dsl.Security(JWTAuth)
+
+		dsl.Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+			CommitteeUIDAttribute()
+			dsl.Attribute("organization", dsl.String, "The name of the organization, compared ignoring case, punctuation and legal suffixes", func() {
+				dsl.MinLength(1)
+				dsl.MaxLength(200)
+				dsl.Example("IBM")
+			})
+
+			dsl.Required("version", "uid", "organization")
+		})
+
+		dsl.Result(func() {
+			dsl.Attribute("members", dsl.ArrayOf(CommitteeMemberFullWithReadonlyAttributes), "Committee members of the organization, ordered by last name and first name")
+			dsl.Required("members")
+		})
+
+		dsl.Error("BadRequest", BadRequestError, "Bad request")
+		dsl.Error("NotFound", NotFoundError, "Committee not found")
+		dsl.Error("InternalServerError", InternalServerError, "Internal server error")
+		dsl.Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		dsl.HTTP(func() {
+			dsl.GET("/committees/{uid}/members-by-organization")
+			dsl.Param("version:v")
+			dsl.Param("uid")
+			dsl.Param("organization")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("NotFound", dsl.StatusNotFound)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
 	// GET - Get single committee member
 	dsl.Method("get-committee-member", func() {
 		dsl.Description("Get a specific committee member by UID")
@@ -376,6 +546,52 @@ var _ = dsl.Service("committee-service", func() {
 		})
 	})
 
+	// GET - Get the stored revisions of a committee member
+	dsl.Method("get-committee-member-history", func() {
+		dsl.Description("Get the stored revisions of a committee member, newest first")
+
+		dsl.Security(JWTAuth)
+
+		dsl.Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+			CommitteeUIDAttribute()
+			MemberUIDAttribute()
+			dsl.Attribute("limit", dsl.Int, "Maximum number of revisions to return", func() {
+				dsl.Minimum(1)
+				dsl.Maximum(100)
+				dsl.Default(20)
+				dsl.Example(10)
+			})
+
+			dsl.Required("version", "uid", "member_uid")
+		})
+
+		dsl.Result(func() {
+			dsl.Attribute("revisions", dsl.ArrayOf(CommitteeMemberRevision), "Revisions of the committee member, newest first")
+			dsl.Required("revisions")
+		})
+
+		dsl.Error("BadRequest", BadRequestError, "Bad request")
+		dsl.Error("NotFound", NotFoundError, "Member not found")
+		dsl.Error("InternalServerError", InternalServerError, "Internal server error")
+		dsl.Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		dsl.HTTP(func() {
+			dsl.GET("/committees/{uid}/members/{member_uid}/history")
+			dsl.Param("version:v")
+			dsl.Param("uid")
+			dsl.Param("member_uid")
+			dsl.Param("limit")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("NotFound", dsl.StatusNotFound)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
 	// PUT - Replace committee member (complete resource replacement)
 	// This endpoint follows PUT semantics: it replaces the entire member resource.
 	// All required fields must be provided, even if unchanged.
@@ -391,6 +607,8 @@ var _ = dsl.Service("committee-service", func() {
 			XSyncAttribute()
 			CommitteeUIDAttribute()
 			MemberUIDAttribute()
+			// Optional, when sent it must match the committee UID of the path
+			CommitteeUIDMemberAttribute()
 
 			CommitteeMemberUpdateAttributes()
 
@@ -422,6 +640,50 @@ var _ = dsl.Service("committee-service", func() {
 		})
 	})
 
+	// PATCH - Partially update committee member
+	dsl.Method("patch-committee-member", func() {
+		dsl.Description("Update the fields of an existing committee member sent in the request, leaving the omitted ones unchanged")
+
+		dsl.Security(JWTAuth)
+
+		dsl.Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+			IfMatchAttribute()
+			XSyncAttribute()
+			CommitteeUIDAttribute()
+			MemberUIDAttribute()
+
+			CommitteeMemberPatchAttributes()
+
+			dsl.Required("version", "uid", "member_uid")
+		})
+
+		dsl.Result(CommitteeMemberFullWithReadonlyAttributes)
+
+		dsl.Error("BadRequest", BadRequestError, "Bad request")
+		dsl.Error("NotFound", NotFoundError, "Member not found")
+		dsl.Error("Conflict", ConflictError, "Conflict")
+		dsl.Error("InternalServerError", InternalServerError, "Internal server error")
+		dsl.Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		dsl.HTTP(func() {
+			dsl.PATCH("/committees/{uid}/members/{member_uid}")
+			dsl.Param("version:v")
+			dsl.Param("uid")
+			dsl.Param("member_uid")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Header("if_match:If-Match")
+			dsl.Header("x_sync:X-Sync")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("NotFound", dsl.StatusNotFound)
+			dsl.Response("Conflict", dsl.StatusConflict)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
 	// DELETE - Remove committee member
 	dsl.Method("delete-committee-member", func() {
 		dsl.Description("Remove a member from a committee")
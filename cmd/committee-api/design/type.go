@@ -29,6 +29,7 @@ func CommitteeBaseAttributes() {
 	CalendarAttribute()
 	DisplayNameAttribute()
 	ParentCommitteeUIDAttribute()
+	LabelsAttribute()
 }
 
 // CommitteeSettings is the DSL type for a committee settings.
@@ -41,6 +42,7 @@ var CommitteeSettings = dsl.Type("committee-settings", func() {
 // CommitteeSettingsAttributes is the DSL attributes for a committee settings.
 func CommitteeSettingsAttributes() {
 	BusinessEmailRequiredAttribute()
+	AllowedEmailDomainsAttribute()
 	LastReviewedAtAttribute()
 	LastReviewedByAttribute()
 	MemberVisibilityAttribute()
@@ -72,6 +74,9 @@ var CommitteeBaseWithReadonlyAttributes = dsl.Type("committee-base-with-readonly
 	TotalMembersAttribute()
 	TotalVotingReposAttribute()
 
+	IndexStatusAttribute()
+	LastIndexedAtAttribute()
+
 })
 
 var CommitteeFullWithReadonlyAttributes = dsl.Type("committee-full-with-readonly-attributes", func() {
@@ -86,6 +91,9 @@ var CommitteeFullWithReadonlyAttributes = dsl.Type("committee-full-with-readonly
 	TotalMembersAttribute()
 	TotalVotingReposAttribute()
 
+	IndexStatusAttribute()
+	LastIndexedAtAttribute()
+
 	// Include settings attributes for complete representation
 	CommitteeSettingsAttributes()
 
@@ -272,6 +280,13 @@ func DisplayNameAttribute() {
 	})
 }
 
+// LabelsAttribute is the DSL attribute for committee labels.
+func LabelsAttribute() {
+	dsl.Attribute("labels", dsl.MapOf(dsl.String, dsl.String), "Arbitrary key/value labels used to filter committees", func() {
+		dsl.Example(map[string]string{"region": "emea"})
+	})
+}
+
 // ParentCommitteeUIDAttribute is the DSL attribute for parent committee UID.
 func ParentCommitteeUIDAttribute() {
 	dsl.Attribute("parent_uid", dsl.String, "The UID of the parent committee -- v2 uid, not related to v1 id directly, should be empty if there is none", func() {
@@ -311,6 +326,30 @@ func VersionAttribute() {
 	})
 }
 
+// PageSizeAttribute is the DSL attribute for the number of items returned per page.
+func PageSizeAttribute() {
+	dsl.Attribute("page_size", dsl.Int, "Maximum number of items to return", func() {
+		dsl.Minimum(1)
+		dsl.Maximum(100)
+		dsl.Default(50)
+		dsl.Example(20)
+	})
+}
+
+// PageTokenAttribute is the DSL attribute for the opaque token of the page to return.
+func PageTokenAttribute() {
+	dsl.Attribute("page_token", dsl.String, "Token of the page to return, as returned in next_page_token by the previous page", func() {
+		dsl.Example("20")
+	})
+}
+
+// NextPageTokenAttribute is the DSL attribute for the token of the next page.
+func NextPageTokenAttribute() {
+	dsl.Attribute("next_page_token", dsl.String, "Token of the next page, absent on the last page", func() {
+		dsl.Example("40")
+	})
+}
+
 // ETagAttribute is the DSL attribute for ETag header.
 func ETagAttribute() {
 	dsl.Attribute("etag", dsl.String, "ETag header value", func() {
@@ -357,6 +396,22 @@ func UpdatedAtAttribute() {
 	})
 }
 
+// IndexStatusAttribute is the DSL attribute for the committee search index status.
+func IndexStatusAttribute() {
+	dsl.Attribute("index_status", dsl.String, "Whether the committee search record reflects the latest changes, pending means a reindex is needed (read-only)", func() {
+		dsl.Enum("synced", "pending")
+		dsl.Example("synced")
+	})
+}
+
+// LastIndexedAtAttribute is the DSL attribute for the last successful index publish timestamp.
+func LastIndexedAtAttribute() {
+	dsl.Attribute("last_indexed_at", dsl.String, "The timestamp when the committee was last published to the search index (read-only)", func() {
+		dsl.Format(dsl.FormatDateTime)
+		dsl.Example("2023-06-20T14:45:31Z")
+	})
+}
+
 // LastAuditedByAttribute is the DSL attribute for last audited by user.
 func LastAuditedByAttribute() {
 	dsl.Attribute("last_audited_by", dsl.String, "The user ID who last audited the committee", func() {
@@ -400,6 +455,7 @@ func CommitteeMemberBaseAttributes() {
 	AppointedByAttribute()
 	StatusAttribute()
 	VotingInfoAttributes()
+	AlternateForUIDAttribute()
 	OrganizationInfoAttributes()
 }
 
@@ -421,6 +477,76 @@ var CommitteeMemberFullWithReadonlyAttributes = dsl.Type("committee-member-full-
 	CommitteeMemberBaseAttributes()
 	CreatedAtAttribute()
 	UpdatedAtAttribute()
+	RoleHistoryAttribute()
+	StatusHistoryAttribute()
+})
+
+// CommitteeMemberReassignResult is the DSL type for the outcome of reassigning a member organization.
+var CommitteeMemberReassignResult = dsl.Type("committee-member-reassign-result", func() {
+	dsl.Description("The outcome of moving a committee member to another organization.")
+
+	CommitteeMemberUIDAttribute()
+	EmailAttribute()
+	dsl.Attribute("member", CommitteeMemberFullWithReadonlyAttributes, "The updated member, omitted when the reassignment failed")
+	dsl.Attribute("error", dsl.String, "The reason the member could not be reassigned", func() {
+		dsl.Example("committee member has been modified by another process")
+	})
+	dsl.Required("uid", "email")
+})
+
+// CommitteeMemberRoleChange is the DSL type for a committee member role change.
+var CommitteeMemberRoleChange = dsl.Type("committee-member-role-change", func() {
+	dsl.Description("A change of the committee member role.")
+
+	dsl.Attribute("old_role", dsl.String, "The role before the change", func() {
+		dsl.Example("Developer Seat")
+	})
+	dsl.Attribute("new_role", dsl.String, "The role after the change", func() {
+		dsl.Example("Chair")
+	})
+	dsl.Attribute("changed_at", dsl.String, "The timestamp when the role changed", func() {
+		dsl.Format(dsl.FormatDateTime)
+		dsl.Example("2023-06-20T14:45:31Z")
+	})
+	dsl.Attribute("actor", dsl.String, "The principal who changed the role", func() {
+		dsl.Example("user123")
+	})
+	dsl.Required("old_role", "new_role", "changed_at")
+})
+
+// CommitteeMemberStatusChange is the DSL type for a change of the committee member status.
+var CommitteeMemberStatusChange = dsl.Type("committee-member-status-change", func() {
+	dsl.Description("A change of the committee member status.")
+
+	dsl.Attribute("old_status", dsl.String, "The status before the change", func() {
+		dsl.Example("Active")
+	})
+	dsl.Attribute("new_status", dsl.String, "The status after the change", func() {
+		dsl.Example("Inactive")
+	})
+	dsl.Attribute("changed_at", dsl.String, "The timestamp when the status changed", func() {
+		dsl.Format(dsl.FormatDateTime)
+		dsl.Example("2023-06-20T14:45:31Z")
+	})
+	dsl.Attribute("actor", dsl.String, "The principal who changed the status", func() {
+		dsl.Example("user123")
+	})
+	dsl.Required("old_status", "new_status", "changed_at")
+})
+
+// CommitteeMemberRevision is the DSL type for a stored revision of a committee member.
+var CommitteeMemberRevision = dsl.Type("committee-member-revision", func() {
+	dsl.Description("A stored revision of a committee member.")
+
+	dsl.Attribute("revision", dsl.UInt64, "The revision number of the member record", func() {
+		dsl.Example(3)
+	})
+	dsl.Attribute("created_at", dsl.String, "The timestamp when the revision was stored", func() {
+		dsl.Format(dsl.FormatDateTime)
+		dsl.Example("2023-06-20T14:45:31Z")
+	})
+	dsl.Attribute("member", CommitteeMemberFullWithReadonlyAttributes, "The committee member as stored at the revision")
+	dsl.Required("revision", "created_at", "member")
 })
 
 // CommitteeMemberCreateAttributes defines attributes for creating a committee member.
@@ -433,6 +559,51 @@ func CommitteeMemberUpdateAttributes() {
 	CommitteeMemberBaseAttributes()
 }
 
+// CommitteeMemberPatchAttributes is the DSL attributes for a partial committee member update.
+// The attributes have no default value, so omitted ones can be told apart and left unchanged.
+func CommitteeMemberPatchAttributes() {
+	UsernameAttribute()
+	EmailAttribute()
+	FirstNameAttribute()
+	LastNameAttribute()
+	JobTitleAttribute()
+	LinkedInProfileAttribute()
+	dsl.Attribute("role", func() {
+		dsl.Description("Committee role information, omitted fields are left unchanged")
+		dsl.Attribute("name", dsl.String, "Committee role name", func() {
+			dsl.Enum(roleNames...)
+			dsl.Example("Chair")
+		})
+		RoleStartDateAttribute()
+		RoleEndDateAttribute()
+	})
+	dsl.Attribute("appointed_by", dsl.String, "How the member was appointed", func() {
+		dsl.Enum(appointedByValues...)
+		dsl.Example("Community")
+	})
+	dsl.Attribute("status", dsl.String, "Member status", func() {
+		dsl.Enum(memberStatuses...)
+		dsl.Example("Active")
+	})
+	dsl.Attribute("voting", func() {
+		dsl.Description("Voting information for the committee member, omitted fields are left unchanged")
+		dsl.Attribute("status", dsl.String, "Voting status", func() {
+			dsl.Enum(votingStatuses...)
+			dsl.Example("Voting Rep")
+		})
+		VotingStartDateAttribute()
+		VotingEndDateAttribute()
+		VotingWeightAttribute()
+	})
+	AlternateForUIDAttribute()
+	dsl.Attribute("organization", func() {
+		dsl.Description("Organization information for the committee member, omitted fields are left unchanged")
+		OrganizationIDAttribute()
+		OrganizationNameAttribute()
+		OrganizationWebsiteAttribute()
+	})
+}
+
 // Organization Information Attributes
 func OrganizationInfoAttributes() {
 	dsl.Attribute("organization", func() {
@@ -460,11 +631,69 @@ func VotingInfoAttributes() {
 		VotingStatusAttribute()
 		VotingStartDateAttribute()
 		VotingEndDateAttribute()
+		VotingWeightAttribute()
 	})
 }
 
 // Committee Member Specific Attributes
 
+// roleNames are the supported committee role names.
+var roleNames = []any{
+	"Chair",
+	"Counsel",
+	"Developer Seat",
+	"TAC/TOC Representative",
+	"Director",
+	"Lead",
+	"None",
+	"Secretary",
+	"Treasurer",
+	"Vice Chair",
+	"LF Staff",
+}
+
+// appointedByValues are the supported member appointment mechanisms.
+var appointedByValues = []any{
+	"Community",
+	"Membership Entitlement",
+	"Vote of End User Member Class",
+	"Vote of TSC Committee",
+	"Vote of TAC Committee",
+	"Vote of Academic Member Class",
+	"Vote of Lab Member Class",
+	"Vote of Marketing Committee",
+	"Vote of Governing Board",
+	"Vote of General Member Class",
+	"Vote of End User Committee",
+	"Vote of TOC Committee",
+	"Vote of Gold Member Class",
+	"Vote of Silver Member Class",
+	"Vote of Strategic Membership Class",
+	"None",
+}
+
+// memberStatuses are the supported member statuses.
+var memberStatuses = []any{"Active", "Inactive"}
+
+// votingStatuses are the supported member voting statuses.
+var votingStatuses = []any{
+	"Alternate Voting Rep",
+	"Observer",
+	"Voting Rep",
+	"Emeritus",
+	"None",
+}
+
+// RoleHistoryAttribute is the DSL attribute for the committee member role change history.
+func RoleHistoryAttribute() {
+	dsl.Attribute("role_history", dsl.ArrayOf(CommitteeMemberRoleChange), "The changes of the member role, oldest first (read-only)")
+}
+
+// StatusHistoryAttribute is the DSL attribute for the committee member status change history.
+func StatusHistoryAttribute() {
+	dsl.Attribute("status_history", dsl.ArrayOf(CommitteeMemberStatusChange), "The changes of the member status, oldest first (read-only)")
+}
+
 // CommitteeMemberUIDAttribute is the DSL attribute for committee member UID.
 func CommitteeMemberUIDAttribute() {
 	dsl.Attribute("uid", dsl.String, "Committee member UID -- v2 uid, not related to v1 id directly", func() {
@@ -554,22 +783,18 @@ func LinkedInProfileAttribute() {
 	})
 }
 
+// AlternateForUIDAttribute is the DSL attribute for the primary member an alternate substitutes for.
+func AlternateForUIDAttribute() {
+	dsl.Attribute("alternate_for_uid", dsl.String, "UID of the member of the same committee this member is the alternate of", func() {
+		dsl.Format(dsl.FormatUUID)
+		dsl.Example("2200b646-fbb2-4de7-ad80-fd195a874baf")
+	})
+}
+
 // RoleNameAttribute is the DSL attribute for committee role name.
 func RoleNameAttribute() {
 	dsl.Attribute("name", dsl.String, "Committee role name", func() {
-		dsl.Enum(
-			"Chair",
-			"Counsel",
-			"Developer Seat",
-			"TAC/TOC Representative",
-			"Director",
-			"Lead",
-			"None",
-			"Secretary",
-			"Treasurer",
-			"Vice Chair",
-			"LF Staff",
-		)
+		dsl.Enum(roleNames...)
 		dsl.Default("None")
 		dsl.Example("Chair")
 	})
@@ -594,24 +819,7 @@ func RoleEndDateAttribute() {
 // AppointedByAttribute is the DSL attribute for appointed by.
 func AppointedByAttribute() {
 	dsl.Attribute("appointed_by", dsl.String, "How the member was appointed", func() {
-		dsl.Enum(
-			"Community",
-			"Membership Entitlement",
-			"Vote of End User Member Class",
-			"Vote of TSC Committee",
-			"Vote of TAC Committee",
-			"Vote of Academic Member Class",
-			"Vote of Lab Member Class",
-			"Vote of Marketing Committee",
-			"Vote of Governing Board",
-			"Vote of General Member Class",
-			"Vote of End User Committee",
-			"Vote of TOC Committee",
-			"Vote of Gold Member Class",
-			"Vote of Silver Member Class",
-			"Vote of Strategic Membership Class",
-			"None",
-		)
+		dsl.Enum(appointedByValues...)
 		dsl.Default("None")
 		dsl.Example("Community")
 	})
@@ -620,7 +828,7 @@ func AppointedByAttribute() {
 // StatusAttribute is the DSL attribute for member status.
 func StatusAttribute() {
 	dsl.Attribute("status", dsl.String, "Member status", func() {
-		dsl.Enum("Active", "Inactive")
+		dsl.Enum(memberStatuses...)
 		dsl.Default("Active")
 		dsl.Example("Active")
 	})
@@ -629,13 +837,7 @@ func StatusAttribute() {
 // VotingStatusAttribute is the DSL attribute for voting status.
 func VotingStatusAttribute() {
 	dsl.Attribute("status", dsl.String, "Voting status", func() {
-		dsl.Enum(
-			"Alternate Voting Rep",
-			"Observer",
-			"Voting Rep",
-			"Emeritus",
-			"None",
-		)
+		dsl.Enum(votingStatuses...)
 		dsl.Default("None")
 		dsl.Example("Voting Rep")
 	})
@@ -657,6 +859,14 @@ func VotingEndDateAttribute() {
 	})
 }
 
+// VotingWeightAttribute is the DSL attribute for voting weight.
+func VotingWeightAttribute() {
+	dsl.Attribute("weight", dsl.Float64, "Voting weight for weighted voting committees, defaults to 1", func() {
+		dsl.Minimum(0)
+		dsl.Example(1.0)
+	})
+}
+
 // Organization Specific Attributes
 
 // OrganizationNameAttribute is the DSL attribute for organization name.
@@ -698,6 +908,13 @@ func ShowMeetingAttendeesAttribute() {
 	})
 }
 
+// AllowedEmailDomainsAttribute is the DSL attribute for the email domains allowed for committee members.
+func AllowedEmailDomainsAttribute() {
+	dsl.Attribute("allowed_email_domains", dsl.ArrayOf(dsl.String), "Email domains allowed for committee members, overriding the business email requirement when not empty", func() {
+		dsl.Example([]string{"linuxfoundation.org"})
+	})
+}
+
 // Errors
 // BadRequestError is the DSL type for a bad request error.
 var BadRequestError = dsl.Type("bad-request-error", func() {
@@ -58,6 +58,9 @@ func main() {
 		"graceful-shutdown-seconds", gracefulShutdownSeconds,
 	)
 
+	// Fail fast on a missing or wrong configuration, before any connection is opened
+	service.ValidateConfig(ctx)
+
 	// Initialize the repositories based on configuration
 	committeeRetriever := service.CommitteeReaderImpl(ctx)
 	committeeWriter := service.CommitteeWriterImpl(ctx)
@@ -74,12 +77,32 @@ func main() {
 		usecaseSvc.WithProjectRetriever(projectRetriever),
 		usecaseSvc.WithUserReader(userReader),
 		usecaseSvc.WithCommitteePublisher(committeePublisher),
+		usecaseSvc.WithSSONameMaxAttempts(service.SSOGroupNameMaxAttempts()),
+		usecaseSvc.WithMemberRequiredFields(service.MemberRequiredFields()),
+		usecaseSvc.WithAppointedByPolicy(service.AppointedByPolicy()),
+		usecaseSvc.WithLegacyCategories(service.LegacyCategories()),
+		usecaseSvc.WithVotingDisallowedCategories(service.VotingDisallowedCategories()),
+		usecaseSvc.WithPublicRequiresReview(service.PublicRequiresReview()),
+		usecaseSvc.WithMinReviewInterval(service.MinReviewInterval()),
+		usecaseSvc.WithBatchConcurrency(service.BatchConcurrency()),
+		usecaseSvc.WithAllowEmptyWriters(service.AllowEmptyWriters()),
+		usecaseSvc.WithEmailOrganizationDomainMatch(service.EmailOrganizationDomainMatch()),
+		usecaseSvc.WithTagLimits(service.IndexerTagLimits()),
+		usecaseSvc.WithSettingsUpsert(service.SettingsUpsert()),
+		usecaseSvc.WithWebhookNotifier(service.WebhookNotifierImpl(ctx)),
+		usecaseSvc.WithCommitteeLocker(service.CommitteeLockerImpl(ctx)),
 	)
 
 	readCommitteeUseCase := usecaseSvc.NewCommitteeReaderOrchestrator(
 		usecaseSvc.WithCommitteeReader(committeeRetriever),
+		usecaseSvc.WithMemberSensitiveFields(service.MemberSensitiveFields()),
 	)
 
+	// Log the use cases lasting longer than the slow operation threshold
+	slowOperationThreshold := service.SlowOperationThreshold()
+	writeCommitteeUseCase = usecaseSvc.NewSlowOperationLoggingWriter(writeCommitteeUseCase, slowOperationThreshold)
+	readCommitteeUseCase = usecaseSvc.NewSlowOperationLoggingReader(readCommitteeUseCase, slowOperationThreshold)
+
 	committeeServiceSvc := service.NewCommitteeService(writeCommitteeUseCase, readCommitteeUseCase, authService, storage)
 
 	// Wrap the services in endpoints that can be invoked from other services
@@ -9,6 +9,7 @@ import (
 	"log/slog"
 
 	committeeservice "github.com/linuxfoundation/lfx-v2-committee-service/gen/committee_service"
+	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/model"
 	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/port"
 	"github.com/linuxfoundation/lfx-v2-committee-service/internal/service"
 	"github.com/linuxfoundation/lfx-v2-committee-service/pkg/constants"
@@ -93,6 +94,98 @@ func (s *committeeServicesrvc) GetCommitteeBase(ctx context.Context, p *committe
 	return res, nil
 }
 
+// GetCommitteeWithMembers retrieves a committee with its settings and a page of its members.
+func (s *committeeServicesrvc) GetCommitteeWithMembers(ctx context.Context, p *committeeservice.GetCommitteeWithMembersPayload) (res *committeeservice.GetCommitteeWithMembersResult, err error) {
+
+	slog.DebugContext(ctx, "committeeService.get-committee-with-members",
+		"committee_uid", p.UID,
+		"page_size", p.PageSize,
+	)
+
+	memberPage := model.PageOptions{PageSize: p.PageSize}
+	if p.PageToken != nil {
+		memberPage.PageToken = *p.PageToken
+	}
+
+	// Execute use case
+	committee, members, err := s.committeeReaderOrchestrator.GetWithMembers(ctx, *p.UID, memberPage)
+	if err != nil {
+		return nil, wrapError(ctx, err)
+	}
+
+	// Convert domain models to GOA response
+	res = &committeeservice.GetCommitteeWithMembersResult{
+		Committee:    s.convertDomainToFullResponse(committee),
+		Members:      make([]*committeeservice.CommitteeMemberFullWithReadonlyAttributes, 0, len(members.Items)),
+		TotalMembers: members.TotalCount,
+	}
+	for _, member := range members.Items {
+		res.Members = append(res.Members, s.convertMemberDomainToFullResponse(member))
+	}
+	if members.NextPageToken != "" {
+		res.NextPageToken = &members.NextPageToken
+	}
+
+	return res, nil
+}
+
+// ListCommitteeMembersByOrganization retrieves the committee members belonging to an organization.
+func (s *committeeServicesrvc) ListCommitteeMembersByOrganization(ctx context.Context, p *committeeservice.ListCommitteeMembersByOrganizationPayload) (res *committeeservice.ListCommitteeMembersByOrganizationResult, err error) {
+
+	slog.DebugContext(ctx, "committeeService.list-committee-members-by-organization",
+		"committee_uid", p.UID,
+	)
+
+	// Execute use case
+	members, err := s.committeeReaderOrchestrator.ListMembersByOrganization(ctx, p.UID, p.Organization)
+	if err != nil {
+		return nil, wrapError(ctx, err)
+	}
+
+	// Convert domain models to GOA response
+	res = &committeeservice.ListCommitteeMembersByOrganizationResult{
+		Members: make([]*committeeservice.CommitteeMemberFullWithReadonlyAttributes, 0, len(members)),
+	}
+	for _, member := range members {
+		res.Members = append(res.Members, s.convertMemberDomainToFullResponse(member))
+	}
+
+	return res, nil
+}
+
+// ListCommitteeChildren retrieves a page of the direct sub-committees of a committee.
+func (s *committeeServicesrvc) ListCommitteeChildren(ctx context.Context, p *committeeservice.ListCommitteeChildrenPayload) (res *committeeservice.ListCommitteeChildrenResult, err error) {
+
+	slog.DebugContext(ctx, "committeeService.list-committee-children",
+		"committee_uid", p.UID,
+		"page_size", p.PageSize,
+	)
+
+	pageToken := ""
+	if p.PageToken != nil {
+		pageToken = *p.PageToken
+	}
+
+	// Execute use case
+	children, nextPageToken, err := s.committeeReaderOrchestrator.ListChildren(ctx, *p.UID, p.PageSize, pageToken)
+	if err != nil {
+		return nil, wrapError(ctx, err)
+	}
+
+	// Convert domain models to GOA response
+	res = &committeeservice.ListCommitteeChildrenResult{
+		Children: make([]*committeeservice.CommitteeBaseWithReadonlyAttributes, 0, len(children)),
+	}
+	for _, child := range children {
+		res.Children = append(res.Children, s.convertBaseToResponse(child))
+	}
+	if nextPageToken != "" {
+		res.NextPageToken = &nextPageToken
+	}
+
+	return res, nil
+}
+
 // Update Committee
 func (s *committeeServicesrvc) UpdateCommitteeBase(ctx context.Context, p *committeeservice.UpdateCommitteeBasePayload) (res *committeeservice.CommitteeBaseWithReadonlyAttributes, err error) {
 	slog.DebugContext(ctx, "committeeService.update-committee-base",
@@ -100,8 +193,9 @@ func (s *committeeServicesrvc) UpdateCommitteeBase(ctx context.Context, p *commi
 		"x_sync", p.XSync,
 	)
 
-	// Parse ETag to get revision for optimistic locking
-	parsedRevision, err := etagValidator(p.IfMatch)
+	// Parse ETag to get revision for optimistic locking,
+	// using the base component when a composite ETag is provided
+	parsedRevision, err := etagComponentValidator(p.IfMatch, etagComponentBase)
 	if err != nil {
 		slog.ErrorContext(ctx, "invalid ETag",
 			"error", err,
@@ -186,8 +280,9 @@ func (s *committeeServicesrvc) UpdateCommitteeSettings(ctx context.Context, p *c
 		"x_sync", p.XSync,
 	)
 
-	// Parse ETag to get revision for optimistic locking
-	parsedRevision, err := etagValidator(p.IfMatch)
+	// Parse ETag to get revision for optimistic locking,
+	// using the settings component when a composite ETag is provided
+	parsedRevision, err := etagComponentValidator(p.IfMatch, etagComponentSettings)
 	if err != nil {
 		slog.ErrorContext(ctx, "invalid ETag",
 			"error", err,
@@ -236,6 +331,32 @@ func (s *committeeServicesrvc) CreateCommitteeMember(ctx context.Context, p *com
 	return result, nil
 }
 
+// ReassignCommitteeMembersOrganization moves the committee members of an organization to another one
+func (s *committeeServicesrvc) ReassignCommitteeMembersOrganization(ctx context.Context, p *committeeservice.ReassignCommitteeMembersOrganizationPayload) (res *committeeservice.ReassignCommitteeMembersOrganizationResult, err error) {
+
+	toOrganization := ""
+	if p.ToOrganization != nil {
+		toOrganization = *p.ToOrganization
+	}
+
+	slog.DebugContext(ctx, "committeeMemberService.reassign-committee-members-organization",
+		"committee_uid", p.UID,
+		"from_organization", p.FromOrganization,
+		"to_organization", toOrganization,
+		"x_sync", p.XSync,
+	)
+
+	// Execute use case
+	results, err := s.committeeWriterOrchestrator.ReassignOrganization(ctx, p.UID, p.FromOrganization, toOrganization, p.XSync)
+	if err != nil {
+		return nil, wrapError(ctx, err)
+	}
+
+	return &committeeservice.ReassignCommitteeMembersOrganizationResult{
+		Results: s.convertMemberReassignResultsToResponse(results),
+	}, nil
+}
+
 // GetCommitteeMember retrieves a specific committee member by UID
 func (s *committeeServicesrvc) GetCommitteeMember(ctx context.Context, p *committeeservice.GetCommitteeMemberPayload) (res *committeeservice.GetCommitteeMemberResult, err error) {
 
@@ -263,6 +384,32 @@ func (s *committeeServicesrvc) GetCommitteeMember(ctx context.Context, p *commit
 	return res, nil
 }
 
+// GetCommitteeMemberHistory retrieves the stored revisions of a committee member, newest first
+func (s *committeeServicesrvc) GetCommitteeMemberHistory(ctx context.Context, p *committeeservice.GetCommitteeMemberHistoryPayload) (res *committeeservice.GetCommitteeMemberHistoryResult, err error) {
+
+	slog.DebugContext(ctx, "committeeMemberService.get-committee-member-history",
+		"committee_uid", p.UID,
+		"member_uid", p.MemberUID,
+		"limit", p.Limit,
+	)
+
+	// Execute use case
+	revisions, err := s.committeeReaderOrchestrator.GetMemberHistory(ctx, p.UID, p.MemberUID, p.Limit)
+	if err != nil {
+		return nil, wrapError(ctx, err)
+	}
+
+	// Convert domain models to GOA response
+	res = &committeeservice.GetCommitteeMemberHistoryResult{
+		Revisions: make([]*committeeservice.CommitteeMemberRevision, 0, len(revisions)),
+	}
+	for _, revision := range revisions {
+		res.Revisions = append(res.Revisions, s.convertMemberRevisionDomainToResponse(revision))
+	}
+
+	return res, nil
+}
+
 // UpdateCommitteeMember updates an existing committee member
 func (s *committeeServicesrvc) UpdateCommitteeMember(ctx context.Context, p *committeeservice.UpdateCommitteeMemberPayload) (res *committeeservice.CommitteeMemberFullWithReadonlyAttributes, err error) {
 
@@ -285,6 +432,16 @@ func (s *committeeServicesrvc) UpdateCommitteeMember(ctx context.Context, p *com
 		return nil, wrapError(ctx, err)
 	}
 
+	// The committee UID of the payload, when sent, must be the one of the path
+	if err := memberCommitteeUIDValidator(p.UID, p.CommitteeUID); err != nil {
+		slog.WarnContext(ctx, "committee UID mismatch between path and payload",
+			"error", err,
+			"committee_uid", p.UID,
+			"member_uid", p.MemberUID,
+		)
+		return nil, wrapError(ctx, err)
+	}
+
 	// Convert payload to domain model
 	committeeMember := s.convertPayloadToUpdateMember(p)
 
@@ -300,6 +457,42 @@ func (s *committeeServicesrvc) UpdateCommitteeMember(ctx context.Context, p *com
 	return result, nil
 }
 
+// PatchCommitteeMember updates the fields of a committee member sent in the payload, leaving the omitted ones unchanged
+func (s *committeeServicesrvc) PatchCommitteeMember(ctx context.Context, p *committeeservice.PatchCommitteeMemberPayload) (res *committeeservice.CommitteeMemberFullWithReadonlyAttributes, err error) {
+
+	slog.DebugContext(ctx, "committeeMemberService.patch-committee-member",
+		"committee_uid", p.UID,
+		"member_uid", p.MemberUID,
+		"x_sync", p.XSync,
+	)
+
+	// Parse ETag to get revision for optimistic locking
+	parsedRevision, err := etagValidator(p.IfMatch)
+	if err != nil {
+		slog.ErrorContext(ctx, "invalid ETag",
+			"error", err,
+			"etag", p.IfMatch,
+			"committee_uid", p.UID,
+			"member_uid", p.MemberUID,
+		)
+		return nil, wrapError(ctx, err)
+	}
+
+	// Convert payload to domain patch
+	patch := s.convertPayloadToMemberPatch(p)
+
+	// Execute use case
+	patchedMember, err := s.committeeWriterOrchestrator.PatchMember(ctx, p.UID, p.MemberUID, patch, parsedRevision, p.XSync)
+	if err != nil {
+		return nil, wrapError(ctx, err)
+	}
+
+	// Convert response to GOA result
+	result := s.convertMemberDomainToFullResponse(patchedMember)
+
+	return result, nil
+}
+
 // DeleteCommitteeMember removes a member from a committee
 func (s *committeeServicesrvc) DeleteCommitteeMember(ctx context.Context, p *committeeservice.DeleteCommitteeMemberPayload) error {
 
@@ -335,7 +528,7 @@ func (s *committeeServicesrvc) Readyz(ctx context.Context) (res []byte, err erro
 	// Check NATS readiness
 	if err := s.storage.IsReady(ctx); err != nil {
 		slog.ErrorContext(ctx, "service not ready", "error", err)
-		return nil, err // This will automatically return ServiceUnavailable
+		return nil, wrapError(ctx, err)
 	}
 
 	return []byte("OK\n"), nil
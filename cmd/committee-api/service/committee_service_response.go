@@ -55,6 +55,9 @@ func (s *committeeServicesrvc) convertPayloadToBase(p *committeeservice.CreateCo
 	// Handle ParentUID (already a pointer, safe to assign directly)
 	base.ParentUID = p.ParentUID
 
+	// Handle Labels (already a map, safe to assign directly)
+	base.Labels = p.Labels
+
 	// Handle calendar if present
 	if p.Calendar != nil {
 		base.Calendar = model.Calendar{
@@ -75,6 +78,7 @@ func (s *committeeServicesrvc) convertPayloadToSettings(p *committeeservice.Crea
 		Auditors:              p.Auditors,
 		ShowMeetingAttendees:  p.ShowMeetingAttendees,
 		MemberVisibility:      p.MemberVisibility,
+		AllowedEmailDomains:   p.AllowedEmailDomains,
 	}
 
 	// Handle LastReviewedAt - GOA validates format via Pattern constraint
@@ -119,6 +123,9 @@ func (s *committeeServicesrvc) convertPayloadToUpdateBase(p *committeeservice.Up
 	// Handle ParentUID (already a pointer, safe to assign directly)
 	base.ParentUID = p.ParentUID
 
+	// Handle Labels (already a map, safe to assign directly)
+	base.Labels = p.Labels
+
 	// Handle calendar if present
 	if p.Calendar != nil {
 		base.Calendar = model.Calendar{
@@ -151,6 +158,7 @@ func (s *committeeServicesrvc) convertPayloadToUpdateSettings(p *committeeservic
 		Auditors:              p.Auditors,
 		ShowMeetingAttendees:  p.ShowMeetingAttendees,
 		MemberVisibility:      p.MemberVisibility,
+		AllowedEmailDomains:   p.AllowedEmailDomains,
 	}
 
 	return settings
@@ -185,6 +193,9 @@ func (s *committeeServicesrvc) convertDomainToFullResponse(response *model.Commi
 	if response.ParentUID != nil && *response.ParentUID != "" {
 		result.ParentUID = response.ParentUID
 	}
+	if len(response.Labels) > 0 {
+		result.Labels = response.Labels
+	}
 	if response.SSOGroupName != "" {
 		result.SsoGroupName = &response.SSOGroupName
 	}
@@ -195,6 +206,14 @@ func (s *committeeServicesrvc) convertDomainToFullResponse(response *model.Commi
 		result.TotalVotingRepos = &response.TotalVotingRepos
 	}
 
+	// Handle search index status
+	indexStatus := response.IndexStatus()
+	result.IndexStatus = &indexStatus
+	if response.LastIndexedAt != nil {
+		lastIndexedAt := response.LastIndexedAt.Format("2006-01-02T15:04:05Z07:00")
+		result.LastIndexedAt = &lastIndexedAt
+	}
+
 	// Handle Calendar mapping
 	result.Calendar = &struct {
 		Public bool
@@ -220,6 +239,9 @@ func (s *committeeServicesrvc) convertDomainToFullResponse(response *model.Commi
 
 		result.ShowMeetingAttendees = response.ShowMeetingAttendees
 		result.MemberVisibility = response.MemberVisibility
+		if len(response.AllowedEmailDomains) > 0 {
+			result.AllowedEmailDomains = response.AllowedEmailDomains
+		}
 	}
 
 	return result
@@ -258,6 +280,9 @@ func (s *committeeServicesrvc) convertBaseToResponse(base *model.CommitteeBase)
 	if base.ParentUID != nil && *base.ParentUID != "" {
 		result.ParentUID = base.ParentUID
 	}
+	if len(base.Labels) > 0 {
+		result.Labels = base.Labels
+	}
 	if base.SSOGroupName != "" {
 		result.SsoGroupName = &base.SSOGroupName
 	}
@@ -268,6 +293,14 @@ func (s *committeeServicesrvc) convertBaseToResponse(base *model.CommitteeBase)
 		result.TotalVotingRepos = &base.TotalVotingRepos
 	}
 
+	// Handle search index status
+	indexStatus := base.IndexStatus()
+	result.IndexStatus = &indexStatus
+	if base.LastIndexedAt != nil {
+		lastIndexedAt := base.LastIndexedAt.Format("2006-01-02T15:04:05Z07:00")
+		result.LastIndexedAt = &lastIndexedAt
+	}
+
 	// Handle Calendar mapping
 	result.Calendar = &struct {
 		Public bool
@@ -292,6 +325,9 @@ func (s *committeeServicesrvc) convertSettingsToResponse(settings *model.Committ
 	}
 
 	// Only set optional fields if they have values
+	if len(settings.AllowedEmailDomains) > 0 {
+		result.AllowedEmailDomains = settings.AllowedEmailDomains
+	}
 	if settings.LastReviewedAt != nil && *settings.LastReviewedAt != "" {
 		result.LastReviewedAt = settings.LastReviewedAt
 	}
@@ -322,10 +358,11 @@ func (s *committeeServicesrvc) convertMemberPayloadToDomain(p *committeeservice.
 
 	member := &model.CommitteeMember{
 		CommitteeMemberBase: model.CommitteeMemberBase{
-			CommitteeUID: p.UID,
-			Email:        p.Email,
-			AppointedBy:  p.AppointedBy,
-			Status:       p.Status,
+			CommitteeUID:    p.UID,
+			Email:           p.Email,
+			AppointedBy:     p.AppointedBy,
+			Status:          p.Status,
+			AlternateForUID: p.AlternateForUID,
 		},
 	}
 
@@ -378,6 +415,7 @@ func (s *committeeServicesrvc) convertMemberPayloadToDomain(p *committeeservice.
 		if p.Voting.EndDate != nil {
 			member.Voting.EndDate = *p.Voting.EndDate
 		}
+		member.Voting.Weight = p.Voting.Weight
 	}
 
 	// Handle Organization if present
@@ -405,11 +443,12 @@ func (s *committeeServicesrvc) convertPayloadToUpdateMember(p *committeeservice.
 
 	member := &model.CommitteeMember{
 		CommitteeMemberBase: model.CommitteeMemberBase{
-			UID:          p.MemberUID, // Member UID is required for updates
-			CommitteeUID: p.UID,       // Committee UID from path parameter
-			Email:        p.Email,
-			AppointedBy:  p.AppointedBy,
-			Status:       p.Status,
+			UID:             p.MemberUID, // Member UID is required for updates
+			CommitteeUID:    p.UID,       // Committee UID from path parameter
+			Email:           p.Email,
+			AppointedBy:     p.AppointedBy,
+			Status:          p.Status,
+			AlternateForUID: p.AlternateForUID,
 		},
 	}
 
@@ -462,6 +501,7 @@ func (s *committeeServicesrvc) convertPayloadToUpdateMember(p *committeeservice.
 		if p.Voting.EndDate != nil {
 			member.Voting.EndDate = *p.Voting.EndDate
 		}
+		member.Voting.Weight = p.Voting.Weight
 	}
 
 	// Handle Organization if present
@@ -480,6 +520,76 @@ func (s *committeeServicesrvc) convertPayloadToUpdateMember(p *committeeservice.
 	return member
 }
 
+// convertPayloadToMemberPatch converts GOA payload to the domain partial member update,
+// keeping omitted fields nil so they are left unchanged
+func (s *committeeServicesrvc) convertPayloadToMemberPatch(p *committeeservice.PatchCommitteeMemberPayload) *model.CommitteeMemberPatch {
+	// Check for nil payload to avoid panic
+	if p == nil {
+		return &model.CommitteeMemberPatch{}
+	}
+
+	patch := &model.CommitteeMemberPatch{
+		Username:        p.Username,
+		Email:           p.Email,
+		FirstName:       p.FirstName,
+		LastName:        p.LastName,
+		JobTitle:        p.JobTitle,
+		LinkedInProfile: p.LinkedinProfile,
+		AppointedBy:     p.AppointedBy,
+		Status:          p.Status,
+		AlternateForUID: p.AlternateForUID,
+	}
+
+	if p.Role != nil {
+		patch.Role = &model.CommitteeMemberRolePatch{
+			Name:      p.Role.Name,
+			StartDate: p.Role.StartDate,
+			EndDate:   p.Role.EndDate,
+		}
+	}
+
+	if p.Voting != nil {
+		patch.Voting = &model.CommitteeMemberVotingPatch{
+			Status:    p.Voting.Status,
+			StartDate: p.Voting.StartDate,
+			EndDate:   p.Voting.EndDate,
+			Weight:    p.Voting.Weight,
+		}
+	}
+
+	if p.Organization != nil {
+		patch.Organization = &model.CommitteeMemberOrganizationPatch{
+			ID:      p.Organization.ID,
+			Name:    p.Organization.Name,
+			Website: p.Organization.Website,
+		}
+	}
+
+	return patch
+}
+
+// convertMemberReassignResultsToResponse converts the per member reassignment outcomes to GOA response types
+func (s *committeeServicesrvc) convertMemberReassignResultsToResponse(results []*model.CommitteeMemberReassignResult) []*committeeservice.CommitteeMemberReassignResult {
+	response := make([]*committeeservice.CommitteeMemberReassignResult, 0, len(results))
+	for _, result := range results {
+		if result == nil {
+			continue
+		}
+		item := &committeeservice.CommitteeMemberReassignResult{
+			UID:   result.MemberUID,
+			Email: result.Email,
+		}
+		if result.Err != nil {
+			errMessage := result.Err.Error()
+			item.Error = &errMessage
+		} else if result.Member != nil {
+			item.Member = s.convertMemberDomainToFullResponse(result.Member)
+		}
+		response = append(response, item)
+	}
+	return response
+}
+
 // convertMemberDomainToFullResponse converts domain CommitteeMember to GOA response type
 func (s *committeeServicesrvc) convertMemberDomainToFullResponse(member *model.CommitteeMember) *committeeservice.CommitteeMemberFullWithReadonlyAttributes {
 	if member == nil {
@@ -487,11 +597,12 @@ func (s *committeeServicesrvc) convertMemberDomainToFullResponse(member *model.C
 	}
 
 	result := &committeeservice.CommitteeMemberFullWithReadonlyAttributes{
-		CommitteeUID: &member.CommitteeUID,
-		UID:          &member.UID,
-		Email:        &member.Email,
-		AppointedBy:  member.AppointedBy,
-		Status:       member.Status,
+		CommitteeUID:    &member.CommitteeUID,
+		UID:             &member.UID,
+		Email:           &member.Email,
+		AppointedBy:     member.AppointedBy,
+		Status:          member.Status,
+		AlternateForUID: member.AlternateForUID,
 	}
 
 	// Only set optional fields if they have values
@@ -537,12 +648,15 @@ func (s *committeeServicesrvc) convertMemberDomainToFullResponse(member *model.C
 
 	// Handle Voting mapping - only include if voting has meaningful data
 	if member.Voting.Status != "" {
+		weight := member.Voting.EffectiveWeight()
 		voting := &struct {
 			Status    string
 			StartDate *string
 			EndDate   *string
+			Weight    *float64
 		}{
 			Status: member.Voting.Status,
+			Weight: &weight,
 		}
 		if member.Voting.StartDate != "" {
 			voting.StartDate = &member.Voting.StartDate
@@ -583,5 +697,40 @@ func (s *committeeServicesrvc) convertMemberDomainToFullResponse(member *model.C
 		result.UpdatedAt = &updatedAt
 	}
 
+	// Handle role history, read-only
+	for _, change := range member.RoleHistory {
+		roleChange := &committeeservice.CommitteeMemberRoleChange{
+			OldRole:   change.OldRole,
+			NewRole:   change.NewRole,
+			ChangedAt: change.ChangedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+		if change.Actor != "" {
+			roleChange.Actor = &change.Actor
+		}
+		result.RoleHistory = append(result.RoleHistory, roleChange)
+	}
+
+	// Handle status history, read-only
+	for _, change := range member.StatusHistory {
+		statusChange := &committeeservice.CommitteeMemberStatusChange{
+			OldStatus: change.OldStatus,
+			NewStatus: change.NewStatus,
+			ChangedAt: change.ChangedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+		if change.Actor != "" {
+			statusChange.Actor = &change.Actor
+		}
+		result.StatusHistory = append(result.StatusHistory, statusChange)
+	}
+
 	return result
 }
+
+// convertMemberRevisionDomainToResponse converts a stored member revision to its GOA representation
+func (s *committeeServicesrvc) convertMemberRevisionDomainToResponse(revision *model.CommitteeMemberRevision) *committeeservice.CommitteeMemberRevision {
+	return &committeeservice.CommitteeMemberRevision{
+		Revision:  revision.Revision,
+		CreatedAt: revision.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		Member:    s.convertMemberDomainToFullResponse(revision.Member),
+	}
+}
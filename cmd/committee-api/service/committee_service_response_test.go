@@ -134,6 +134,7 @@ func TestConvertPayloadToBase(t *testing.T) {
 				Public:          true,
 				DisplayName:     stringPtr("Test Display Name"),
 				ParentUID:       stringPtr("parent-123"),
+				Labels:          map[string]string{"region": "emea"},
 				Calendar: &struct {
 					Public bool
 				}{
@@ -152,6 +153,7 @@ func TestConvertPayloadToBase(t *testing.T) {
 				Public:          true,
 				DisplayName:     "Test Display Name",
 				ParentUID:       stringPtr("parent-123"),
+				Labels:          map[string]string{"region": "emea"},
 				Calendar: model.Calendar{
 					Public: true,
 				},
@@ -447,6 +449,7 @@ func TestConvertDomainToFullResponse(t *testing.T) {
 				ParentUID:        stringPtr("parent-123"),
 				TotalMembers:     intPtr(10),
 				TotalVotingRepos: intPtr(5),
+				IndexStatus:      stringPtr(model.IndexStatusPending),
 				Calendar: &struct {
 					Public bool
 				}{
@@ -485,6 +488,7 @@ func TestConvertDomainToFullResponse(t *testing.T) {
 				SsoGroupName:     nil,
 				TotalMembers:     nil,
 				TotalVotingRepos: nil,
+				IndexStatus:      stringPtr(model.IndexStatusPending),
 				Calendar: &struct {
 					Public bool
 				}{
@@ -527,6 +531,7 @@ func TestConvertBaseToResponse(t *testing.T) {
 				Public:           true,
 				DisplayName:      "Test Display Name",
 				ParentUID:        stringPtr("parent-123"),
+				Labels:           map[string]string{"region": "emea"},
 				TotalMembers:     15,
 				TotalVotingRepos: 8,
 				Calendar: model.Calendar{
@@ -548,8 +553,10 @@ func TestConvertBaseToResponse(t *testing.T) {
 				Public:           true,
 				DisplayName:      stringPtr("Test Display Name"),
 				ParentUID:        stringPtr("parent-123"),
+				Labels:           map[string]string{"region": "emea"},
 				TotalMembers:     intPtr(15),
 				TotalVotingRepos: intPtr(8),
+				IndexStatus:      stringPtr(model.IndexStatusPending),
 				Calendar: &struct {
 					Public bool
 				}{
@@ -569,6 +576,55 @@ func TestConvertBaseToResponse(t *testing.T) {
 	}
 }
 
+func TestConvertBaseToResponse_IndexStatus(t *testing.T) {
+	updatedAt := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	indexedAt := updatedAt.Add(time.Second)
+	staleIndexedAt := updatedAt.Add(-time.Hour)
+
+	tests := []struct {
+		name                  string
+		lastIndexedAt         *time.Time
+		expectedStatus        string
+		expectedLastIndexedAt *string
+	}{
+		{
+			name:           "never indexed",
+			expectedStatus: model.IndexStatusPending,
+		},
+		{
+			name:                  "indexed after the last update",
+			lastIndexedAt:         &indexedAt,
+			expectedStatus:        model.IndexStatusSynced,
+			expectedLastIndexedAt: stringPtr("2024-01-02T12:00:01Z"),
+		},
+		{
+			name:                  "indexed before the last update",
+			lastIndexedAt:         &staleIndexedAt,
+			expectedStatus:        model.IndexStatusPending,
+			expectedLastIndexedAt: stringPtr("2024-01-02T11:00:00Z"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &committeeServicesrvc{}
+			base := &model.CommitteeBase{
+				UID:           "committee-123",
+				UpdatedAt:     updatedAt,
+				LastIndexedAt: tt.lastIndexedAt,
+			}
+
+			result := svc.convertBaseToResponse(base)
+			assert.Equal(t, &tt.expectedStatus, result.IndexStatus)
+			assert.Equal(t, tt.expectedLastIndexedAt, result.LastIndexedAt)
+
+			full := svc.convertDomainToFullResponse(&model.Committee{CommitteeBase: *base})
+			assert.Equal(t, &tt.expectedStatus, full.IndexStatus)
+			assert.Equal(t, tt.expectedLastIndexedAt, full.LastIndexedAt)
+		})
+	}
+}
+
 func TestConvertSettingsToResponse(t *testing.T) {
 	createdAt := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
 	updatedAt := time.Date(2023, 1, 2, 12, 0, 0, 0, time.UTC)
@@ -657,6 +713,7 @@ func TestConvertMemberPayloadToDomain(t *testing.T) {
 					Status    string
 					StartDate *string
 					EndDate   *string
+					Weight    *float64
 				}{
 					Status:    "eligible",
 					StartDate: stringPtr("2024-01-01"),
@@ -882,8 +939,10 @@ func TestConvertMemberDomainToFullResponse(t *testing.T) {
 					Status    string
 					StartDate *string
 					EndDate   *string
+					Weight    *float64
 				}{
 					Status:    "eligible",
+					Weight:    float64Ptr(model.DefaultVotingWeight),
 					StartDate: stringPtr("2024-01-01"),
 					EndDate:   stringPtr("2024-12-31"),
 				},
@@ -1003,8 +1062,10 @@ func TestConvertMemberDomainToFullResponse(t *testing.T) {
 					Status    string
 					StartDate *string
 					EndDate   *string
+					Weight    *float64
 				}{
 					Status:    "eligible",
+					Weight:    float64Ptr(model.DefaultVotingWeight),
 					StartDate: nil, // Empty dates should be nil
 					EndDate:   nil,
 				},
@@ -1022,6 +1083,70 @@ func TestConvertMemberDomainToFullResponse(t *testing.T) {
 	}
 }
 
+func TestConvertMember_VotingWeight(t *testing.T) {
+	voting := func(weight *float64) *struct {
+		Status    string
+		StartDate *string
+		EndDate   *string
+		Weight    *float64
+	} {
+		return &struct {
+			Status    string
+			StartDate *string
+			EndDate   *string
+			Weight    *float64
+		}{
+			Status: "Voting Rep",
+			Weight: weight,
+		}
+	}
+
+	tests := []struct {
+		name           string
+		weight         *float64
+		expectedDomain *float64
+		expectedWeight float64
+	}{
+		{
+			name:           "weight not provided defaults to 1",
+			weight:         nil,
+			expectedDomain: nil,
+			expectedWeight: model.DefaultVotingWeight,
+		},
+		{
+			name:           "custom weight round-trips",
+			weight:         float64Ptr(2.5),
+			expectedDomain: float64Ptr(2.5),
+			expectedWeight: 2.5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &committeeServicesrvc{}
+
+			created := svc.convertMemberPayloadToDomain(&committeeservice.CreateCommitteeMemberPayload{
+				UID:    "committee-123",
+				Email:  "voter@example.com",
+				Voting: voting(tt.weight),
+			})
+			assert.Equal(t, tt.expectedDomain, created.Voting.Weight)
+
+			updated := svc.convertPayloadToUpdateMember(&committeeservice.UpdateCommitteeMemberPayload{
+				UID:    "committee-123",
+				Email:  "voter@example.com",
+				Voting: voting(tt.weight),
+			})
+			assert.Equal(t, tt.expectedDomain, updated.Voting.Weight)
+
+			response := svc.convertMemberDomainToFullResponse(created)
+			if assert.NotNil(t, response.Voting) && assert.NotNil(t, response.Voting.Weight) {
+				assert.Equal(t, tt.expectedWeight, *response.Voting.Weight)
+			}
+		})
+	}
+}
+
 func TestConvertPayloadToUpdateMember(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -1053,6 +1178,7 @@ func TestConvertPayloadToUpdateMember(t *testing.T) {
 					Status    string
 					StartDate *string
 					EndDate   *string
+					Weight    *float64
 				}{
 					Status:    "eligible",
 					StartDate: stringPtr("2023-01-01"),
@@ -1385,6 +1511,80 @@ func TestConvertMemberDomainToFullResponse_LinkedInProfile(t *testing.T) {
 	}
 }
 
+func TestConvertMemberDomainToFullResponse_RoleHistory(t *testing.T) {
+	changedAt := time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)
+
+	member := &model.CommitteeMember{
+		CommitteeMemberBase: model.CommitteeMemberBase{
+			UID:          "member-123",
+			Email:        "john@example.com",
+			CommitteeUID: "committee-123",
+			RoleHistory: []model.CommitteeMemberRoleChange{
+				{OldRole: "Developer Seat", NewRole: "Chair", ChangedAt: changedAt, Actor: "admin"},
+				{OldRole: "Chair", NewRole: "None", ChangedAt: changedAt},
+			},
+		},
+	}
+
+	svc := &committeeServicesrvc{}
+	result := svc.convertMemberDomainToFullResponse(member)
+
+	expected := []*committeeservice.CommitteeMemberRoleChange{
+		{OldRole: "Developer Seat", NewRole: "Chair", ChangedAt: "2024-03-01T09:30:00Z", Actor: stringPtr("admin")},
+		{OldRole: "Chair", NewRole: "None", ChangedAt: "2024-03-01T09:30:00Z"},
+	}
+	assert.Equal(t, expected, result.RoleHistory)
+}
+
+func TestConvertMemberDomainToFullResponse_StatusHistory(t *testing.T) {
+	changedAt := time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)
+
+	member := &model.CommitteeMember{
+		CommitteeMemberBase: model.CommitteeMemberBase{
+			UID:          "member-123",
+			Email:        "john@example.com",
+			CommitteeUID: "committee-123",
+			StatusHistory: []model.CommitteeMemberStatusChange{
+				{OldStatus: "Active", NewStatus: "Inactive", ChangedAt: changedAt, Actor: "admin"},
+				{OldStatus: "Inactive", NewStatus: "Active", ChangedAt: changedAt},
+			},
+		},
+	}
+
+	svc := &committeeServicesrvc{}
+	result := svc.convertMemberDomainToFullResponse(member)
+
+	expected := []*committeeservice.CommitteeMemberStatusChange{
+		{OldStatus: "Active", NewStatus: "Inactive", ChangedAt: "2024-03-01T09:30:00Z", Actor: stringPtr("admin")},
+		{OldStatus: "Inactive", NewStatus: "Active", ChangedAt: "2024-03-01T09:30:00Z"},
+	}
+	assert.Equal(t, expected, result.StatusHistory)
+}
+
+func TestConvertMemberRevisionDomainToResponse(t *testing.T) {
+	revision := &model.CommitteeMemberRevision{
+		Revision:  3,
+		CreatedAt: time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC),
+		Member: &model.CommitteeMember{
+			CommitteeMemberBase: model.CommitteeMemberBase{
+				UID:          "member-123",
+				Email:        "john@example.com",
+				CommitteeUID: "committee-123",
+			},
+		},
+	}
+
+	svc := &committeeServicesrvc{}
+	result := svc.convertMemberRevisionDomainToResponse(revision)
+
+	assert.Equal(t, uint64(3), result.Revision)
+	assert.Equal(t, "2024-03-01T09:30:00Z", result.CreatedAt)
+	if assert.NotNil(t, result.Member) {
+		assert.Equal(t, stringPtr("member-123"), result.Member.UID)
+		assert.Equal(t, stringPtr("john@example.com"), result.Member.Email)
+	}
+}
+
 func TestConvertPayloadToUpdateMember_LinkedInProfile(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -1465,11 +1665,88 @@ func TestConvertPayloadToUpdateMember_LinkedInProfile(t *testing.T) {
 	}
 }
 
+func TestConvertPayloadToMemberPatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		payload  *committeeservice.PatchCommitteeMemberPayload
+		expected *model.CommitteeMemberPatch
+	}{
+		{
+			name: "omitted job title stays nil",
+			payload: &committeeservice.PatchCommitteeMemberPayload{
+				UID:       "committee-123",
+				MemberUID: "member-456",
+				FirstName: stringPtr("Jane"),
+			},
+			expected: &model.CommitteeMemberPatch{
+				FirstName: stringPtr("Jane"),
+			},
+		},
+		{
+			name: "explicitly empty job title is kept",
+			payload: &committeeservice.PatchCommitteeMemberPayload{
+				UID:       "committee-123",
+				MemberUID: "member-456",
+				JobTitle:  stringPtr(""),
+			},
+			expected: &model.CommitteeMemberPatch{
+				JobTitle: stringPtr(""),
+			},
+		},
+		{
+			name: "sub-objects keep their omitted fields nil",
+			payload: &committeeservice.PatchCommitteeMemberPayload{
+				UID:       "committee-123",
+				MemberUID: "member-456",
+				Role: &struct {
+					Name      *string
+					StartDate *string
+					EndDate   *string
+				}{
+					EndDate: stringPtr("2025-01-01"),
+				},
+				Organization: &struct {
+					ID      *string
+					Name    *string
+					Website *string
+				}{
+					Name: stringPtr("Example Corp"),
+				},
+			},
+			expected: &model.CommitteeMemberPatch{
+				Role: &model.CommitteeMemberRolePatch{
+					EndDate: stringPtr("2025-01-01"),
+				},
+				Organization: &model.CommitteeMemberOrganizationPatch{
+					Name: stringPtr("Example Corp"),
+				},
+			},
+		},
+		{
+			name:     "nil payload",
+			payload:  nil,
+			expected: &model.CommitteeMemberPatch{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &committeeServicesrvc{}
+			result := svc.convertPayloadToMemberPatch(tt.payload)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
 // Helper functions for creating pointers to primitives
 func stringPtr(s string) *string {
 	return &s
 }
 
+func float64Ptr(f float64) *float64 {
+	return &f
+}
+
 func intPtr(i int) *int {
 	return &i
 }
@@ -12,17 +12,20 @@ import (
 
 	committeeservice "github.com/linuxfoundation/lfx-v2-committee-service/gen/committee_service"
 	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/model"
+	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/port"
 	"github.com/linuxfoundation/lfx-v2-committee-service/internal/infrastructure/mock"
 	errs "github.com/linuxfoundation/lfx-v2-committee-service/pkg/errors"
 )
 
 // Mock orchestrator for testing service layer
 type mockCommitteeWriterOrchestrator struct {
+	currentRevision   model.CommitteeRevision
 	deleteError       error
 	deleteCalls       []deleteCall
 	updateMember      *model.CommitteeMember
 	updateMemberErr   error
 	updateMemberCalls []updateMemberCall
+	patchMemberCalls  []patchMemberCall
 }
 
 type updateMemberCall struct {
@@ -30,6 +33,13 @@ type updateMemberCall struct {
 	revision uint64
 }
 
+type patchMemberCall struct {
+	committeeUID string
+	memberUID    string
+	patch        *model.CommitteeMemberPatch
+	revision     uint64
+}
+
 type deleteCall struct {
 	uid      string
 	revision uint64
@@ -40,17 +50,39 @@ func (m *mockCommitteeWriterOrchestrator) Create(ctx context.Context, committee
 }
 
 func (m *mockCommitteeWriterOrchestrator) Update(ctx context.Context, committee *model.Committee, revision uint64, sync bool) (*model.Committee, error) {
-	return nil, errs.NewUnexpected("not implemented for test")
+	if revision != m.currentRevision.Base {
+		return nil, errs.NewConflict("committee has been modified by another process")
+	}
+	return committee, nil
 }
 
 func (m *mockCommitteeWriterOrchestrator) UpdateSettings(ctx context.Context, settings *model.CommitteeSettings, revision uint64, sync bool) (*model.CommitteeSettings, error) {
-	return nil, errs.NewUnexpected("not implemented for test")
+	if revision != m.currentRevision.Settings {
+		return nil, errs.NewConflict("committee settings have been modified by another process")
+	}
+	return settings, nil
 }
 
 func (m *mockCommitteeWriterOrchestrator) Delete(ctx context.Context, uid string, revision uint64, sync bool) error {
 	return errs.NewUnexpected("not implemented for test")
 }
 
+func (m *mockCommitteeWriterOrchestrator) DeleteCascade(ctx context.Context, uid string, revision uint64, sync bool) error {
+	return errs.NewUnexpected("not implemented for test")
+}
+
+func (m *mockCommitteeWriterOrchestrator) Touch(ctx context.Context, uid string, revision uint64, sync bool) (*model.Committee, error) {
+	return nil, errs.NewUnexpected("not implemented for test")
+}
+
+func (m *mockCommitteeWriterOrchestrator) RecountMembers(ctx context.Context, uid string, sync bool) (*model.CommitteeBase, error) {
+	return nil, errs.NewUnexpected("not implemented for test")
+}
+
+func (m *mockCommitteeWriterOrchestrator) MarkReviewed(ctx context.Context, uid, reviewer string, revision uint64, sync bool) (*model.CommitteeSettings, error) {
+	return nil, errs.NewUnexpected("not implemented for test")
+}
+
 func (m *mockCommitteeWriterOrchestrator) CreateMember(ctx context.Context, member *model.CommitteeMember, sync bool) (*model.CommitteeMember, error) {
 	return nil, errs.NewUnexpected("not implemented for test")
 }
@@ -63,11 +95,31 @@ func (m *mockCommitteeWriterOrchestrator) UpdateMember(ctx context.Context, memb
 	return m.updateMember, nil
 }
 
+func (m *mockCommitteeWriterOrchestrator) PatchMember(ctx context.Context, committeeUID, memberUID string, patch *model.CommitteeMemberPatch, revision uint64, sync bool) (*model.CommitteeMember, error) {
+	m.patchMemberCalls = append(m.patchMemberCalls, patchMemberCall{committeeUID: committeeUID, memberUID: memberUID, patch: patch, revision: revision})
+	if m.updateMemberErr != nil {
+		return nil, m.updateMemberErr
+	}
+	return m.updateMember, nil
+}
+
+func (m *mockCommitteeWriterOrchestrator) SelfUpdateMember(ctx context.Context, member *model.CommitteeMember, revision uint64, sync bool) (*model.CommitteeMember, error) {
+	return nil, errs.NewUnexpected("not implemented for test")
+}
+
 func (m *mockCommitteeWriterOrchestrator) DeleteMember(ctx context.Context, uid string, revision uint64, sync bool) error {
 	m.deleteCalls = append(m.deleteCalls, deleteCall{uid: uid, revision: revision})
 	return m.deleteError
 }
 
+func (m *mockCommitteeWriterOrchestrator) ValidateMembers(ctx context.Context, committeeUID string, members []*model.CommitteeMember) ([]*model.CommitteeMemberValidationResult, error) {
+	return nil, errs.NewUnexpected("not implemented for test")
+}
+
+func (m *mockCommitteeWriterOrchestrator) ReassignOrganization(ctx context.Context, committeeUID, fromOrg, toOrg string, sync bool) ([]*model.CommitteeMemberReassignResult, error) {
+	return nil, errs.NewUnexpected("not implemented for test")
+}
+
 func setupServiceTest() (*committeeServicesrvc, *mockCommitteeWriterOrchestrator) {
 	mockOrchestrator := &mockCommitteeWriterOrchestrator{}
 	mockRepo := mock.NewMockRepository()
@@ -379,6 +431,50 @@ func TestUpdateCommitteeMember(t *testing.T) {
 				assert.Empty(t, calls)
 			},
 		},
+		{
+			name: "payload committee uid matching the path",
+			payload: &committeeservice.UpdateCommitteeMemberPayload{
+				UID:          "committee-123",
+				MemberUID:    "member-456",
+				CommitteeUID: stringPtr("committee-123"),
+				Email:        "test@example.com",
+				AppointedBy:  "admin",
+				Status:       "active",
+				IfMatch:      stringPtr("1"),
+			},
+			setupMock: func(mock *mockCommitteeWriterOrchestrator) {
+				mock.updateMember = &model.CommitteeMember{
+					CommitteeMemberBase: model.CommitteeMemberBase{
+						UID:          "member-456",
+						CommitteeUID: "committee-123",
+						Email:        "test@example.com",
+					},
+				}
+			},
+			expectError: false,
+			validateCall: func(t *testing.T, calls []updateMemberCall) {
+				require.Len(t, calls, 1)
+				assert.Equal(t, "committee-123", calls[0].member.CommitteeUID)
+			},
+		},
+		{
+			name: "payload committee uid not matching the path",
+			payload: &committeeservice.UpdateCommitteeMemberPayload{
+				UID:          "committee-123",
+				MemberUID:    "member-456",
+				CommitteeUID: stringPtr("committee-999"),
+				Email:        "test@example.com",
+				AppointedBy:  "admin",
+				Status:       "active",
+				IfMatch:      stringPtr("1"),
+			},
+			setupMock:     func(mock *mockCommitteeWriterOrchestrator) {},
+			expectError:   true,
+			expectedError: "does not match the committee UID of the path",
+			validateCall: func(t *testing.T, calls []updateMemberCall) {
+				assert.Empty(t, calls)
+			},
+		},
 		{
 			name: "orchestrator error - not found",
 			payload: &committeeservice.UpdateCommitteeMemberPayload{
@@ -457,3 +553,146 @@ func TestUpdateCommitteeMember(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateCommittee_CompositeETag(t *testing.T) {
+	current := model.CommitteeRevision{Base: 3, Settings: 7}
+
+	tests := []struct {
+		name          string
+		etag          string
+		updateBase    bool
+		expectError   bool
+		expectedError string
+	}{
+		{
+			name:       "base update with composite etag matching base revision",
+			etag:       compositeETag(current),
+			updateBase: true,
+		},
+		{
+			name:       "settings update with composite etag matching settings revision",
+			etag:       compositeETag(current),
+			updateBase: false,
+		},
+		{
+			name:          "base update ignores settings divergence but detects stale base",
+			etag:          compositeETag(model.CommitteeRevision{Base: 2, Settings: 7}),
+			updateBase:    true,
+			expectError:   true,
+			expectedError: "committee has been modified",
+		},
+		{
+			name:       "base update succeeds when only settings moved on",
+			etag:       compositeETag(model.CommitteeRevision{Base: 3, Settings: 6}),
+			updateBase: true,
+		},
+		{
+			name:          "settings update detects stale settings",
+			etag:          compositeETag(model.CommitteeRevision{Base: 3, Settings: 6}),
+			updateBase:    false,
+			expectError:   true,
+			expectedError: "committee settings have been modified",
+		},
+		{
+			name:       "plain etag is still accepted for settings",
+			etag:       "7",
+			updateBase: false,
+		},
+		{
+			name:          "malformed composite etag",
+			etag:          "base:3-members:7",
+			updateBase:    true,
+			expectError:   true,
+			expectedError: "invalid ETag format",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockOrchestrator := setupServiceTest()
+			mockOrchestrator.currentRevision = current
+			ctx := context.Background()
+			uid := "committee-123"
+
+			var err error
+			if tt.updateBase {
+				_, err = service.UpdateCommitteeBase(ctx, &committeeservice.UpdateCommitteeBasePayload{
+					UID:        &uid,
+					ProjectUID: "project-123",
+					Name:       "TSC",
+					Category:   "Technical Steering Committee",
+					IfMatch:    &tt.etag,
+				})
+			} else {
+				_, err = service.UpdateCommitteeSettings(ctx, &committeeservice.UpdateCommitteeSettingsPayload{
+					UID:     &uid,
+					IfMatch: &tt.etag,
+				})
+			}
+
+			if tt.expectError {
+				require.Error(t, err)
+				switch e := err.(type) {
+				case *committeeservice.BadRequestError:
+					assert.Contains(t, e.Message, tt.expectedError)
+				case *committeeservice.ConflictError:
+					assert.Contains(t, e.Message, tt.expectedError)
+				default:
+					assert.Contains(t, err.Error(), tt.expectedError)
+				}
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+// unavailableStorage reports the storage as not ready
+type unavailableStorage struct {
+	port.CommitteeReaderWriter
+	readyErr error
+}
+
+func (s *unavailableStorage) IsReady(ctx context.Context) error {
+	return s.readyErr
+}
+
+func TestReadyz(t *testing.T) {
+	tests := []struct {
+		name        string
+		readyErr    error
+		expectError bool
+	}{
+		{
+			name: "ready",
+		},
+		{
+			name:        "NATS unavailable maps to service unavailable",
+			readyErr:    errs.NewServiceUnavailable("NATS key-value bucket committees is not reachable"),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _ := setupServiceTest()
+			service.storage = &unavailableStorage{
+				CommitteeReaderWriter: service.storage,
+				readyErr:              tt.readyErr,
+			}
+
+			res, err := service.Readyz(context.Background())
+
+			if tt.expectError {
+				require.Error(t, err)
+				assert.Nil(t, res)
+				var unavailableErr *committeeservice.ServiceUnavailableError
+				assert.ErrorAs(t, err, &unavailableErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, []byte("OK\n"), res)
+		})
+	}
+}
@@ -4,11 +4,27 @@
 package service
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
 
+	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/model"
 	"github.com/linuxfoundation/lfx-v2-committee-service/pkg/errors"
 )
 
+// etagComponent identifies which record of a full committee an ETag revision applies to
+type etagComponent string
+
+const (
+	etagComponentBase     etagComponent = "base"
+	etagComponentSettings etagComponent = "settings"
+)
+
+// compositeETag encodes the base and settings revisions of a full committee as "base:N-settings:M"
+func compositeETag(revision model.CommitteeRevision) string {
+	return fmt.Sprintf("%s:%d-%s:%d", etagComponentBase, revision.Base, etagComponentSettings, revision.Settings)
+}
+
 func etagValidator(etag *string) (uint64, error) {
 
 	// Parse ETag to get revision for optimistic locking
@@ -22,3 +38,52 @@ func etagValidator(etag *string) (uint64, error) {
 
 	return parsedRevision, nil
 }
+
+// etagComponentValidator parses an ETag and returns the revision of the given component.
+// It accepts both a plain revision, as returned by the base and settings endpoints,
+// and a composite "base:N-settings:M" revision, as returned for full resources.
+func etagComponentValidator(etag *string, component etagComponent) (uint64, error) {
+
+	if etag == nil || *etag == "" || !strings.Contains(*etag, ":") {
+		return etagValidator(etag)
+	}
+
+	revisions := make(map[etagComponent]uint64, 2)
+	for _, part := range strings.Split(*etag, "-") {
+		name, value, found := strings.Cut(part, ":")
+		if !found {
+			return 0, errors.NewValidation("invalid ETag format")
+		}
+		key := etagComponent(name)
+		if key != etagComponentBase && key != etagComponentSettings {
+			return 0, errors.NewValidation(fmt.Sprintf("invalid ETag format: unknown component %q", name))
+		}
+		if _, duplicated := revisions[key]; duplicated {
+			return 0, errors.NewValidation(fmt.Sprintf("invalid ETag format: duplicated component %q", name))
+		}
+		parsedRevision, errParse := strconv.ParseUint(value, 10, 64)
+		if errParse != nil {
+			return 0, errors.NewValidation("invalid ETag format", errParse)
+		}
+		revisions[key] = parsedRevision
+	}
+
+	revision, ok := revisions[component]
+	if !ok {
+		return 0, errors.NewValidation(fmt.Sprintf("invalid ETag format: missing %s revision", component))
+	}
+
+	return revision, nil
+}
+
+// memberCommitteeUIDValidator checks that the committee UID sent in a member payload, when present,
+// is the committee UID of the path, so a member is never associated with another committee
+func memberCommitteeUIDValidator(pathUID string, payloadUID *string) error {
+	if payloadUID == nil || *payloadUID == "" {
+		return nil
+	}
+	if *payloadUID != pathUID {
+		return errors.NewValidation(fmt.Sprintf("committee_uid %q does not match the committee UID of the path %q", *payloadUID, pathUID))
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ package service
 import (
 	"testing"
 
+	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/model"
 	"github.com/linuxfoundation/lfx-v2-committee-service/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -195,3 +196,149 @@ func TestEtagValidatorEdgeCases(t *testing.T) {
 		assert.Equal(t, uint64(0), result)
 	})
 }
+
+func TestCompositeETag(t *testing.T) {
+	assert.Equal(t, "base:1-settings:1", compositeETag(model.CommitteeRevision{Base: 1, Settings: 1}))
+	assert.Equal(t, "base:12-settings:3", compositeETag(model.CommitteeRevision{Base: 12, Settings: 3}))
+	assert.Equal(t, "base:0-settings:0", compositeETag(model.CommitteeRevision{}))
+}
+
+func TestEtagComponentValidator(t *testing.T) {
+	tests := []struct {
+		name           string
+		etag           *string
+		component      etagComponent
+		expectedResult uint64
+		expectError    bool
+		errorMessage   string
+	}{
+		{
+			name:           "plain etag",
+			etag:           stringPtr("5"),
+			component:      etagComponentBase,
+			expectedResult: 5,
+		},
+		{
+			name:           "composite etag - base component",
+			etag:           stringPtr("base:12-settings:3"),
+			component:      etagComponentBase,
+			expectedResult: 12,
+		},
+		{
+			name:           "composite etag - settings component",
+			etag:           stringPtr("base:12-settings:3"),
+			component:      etagComponentSettings,
+			expectedResult: 3,
+		},
+		{
+			name:           "composite etag - components in any order",
+			etag:           stringPtr("settings:3-base:12"),
+			component:      etagComponentBase,
+			expectedResult: 12,
+		},
+		{
+			name:           "round trip with compositeETag",
+			etag:           stringPtr(compositeETag(model.CommitteeRevision{Base: 8, Settings: 9})),
+			component:      etagComponentSettings,
+			expectedResult: 9,
+		},
+		{
+			name:         "nil etag",
+			etag:         nil,
+			component:    etagComponentBase,
+			expectError:  true,
+			errorMessage: "ETag is required for update operations",
+		},
+		{
+			name:         "missing requested component",
+			etag:         stringPtr("base:12"),
+			component:    etagComponentSettings,
+			expectError:  true,
+			errorMessage: "missing settings revision",
+		},
+		{
+			name:         "unknown component",
+			etag:         stringPtr("base:12-members:3"),
+			component:    etagComponentBase,
+			expectError:  true,
+			errorMessage: "unknown component",
+		},
+		{
+			name:         "duplicated component",
+			etag:         stringPtr("base:12-base:13"),
+			component:    etagComponentBase,
+			expectError:  true,
+			errorMessage: "duplicated component",
+		},
+		{
+			name:         "non-numeric revision",
+			etag:         stringPtr("base:abc-settings:3"),
+			component:    etagComponentBase,
+			expectError:  true,
+			errorMessage: "invalid ETag format",
+		},
+		{
+			name:         "part without separator",
+			etag:         stringPtr("base:12-settings"),
+			component:    etagComponentBase,
+			expectError:  true,
+			errorMessage: "invalid ETag format",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := etagComponentValidator(tt.etag, tt.component)
+
+			if tt.expectError {
+				require.Error(t, err)
+				assert.IsType(t, errors.Validation{}, err)
+				assert.Contains(t, err.Error(), tt.errorMessage)
+				assert.Equal(t, uint64(0), result)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, result)
+		})
+	}
+}
+
+func TestMemberCommitteeUIDValidator(t *testing.T) {
+	tests := []struct {
+		name        string
+		payloadUID  *string
+		expectError bool
+	}{
+		{
+			name:       "committee uid not sent",
+			payloadUID: nil,
+		},
+		{
+			name:       "empty committee uid",
+			payloadUID: stringPtr(""),
+		},
+		{
+			name:       "matching committee uid",
+			payloadUID: stringPtr("committee-123"),
+		},
+		{
+			name:        "mismatched committee uid",
+			payloadUID:  stringPtr("committee-999"),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := memberCommitteeUIDValidator("committee-123", tt.payloadUID)
+
+			if tt.expectError {
+				require.Error(t, err)
+				assert.IsType(t, errors.Validation{}, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
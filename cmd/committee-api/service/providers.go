@@ -10,13 +10,16 @@ import (
 	"log/slog"
 	"os"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/model"
 	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/port"
 	"github.com/linuxfoundation/lfx-v2-committee-service/internal/infrastructure/auth"
 	infrastructure "github.com/linuxfoundation/lfx-v2-committee-service/internal/infrastructure/mock"
 	"github.com/linuxfoundation/lfx-v2-committee-service/internal/infrastructure/nats"
+	"github.com/linuxfoundation/lfx-v2-committee-service/internal/infrastructure/webhook"
 	usecaseSvc "github.com/linuxfoundation/lfx-v2-committee-service/internal/service"
 	"github.com/linuxfoundation/lfx-v2-committee-service/pkg/constants"
 )
@@ -26,6 +29,7 @@ var (
 	natsMessaging  port.ProjectReader
 	natsUserReader port.UserReader
 	natsPublisher  port.CommitteePublisher
+	natsLocker     port.CommitteeLocker
 
 	// expose the NATS client for direct access in subscriptions
 	natsClient *nats.NATSClient
@@ -33,60 +37,300 @@ var (
 	natsDoOnce sync.Once
 )
 
-func natsInit(ctx context.Context) {
+// natsConfig reads the NATS client configuration from the environment
+func natsConfig() nats.Config {
+	natsURL := os.Getenv("NATS_URL")
+	if natsURL == "" {
+		natsURL = "nats://localhost:4222"
+	}
 
-	natsDoOnce.Do(func() {
-		natsURL := os.Getenv("NATS_URL")
-		if natsURL == "" {
-			natsURL = "nats://localhost:4222"
-		}
+	natsTimeout := os.Getenv("NATS_TIMEOUT")
+	if natsTimeout == "" {
+		natsTimeout = "10s"
+	}
+	natsTimeoutDuration, err := time.ParseDuration(natsTimeout)
+	if err != nil {
+		log.Fatalf("invalid NATS timeout duration: %v", err)
+	}
 
-		natsTimeout := os.Getenv("NATS_TIMEOUT")
-		if natsTimeout == "" {
-			natsTimeout = "10s"
-		}
-		natsTimeoutDuration, err := time.ParseDuration(natsTimeout)
-		if err != nil {
-			log.Fatalf("invalid NATS timeout duration: %v", err)
-		}
+	natsMaxReconnect := os.Getenv("NATS_MAX_RECONNECT")
+	if natsMaxReconnect == "" {
+		natsMaxReconnect = "3"
+	}
+	natsMaxReconnectInt, err := strconv.Atoi(natsMaxReconnect)
+	if err != nil {
+		log.Fatalf("invalid NATS max reconnect value %s: %v", natsMaxReconnect, err)
+	}
 
-		natsMaxReconnect := os.Getenv("NATS_MAX_RECONNECT")
-		if natsMaxReconnect == "" {
-			natsMaxReconnect = "3"
-		}
-		natsMaxReconnectInt, err := strconv.Atoi(natsMaxReconnect)
-		if err != nil {
-			log.Fatalf("invalid NATS max reconnect value %s: %v", natsMaxReconnect, err)
-		}
+	natsReconnectWait := os.Getenv("NATS_RECONNECT_WAIT")
+	if natsReconnectWait == "" {
+		natsReconnectWait = "2s"
+	}
+	natsReconnectWaitDuration, err := time.ParseDuration(natsReconnectWait)
+	if err != nil {
+		log.Fatalf("invalid NATS reconnect wait duration %s : %v", natsReconnectWait, err)
+	}
 
-		natsReconnectWait := os.Getenv("NATS_RECONNECT_WAIT")
-		if natsReconnectWait == "" {
-			natsReconnectWait = "2s"
-		}
-		natsReconnectWaitDuration, err := time.ParseDuration(natsReconnectWait)
-		if err != nil {
-			log.Fatalf("invalid NATS reconnect wait duration %s : %v", natsReconnectWait, err)
-		}
+	natsPublishBufferSize := os.Getenv("NATS_PUBLISH_BUFFER_SIZE")
+	if natsPublishBufferSize == "" {
+		natsPublishBufferSize = "1000"
+	}
+	natsPublishBufferSizeInt, err := strconv.Atoi(natsPublishBufferSize)
+	if err != nil || natsPublishBufferSizeInt < 0 {
+		log.Fatalf("invalid NATS publish buffer size %s: %v", natsPublishBufferSize, err)
+	}
 
-		config := nats.Config{
-			URL:           natsURL,
-			Timeout:       natsTimeoutDuration,
-			MaxReconnect:  natsMaxReconnectInt,
-			ReconnectWait: natsReconnectWaitDuration,
-		}
+	return nats.Config{
+		URL:               natsURL,
+		Timeout:           natsTimeoutDuration,
+		MaxReconnect:      natsMaxReconnectInt,
+		ReconnectWait:     natsReconnectWaitDuration,
+		PublishBufferSize: natsPublishBufferSizeInt,
+		BucketPrefix:      os.Getenv("NATS_BUCKET_PREFIX"),
+		QueueGroup:        os.Getenv("NATS_QUEUE_GROUP"),
+	}
+}
+
+func natsInit(ctx context.Context) {
+
+	natsDoOnce.Do(func() {
+		config := natsConfig()
 
 		client, errNewClient := nats.NewClient(ctx, config)
 		if errNewClient != nil {
 			log.Fatalf("failed to create NATS client: %v", errNewClient)
 		}
 		natsClient = client
-		natsStorage = nats.NewStorage(client)
+		natsStorage = nats.NewStorage(client,
+			nats.WithMemberUniquenessScope(memberUniquenessScope()),
+		)
 		natsMessaging = nats.NewMessageRequest(client)
 		natsUserReader = nats.NewUserRequest(client)
 		natsPublisher = nats.NewMessagePublisher(client)
+		natsLocker = nats.NewCommitteeLocker(client)
 	})
 }
 
+// ValidateConfig checks the configuration of the NATS backed implementations before serving traffic,
+// stopping the service with a clear message when it is missing, invalid or the server is unreachable
+func ValidateConfig(ctx context.Context) {
+	usesNATS := false
+	for _, source := range []string{os.Getenv("REPOSITORY_SOURCE"), os.Getenv("MESSAGING_SOURCE")} {
+		if source == "" || source == "nats" {
+			usesNATS = true
+		}
+	}
+	if !usesNATS {
+		return
+	}
+
+	config := natsConfig()
+	if err := nats.CheckConfig(ctx, config); err != nil {
+		log.Fatalf("invalid NATS configuration: %v", err)
+	}
+
+	slog.InfoContext(ctx, "NATS configuration validated")
+}
+
+// memberUniquenessScope reads the scope in which member emails must be unique
+// from the environment, defaulting to the committee scope
+func memberUniquenessScope() model.MemberUniquenessScope {
+	scope := model.MemberUniquenessScope(os.Getenv("MEMBER_UNIQUENESS_SCOPE"))
+	switch scope {
+	case "":
+		return model.MemberUniquenessScopeCommittee
+	case model.MemberUniquenessScopeCommittee, model.MemberUniquenessScopeProject:
+		return scope
+	default:
+		log.Fatalf("invalid member uniqueness scope %s, expected %s or %s", scope, model.MemberUniquenessScopeCommittee, model.MemberUniquenessScopeProject)
+	}
+	return scope
+}
+
+// SSOGroupNameMaxAttempts reads the number of SSO group names tried before giving up
+// from the environment, returning zero to keep the orchestrator default when unset
+func SSOGroupNameMaxAttempts() int {
+	maxAttempts := os.Getenv("SSO_GROUP_NAME_MAX_ATTEMPTS")
+	if maxAttempts == "" {
+		return 0
+	}
+	maxAttemptsInt, err := strconv.Atoi(maxAttempts)
+	if err != nil || maxAttemptsInt <= 0 {
+		log.Fatalf("invalid SSO group name max attempts value %s, expected a positive integer", maxAttempts)
+	}
+	return maxAttemptsInt
+}
+
+// BatchConcurrency reads the number of batch items processed in parallel from the environment,
+// returning zero to keep the orchestrator default when unset
+func BatchConcurrency() int {
+	concurrency := os.Getenv("BATCH_CONCURRENCY")
+	if concurrency == "" {
+		return 0
+	}
+	concurrencyInt, err := strconv.Atoi(concurrency)
+	if err != nil || concurrencyInt <= 0 {
+		log.Fatalf("invalid batch concurrency value %s, expected a positive integer", concurrency)
+	}
+	return concurrencyInt
+}
+
+// IndexerTagLimits reads the maximum number and length of the tags of indexer messages from the environment,
+// returning zero values to keep the orchestrator defaults when unset
+func IndexerTagLimits() model.TagLimits {
+	limits := model.TagLimits{}
+	if maxTags := os.Getenv("INDEXER_MAX_TAGS"); maxTags != "" {
+		maxTagsInt, err := strconv.Atoi(maxTags)
+		if err != nil || maxTagsInt <= 0 {
+			log.Fatalf("invalid indexer max tags value %s, expected a positive integer", maxTags)
+		}
+		limits.MaxTags = maxTagsInt
+	}
+	if maxTagLength := os.Getenv("INDEXER_MAX_TAG_LENGTH"); maxTagLength != "" {
+		maxTagLengthInt, err := strconv.Atoi(maxTagLength)
+		if err != nil || maxTagLengthInt <= 0 {
+			log.Fatalf("invalid indexer max tag length value %s, expected a positive integer", maxTagLength)
+		}
+		limits.MaxTagLength = maxTagLengthInt
+	}
+	return limits
+}
+
+// AllowEmptyWriters reads from the environment whether settings updates may remove every committee writer
+func AllowEmptyWriters() bool {
+	allow := os.Getenv("COMMITTEE_ALLOW_EMPTY_WRITERS")
+	if allow == "" {
+		return false
+	}
+	allowBool, err := strconv.ParseBool(allow)
+	if err != nil {
+		log.Fatalf("invalid allow empty writers value %s, expected a boolean", allow)
+	}
+	return allowBool
+}
+
+// PublicRequiresReview reads from the environment whether public committees must require review
+func PublicRequiresReview() bool {
+	enabled := os.Getenv("COMMITTEE_PUBLIC_REQUIRES_REVIEW")
+	if enabled == "" {
+		return false
+	}
+	enabledBool, err := strconv.ParseBool(enabled)
+	if err != nil {
+		log.Fatalf("invalid public requires review value %s, expected a boolean", enabled)
+	}
+	return enabledBool
+}
+
+// SettingsUpsert reads from the environment whether settings updates create the settings
+// of committees that have none
+func SettingsUpsert() bool {
+	enabled := os.Getenv("COMMITTEE_SETTINGS_UPSERT")
+	if enabled == "" {
+		return false
+	}
+	enabledBool, err := strconv.ParseBool(enabled)
+	if err != nil {
+		log.Fatalf("invalid committee settings upsert value %s, expected a boolean", enabled)
+	}
+	return enabledBool
+}
+
+// EmailOrganizationDomainMatch reads from the environment whether member emails of business email committees
+// must match the organization website domain
+func EmailOrganizationDomainMatch() bool {
+	enabled := os.Getenv("MEMBER_EMAIL_ORG_DOMAIN_MATCH")
+	if enabled == "" {
+		return false
+	}
+	enabledBool, err := strconv.ParseBool(enabled)
+	if err != nil {
+		log.Fatalf("invalid email organization domain match value %s, expected a boolean", enabled)
+	}
+	return enabledBool
+}
+
+// SlowOperationThreshold reads from the environment the duration after which an operation is logged as slow,
+// a zero duration disables the slow operation logging
+func SlowOperationThreshold() time.Duration {
+	threshold := os.Getenv("SLOW_OPERATION_THRESHOLD")
+	if threshold == "" {
+		threshold = "1s"
+	}
+	thresholdDuration, err := time.ParseDuration(threshold)
+	if err != nil || thresholdDuration < 0 {
+		log.Fatalf("invalid slow operation threshold %s, expected a non-negative duration", threshold)
+	}
+	return thresholdDuration
+}
+
+// MemberRequiredFields reads the member fields required per committee category from the environment,
+// in the form "Board=job_title,organization;Technical Steering Committee=job_title"
+func MemberRequiredFields() model.MemberRequiredFields {
+	requiredFields, err := model.ParseMemberRequiredFields(os.Getenv("MEMBER_REQUIRED_FIELDS"))
+	if err != nil {
+		log.Fatalf("invalid member required fields: %v", err)
+	}
+	return requiredFields
+}
+
+// MemberSensitiveFields reads from the environment the comma separated member fields
+// omitted for unprivileged callers on top of the email
+func MemberSensitiveFields() model.MemberSensitiveFields {
+	fields, err := model.ParseMemberSensitiveFields(os.Getenv("MEMBER_SENSITIVE_FIELDS"))
+	if err != nil {
+		log.Fatalf("invalid member sensitive fields: %v", err)
+	}
+	return fields
+}
+
+// AppointedByPolicy reads the appointment mechanisms allowed per committee category from the environment,
+// in the form "Board=Vote of Governing Board,Membership Entitlement;Technical Steering Committee=Vote of TSC Committee"
+func AppointedByPolicy() model.AppointedByPolicy {
+	policy, err := model.ParseAppointedByPolicy(os.Getenv("MEMBER_APPOINTED_BY_POLICY"))
+	if err != nil {
+		log.Fatalf("invalid member appointed_by policy: %v", err)
+	}
+	return policy
+}
+
+// LegacyCategories reads the comma separated non canonical committee categories
+// still accepted while existing committees are migrated
+func LegacyCategories() []string {
+	var categories []string
+	for _, category := range strings.Split(os.Getenv("COMMITTEE_LEGACY_CATEGORIES"), ",") {
+		if category = strings.TrimSpace(category); category != "" {
+			categories = append(categories, category)
+		}
+	}
+	return categories
+}
+
+// VotingDisallowedCategories reads the comma separated committee categories where voting cannot be enabled
+func VotingDisallowedCategories() []string {
+	var categories []string
+	for _, category := range strings.Split(os.Getenv("COMMITTEE_VOTING_DISALLOWED_CATEGORIES"), ",") {
+		if category = strings.TrimSpace(category); category != "" {
+			categories = append(categories, category)
+		}
+	}
+	return categories
+}
+
+// MinReviewInterval reads the minimum time between two reviews of the same committee from the environment,
+// a zero duration disables the check
+func MinReviewInterval() time.Duration {
+	interval := os.Getenv("COMMITTEE_MIN_REVIEW_INTERVAL")
+	if interval == "" {
+		interval = "1h"
+	}
+	intervalDuration, err := time.ParseDuration(interval)
+	if err != nil || intervalDuration < 0 {
+		log.Fatalf("invalid committee minimum review interval %s, expected a non-negative duration", interval)
+	}
+	return intervalDuration
+}
+
 func natsStorageImpl(ctx context.Context) port.CommitteeReaderWriter {
 	natsInit(ctx)
 	return natsStorage
@@ -132,6 +376,36 @@ func CommitteeReaderImpl(ctx context.Context) port.CommitteeReader {
 	return committeeRetriever
 }
 
+// CommitteeLockerImpl initializes the committee locker implementation based on the repository source
+func CommitteeLockerImpl(ctx context.Context) port.CommitteeLocker {
+	var committeeLocker port.CommitteeLocker
+
+	// Repository implementation configuration
+	repoSource := os.Getenv("REPOSITORY_SOURCE")
+	if repoSource == "" {
+		repoSource = "nats"
+	}
+
+	switch repoSource {
+	case "mock":
+		slog.InfoContext(ctx, "initializing mock committee locker")
+		committeeLocker = infrastructure.NewMockCommitteeLocker()
+
+	case "nats":
+		slog.InfoContext(ctx, "initializing NATS committee locker")
+		natsInit(ctx)
+		if natsLocker == nil {
+			log.Fatalf("failed to initialize NATS client")
+		}
+		committeeLocker = natsLocker
+
+	default:
+		log.Fatalf("unsupported committee locker implementation: %s", repoSource)
+	}
+
+	return committeeLocker
+}
+
 // CommitteeWriterImpl initializes the committee writer implementation based on the repository source
 func CommitteeWriterImpl(ctx context.Context) port.CommitteeWriter {
 	var committeeWriter port.CommitteeWriter
@@ -189,7 +463,21 @@ func ProjectRetrieverImpl(ctx context.Context) port.ProjectReader {
 		log.Fatalf("unsupported project reader implementation: %s", repoSource)
 	}
 
-	return projectReader
+	return usecaseSvc.NewCachedProjectReader(projectReader, projectCacheTTL())
+}
+
+// projectCacheTTL reads how long project slugs and names are cached from the environment,
+// a zero duration bypasses the cache
+func projectCacheTTL() time.Duration {
+	cacheTTL := os.Getenv("PROJECT_CACHE_TTL")
+	if cacheTTL == "" {
+		cacheTTL = "5m"
+	}
+	cacheTTLDuration, err := time.ParseDuration(cacheTTL)
+	if err != nil || cacheTTLDuration < 0 {
+		log.Fatalf("invalid project cache TTL %s, expected a non-negative duration", cacheTTL)
+	}
+	return cacheTTLDuration
 }
 
 // UserReaderImpl initializes the user reader implementation based on the repository source
@@ -284,6 +572,52 @@ func CommitteePublisherImpl(ctx context.Context) port.CommitteePublisher {
 	return committeePublisher
 }
 
+// WebhookNotifierImpl initializes the outbound webhook notifier from the environment,
+// returning nil when no webhook URL is configured
+func WebhookNotifierImpl(ctx context.Context) port.WebhookNotifier {
+	var urls []string
+	for _, url := range strings.Split(os.Getenv("WEBHOOK_URLS"), ",") {
+		if url = strings.TrimSpace(url); url != "" {
+			urls = append(urls, url)
+		}
+	}
+	if len(urls) == 0 {
+		return nil
+	}
+
+	config := webhook.Config{
+		URLs:   urls,
+		Secret: os.Getenv("WEBHOOK_SECRET"),
+	}
+
+	if maxAttempts := os.Getenv("WEBHOOK_MAX_ATTEMPTS"); maxAttempts != "" {
+		maxAttemptsInt, err := strconv.Atoi(maxAttempts)
+		if err != nil || maxAttemptsInt <= 0 {
+			log.Fatalf("invalid webhook max attempts value %s, expected a positive integer", maxAttempts)
+		}
+		config.MaxAttempts = maxAttemptsInt
+	}
+
+	if timeout := os.Getenv("WEBHOOK_TIMEOUT"); timeout != "" {
+		timeoutDuration, err := time.ParseDuration(timeout)
+		if err != nil || timeoutDuration <= 0 {
+			log.Fatalf("invalid webhook timeout %s, expected a positive duration", timeout)
+		}
+		config.Timeout = timeoutDuration
+	}
+
+	notifier, err := webhook.NewNotifier(config)
+	if err != nil {
+		log.Fatalf("failed to initialize webhook notifier: %v", err)
+	}
+
+	slog.InfoContext(ctx, "initializing webhook notifier",
+		"urls", len(urls),
+	)
+
+	return notifier
+}
+
 // CommitteeReaderWriterImpl initializes the committee reader/writer implementation based on the repository source
 func CommitteeReaderWriterImpl(ctx context.Context) port.CommitteeReaderWriter {
 	var storage port.CommitteeReaderWriter
@@ -348,7 +682,7 @@ func QueueSubscriptions(ctx context.Context, committeeReader port.CommitteeReade
 
 	for subject, handler := range subjects {
 		slog.InfoContext(ctx, "subscribing to NATS subject", "subject", subject)
-		if _, err := natsClient.SubscribeWithTransportMessenger(ctx, subject, constants.CommitteeAPIQueue, handler); err != nil {
+		if _, err := natsClient.SubscribeWithTransportMessenger(ctx, subject, natsClient.QueueGroup(), handler); err != nil {
 			slog.ErrorContext(ctx, "failed to subscribe to NATS subject",
 				"error", err,
 				"subject", subject,
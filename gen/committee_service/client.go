@@ -16,36 +16,48 @@ import (
 
 // Client is the "committee-service" service client.
 type Client struct {
-	CreateCommitteeEndpoint         goa.Endpoint
-	GetCommitteeBaseEndpoint        goa.Endpoint
-	UpdateCommitteeBaseEndpoint     goa.Endpoint
-	DeleteCommitteeEndpoint         goa.Endpoint
-	GetCommitteeSettingsEndpoint    goa.Endpoint
-	UpdateCommitteeSettingsEndpoint goa.Endpoint
-	ReadyzEndpoint                  goa.Endpoint
-	LivezEndpoint                   goa.Endpoint
-	CreateCommitteeMemberEndpoint   goa.Endpoint
-	GetCommitteeMemberEndpoint      goa.Endpoint
-	UpdateCommitteeMemberEndpoint   goa.Endpoint
-	DeleteCommitteeMemberEndpoint   goa.Endpoint
+	CreateCommitteeEndpoint                      goa.Endpoint
+	GetCommitteeBaseEndpoint                     goa.Endpoint
+	GetCommitteeWithMembersEndpoint              goa.Endpoint
+	ListCommitteeChildrenEndpoint                goa.Endpoint
+	UpdateCommitteeBaseEndpoint                  goa.Endpoint
+	DeleteCommitteeEndpoint                      goa.Endpoint
+	GetCommitteeSettingsEndpoint                 goa.Endpoint
+	UpdateCommitteeSettingsEndpoint              goa.Endpoint
+	ReadyzEndpoint                               goa.Endpoint
+	LivezEndpoint                                goa.Endpoint
+	CreateCommitteeMemberEndpoint                goa.Endpoint
+	ReassignCommitteeMembersOrganizationEndpoint goa.Endpoint
+	ListCommitteeMembersByOrganizationEndpoint   goa.Endpoint
+	GetCommitteeMemberEndpoint                   goa.Endpoint
+	GetCommitteeMemberHistoryEndpoint            goa.Endpoint
+	UpdateCommitteeMemberEndpoint                goa.Endpoint
+	PatchCommitteeMemberEndpoint                 goa.Endpoint
+	DeleteCommitteeMemberEndpoint                goa.Endpoint
 }
 
 // NewClient initializes a "committee-service" service client given the
 // endpoints.
-func NewClient(createCommittee, getCommitteeBase, updateCommitteeBase, deleteCommittee, getCommitteeSettings, updateCommitteeSettings, readyz, livez, createCommitteeMember, getCommitteeMember, updateCommitteeMember, deleteCommitteeMember goa.Endpoint) *Client {
+func NewClient(createCommittee, getCommitteeBase, getCommitteeWithMembers, listCommitteeChildren, updateCommitteeBase, deleteCommittee, getCommitteeSettings, updateCommitteeSettings, readyz, livez, createCommitteeMember, reassignCommitteeMembersOrganization, listCommitteeMembersByOrganization, getCommitteeMember, getCommitteeMemberHistory, updateCommitteeMember, patchCommitteeMember, deleteCommitteeMember goa.Endpoint) *Client {
 	return &Client{
-		CreateCommitteeEndpoint:         createCommittee,
-		GetCommitteeBaseEndpoint:        getCommitteeBase,
-		UpdateCommitteeBaseEndpoint:     updateCommitteeBase,
-		DeleteCommitteeEndpoint:         deleteCommittee,
-		GetCommitteeSettingsEndpoint:    getCommitteeSettings,
-		UpdateCommitteeSettingsEndpoint: updateCommitteeSettings,
-		ReadyzEndpoint:                  readyz,
-		LivezEndpoint:                   livez,
-		CreateCommitteeMemberEndpoint:   createCommitteeMember,
-		GetCommitteeMemberEndpoint:      getCommitteeMember,
-		UpdateCommitteeMemberEndpoint:   updateCommitteeMember,
-		DeleteCommitteeMemberEndpoint:   deleteCommitteeMember,
+		CreateCommitteeEndpoint:                      createCommittee,
+		GetCommitteeBaseEndpoint:                     getCommitteeBase,
+		GetCommitteeWithMembersEndpoint:              getCommitteeWithMembers,
+		ListCommitteeChildrenEndpoint:                listCommitteeChildren,
+		UpdateCommitteeBaseEndpoint:                  updateCommitteeBase,
+		DeleteCommitteeEndpoint:                      deleteCommittee,
+		GetCommitteeSettingsEndpoint:                 getCommitteeSettings,
+		UpdateCommitteeSettingsEndpoint:              updateCommitteeSettings,
+		ReadyzEndpoint:                               readyz,
+		LivezEndpoint:                                livez,
+		CreateCommitteeMemberEndpoint:                createCommitteeMember,
+		ReassignCommitteeMembersOrganizationEndpoint: reassignCommitteeMembersOrganization,
+		ListCommitteeMembersByOrganizationEndpoint:   listCommitteeMembersByOrganization,
+		GetCommitteeMemberEndpoint:                   getCommitteeMember,
+		GetCommitteeMemberHistoryEndpoint:            getCommitteeMemberHistory,
+		UpdateCommitteeMemberEndpoint:                updateCommitteeMember,
+		PatchCommitteeMemberEndpoint:                 patchCommitteeMember,
+		DeleteCommitteeMemberEndpoint:                deleteCommitteeMember,
 	}
 }
 
@@ -83,6 +95,40 @@ func (c *Client) GetCommitteeBase(ctx context.Context, p *GetCommitteeBasePayloa
 	return ires.(*GetCommitteeBaseResult), nil
 }
 
+// GetCommitteeWithMembers calls the "get-committee-with-members" endpoint of
+// the "committee-service" service.
+// GetCommitteeWithMembers may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Resource not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetCommitteeWithMembers(ctx context.Context, p *GetCommitteeWithMembersPayload) (res *GetCommitteeWithMembersResult, err error) {
+	var ires any
+	ires, err = c.GetCommitteeWithMembersEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GetCommitteeWithMembersResult), nil
+}
+
+// ListCommitteeChildren calls the "list-committee-children" endpoint of the
+// "committee-service" service.
+// ListCommitteeChildren may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Resource not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ListCommitteeChildren(ctx context.Context, p *ListCommitteeChildrenPayload) (res *ListCommitteeChildrenResult, err error) {
+	var ires any
+	ires, err = c.ListCommitteeChildrenEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ListCommitteeChildrenResult), nil
+}
+
 // UpdateCommitteeBase calls the "update-committee-base" endpoint of the
 // "committee-service" service.
 // UpdateCommitteeBase may return the following errors:
@@ -190,6 +236,42 @@ func (c *Client) CreateCommitteeMember(ctx context.Context, p *CreateCommitteeMe
 	return ires.(*CommitteeMemberFullWithReadonlyAttributes), nil
 }
 
+// ReassignCommitteeMembersOrganization calls the
+// "reassign-committee-members-organization" endpoint of the
+// "committee-service" service.
+// ReassignCommitteeMembersOrganization may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Committee not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ReassignCommitteeMembersOrganization(ctx context.Context, p *ReassignCommitteeMembersOrganizationPayload) (res *ReassignCommitteeMembersOrganizationResult, err error) {
+	var ires any
+	ires, err = c.ReassignCommitteeMembersOrganizationEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ReassignCommitteeMembersOrganizationResult), nil
+}
+
+// ListCommitteeMembersByOrganization calls the
+// "list-committee-members-by-organization" endpoint of the "committee-service"
+// service.
+// ListCommitteeMembersByOrganization may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Committee not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ListCommitteeMembersByOrganization(ctx context.Context, p *ListCommitteeMembersByOrganizationPayload) (res *ListCommitteeMembersByOrganizationResult, err error) {
+	var ires any
+	ires, err = c.ListCommitteeMembersByOrganizationEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ListCommitteeMembersByOrganizationResult), nil
+}
+
 // GetCommitteeMember calls the "get-committee-member" endpoint of the
 // "committee-service" service.
 // GetCommitteeMember may return the following errors:
@@ -207,6 +289,23 @@ func (c *Client) GetCommitteeMember(ctx context.Context, p *GetCommitteeMemberPa
 	return ires.(*GetCommitteeMemberResult), nil
 }
 
+// GetCommitteeMemberHistory calls the "get-committee-member-history" endpoint
+// of the "committee-service" service.
+// GetCommitteeMemberHistory may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Member not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetCommitteeMemberHistory(ctx context.Context, p *GetCommitteeMemberHistoryPayload) (res *GetCommitteeMemberHistoryResult, err error) {
+	var ires any
+	ires, err = c.GetCommitteeMemberHistoryEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GetCommitteeMemberHistoryResult), nil
+}
+
 // UpdateCommitteeMember calls the "update-committee-member" endpoint of the
 // "committee-service" service.
 // UpdateCommitteeMember may return the following errors:
@@ -225,6 +324,24 @@ func (c *Client) UpdateCommitteeMember(ctx context.Context, p *UpdateCommitteeMe
 	return ires.(*CommitteeMemberFullWithReadonlyAttributes), nil
 }
 
+// PatchCommitteeMember calls the "patch-committee-member" endpoint of the
+// "committee-service" service.
+// PatchCommitteeMember may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Member not found
+//   - "Conflict" (type *ConflictError): Conflict
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) PatchCommitteeMember(ctx context.Context, p *PatchCommitteeMemberPayload) (res *CommitteeMemberFullWithReadonlyAttributes, err error) {
+	var ires any
+	ires, err = c.PatchCommitteeMemberEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CommitteeMemberFullWithReadonlyAttributes), nil
+}
+
 // DeleteCommitteeMember calls the "delete-committee-member" endpoint of the
 // "committee-service" service.
 // DeleteCommitteeMember may return the following errors:
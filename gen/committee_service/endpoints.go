@@ -17,18 +17,24 @@ import (
 
 // Endpoints wraps the "committee-service" service endpoints.
 type Endpoints struct {
-	CreateCommittee         goa.Endpoint
-	GetCommitteeBase        goa.Endpoint
-	UpdateCommitteeBase     goa.Endpoint
-	DeleteCommittee         goa.Endpoint
-	GetCommitteeSettings    goa.Endpoint
-	UpdateCommitteeSettings goa.Endpoint
-	Readyz                  goa.Endpoint
-	Livez                   goa.Endpoint
-	CreateCommitteeMember   goa.Endpoint
-	GetCommitteeMember      goa.Endpoint
-	UpdateCommitteeMember   goa.Endpoint
-	DeleteCommitteeMember   goa.Endpoint
+	CreateCommittee                      goa.Endpoint
+	GetCommitteeBase                     goa.Endpoint
+	GetCommitteeWithMembers              goa.Endpoint
+	ListCommitteeChildren                goa.Endpoint
+	UpdateCommitteeBase                  goa.Endpoint
+	DeleteCommittee                      goa.Endpoint
+	GetCommitteeSettings                 goa.Endpoint
+	UpdateCommitteeSettings              goa.Endpoint
+	Readyz                               goa.Endpoint
+	Livez                                goa.Endpoint
+	CreateCommitteeMember                goa.Endpoint
+	ReassignCommitteeMembersOrganization goa.Endpoint
+	ListCommitteeMembersByOrganization   goa.Endpoint
+	GetCommitteeMember                   goa.Endpoint
+	GetCommitteeMemberHistory            goa.Endpoint
+	UpdateCommitteeMember                goa.Endpoint
+	PatchCommitteeMember                 goa.Endpoint
+	DeleteCommitteeMember                goa.Endpoint
 }
 
 // NewEndpoints wraps the methods of the "committee-service" service with
@@ -37,18 +43,24 @@ func NewEndpoints(s Service) *Endpoints {
 	// Casting service to Auther interface
 	a := s.(Auther)
 	return &Endpoints{
-		CreateCommittee:         NewCreateCommitteeEndpoint(s, a.JWTAuth),
-		GetCommitteeBase:        NewGetCommitteeBaseEndpoint(s, a.JWTAuth),
-		UpdateCommitteeBase:     NewUpdateCommitteeBaseEndpoint(s, a.JWTAuth),
-		DeleteCommittee:         NewDeleteCommitteeEndpoint(s, a.JWTAuth),
-		GetCommitteeSettings:    NewGetCommitteeSettingsEndpoint(s, a.JWTAuth),
-		UpdateCommitteeSettings: NewUpdateCommitteeSettingsEndpoint(s, a.JWTAuth),
-		Readyz:                  NewReadyzEndpoint(s),
-		Livez:                   NewLivezEndpoint(s),
-		CreateCommitteeMember:   NewCreateCommitteeMemberEndpoint(s, a.JWTAuth),
-		GetCommitteeMember:      NewGetCommitteeMemberEndpoint(s, a.JWTAuth),
-		UpdateCommitteeMember:   NewUpdateCommitteeMemberEndpoint(s, a.JWTAuth),
-		DeleteCommitteeMember:   NewDeleteCommitteeMemberEndpoint(s, a.JWTAuth),
+		CreateCommittee:                      NewCreateCommitteeEndpoint(s, a.JWTAuth),
+		GetCommitteeBase:                     NewGetCommitteeBaseEndpoint(s, a.JWTAuth),
+		GetCommitteeWithMembers:              NewGetCommitteeWithMembersEndpoint(s, a.JWTAuth),
+		ListCommitteeChildren:                NewListCommitteeChildrenEndpoint(s, a.JWTAuth),
+		UpdateCommitteeBase:                  NewUpdateCommitteeBaseEndpoint(s, a.JWTAuth),
+		DeleteCommittee:                      NewDeleteCommitteeEndpoint(s, a.JWTAuth),
+		GetCommitteeSettings:                 NewGetCommitteeSettingsEndpoint(s, a.JWTAuth),
+		UpdateCommitteeSettings:              NewUpdateCommitteeSettingsEndpoint(s, a.JWTAuth),
+		Readyz:                               NewReadyzEndpoint(s),
+		Livez:                                NewLivezEndpoint(s),
+		CreateCommitteeMember:                NewCreateCommitteeMemberEndpoint(s, a.JWTAuth),
+		ReassignCommitteeMembersOrganization: NewReassignCommitteeMembersOrganizationEndpoint(s, a.JWTAuth),
+		ListCommitteeMembersByOrganization:   NewListCommitteeMembersByOrganizationEndpoint(s, a.JWTAuth),
+		GetCommitteeMember:                   NewGetCommitteeMemberEndpoint(s, a.JWTAuth),
+		GetCommitteeMemberHistory:            NewGetCommitteeMemberHistoryEndpoint(s, a.JWTAuth),
+		UpdateCommitteeMember:                NewUpdateCommitteeMemberEndpoint(s, a.JWTAuth),
+		PatchCommitteeMember:                 NewPatchCommitteeMemberEndpoint(s, a.JWTAuth),
+		DeleteCommitteeMember:                NewDeleteCommitteeMemberEndpoint(s, a.JWTAuth),
 	}
 }
 
@@ -57,6 +69,8 @@ func NewEndpoints(s Service) *Endpoints {
 func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.CreateCommittee = m(e.CreateCommittee)
 	e.GetCommitteeBase = m(e.GetCommitteeBase)
+	e.GetCommitteeWithMembers = m(e.GetCommitteeWithMembers)
+	e.ListCommitteeChildren = m(e.ListCommitteeChildren)
 	e.UpdateCommitteeBase = m(e.UpdateCommitteeBase)
 	e.DeleteCommittee = m(e.DeleteCommittee)
 	e.GetCommitteeSettings = m(e.GetCommitteeSettings)
@@ -64,8 +78,12 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.Readyz = m(e.Readyz)
 	e.Livez = m(e.Livez)
 	e.CreateCommitteeMember = m(e.CreateCommitteeMember)
+	e.ReassignCommitteeMembersOrganization = m(e.ReassignCommitteeMembersOrganization)
+	e.ListCommitteeMembersByOrganization = m(e.ListCommitteeMembersByOrganization)
 	e.GetCommitteeMember = m(e.GetCommitteeMember)
+	e.GetCommitteeMemberHistory = m(e.GetCommitteeMemberHistory)
 	e.UpdateCommitteeMember = m(e.UpdateCommitteeMember)
+	e.PatchCommitteeMember = m(e.PatchCommitteeMember)
 	e.DeleteCommitteeMember = m(e.DeleteCommitteeMember)
 }
 
@@ -115,6 +133,52 @@ func NewGetCommitteeBaseEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.
 	}
 }
 
+// NewGetCommitteeWithMembersEndpoint returns an endpoint function that calls
+// the method "get-committee-with-members" of service "committee-service".
+func NewGetCommitteeWithMembersEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetCommitteeWithMembersPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetCommitteeWithMembers(ctx, p)
+	}
+}
+
+// NewListCommitteeChildrenEndpoint returns an endpoint function that calls the
+// method "list-committee-children" of service "committee-service".
+func NewListCommitteeChildrenEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListCommitteeChildrenPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListCommitteeChildren(ctx, p)
+	}
+}
+
 // NewUpdateCommitteeBaseEndpoint returns an endpoint function that calls the
 // method "update-committee-base" of service "committee-service".
 func NewUpdateCommitteeBaseEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
@@ -246,6 +310,54 @@ func NewCreateCommitteeMemberEndpoint(s Service, authJWTFn security.AuthJWTFunc)
 	}
 }
 
+// NewReassignCommitteeMembersOrganizationEndpoint returns an endpoint function
+// that calls the method "reassign-committee-members-organization" of service
+// "committee-service".
+func NewReassignCommitteeMembersOrganizationEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ReassignCommitteeMembersOrganizationPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ReassignCommitteeMembersOrganization(ctx, p)
+	}
+}
+
+// NewListCommitteeMembersByOrganizationEndpoint returns an endpoint function
+// that calls the method "list-committee-members-by-organization" of service
+// "committee-service".
+func NewListCommitteeMembersByOrganizationEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListCommitteeMembersByOrganizationPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListCommitteeMembersByOrganization(ctx, p)
+	}
+}
+
 // NewGetCommitteeMemberEndpoint returns an endpoint function that calls the
 // method "get-committee-member" of service "committee-service".
 func NewGetCommitteeMemberEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
@@ -269,6 +381,29 @@ func NewGetCommitteeMemberEndpoint(s Service, authJWTFn security.AuthJWTFunc) go
 	}
 }
 
+// NewGetCommitteeMemberHistoryEndpoint returns an endpoint function that calls
+// the method "get-committee-member-history" of service "committee-service".
+func NewGetCommitteeMemberHistoryEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetCommitteeMemberHistoryPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetCommitteeMemberHistory(ctx, p)
+	}
+}
+
 // NewUpdateCommitteeMemberEndpoint returns an endpoint function that calls the
 // method "update-committee-member" of service "committee-service".
 func NewUpdateCommitteeMemberEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
@@ -292,6 +427,29 @@ func NewUpdateCommitteeMemberEndpoint(s Service, authJWTFn security.AuthJWTFunc)
 	}
 }
 
+// NewPatchCommitteeMemberEndpoint returns an endpoint function that calls the
+// method "patch-committee-member" of service "committee-service".
+func NewPatchCommitteeMemberEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*PatchCommitteeMemberPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.PatchCommitteeMember(ctx, p)
+	}
+}
+
 // NewDeleteCommitteeMemberEndpoint returns an endpoint function that calls the
 // method "delete-committee-member" of service "committee-service".
 func NewDeleteCommitteeMemberEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
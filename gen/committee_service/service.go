@@ -20,6 +20,10 @@ type Service interface {
 	CreateCommittee(context.Context, *CreateCommitteePayload) (res *CommitteeFullWithReadonlyAttributes, err error)
 	// Get Committee
 	GetCommitteeBase(context.Context, *GetCommitteeBasePayload) (res *GetCommitteeBaseResult, err error)
+	// Get a committee with its settings and a page of its members
+	GetCommitteeWithMembers(context.Context, *GetCommitteeWithMembersPayload) (res *GetCommitteeWithMembersResult, err error)
+	// List the direct sub-committees of a committee
+	ListCommitteeChildren(context.Context, *ListCommitteeChildrenPayload) (res *ListCommitteeChildrenResult, err error)
 	// Update Committee
 	UpdateCommitteeBase(context.Context, *UpdateCommitteeBasePayload) (res *CommitteeBaseWithReadonlyAttributes, err error)
 	// Delete Committee
@@ -34,10 +38,20 @@ type Service interface {
 	Livez(context.Context) (res []byte, err error)
 	// Add a new member to a committee
 	CreateCommitteeMember(context.Context, *CreateCommitteeMemberPayload) (res *CommitteeMemberFullWithReadonlyAttributes, err error)
+	// Move the committee members of an organization to another one, or clear their
+	// organization
+	ReassignCommitteeMembersOrganization(context.Context, *ReassignCommitteeMembersOrganizationPayload) (res *ReassignCommitteeMembersOrganizationResult, err error)
+	// List the committee members belonging to an organization
+	ListCommitteeMembersByOrganization(context.Context, *ListCommitteeMembersByOrganizationPayload) (res *ListCommitteeMembersByOrganizationResult, err error)
 	// Get a specific committee member by UID
 	GetCommitteeMember(context.Context, *GetCommitteeMemberPayload) (res *GetCommitteeMemberResult, err error)
+	// Get the stored revisions of a committee member, newest first
+	GetCommitteeMemberHistory(context.Context, *GetCommitteeMemberHistoryPayload) (res *GetCommitteeMemberHistoryResult, err error)
 	// Replace an existing committee member (requires complete resource)
 	UpdateCommitteeMember(context.Context, *UpdateCommitteeMemberPayload) (res *CommitteeMemberFullWithReadonlyAttributes, err error)
+	// Update the fields of an existing committee member sent in the request,
+	// leaving the omitted ones unchanged
+	PatchCommitteeMember(context.Context, *PatchCommitteeMemberPayload) (res *CommitteeMemberFullWithReadonlyAttributes, err error)
 	// Remove a member from a committee
 	DeleteCommitteeMember(context.Context, *DeleteCommitteeMemberPayload) (err error)
 }
@@ -62,7 +76,7 @@ const ServiceName = "committee-service"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [12]string{"create-committee", "get-committee-base", "update-committee-base", "delete-committee", "get-committee-settings", "update-committee-settings", "readyz", "livez", "create-committee-member", "get-committee-member", "update-committee-member", "delete-committee-member"}
+var MethodNames = [18]string{"create-committee", "get-committee-base", "get-committee-with-members", "list-committee-children", "update-committee-base", "delete-committee", "get-committee-settings", "update-committee-settings", "readyz", "livez", "create-committee-member", "reassign-committee-members-organization", "list-committee-members-by-organization", "get-committee-member", "get-committee-member-history", "update-committee-member", "patch-committee-member", "delete-committee-member"}
 
 // CommitteeBaseWithReadonlyAttributes is the result type of the
 // committee-service service update-committee-base method.
@@ -98,6 +112,8 @@ type CommitteeBaseWithReadonlyAttributes struct {
 	// The UID of the parent committee -- v2 uid, not related to v1 id directly,
 	// should be empty if there is none
 	ParentUID *string
+	// Arbitrary key/value labels used to filter committees
+	Labels map[string]string
 	// The name of the project this committee belongs to
 	ProjectName *string
 	// The name of the SSO group - read-only
@@ -106,6 +122,12 @@ type CommitteeBaseWithReadonlyAttributes struct {
 	TotalMembers *int
 	// The total number of repositories with voting permissions for this committee
 	TotalVotingRepos *int
+	// Whether the committee search record reflects the latest changes, pending
+	// means a reindex is needed (read-only)
+	IndexStatus *string
+	// The timestamp when the committee was last published to the search index
+	// (read-only)
+	LastIndexedAt *string
 }
 
 // CommitteeFullWithReadonlyAttributes is the result type of the
@@ -142,14 +164,25 @@ type CommitteeFullWithReadonlyAttributes struct {
 	// The UID of the parent committee -- v2 uid, not related to v1 id directly,
 	// should be empty if there is none
 	ParentUID *string
+	// Arbitrary key/value labels used to filter committees
+	Labels map[string]string
 	// The name of the SSO group - read-only
 	SsoGroupName *string
 	// The total number of members in this committee
 	TotalMembers *int
 	// The total number of repositories with voting permissions for this committee
 	TotalVotingRepos *int
+	// Whether the committee search record reflects the latest changes, pending
+	// means a reindex is needed (read-only)
+	IndexStatus *string
+	// The timestamp when the committee was last published to the search index
+	// (read-only)
+	LastIndexedAt *string
 	// Whether business email is required for committee members
 	BusinessEmailRequired bool
+	// Email domains allowed for committee members, overriding the business email
+	// requirement when not empty
+	AllowedEmailDomains []string
 	// The timestamp when the committee was last reviewed in RFC3339 format
 	LastReviewedAt *string
 	// The user ID who last reviewed this committee
@@ -210,7 +243,11 @@ type CommitteeMemberFullWithReadonlyAttributes struct {
 		StartDate *string
 		// Voting end date
 		EndDate *string
+		// Voting weight for weighted voting committees, defaults to 1
+		Weight *float64
 	}
+	// UID of the member of the same committee this member is the alternate of
+	AlternateForUID *string
 	// Organization information for the committee member
 	Organization *struct {
 		// Organization ID
@@ -224,6 +261,56 @@ type CommitteeMemberFullWithReadonlyAttributes struct {
 	CreatedAt *string
 	// The timestamp when the resource was last updated (read-only)
 	UpdatedAt *string
+	// The changes of the member role, oldest first (read-only)
+	RoleHistory []*CommitteeMemberRoleChange
+	// The changes of the member status, oldest first (read-only)
+	StatusHistory []*CommitteeMemberStatusChange
+}
+
+// The outcome of moving a committee member to another organization.
+type CommitteeMemberReassignResult struct {
+	// Committee member UID -- v2 uid, not related to v1 id directly
+	UID string
+	// Primary email address
+	Email string
+	// The updated member, omitted when the reassignment failed
+	Member *CommitteeMemberFullWithReadonlyAttributes
+	// The reason the member could not be reassigned
+	Error *string
+}
+
+// A stored revision of a committee member.
+type CommitteeMemberRevision struct {
+	// The revision number of the member record
+	Revision uint64
+	// The timestamp when the revision was stored
+	CreatedAt string
+	// The committee member as stored at the revision
+	Member *CommitteeMemberFullWithReadonlyAttributes
+}
+
+// A change of the committee member role.
+type CommitteeMemberRoleChange struct {
+	// The role before the change
+	OldRole string
+	// The role after the change
+	NewRole string
+	// The timestamp when the role changed
+	ChangedAt string
+	// The principal who changed the role
+	Actor *string
+}
+
+// A change of the committee member status.
+type CommitteeMemberStatusChange struct {
+	// The status before the change
+	OldStatus string
+	// The status after the change
+	NewStatus string
+	// The timestamp when the status changed
+	ChangedAt string
+	// The principal who changed the status
+	Actor *string
 }
 
 // CommitteeSettingsWithReadonlyAttributes is the result type of the
@@ -233,6 +320,9 @@ type CommitteeSettingsWithReadonlyAttributes struct {
 	UID *string
 	// Whether business email is required for committee members
 	BusinessEmailRequired bool
+	// Email domains allowed for committee members, overriding the business email
+	// requirement when not empty
+	AllowedEmailDomains []string
 	// The timestamp when the committee was last reviewed in RFC3339 format
 	LastReviewedAt *string
 	// The user ID who last reviewed this committee
@@ -294,7 +384,11 @@ type CreateCommitteeMemberPayload struct {
 		StartDate *string
 		// Voting end date
 		EndDate *string
+		// Voting weight for weighted voting committees, defaults to 1
+		Weight *float64
 	}
+	// UID of the member of the same committee this member is the alternate of
+	AlternateForUID *string
 	// Organization information for the committee member
 	Organization *struct {
 		// Organization ID
@@ -345,8 +439,13 @@ type CreateCommitteePayload struct {
 	// The UID of the parent committee -- v2 uid, not related to v1 id directly,
 	// should be empty if there is none
 	ParentUID *string
+	// Arbitrary key/value labels used to filter committees
+	Labels map[string]string
 	// Whether business email is required for committee members
 	BusinessEmailRequired bool
+	// Email domains allowed for committee members, overriding the business email
+	// requirement when not empty
+	AllowedEmailDomains []string
 	// The timestamp when the committee was last reviewed in RFC3339 format
 	LastReviewedAt *string
 	// The user ID who last reviewed this committee
@@ -416,6 +515,28 @@ type GetCommitteeBaseResult struct {
 	Etag *string
 }
 
+// GetCommitteeMemberHistoryPayload is the payload type of the
+// committee-service service get-committee-member-history method.
+type GetCommitteeMemberHistoryPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version string
+	// Committee UID -- v2 uid, not related to v1 id directly
+	UID string
+	// Committee member UID -- v2 uid, not related to v1 id directly
+	MemberUID string
+	// Maximum number of revisions to return
+	Limit int
+}
+
+// GetCommitteeMemberHistoryResult is the result type of the committee-service
+// service get-committee-member-history method.
+type GetCommitteeMemberHistoryResult struct {
+	// Revisions of the committee member, newest first
+	Revisions []*CommitteeMemberRevision
+}
+
 // GetCommitteeMemberPayload is the payload type of the committee-service
 // service get-committee-member method.
 type GetCommitteeMemberPayload struct {
@@ -456,6 +577,173 @@ type GetCommitteeSettingsResult struct {
 	Etag *string
 }
 
+// GetCommitteeWithMembersPayload is the payload type of the committee-service
+// service get-committee-with-members method.
+type GetCommitteeWithMembersPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version *string
+	// Committee UID -- v2 uid, not related to v1 id directly
+	UID *string
+	// Maximum number of items to return
+	PageSize int
+	// Token of the page to return, as returned in next_page_token by the previous
+	// page
+	PageToken *string
+}
+
+// GetCommitteeWithMembersResult is the result type of the committee-service
+// service get-committee-with-members method.
+type GetCommitteeWithMembersResult struct {
+	// Committee base and settings
+	Committee *CommitteeFullWithReadonlyAttributes
+	// Committee members of the page, ordered by last name and first name
+	Members []*CommitteeMemberFullWithReadonlyAttributes
+	// Number of committee members across every page
+	TotalMembers int
+	// Token of the next page, absent on the last page
+	NextPageToken *string
+}
+
+// ListCommitteeChildrenPayload is the payload type of the committee-service
+// service list-committee-children method.
+type ListCommitteeChildrenPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version *string
+	// Committee UID -- v2 uid, not related to v1 id directly
+	UID *string
+	// Maximum number of items to return
+	PageSize int
+	// Token of the page to return, as returned in next_page_token by the previous
+	// page
+	PageToken *string
+}
+
+// ListCommitteeChildrenResult is the result type of the committee-service
+// service list-committee-children method.
+type ListCommitteeChildrenResult struct {
+	// Direct sub-committees, ordered by name
+	Children []*CommitteeBaseWithReadonlyAttributes
+	// Token of the next page, absent on the last page
+	NextPageToken *string
+}
+
+// ListCommitteeMembersByOrganizationPayload is the payload type of the
+// committee-service service list-committee-members-by-organization method.
+type ListCommitteeMembersByOrganizationPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version string
+	// Committee UID -- v2 uid, not related to v1 id directly
+	UID string
+	// The name of the organization, compared ignoring case, punctuation and legal
+	// suffixes
+	Organization string
+}
+
+// ListCommitteeMembersByOrganizationResult is the result type of the
+// committee-service service list-committee-members-by-organization method.
+type ListCommitteeMembersByOrganizationResult struct {
+	// Committee members of the organization, ordered by last name and first name
+	Members []*CommitteeMemberFullWithReadonlyAttributes
+}
+
+// PatchCommitteeMemberPayload is the payload type of the committee-service
+// service patch-committee-member method.
+type PatchCommitteeMemberPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version string
+	// If-Match header value for conditional requests
+	IfMatch *string
+	// Determines if the operation should be synchronous (true) or asynchronous
+	// (false, default)
+	XSync bool
+	// Committee UID -- v2 uid, not related to v1 id directly
+	UID string
+	// Committee member UID -- v2 uid, not related to v1 id directly
+	MemberUID string
+	// User's LF ID
+	Username *string
+	// Primary email address
+	Email *string
+	// First name
+	FirstName *string
+	// Last name
+	LastName *string
+	// Job title at organization
+	JobTitle *string
+	// LinkedIn profile URL
+	LinkedinProfile *string
+	// Committee role information, omitted fields are left unchanged
+	Role *struct {
+		// Committee role name
+		Name *string
+		// Role start date
+		StartDate *string
+		// Role end date
+		EndDate *string
+	}
+	// How the member was appointed
+	AppointedBy *string
+	// Member status
+	Status *string
+	// Voting information for the committee member, omitted fields are left
+	// unchanged
+	Voting *struct {
+		// Voting status
+		Status *string
+		// Voting start date
+		StartDate *string
+		// Voting end date
+		EndDate *string
+		// Voting weight for weighted voting committees, defaults to 1
+		Weight *float64
+	}
+	// UID of the member of the same committee this member is the alternate of
+	AlternateForUID *string
+	// Organization information for the committee member, omitted fields are left
+	// unchanged
+	Organization *struct {
+		// Organization ID
+		ID *string
+		// Organization name
+		Name *string
+		// Organization website URL
+		Website *string
+	}
+}
+
+// ReassignCommitteeMembersOrganizationPayload is the payload type of the
+// committee-service service reassign-committee-members-organization method.
+type ReassignCommitteeMembersOrganizationPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version string
+	// Determines if the operation should be synchronous (true) or asynchronous
+	// (false, default)
+	XSync bool
+	// Committee UID -- v2 uid, not related to v1 id directly
+	UID string
+	// The name of the organization the members are moved from
+	FromOrganization string
+	// The name of the organization the members are moved to, empty to clear it
+	ToOrganization *string
+}
+
+// ReassignCommitteeMembersOrganizationResult is the result type of the
+// committee-service service reassign-committee-members-organization method.
+type ReassignCommitteeMembersOrganizationResult struct {
+	// The outcome for each member of the organization
+	Results []*CommitteeMemberReassignResult
+}
+
 // UpdateCommitteeBasePayload is the payload type of the committee-service
 // service update-committee-base method.
 type UpdateCommitteeBasePayload struct {
@@ -499,6 +787,8 @@ type UpdateCommitteeBasePayload struct {
 	// The UID of the parent committee -- v2 uid, not related to v1 id directly,
 	// should be empty if there is none
 	ParentUID *string
+	// Arbitrary key/value labels used to filter committees
+	Labels map[string]string
 }
 
 // UpdateCommitteeMemberPayload is the payload type of the committee-service
@@ -517,6 +807,8 @@ type UpdateCommitteeMemberPayload struct {
 	UID string
 	// Committee member UID -- v2 uid, not related to v1 id directly
 	MemberUID string
+	// Committee UID -- v2 uid, not related to v1 id directly
+	CommitteeUID *string
 	// User's LF ID
 	Username *string
 	// Primary email address
@@ -550,7 +842,11 @@ type UpdateCommitteeMemberPayload struct {
 		StartDate *string
 		// Voting end date
 		EndDate *string
+		// Voting weight for weighted voting committees, defaults to 1
+		Weight *float64
 	}
+	// UID of the member of the same committee this member is the alternate of
+	AlternateForUID *string
 	// Organization information for the committee member
 	Organization *struct {
 		// Organization ID
@@ -578,6 +874,9 @@ type UpdateCommitteeSettingsPayload struct {
 	UID *string
 	// Whether business email is required for committee members
 	BusinessEmailRequired bool
+	// Email domains allowed for committee members, overriding the business email
+	// requirement when not empty
+	AllowedEmailDomains []string
 	// The timestamp when the committee was last reviewed in RFC3339 format
 	LastReviewedAt *string
 	// The user ID who last reviewed this committee
@@ -24,13 +24,13 @@ import (
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() []string {
 	return []string{
-		"committee-service (create-committee|get-committee-base|update-committee-base|delete-committee|get-committee-settings|update-committee-settings|readyz|livez|create-committee-member|get-committee-member|update-committee-member|delete-committee-member)",
+		"committee-service (create-committee|get-committee-base|get-committee-with-members|list-committee-children|update-committee-base|delete-committee|get-committee-settings|update-committee-settings|readyz|livez|create-committee-member|reassign-committee-members-organization|list-committee-members-by-organization|get-committee-member|get-committee-member-history|update-committee-member|patch-committee-member|delete-committee-member)",
 	}
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
-	return os.Args[0] + " " + "committee-service create-committee --body '{\n      \"auditors\": [\n         \"auditor_user_id1\",\n         \"auditor_user_id2\"\n      ],\n      \"business_email_required\": false,\n      \"calendar\": {\n         \"public\": true\n      },\n      \"category\": \"Technical Steering Committee\",\n      \"description\": \"Main technical oversight committee for the project\",\n      \"display_name\": \"TSC Committee Calendar\",\n      \"enable_voting\": true,\n      \"last_reviewed_at\": \"2025-08-04T09:00:00Z\",\n      \"last_reviewed_by\": \"user_id_12345\",\n      \"member_visibility\": \"hidden\",\n      \"name\": \"Technical Steering Committee\",\n      \"parent_uid\": \"90b147f2-7cdd-157a-a2f4-9d4a567123fc\",\n      \"project_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"public\": true,\n      \"requires_review\": true,\n      \"show_meeting_attendees\": false,\n      \"sso_group_enabled\": true,\n      \"website\": \"https://committee.example.org\",\n      \"writers\": [\n         \"manager_user_id1\",\n         \"manager_user_id2\"\n      ]\n   }' --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true" + "\n" +
+	return os.Args[0] + " " + "committee-service create-committee --body '{\n      \"allowed_email_domains\": [\n         \"linuxfoundation.org\"\n      ],\n      \"auditors\": [\n         \"auditor_user_id1\",\n         \"auditor_user_id2\"\n      ],\n      \"business_email_required\": false,\n      \"calendar\": {\n         \"public\": true\n      },\n      \"category\": \"Technical Steering Committee\",\n      \"description\": \"Main technical oversight committee for the project\",\n      \"display_name\": \"TSC Committee Calendar\",\n      \"enable_voting\": true,\n      \"labels\": {\n         \"region\": \"emea\"\n      },\n      \"last_reviewed_at\": \"2025-08-04T09:00:00Z\",\n      \"last_reviewed_by\": \"user_id_12345\",\n      \"member_visibility\": \"hidden\",\n      \"name\": \"Technical Steering Committee\",\n      \"parent_uid\": \"90b147f2-7cdd-157a-a2f4-9d4a567123fc\",\n      \"project_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"public\": true,\n      \"requires_review\": true,\n      \"show_meeting_attendees\": false,\n      \"sso_group_enabled\": true,\n      \"website\": \"https://committee.example.org\",\n      \"writers\": [\n         \"manager_user_id1\",\n         \"manager_user_id2\"\n      ]\n   }' --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true" + "\n" +
 		""
 }
 
@@ -57,6 +57,20 @@ func ParseEndpoint(
 		committeeServiceGetCommitteeBaseVersionFlag     = committeeServiceGetCommitteeBaseFlags.String("version", "", "")
 		committeeServiceGetCommitteeBaseBearerTokenFlag = committeeServiceGetCommitteeBaseFlags.String("bearer-token", "", "")
 
+		committeeServiceGetCommitteeWithMembersFlags           = flag.NewFlagSet("get-committee-with-members", flag.ExitOnError)
+		committeeServiceGetCommitteeWithMembersUIDFlag         = committeeServiceGetCommitteeWithMembersFlags.String("uid", "REQUIRED", "Committee UID -- v2 uid, not related to v1 id directly")
+		committeeServiceGetCommitteeWithMembersVersionFlag     = committeeServiceGetCommitteeWithMembersFlags.String("version", "", "")
+		committeeServiceGetCommitteeWithMembersPageSizeFlag    = committeeServiceGetCommitteeWithMembersFlags.String("page-size", "50", "")
+		committeeServiceGetCommitteeWithMembersPageTokenFlag   = committeeServiceGetCommitteeWithMembersFlags.String("page-token", "", "")
+		committeeServiceGetCommitteeWithMembersBearerTokenFlag = committeeServiceGetCommitteeWithMembersFlags.String("bearer-token", "", "")
+
+		committeeServiceListCommitteeChildrenFlags           = flag.NewFlagSet("list-committee-children", flag.ExitOnError)
+		committeeServiceListCommitteeChildrenUIDFlag         = committeeServiceListCommitteeChildrenFlags.String("uid", "REQUIRED", "Committee UID -- v2 uid, not related to v1 id directly")
+		committeeServiceListCommitteeChildrenVersionFlag     = committeeServiceListCommitteeChildrenFlags.String("version", "", "")
+		committeeServiceListCommitteeChildrenPageSizeFlag    = committeeServiceListCommitteeChildrenFlags.String("page-size", "50", "")
+		committeeServiceListCommitteeChildrenPageTokenFlag   = committeeServiceListCommitteeChildrenFlags.String("page-token", "", "")
+		committeeServiceListCommitteeChildrenBearerTokenFlag = committeeServiceListCommitteeChildrenFlags.String("bearer-token", "", "")
+
 		committeeServiceUpdateCommitteeBaseFlags           = flag.NewFlagSet("update-committee-base", flag.ExitOnError)
 		committeeServiceUpdateCommitteeBaseBodyFlag        = committeeServiceUpdateCommitteeBaseFlags.String("body", "REQUIRED", "")
 		committeeServiceUpdateCommitteeBaseUIDFlag         = committeeServiceUpdateCommitteeBaseFlags.String("uid", "REQUIRED", "Committee UID -- v2 uid, not related to v1 id directly")
@@ -96,12 +110,32 @@ func ParseEndpoint(
 		committeeServiceCreateCommitteeMemberBearerTokenFlag = committeeServiceCreateCommitteeMemberFlags.String("bearer-token", "", "")
 		committeeServiceCreateCommitteeMemberXSyncFlag       = committeeServiceCreateCommitteeMemberFlags.String("x-sync", "", "")
 
+		committeeServiceReassignCommitteeMembersOrganizationFlags           = flag.NewFlagSet("reassign-committee-members-organization", flag.ExitOnError)
+		committeeServiceReassignCommitteeMembersOrganizationBodyFlag        = committeeServiceReassignCommitteeMembersOrganizationFlags.String("body", "REQUIRED", "")
+		committeeServiceReassignCommitteeMembersOrganizationUIDFlag         = committeeServiceReassignCommitteeMembersOrganizationFlags.String("uid", "REQUIRED", "Committee UID -- v2 uid, not related to v1 id directly")
+		committeeServiceReassignCommitteeMembersOrganizationVersionFlag     = committeeServiceReassignCommitteeMembersOrganizationFlags.String("version", "REQUIRED", "")
+		committeeServiceReassignCommitteeMembersOrganizationBearerTokenFlag = committeeServiceReassignCommitteeMembersOrganizationFlags.String("bearer-token", "", "")
+		committeeServiceReassignCommitteeMembersOrganizationXSyncFlag       = committeeServiceReassignCommitteeMembersOrganizationFlags.String("x-sync", "", "")
+
+		committeeServiceListCommitteeMembersByOrganizationFlags            = flag.NewFlagSet("list-committee-members-by-organization", flag.ExitOnError)
+		committeeServiceListCommitteeMembersByOrganizationUIDFlag          = committeeServiceListCommitteeMembersByOrganizationFlags.String("uid", "REQUIRED", "Committee UID -- v2 uid, not related to v1 id directly")
+		committeeServiceListCommitteeMembersByOrganizationVersionFlag      = committeeServiceListCommitteeMembersByOrganizationFlags.String("version", "REQUIRED", "")
+		committeeServiceListCommitteeMembersByOrganizationOrganizationFlag = committeeServiceListCommitteeMembersByOrganizationFlags.String("organization", "REQUIRED", "")
+		committeeServiceListCommitteeMembersByOrganizationBearerTokenFlag  = committeeServiceListCommitteeMembersByOrganizationFlags.String("bearer-token", "", "")
+
 		committeeServiceGetCommitteeMemberFlags           = flag.NewFlagSet("get-committee-member", flag.ExitOnError)
 		committeeServiceGetCommitteeMemberUIDFlag         = committeeServiceGetCommitteeMemberFlags.String("uid", "REQUIRED", "Committee UID -- v2 uid, not related to v1 id directly")
 		committeeServiceGetCommitteeMemberMemberUIDFlag   = committeeServiceGetCommitteeMemberFlags.String("member-uid", "REQUIRED", "Committee member UID -- v2 uid, not related to v1 id directly")
 		committeeServiceGetCommitteeMemberVersionFlag     = committeeServiceGetCommitteeMemberFlags.String("version", "REQUIRED", "")
 		committeeServiceGetCommitteeMemberBearerTokenFlag = committeeServiceGetCommitteeMemberFlags.String("bearer-token", "", "")
 
+		committeeServiceGetCommitteeMemberHistoryFlags           = flag.NewFlagSet("get-committee-member-history", flag.ExitOnError)
+		committeeServiceGetCommitteeMemberHistoryUIDFlag         = committeeServiceGetCommitteeMemberHistoryFlags.String("uid", "REQUIRED", "Committee UID -- v2 uid, not related to v1 id directly")
+		committeeServiceGetCommitteeMemberHistoryMemberUIDFlag   = committeeServiceGetCommitteeMemberHistoryFlags.String("member-uid", "REQUIRED", "Committee member UID -- v2 uid, not related to v1 id directly")
+		committeeServiceGetCommitteeMemberHistoryVersionFlag     = committeeServiceGetCommitteeMemberHistoryFlags.String("version", "REQUIRED", "")
+		committeeServiceGetCommitteeMemberHistoryLimitFlag       = committeeServiceGetCommitteeMemberHistoryFlags.String("limit", "20", "")
+		committeeServiceGetCommitteeMemberHistoryBearerTokenFlag = committeeServiceGetCommitteeMemberHistoryFlags.String("bearer-token", "", "")
+
 		committeeServiceUpdateCommitteeMemberFlags           = flag.NewFlagSet("update-committee-member", flag.ExitOnError)
 		committeeServiceUpdateCommitteeMemberBodyFlag        = committeeServiceUpdateCommitteeMemberFlags.String("body", "REQUIRED", "")
 		committeeServiceUpdateCommitteeMemberUIDFlag         = committeeServiceUpdateCommitteeMemberFlags.String("uid", "REQUIRED", "Committee UID -- v2 uid, not related to v1 id directly")
@@ -111,6 +145,15 @@ func ParseEndpoint(
 		committeeServiceUpdateCommitteeMemberIfMatchFlag     = committeeServiceUpdateCommitteeMemberFlags.String("if-match", "", "")
 		committeeServiceUpdateCommitteeMemberXSyncFlag       = committeeServiceUpdateCommitteeMemberFlags.String("x-sync", "", "")
 
+		committeeServicePatchCommitteeMemberFlags           = flag.NewFlagSet("patch-committee-member", flag.ExitOnError)
+		committeeServicePatchCommitteeMemberBodyFlag        = committeeServicePatchCommitteeMemberFlags.String("body", "REQUIRED", "")
+		committeeServicePatchCommitteeMemberUIDFlag         = committeeServicePatchCommitteeMemberFlags.String("uid", "REQUIRED", "Committee UID -- v2 uid, not related to v1 id directly")
+		committeeServicePatchCommitteeMemberMemberUIDFlag   = committeeServicePatchCommitteeMemberFlags.String("member-uid", "REQUIRED", "Committee member UID -- v2 uid, not related to v1 id directly")
+		committeeServicePatchCommitteeMemberVersionFlag     = committeeServicePatchCommitteeMemberFlags.String("version", "REQUIRED", "")
+		committeeServicePatchCommitteeMemberBearerTokenFlag = committeeServicePatchCommitteeMemberFlags.String("bearer-token", "", "")
+		committeeServicePatchCommitteeMemberIfMatchFlag     = committeeServicePatchCommitteeMemberFlags.String("if-match", "", "")
+		committeeServicePatchCommitteeMemberXSyncFlag       = committeeServicePatchCommitteeMemberFlags.String("x-sync", "", "")
+
 		committeeServiceDeleteCommitteeMemberFlags           = flag.NewFlagSet("delete-committee-member", flag.ExitOnError)
 		committeeServiceDeleteCommitteeMemberUIDFlag         = committeeServiceDeleteCommitteeMemberFlags.String("uid", "REQUIRED", "Committee UID -- v2 uid, not related to v1 id directly")
 		committeeServiceDeleteCommitteeMemberMemberUIDFlag   = committeeServiceDeleteCommitteeMemberFlags.String("member-uid", "REQUIRED", "Committee member UID -- v2 uid, not related to v1 id directly")
@@ -122,6 +165,8 @@ func ParseEndpoint(
 	committeeServiceFlags.Usage = committeeServiceUsage
 	committeeServiceCreateCommitteeFlags.Usage = committeeServiceCreateCommitteeUsage
 	committeeServiceGetCommitteeBaseFlags.Usage = committeeServiceGetCommitteeBaseUsage
+	committeeServiceGetCommitteeWithMembersFlags.Usage = committeeServiceGetCommitteeWithMembersUsage
+	committeeServiceListCommitteeChildrenFlags.Usage = committeeServiceListCommitteeChildrenUsage
 	committeeServiceUpdateCommitteeBaseFlags.Usage = committeeServiceUpdateCommitteeBaseUsage
 	committeeServiceDeleteCommitteeFlags.Usage = committeeServiceDeleteCommitteeUsage
 	committeeServiceGetCommitteeSettingsFlags.Usage = committeeServiceGetCommitteeSettingsUsage
@@ -129,8 +174,12 @@ func ParseEndpoint(
 	committeeServiceReadyzFlags.Usage = committeeServiceReadyzUsage
 	committeeServiceLivezFlags.Usage = committeeServiceLivezUsage
 	committeeServiceCreateCommitteeMemberFlags.Usage = committeeServiceCreateCommitteeMemberUsage
+	committeeServiceReassignCommitteeMembersOrganizationFlags.Usage = committeeServiceReassignCommitteeMembersOrganizationUsage
+	committeeServiceListCommitteeMembersByOrganizationFlags.Usage = committeeServiceListCommitteeMembersByOrganizationUsage
 	committeeServiceGetCommitteeMemberFlags.Usage = committeeServiceGetCommitteeMemberUsage
+	committeeServiceGetCommitteeMemberHistoryFlags.Usage = committeeServiceGetCommitteeMemberHistoryUsage
 	committeeServiceUpdateCommitteeMemberFlags.Usage = committeeServiceUpdateCommitteeMemberUsage
+	committeeServicePatchCommitteeMemberFlags.Usage = committeeServicePatchCommitteeMemberUsage
 	committeeServiceDeleteCommitteeMemberFlags.Usage = committeeServiceDeleteCommitteeMemberUsage
 
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
@@ -173,6 +222,12 @@ func ParseEndpoint(
 			case "get-committee-base":
 				epf = committeeServiceGetCommitteeBaseFlags
 
+			case "get-committee-with-members":
+				epf = committeeServiceGetCommitteeWithMembersFlags
+
+			case "list-committee-children":
+				epf = committeeServiceListCommitteeChildrenFlags
+
 			case "update-committee-base":
 				epf = committeeServiceUpdateCommitteeBaseFlags
 
@@ -194,12 +249,24 @@ func ParseEndpoint(
 			case "create-committee-member":
 				epf = committeeServiceCreateCommitteeMemberFlags
 
+			case "reassign-committee-members-organization":
+				epf = committeeServiceReassignCommitteeMembersOrganizationFlags
+
+			case "list-committee-members-by-organization":
+				epf = committeeServiceListCommitteeMembersByOrganizationFlags
+
 			case "get-committee-member":
 				epf = committeeServiceGetCommitteeMemberFlags
 
+			case "get-committee-member-history":
+				epf = committeeServiceGetCommitteeMemberHistoryFlags
+
 			case "update-committee-member":
 				epf = committeeServiceUpdateCommitteeMemberFlags
 
+			case "patch-committee-member":
+				epf = committeeServicePatchCommitteeMemberFlags
+
 			case "delete-committee-member":
 				epf = committeeServiceDeleteCommitteeMemberFlags
 
@@ -234,6 +301,12 @@ func ParseEndpoint(
 			case "get-committee-base":
 				endpoint = c.GetCommitteeBase()
 				data, err = committeeservicec.BuildGetCommitteeBasePayload(*committeeServiceGetCommitteeBaseUIDFlag, *committeeServiceGetCommitteeBaseVersionFlag, *committeeServiceGetCommitteeBaseBearerTokenFlag)
+			case "get-committee-with-members":
+				endpoint = c.GetCommitteeWithMembers()
+				data, err = committeeservicec.BuildGetCommitteeWithMembersPayload(*committeeServiceGetCommitteeWithMembersUIDFlag, *committeeServiceGetCommitteeWithMembersVersionFlag, *committeeServiceGetCommitteeWithMembersPageSizeFlag, *committeeServiceGetCommitteeWithMembersPageTokenFlag, *committeeServiceGetCommitteeWithMembersBearerTokenFlag)
+			case "list-committee-children":
+				endpoint = c.ListCommitteeChildren()
+				data, err = committeeservicec.BuildListCommitteeChildrenPayload(*committeeServiceListCommitteeChildrenUIDFlag, *committeeServiceListCommitteeChildrenVersionFlag, *committeeServiceListCommitteeChildrenPageSizeFlag, *committeeServiceListCommitteeChildrenPageTokenFlag, *committeeServiceListCommitteeChildrenBearerTokenFlag)
 			case "update-committee-base":
 				endpoint = c.UpdateCommitteeBase()
 				data, err = committeeservicec.BuildUpdateCommitteeBasePayload(*committeeServiceUpdateCommitteeBaseBodyFlag, *committeeServiceUpdateCommitteeBaseUIDFlag, *committeeServiceUpdateCommitteeBaseVersionFlag, *committeeServiceUpdateCommitteeBaseBearerTokenFlag, *committeeServiceUpdateCommitteeBaseIfMatchFlag, *committeeServiceUpdateCommitteeBaseXSyncFlag)
@@ -253,12 +326,24 @@ func ParseEndpoint(
 			case "create-committee-member":
 				endpoint = c.CreateCommitteeMember()
 				data, err = committeeservicec.BuildCreateCommitteeMemberPayload(*committeeServiceCreateCommitteeMemberBodyFlag, *committeeServiceCreateCommitteeMemberUIDFlag, *committeeServiceCreateCommitteeMemberVersionFlag, *committeeServiceCreateCommitteeMemberBearerTokenFlag, *committeeServiceCreateCommitteeMemberXSyncFlag)
+			case "reassign-committee-members-organization":
+				endpoint = c.ReassignCommitteeMembersOrganization()
+				data, err = committeeservicec.BuildReassignCommitteeMembersOrganizationPayload(*committeeServiceReassignCommitteeMembersOrganizationBodyFlag, *committeeServiceReassignCommitteeMembersOrganizationUIDFlag, *committeeServiceReassignCommitteeMembersOrganizationVersionFlag, *committeeServiceReassignCommitteeMembersOrganizationBearerTokenFlag, *committeeServiceReassignCommitteeMembersOrganizationXSyncFlag)
+			case "list-committee-members-by-organization":
+				endpoint = c.ListCommitteeMembersByOrganization()
+				data, err = committeeservicec.BuildListCommitteeMembersByOrganizationPayload(*committeeServiceListCommitteeMembersByOrganizationUIDFlag, *committeeServiceListCommitteeMembersByOrganizationVersionFlag, *committeeServiceListCommitteeMembersByOrganizationOrganizationFlag, *committeeServiceListCommitteeMembersByOrganizationBearerTokenFlag)
 			case "get-committee-member":
 				endpoint = c.GetCommitteeMember()
 				data, err = committeeservicec.BuildGetCommitteeMemberPayload(*committeeServiceGetCommitteeMemberUIDFlag, *committeeServiceGetCommitteeMemberMemberUIDFlag, *committeeServiceGetCommitteeMemberVersionFlag, *committeeServiceGetCommitteeMemberBearerTokenFlag)
+			case "get-committee-member-history":
+				endpoint = c.GetCommitteeMemberHistory()
+				data, err = committeeservicec.BuildGetCommitteeMemberHistoryPayload(*committeeServiceGetCommitteeMemberHistoryUIDFlag, *committeeServiceGetCommitteeMemberHistoryMemberUIDFlag, *committeeServiceGetCommitteeMemberHistoryVersionFlag, *committeeServiceGetCommitteeMemberHistoryLimitFlag, *committeeServiceGetCommitteeMemberHistoryBearerTokenFlag)
 			case "update-committee-member":
 				endpoint = c.UpdateCommitteeMember()
 				data, err = committeeservicec.BuildUpdateCommitteeMemberPayload(*committeeServiceUpdateCommitteeMemberBodyFlag, *committeeServiceUpdateCommitteeMemberUIDFlag, *committeeServiceUpdateCommitteeMemberMemberUIDFlag, *committeeServiceUpdateCommitteeMemberVersionFlag, *committeeServiceUpdateCommitteeMemberBearerTokenFlag, *committeeServiceUpdateCommitteeMemberIfMatchFlag, *committeeServiceUpdateCommitteeMemberXSyncFlag)
+			case "patch-committee-member":
+				endpoint = c.PatchCommitteeMember()
+				data, err = committeeservicec.BuildPatchCommitteeMemberPayload(*committeeServicePatchCommitteeMemberBodyFlag, *committeeServicePatchCommitteeMemberUIDFlag, *committeeServicePatchCommitteeMemberMemberUIDFlag, *committeeServicePatchCommitteeMemberVersionFlag, *committeeServicePatchCommitteeMemberBearerTokenFlag, *committeeServicePatchCommitteeMemberIfMatchFlag, *committeeServicePatchCommitteeMemberXSyncFlag)
 			case "delete-committee-member":
 				endpoint = c.DeleteCommitteeMember()
 				data, err = committeeservicec.BuildDeleteCommitteeMemberPayload(*committeeServiceDeleteCommitteeMemberUIDFlag, *committeeServiceDeleteCommitteeMemberMemberUIDFlag, *committeeServiceDeleteCommitteeMemberVersionFlag, *committeeServiceDeleteCommitteeMemberBearerTokenFlag, *committeeServiceDeleteCommitteeMemberIfMatchFlag, *committeeServiceDeleteCommitteeMemberXSyncFlag)
@@ -280,6 +365,8 @@ func committeeServiceUsage() {
 	fmt.Fprintln(os.Stderr, "COMMAND:")
 	fmt.Fprintln(os.Stderr, `    create-committee: Create Committee`)
 	fmt.Fprintln(os.Stderr, `    get-committee-base: Get Committee`)
+	fmt.Fprintln(os.Stderr, `    get-committee-with-members: Get a committee with its settings and a page of its members`)
+	fmt.Fprintln(os.Stderr, `    list-committee-children: List the direct sub-committees of a committee`)
 	fmt.Fprintln(os.Stderr, `    update-committee-base: Update Committee`)
 	fmt.Fprintln(os.Stderr, `    delete-committee: Delete Committee`)
 	fmt.Fprintln(os.Stderr, `    get-committee-settings: Get Committee Settings`)
@@ -287,8 +374,12 @@ func committeeServiceUsage() {
 	fmt.Fprintln(os.Stderr, `    readyz: Check if the service is able to take inbound requests.`)
 	fmt.Fprintln(os.Stderr, `    livez: Check if the service is alive.`)
 	fmt.Fprintln(os.Stderr, `    create-committee-member: Add a new member to a committee`)
+	fmt.Fprintln(os.Stderr, `    reassign-committee-members-organization: Move the committee members of an organization to another one, or clear their organization`)
+	fmt.Fprintln(os.Stderr, `    list-committee-members-by-organization: List the committee members belonging to an organization`)
 	fmt.Fprintln(os.Stderr, `    get-committee-member: Get a specific committee member by UID`)
+	fmt.Fprintln(os.Stderr, `    get-committee-member-history: Get the stored revisions of a committee member, newest first`)
 	fmt.Fprintln(os.Stderr, `    update-committee-member: Replace an existing committee member (requires complete resource)`)
+	fmt.Fprintln(os.Stderr, `    patch-committee-member: Update the fields of an existing committee member sent in the request, leaving the omitted ones unchanged`)
 	fmt.Fprintln(os.Stderr, `    delete-committee-member: Remove a member from a committee`)
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Additional help:")
@@ -315,7 +406,7 @@ func committeeServiceCreateCommitteeUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "committee-service create-committee --body '{\n      \"auditors\": [\n         \"auditor_user_id1\",\n         \"auditor_user_id2\"\n      ],\n      \"business_email_required\": false,\n      \"calendar\": {\n         \"public\": true\n      },\n      \"category\": \"Technical Steering Committee\",\n      \"description\": \"Main technical oversight committee for the project\",\n      \"display_name\": \"TSC Committee Calendar\",\n      \"enable_voting\": true,\n      \"last_reviewed_at\": \"2025-08-04T09:00:00Z\",\n      \"last_reviewed_by\": \"user_id_12345\",\n      \"member_visibility\": \"hidden\",\n      \"name\": \"Technical Steering Committee\",\n      \"parent_uid\": \"90b147f2-7cdd-157a-a2f4-9d4a567123fc\",\n      \"project_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"public\": true,\n      \"requires_review\": true,\n      \"show_meeting_attendees\": false,\n      \"sso_group_enabled\": true,\n      \"website\": \"https://committee.example.org\",\n      \"writers\": [\n         \"manager_user_id1\",\n         \"manager_user_id2\"\n      ]\n   }' --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "committee-service create-committee --body '{\n      \"allowed_email_domains\": [\n         \"linuxfoundation.org\"\n      ],\n      \"auditors\": [\n         \"auditor_user_id1\",\n         \"auditor_user_id2\"\n      ],\n      \"business_email_required\": false,\n      \"calendar\": {\n         \"public\": true\n      },\n      \"category\": \"Technical Steering Committee\",\n      \"description\": \"Main technical oversight committee for the project\",\n      \"display_name\": \"TSC Committee Calendar\",\n      \"enable_voting\": true,\n      \"labels\": {\n         \"region\": \"emea\"\n      },\n      \"last_reviewed_at\": \"2025-08-04T09:00:00Z\",\n      \"last_reviewed_by\": \"user_id_12345\",\n      \"member_visibility\": \"hidden\",\n      \"name\": \"Technical Steering Committee\",\n      \"parent_uid\": \"90b147f2-7cdd-157a-a2f4-9d4a567123fc\",\n      \"project_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"public\": true,\n      \"requires_review\": true,\n      \"show_meeting_attendees\": false,\n      \"sso_group_enabled\": true,\n      \"website\": \"https://committee.example.org\",\n      \"writers\": [\n         \"manager_user_id1\",\n         \"manager_user_id2\"\n      ]\n   }' --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true")
 }
 
 func committeeServiceGetCommitteeBaseUsage() {
@@ -340,6 +431,58 @@ func committeeServiceGetCommitteeBaseUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "committee-service get-committee-base --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\"")
 }
 
+func committeeServiceGetCommitteeWithMembersUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] committee-service get-committee-with-members", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -page-size INT")
+	fmt.Fprint(os.Stderr, " -page-token STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get a committee with its settings and a page of its members`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Committee UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -page-size INT: `)
+	fmt.Fprintln(os.Stderr, `    -page-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "committee-service get-committee-with-members --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --page-size 20 --page-token \"20\" --bearer-token \"eyJhbGci...\"")
+}
+
+func committeeServiceListCommitteeChildrenUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] committee-service list-committee-children", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -page-size INT")
+	fmt.Fprint(os.Stderr, " -page-token STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the direct sub-committees of a committee`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Committee UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -page-size INT: `)
+	fmt.Fprintln(os.Stderr, `    -page-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "committee-service list-committee-children --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --page-size 20 --page-token \"20\" --bearer-token \"eyJhbGci...\"")
+}
+
 func committeeServiceUpdateCommitteeBaseUsage() {
 	// Header with flags
 	fmt.Fprintf(os.Stderr, "%s [flags] committee-service update-committee-base", os.Args[0])
@@ -365,7 +508,7 @@ func committeeServiceUpdateCommitteeBaseUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "committee-service update-committee-base --body '{\n      \"calendar\": {\n         \"public\": true\n      },\n      \"category\": \"Technical Steering Committee\",\n      \"description\": \"Main technical oversight committee for the project\",\n      \"display_name\": \"TSC Committee Calendar\",\n      \"enable_voting\": true,\n      \"name\": \"Technical Steering Committee\",\n      \"parent_uid\": \"90b147f2-7cdd-157a-a2f4-9d4a567123fc\",\n      \"project_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"public\": true,\n      \"requires_review\": true,\n      \"sso_group_enabled\": true,\n      \"website\": \"https://committee.example.org\"\n   }' --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\" --if-match \"123\" --x-sync true")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "committee-service update-committee-base --body '{\n      \"calendar\": {\n         \"public\": true\n      },\n      \"category\": \"Technical Steering Committee\",\n      \"description\": \"Main technical oversight committee for the project\",\n      \"display_name\": \"TSC Committee Calendar\",\n      \"enable_voting\": true,\n      \"labels\": {\n         \"region\": \"emea\"\n      },\n      \"name\": \"Technical Steering Committee\",\n      \"parent_uid\": \"90b147f2-7cdd-157a-a2f4-9d4a567123fc\",\n      \"project_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"public\": true,\n      \"requires_review\": true,\n      \"sso_group_enabled\": true,\n      \"website\": \"https://committee.example.org\"\n   }' --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\" --if-match \"123\" --x-sync true")
 }
 
 func committeeServiceDeleteCommitteeUsage() {
@@ -441,7 +584,7 @@ func committeeServiceUpdateCommitteeSettingsUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "committee-service update-committee-settings --body '{\n      \"auditors\": [\n         \"auditor_user_id1\",\n         \"auditor_user_id2\"\n      ],\n      \"business_email_required\": false,\n      \"last_reviewed_at\": \"2025-08-04T09:00:00Z\",\n      \"last_reviewed_by\": \"user_id_12345\",\n      \"member_visibility\": \"hidden\",\n      \"show_meeting_attendees\": false,\n      \"writers\": [\n         \"manager_user_id1\",\n         \"manager_user_id2\"\n      ]\n   }' --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\" --if-match \"123\" --x-sync true")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "committee-service update-committee-settings --body '{\n      \"allowed_email_domains\": [\n         \"linuxfoundation.org\"\n      ],\n      \"auditors\": [\n         \"auditor_user_id1\",\n         \"auditor_user_id2\"\n      ],\n      \"business_email_required\": false,\n      \"last_reviewed_at\": \"2025-08-04T09:00:00Z\",\n      \"last_reviewed_by\": \"user_id_12345\",\n      \"member_visibility\": \"hidden\",\n      \"show_meeting_attendees\": false,\n      \"writers\": [\n         \"manager_user_id1\",\n         \"manager_user_id2\"\n      ]\n   }' --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\" --if-match \"123\" --x-sync true")
 }
 
 func committeeServiceReadyzUsage() {
@@ -499,7 +642,57 @@ func committeeServiceCreateCommitteeMemberUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "committee-service create-committee-member --body '{\n      \"appointed_by\": \"Community\",\n      \"email\": \"user@example.com\",\n      \"first_name\": \"John\",\n      \"job_title\": \"Chief Technology Officer\",\n      \"last_name\": \"Doe\",\n      \"linkedin_profile\": \"https://www.linkedin.com/in/johndoe\",\n      \"organization\": {\n         \"id\": \"org-123456\",\n         \"name\": \"The Linux Foundation\",\n         \"website\": \"https://linuxfoundation.org\"\n      },\n      \"role\": {\n         \"end_date\": \"2024-12-31\",\n         \"name\": \"Chair\",\n         \"start_date\": \"2023-01-01\"\n      },\n      \"status\": \"Active\",\n      \"username\": \"user123\",\n      \"voting\": {\n         \"end_date\": \"2024-12-31\",\n         \"start_date\": \"2023-01-01\",\n         \"status\": \"Voting Rep\"\n      }\n   }' --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "committee-service create-committee-member --body '{\n      \"alternate_for_uid\": \"2200b646-fbb2-4de7-ad80-fd195a874baf\",\n      \"appointed_by\": \"Community\",\n      \"email\": \"user@example.com\",\n      \"first_name\": \"John\",\n      \"job_title\": \"Chief Technology Officer\",\n      \"last_name\": \"Doe\",\n      \"linkedin_profile\": \"https://www.linkedin.com/in/johndoe\",\n      \"organization\": {\n         \"id\": \"org-123456\",\n         \"name\": \"The Linux Foundation\",\n         \"website\": \"https://linuxfoundation.org\"\n      },\n      \"role\": {\n         \"end_date\": \"2024-12-31\",\n         \"name\": \"Chair\",\n         \"start_date\": \"2023-01-01\"\n      },\n      \"status\": \"Active\",\n      \"username\": \"user123\",\n      \"voting\": {\n         \"end_date\": \"2024-12-31\",\n         \"start_date\": \"2023-01-01\",\n         \"status\": \"Voting Rep\",\n         \"weight\": 1\n      }\n   }' --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true")
+}
+
+func committeeServiceReassignCommitteeMembersOrganizationUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] committee-service reassign-committee-members-organization", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprint(os.Stderr, " -x-sync BOOL")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Move the committee members of an organization to another one, or clear their organization`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Committee UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "committee-service reassign-committee-members-organization --body '{\n      \"from_organization\": \"Departing Corp\",\n      \"to_organization\": \"The Linux Foundation\"\n   }' --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true")
+}
+
+func committeeServiceListCommitteeMembersByOrganizationUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] committee-service list-committee-members-by-organization", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -organization STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the committee members belonging to an organization`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Committee UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -organization STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "committee-service list-committee-members-by-organization --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --organization \"IBM\" --bearer-token \"eyJhbGci...\"")
 }
 
 func committeeServiceGetCommitteeMemberUsage() {
@@ -526,6 +719,32 @@ func committeeServiceGetCommitteeMemberUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "committee-service get-committee-member --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --member-uid \"2200b646-fbb2-4de7-ad80-fd195a874baf\" --version \"1\" --bearer-token \"eyJhbGci...\"")
 }
 
+func committeeServiceGetCommitteeMemberHistoryUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] committee-service get-committee-member-history", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -member-uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -limit INT")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get the stored revisions of a committee member, newest first`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Committee UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -member-uid STRING: Committee member UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -limit INT: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "committee-service get-committee-member-history --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --member-uid \"2200b646-fbb2-4de7-ad80-fd195a874baf\" --version \"1\" --limit 10 --bearer-token \"eyJhbGci...\"")
+}
+
 func committeeServiceUpdateCommitteeMemberUsage() {
 	// Header with flags
 	fmt.Fprintf(os.Stderr, "%s [flags] committee-service update-committee-member", os.Args[0])
@@ -553,7 +772,37 @@ func committeeServiceUpdateCommitteeMemberUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "committee-service update-committee-member --body '{\n      \"appointed_by\": \"Community\",\n      \"email\": \"user@example.com\",\n      \"first_name\": \"John\",\n      \"job_title\": \"Chief Technology Officer\",\n      \"last_name\": \"Doe\",\n      \"linkedin_profile\": \"https://www.linkedin.com/in/johndoe\",\n      \"organization\": {\n         \"id\": \"org-123456\",\n         \"name\": \"The Linux Foundation\",\n         \"website\": \"https://linuxfoundation.org\"\n      },\n      \"role\": {\n         \"end_date\": \"2024-12-31\",\n         \"name\": \"Chair\",\n         \"start_date\": \"2023-01-01\"\n      },\n      \"status\": \"Active\",\n      \"username\": \"user123\",\n      \"voting\": {\n         \"end_date\": \"2024-12-31\",\n         \"start_date\": \"2023-01-01\",\n         \"status\": \"Voting Rep\"\n      }\n   }' --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --member-uid \"2200b646-fbb2-4de7-ad80-fd195a874baf\" --version \"1\" --bearer-token \"eyJhbGci...\" --if-match \"123\" --x-sync true")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "committee-service update-committee-member --body '{\n      \"alternate_for_uid\": \"2200b646-fbb2-4de7-ad80-fd195a874baf\",\n      \"appointed_by\": \"Community\",\n      \"committee_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"email\": \"user@example.com\",\n      \"first_name\": \"John\",\n      \"job_title\": \"Chief Technology Officer\",\n      \"last_name\": \"Doe\",\n      \"linkedin_profile\": \"https://www.linkedin.com/in/johndoe\",\n      \"organization\": {\n         \"id\": \"org-123456\",\n         \"name\": \"The Linux Foundation\",\n         \"website\": \"https://linuxfoundation.org\"\n      },\n      \"role\": {\n         \"end_date\": \"2024-12-31\",\n         \"name\": \"Chair\",\n         \"start_date\": \"2023-01-01\"\n      },\n      \"status\": \"Active\",\n      \"username\": \"user123\",\n      \"voting\": {\n         \"end_date\": \"2024-12-31\",\n         \"start_date\": \"2023-01-01\",\n         \"status\": \"Voting Rep\",\n         \"weight\": 1\n      }\n   }' --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --member-uid \"2200b646-fbb2-4de7-ad80-fd195a874baf\" --version \"1\" --bearer-token \"eyJhbGci...\" --if-match \"123\" --x-sync true")
+}
+
+func committeeServicePatchCommitteeMemberUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] committee-service patch-committee-member", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -member-uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprint(os.Stderr, " -if-match STRING")
+	fmt.Fprint(os.Stderr, " -x-sync BOOL")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Update the fields of an existing committee member sent in the request, leaving the omitted ones unchanged`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Committee UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -member-uid STRING: Committee member UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
+	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "committee-service patch-committee-member --body '{\n      \"alternate_for_uid\": \"2200b646-fbb2-4de7-ad80-fd195a874baf\",\n      \"appointed_by\": \"Community\",\n      \"email\": \"user@example.com\",\n      \"first_name\": \"John\",\n      \"job_title\": \"Chief Technology Officer\",\n      \"last_name\": \"Doe\",\n      \"linkedin_profile\": \"https://www.linkedin.com/in/johndoe\",\n      \"organization\": {\n         \"id\": \"org-123456\",\n         \"name\": \"The Linux Foundation\",\n         \"website\": \"https://linuxfoundation.org\"\n      },\n      \"role\": {\n         \"end_date\": \"2024-12-31\",\n         \"name\": \"Chair\",\n         \"start_date\": \"2023-01-01\"\n      },\n      \"status\": \"Active\",\n      \"username\": \"user123\",\n      \"voting\": {\n         \"end_date\": \"2024-12-31\",\n         \"start_date\": \"2023-01-01\",\n         \"status\": \"Voting Rep\",\n         \"weight\": 1\n      }\n   }' --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --member-uid \"2200b646-fbb2-4de7-ad80-fd195a874baf\" --version \"1\" --bearer-token \"eyJhbGci...\" --if-match \"123\" --x-sync true")
 }
 
 func committeeServiceDeleteCommitteeMemberUsage() {
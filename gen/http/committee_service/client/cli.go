@@ -26,7 +26,7 @@ func BuildCreateCommitteePayload(committeeServiceCreateCommitteeBody string, com
 	{
 		err = json.Unmarshal([]byte(committeeServiceCreateCommitteeBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"auditors\": [\n         \"auditor_user_id1\",\n         \"auditor_user_id2\"\n      ],\n      \"business_email_required\": false,\n      \"calendar\": {\n         \"public\": true\n      },\n      \"category\": \"Technical Steering Committee\",\n      \"description\": \"Main technical oversight committee for the project\",\n      \"display_name\": \"TSC Committee Calendar\",\n      \"enable_voting\": true,\n      \"last_reviewed_at\": \"2025-08-04T09:00:00Z\",\n      \"last_reviewed_by\": \"user_id_12345\",\n      \"member_visibility\": \"hidden\",\n      \"name\": \"Technical Steering Committee\",\n      \"parent_uid\": \"90b147f2-7cdd-157a-a2f4-9d4a567123fc\",\n      \"project_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"public\": true,\n      \"requires_review\": true,\n      \"show_meeting_attendees\": false,\n      \"sso_group_enabled\": true,\n      \"website\": \"https://committee.example.org\",\n      \"writers\": [\n         \"manager_user_id1\",\n         \"manager_user_id2\"\n      ]\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"allowed_email_domains\": [\n         \"linuxfoundation.org\"\n      ],\n      \"auditors\": [\n         \"auditor_user_id1\",\n         \"auditor_user_id2\"\n      ],\n      \"business_email_required\": false,\n      \"calendar\": {\n         \"public\": true\n      },\n      \"category\": \"Technical Steering Committee\",\n      \"description\": \"Main technical oversight committee for the project\",\n      \"display_name\": \"TSC Committee Calendar\",\n      \"enable_voting\": true,\n      \"labels\": {\n         \"region\": \"emea\"\n      },\n      \"last_reviewed_at\": \"2025-08-04T09:00:00Z\",\n      \"last_reviewed_by\": \"user_id_12345\",\n      \"member_visibility\": \"hidden\",\n      \"name\": \"Technical Steering Committee\",\n      \"parent_uid\": \"90b147f2-7cdd-157a-a2f4-9d4a567123fc\",\n      \"project_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"public\": true,\n      \"requires_review\": true,\n      \"show_meeting_attendees\": false,\n      \"sso_group_enabled\": true,\n      \"website\": \"https://committee.example.org\",\n      \"writers\": [\n         \"manager_user_id1\",\n         \"manager_user_id2\"\n      ]\n   }'")
 		}
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.project_uid", body.ProjectUID, goa.FormatUUID))
 		if utf8.RuneCountInString(body.Name) > 100 {
@@ -147,12 +147,26 @@ func BuildCreateCommitteePayload(committeeServiceCreateCommitteeBody string, com
 			}
 		}
 	}
+	if body.Labels != nil {
+		v.Labels = make(map[string]string, len(body.Labels))
+		for key, val := range body.Labels {
+			tk := key
+			tv := val
+			v.Labels[tk] = tv
+		}
+	}
 	{
 		var zero bool
 		if v.BusinessEmailRequired == zero {
 			v.BusinessEmailRequired = false
 		}
 	}
+	if body.AllowedEmailDomains != nil {
+		v.AllowedEmailDomains = make([]string, len(body.AllowedEmailDomains))
+		for i, val := range body.AllowedEmailDomains {
+			v.AllowedEmailDomains[i] = val
+		}
+	}
 	{
 		var zero string
 		if v.MemberVisibility == zero {
@@ -222,6 +236,138 @@ func BuildGetCommitteeBasePayload(committeeServiceGetCommitteeBaseUID string, co
 	return v, nil
 }
 
+// BuildGetCommitteeWithMembersPayload builds the payload for the
+// committee-service get-committee-with-members endpoint from CLI flags.
+func BuildGetCommitteeWithMembersPayload(committeeServiceGetCommitteeWithMembersUID string, committeeServiceGetCommitteeWithMembersVersion string, committeeServiceGetCommitteeWithMembersPageSize string, committeeServiceGetCommitteeWithMembersPageToken string, committeeServiceGetCommitteeWithMembersBearerToken string) (*committeeservice.GetCommitteeWithMembersPayload, error) {
+	var err error
+	var uid string
+	{
+		uid = committeeServiceGetCommitteeWithMembersUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if committeeServiceGetCommitteeWithMembersVersion != "" {
+			version = &committeeServiceGetCommitteeWithMembersVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var pageSize int
+	{
+		if committeeServiceGetCommitteeWithMembersPageSize != "" {
+			var v int64
+			v, err = strconv.ParseInt(committeeServiceGetCommitteeWithMembersPageSize, 10, strconv.IntSize)
+			pageSize = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for pageSize, must be INT")
+			}
+			if pageSize < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("page_size", pageSize, 1, true))
+			}
+			if pageSize > 100 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("page_size", pageSize, 100, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var pageToken *string
+	{
+		if committeeServiceGetCommitteeWithMembersPageToken != "" {
+			pageToken = &committeeServiceGetCommitteeWithMembersPageToken
+		}
+	}
+	var bearerToken *string
+	{
+		if committeeServiceGetCommitteeWithMembersBearerToken != "" {
+			bearerToken = &committeeServiceGetCommitteeWithMembersBearerToken
+		}
+	}
+	v := &committeeservice.GetCommitteeWithMembersPayload{}
+	v.UID = &uid
+	v.Version = version
+	v.PageSize = pageSize
+	v.PageToken = pageToken
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildListCommitteeChildrenPayload builds the payload for the
+// committee-service list-committee-children endpoint from CLI flags.
+func BuildListCommitteeChildrenPayload(committeeServiceListCommitteeChildrenUID string, committeeServiceListCommitteeChildrenVersion string, committeeServiceListCommitteeChildrenPageSize string, committeeServiceListCommitteeChildrenPageToken string, committeeServiceListCommitteeChildrenBearerToken string) (*committeeservice.ListCommitteeChildrenPayload, error) {
+	var err error
+	var uid string
+	{
+		uid = committeeServiceListCommitteeChildrenUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if committeeServiceListCommitteeChildrenVersion != "" {
+			version = &committeeServiceListCommitteeChildrenVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var pageSize int
+	{
+		if committeeServiceListCommitteeChildrenPageSize != "" {
+			var v int64
+			v, err = strconv.ParseInt(committeeServiceListCommitteeChildrenPageSize, 10, strconv.IntSize)
+			pageSize = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for pageSize, must be INT")
+			}
+			if pageSize < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("page_size", pageSize, 1, true))
+			}
+			if pageSize > 100 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("page_size", pageSize, 100, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var pageToken *string
+	{
+		if committeeServiceListCommitteeChildrenPageToken != "" {
+			pageToken = &committeeServiceListCommitteeChildrenPageToken
+		}
+	}
+	var bearerToken *string
+	{
+		if committeeServiceListCommitteeChildrenBearerToken != "" {
+			bearerToken = &committeeServiceListCommitteeChildrenBearerToken
+		}
+	}
+	v := &committeeservice.ListCommitteeChildrenPayload{}
+	v.UID = &uid
+	v.Version = version
+	v.PageSize = pageSize
+	v.PageToken = pageToken
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
 // BuildUpdateCommitteeBasePayload builds the payload for the committee-service
 // update-committee-base endpoint from CLI flags.
 func BuildUpdateCommitteeBasePayload(committeeServiceUpdateCommitteeBaseBody string, committeeServiceUpdateCommitteeBaseUID string, committeeServiceUpdateCommitteeBaseVersion string, committeeServiceUpdateCommitteeBaseBearerToken string, committeeServiceUpdateCommitteeBaseIfMatch string, committeeServiceUpdateCommitteeBaseXSync string) (*committeeservice.UpdateCommitteeBasePayload, error) {
@@ -230,7 +376,7 @@ func BuildUpdateCommitteeBasePayload(committeeServiceUpdateCommitteeBaseBody str
 	{
 		err = json.Unmarshal([]byte(committeeServiceUpdateCommitteeBaseBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"calendar\": {\n         \"public\": true\n      },\n      \"category\": \"Technical Steering Committee\",\n      \"description\": \"Main technical oversight committee for the project\",\n      \"display_name\": \"TSC Committee Calendar\",\n      \"enable_voting\": true,\n      \"name\": \"Technical Steering Committee\",\n      \"parent_uid\": \"90b147f2-7cdd-157a-a2f4-9d4a567123fc\",\n      \"project_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"public\": true,\n      \"requires_review\": true,\n      \"sso_group_enabled\": true,\n      \"website\": \"https://committee.example.org\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"calendar\": {\n         \"public\": true\n      },\n      \"category\": \"Technical Steering Committee\",\n      \"description\": \"Main technical oversight committee for the project\",\n      \"display_name\": \"TSC Committee Calendar\",\n      \"enable_voting\": true,\n      \"labels\": {\n         \"region\": \"emea\"\n      },\n      \"name\": \"Technical Steering Committee\",\n      \"parent_uid\": \"90b147f2-7cdd-157a-a2f4-9d4a567123fc\",\n      \"project_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"public\": true,\n      \"requires_review\": true,\n      \"sso_group_enabled\": true,\n      \"website\": \"https://committee.example.org\"\n   }'")
 		}
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.project_uid", body.ProjectUID, goa.FormatUUID))
 		if utf8.RuneCountInString(body.Name) > 100 {
@@ -354,6 +500,14 @@ func BuildUpdateCommitteeBasePayload(committeeServiceUpdateCommitteeBaseBody str
 			}
 		}
 	}
+	if body.Labels != nil {
+		v.Labels = make(map[string]string, len(body.Labels))
+		for key, val := range body.Labels {
+			tk := key
+			tv := val
+			v.Labels[tk] = tv
+		}
+	}
 	v.UID = &uid
 	v.Version = version
 	v.BearerToken = bearerToken
@@ -464,7 +618,7 @@ func BuildUpdateCommitteeSettingsPayload(committeeServiceUpdateCommitteeSettings
 	{
 		err = json.Unmarshal([]byte(committeeServiceUpdateCommitteeSettingsBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"auditors\": [\n         \"auditor_user_id1\",\n         \"auditor_user_id2\"\n      ],\n      \"business_email_required\": false,\n      \"last_reviewed_at\": \"2025-08-04T09:00:00Z\",\n      \"last_reviewed_by\": \"user_id_12345\",\n      \"member_visibility\": \"hidden\",\n      \"show_meeting_attendees\": false,\n      \"writers\": [\n         \"manager_user_id1\",\n         \"manager_user_id2\"\n      ]\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"allowed_email_domains\": [\n         \"linuxfoundation.org\"\n      ],\n      \"auditors\": [\n         \"auditor_user_id1\",\n         \"auditor_user_id2\"\n      ],\n      \"business_email_required\": false,\n      \"last_reviewed_at\": \"2025-08-04T09:00:00Z\",\n      \"last_reviewed_by\": \"user_id_12345\",\n      \"member_visibility\": \"hidden\",\n      \"show_meeting_attendees\": false,\n      \"writers\": [\n         \"manager_user_id1\",\n         \"manager_user_id2\"\n      ]\n   }'")
 		}
 		if body.LastReviewedAt != nil {
 			err = goa.MergeErrors(err, goa.ValidateFormat("body.last_reviewed_at", *body.LastReviewedAt, goa.FormatDateTime))
@@ -524,6 +678,12 @@ func BuildUpdateCommitteeSettingsPayload(committeeServiceUpdateCommitteeSettings
 		MemberVisibility:      body.MemberVisibility,
 		ShowMeetingAttendees:  body.ShowMeetingAttendees,
 	}
+	if body.AllowedEmailDomains != nil {
+		v.AllowedEmailDomains = make([]string, len(body.AllowedEmailDomains))
+		for i, val := range body.AllowedEmailDomains {
+			v.AllowedEmailDomains[i] = val
+		}
+	}
 	{
 		var zero string
 		if v.MemberVisibility == zero {
@@ -565,7 +725,7 @@ func BuildCreateCommitteeMemberPayload(committeeServiceCreateCommitteeMemberBody
 	{
 		err = json.Unmarshal([]byte(committeeServiceCreateCommitteeMemberBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"appointed_by\": \"Community\",\n      \"email\": \"user@example.com\",\n      \"first_name\": \"John\",\n      \"job_title\": \"Chief Technology Officer\",\n      \"last_name\": \"Doe\",\n      \"linkedin_profile\": \"https://www.linkedin.com/in/johndoe\",\n      \"organization\": {\n         \"id\": \"org-123456\",\n         \"name\": \"The Linux Foundation\",\n         \"website\": \"https://linuxfoundation.org\"\n      },\n      \"role\": {\n         \"end_date\": \"2024-12-31\",\n         \"name\": \"Chair\",\n         \"start_date\": \"2023-01-01\"\n      },\n      \"status\": \"Active\",\n      \"username\": \"user123\",\n      \"voting\": {\n         \"end_date\": \"2024-12-31\",\n         \"start_date\": \"2023-01-01\",\n         \"status\": \"Voting Rep\"\n      }\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"alternate_for_uid\": \"2200b646-fbb2-4de7-ad80-fd195a874baf\",\n      \"appointed_by\": \"Community\",\n      \"email\": \"user@example.com\",\n      \"first_name\": \"John\",\n      \"job_title\": \"Chief Technology Officer\",\n      \"last_name\": \"Doe\",\n      \"linkedin_profile\": \"https://www.linkedin.com/in/johndoe\",\n      \"organization\": {\n         \"id\": \"org-123456\",\n         \"name\": \"The Linux Foundation\",\n         \"website\": \"https://linuxfoundation.org\"\n      },\n      \"role\": {\n         \"end_date\": \"2024-12-31\",\n         \"name\": \"Chair\",\n         \"start_date\": \"2023-01-01\"\n      },\n      \"status\": \"Active\",\n      \"username\": \"user123\",\n      \"voting\": {\n         \"end_date\": \"2024-12-31\",\n         \"start_date\": \"2023-01-01\",\n         \"status\": \"Voting Rep\",\n         \"weight\": 1\n      }\n   }'")
 		}
 		if body.Username != nil {
 			if utf8.RuneCountInString(*body.Username) > 100 {
@@ -621,6 +781,14 @@ func BuildCreateCommitteeMemberPayload(committeeServiceCreateCommitteeMemberBody
 			if body.Voting.EndDate != nil {
 				err = goa.MergeErrors(err, goa.ValidateFormat("body.voting.end_date", *body.Voting.EndDate, goa.FormatDate))
 			}
+			if body.Voting.Weight != nil {
+				if *body.Voting.Weight < 0 {
+					err = goa.MergeErrors(err, goa.InvalidRangeError("body.voting.weight", *body.Voting.Weight, 0, true))
+				}
+			}
+		}
+		if body.AlternateForUID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.alternate_for_uid", *body.AlternateForUID, goa.FormatUUID))
 		}
 		if body.Organization != nil {
 			if body.Organization.Name != nil {
@@ -678,6 +846,7 @@ func BuildCreateCommitteeMemberPayload(committeeServiceCreateCommitteeMemberBody
 		LinkedinProfile: body.LinkedinProfile,
 		AppointedBy:     body.AppointedBy,
 		Status:          body.Status,
+		AlternateForUID: body.AlternateForUID,
 	}
 	if body.Role != nil {
 		v.Role = &struct {
@@ -719,10 +888,13 @@ func BuildCreateCommitteeMemberPayload(committeeServiceCreateCommitteeMemberBody
 			StartDate *string
 			// Voting end date
 			EndDate *string
+			// Voting weight for weighted voting committees, defaults to 1
+			Weight *float64
 		}{
 			Status:    body.Voting.Status,
 			StartDate: body.Voting.StartDate,
 			EndDate:   body.Voting.EndDate,
+			Weight:    body.Voting.Weight,
 		}
 		{
 			var zero string
@@ -753,6 +925,125 @@ func BuildCreateCommitteeMemberPayload(committeeServiceCreateCommitteeMemberBody
 	return v, nil
 }
 
+// BuildReassignCommitteeMembersOrganizationPayload builds the payload for the
+// committee-service reassign-committee-members-organization endpoint from CLI
+// flags.
+func BuildReassignCommitteeMembersOrganizationPayload(committeeServiceReassignCommitteeMembersOrganizationBody string, committeeServiceReassignCommitteeMembersOrganizationUID string, committeeServiceReassignCommitteeMembersOrganizationVersion string, committeeServiceReassignCommitteeMembersOrganizationBearerToken string, committeeServiceReassignCommitteeMembersOrganizationXSync string) (*committeeservice.ReassignCommitteeMembersOrganizationPayload, error) {
+	var err error
+	var body ReassignCommitteeMembersOrganizationRequestBody
+	{
+		err = json.Unmarshal([]byte(committeeServiceReassignCommitteeMembersOrganizationBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"from_organization\": \"Departing Corp\",\n      \"to_organization\": \"The Linux Foundation\"\n   }'")
+		}
+		if utf8.RuneCountInString(body.FromOrganization) > 200 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.from_organization", body.FromOrganization, utf8.RuneCountInString(body.FromOrganization), 200, false))
+		}
+		if body.ToOrganization != nil {
+			if utf8.RuneCountInString(*body.ToOrganization) > 200 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.to_organization", *body.ToOrganization, utf8.RuneCountInString(*body.ToOrganization), 200, false))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var uid string
+	{
+		uid = committeeServiceReassignCommitteeMembersOrganizationUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version string
+	{
+		version = committeeServiceReassignCommitteeMembersOrganizationVersion
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var bearerToken *string
+	{
+		if committeeServiceReassignCommitteeMembersOrganizationBearerToken != "" {
+			bearerToken = &committeeServiceReassignCommitteeMembersOrganizationBearerToken
+		}
+	}
+	var xSync bool
+	{
+		if committeeServiceReassignCommitteeMembersOrganizationXSync != "" {
+			xSync, err = strconv.ParseBool(committeeServiceReassignCommitteeMembersOrganizationXSync)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for xSync, must be BOOL")
+			}
+		}
+	}
+	v := &committeeservice.ReassignCommitteeMembersOrganizationPayload{
+		FromOrganization: body.FromOrganization,
+		ToOrganization:   body.ToOrganization,
+	}
+	v.UID = uid
+	v.Version = version
+	v.BearerToken = bearerToken
+	v.XSync = xSync
+
+	return v, nil
+}
+
+// BuildListCommitteeMembersByOrganizationPayload builds the payload for the
+// committee-service list-committee-members-by-organization endpoint from CLI
+// flags.
+func BuildListCommitteeMembersByOrganizationPayload(committeeServiceListCommitteeMembersByOrganizationUID string, committeeServiceListCommitteeMembersByOrganizationVersion string, committeeServiceListCommitteeMembersByOrganizationOrganization string, committeeServiceListCommitteeMembersByOrganizationBearerToken string) (*committeeservice.ListCommitteeMembersByOrganizationPayload, error) {
+	var err error
+	var uid string
+	{
+		uid = committeeServiceListCommitteeMembersByOrganizationUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version string
+	{
+		version = committeeServiceListCommitteeMembersByOrganizationVersion
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var organization string
+	{
+		organization = committeeServiceListCommitteeMembersByOrganizationOrganization
+		if utf8.RuneCountInString(organization) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("organization", organization, utf8.RuneCountInString(organization), 1, true))
+		}
+		if utf8.RuneCountInString(organization) > 200 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("organization", organization, utf8.RuneCountInString(organization), 200, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var bearerToken *string
+	{
+		if committeeServiceListCommitteeMembersByOrganizationBearerToken != "" {
+			bearerToken = &committeeServiceListCommitteeMembersByOrganizationBearerToken
+		}
+	}
+	v := &committeeservice.ListCommitteeMembersByOrganizationPayload{}
+	v.UID = uid
+	v.Version = version
+	v.Organization = organization
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
 // BuildGetCommitteeMemberPayload builds the payload for the committee-service
 // get-committee-member endpoint from CLI flags.
 func BuildGetCommitteeMemberPayload(committeeServiceGetCommitteeMemberUID string, committeeServiceGetCommitteeMemberMemberUID string, committeeServiceGetCommitteeMemberVersion string, committeeServiceGetCommitteeMemberBearerToken string) (*committeeservice.GetCommitteeMemberPayload, error) {
@@ -798,6 +1089,72 @@ func BuildGetCommitteeMemberPayload(committeeServiceGetCommitteeMemberUID string
 	return v, nil
 }
 
+// BuildGetCommitteeMemberHistoryPayload builds the payload for the
+// committee-service get-committee-member-history endpoint from CLI flags.
+func BuildGetCommitteeMemberHistoryPayload(committeeServiceGetCommitteeMemberHistoryUID string, committeeServiceGetCommitteeMemberHistoryMemberUID string, committeeServiceGetCommitteeMemberHistoryVersion string, committeeServiceGetCommitteeMemberHistoryLimit string, committeeServiceGetCommitteeMemberHistoryBearerToken string) (*committeeservice.GetCommitteeMemberHistoryPayload, error) {
+	var err error
+	var uid string
+	{
+		uid = committeeServiceGetCommitteeMemberHistoryUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var memberUID string
+	{
+		memberUID = committeeServiceGetCommitteeMemberHistoryMemberUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("member_uid", memberUID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version string
+	{
+		version = committeeServiceGetCommitteeMemberHistoryVersion
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var limit int
+	{
+		if committeeServiceGetCommitteeMemberHistoryLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(committeeServiceGetCommitteeMemberHistoryLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 100 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if committeeServiceGetCommitteeMemberHistoryBearerToken != "" {
+			bearerToken = &committeeServiceGetCommitteeMemberHistoryBearerToken
+		}
+	}
+	v := &committeeservice.GetCommitteeMemberHistoryPayload{}
+	v.UID = uid
+	v.MemberUID = memberUID
+	v.Version = version
+	v.Limit = limit
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
 // BuildUpdateCommitteeMemberPayload builds the payload for the
 // committee-service update-committee-member endpoint from CLI flags.
 func BuildUpdateCommitteeMemberPayload(committeeServiceUpdateCommitteeMemberBody string, committeeServiceUpdateCommitteeMemberUID string, committeeServiceUpdateCommitteeMemberMemberUID string, committeeServiceUpdateCommitteeMemberVersion string, committeeServiceUpdateCommitteeMemberBearerToken string, committeeServiceUpdateCommitteeMemberIfMatch string, committeeServiceUpdateCommitteeMemberXSync string) (*committeeservice.UpdateCommitteeMemberPayload, error) {
@@ -806,7 +1163,10 @@ func BuildUpdateCommitteeMemberPayload(committeeServiceUpdateCommitteeMemberBody
 	{
 		err = json.Unmarshal([]byte(committeeServiceUpdateCommitteeMemberBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"appointed_by\": \"Community\",\n      \"email\": \"user@example.com\",\n      \"first_name\": \"John\",\n      \"job_title\": \"Chief Technology Officer\",\n      \"last_name\": \"Doe\",\n      \"linkedin_profile\": \"https://www.linkedin.com/in/johndoe\",\n      \"organization\": {\n         \"id\": \"org-123456\",\n         \"name\": \"The Linux Foundation\",\n         \"website\": \"https://linuxfoundation.org\"\n      },\n      \"role\": {\n         \"end_date\": \"2024-12-31\",\n         \"name\": \"Chair\",\n         \"start_date\": \"2023-01-01\"\n      },\n      \"status\": \"Active\",\n      \"username\": \"user123\",\n      \"voting\": {\n         \"end_date\": \"2024-12-31\",\n         \"start_date\": \"2023-01-01\",\n         \"status\": \"Voting Rep\"\n      }\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"alternate_for_uid\": \"2200b646-fbb2-4de7-ad80-fd195a874baf\",\n      \"appointed_by\": \"Community\",\n      \"committee_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"email\": \"user@example.com\",\n      \"first_name\": \"John\",\n      \"job_title\": \"Chief Technology Officer\",\n      \"last_name\": \"Doe\",\n      \"linkedin_profile\": \"https://www.linkedin.com/in/johndoe\",\n      \"organization\": {\n         \"id\": \"org-123456\",\n         \"name\": \"The Linux Foundation\",\n         \"website\": \"https://linuxfoundation.org\"\n      },\n      \"role\": {\n         \"end_date\": \"2024-12-31\",\n         \"name\": \"Chair\",\n         \"start_date\": \"2023-01-01\"\n      },\n      \"status\": \"Active\",\n      \"username\": \"user123\",\n      \"voting\": {\n         \"end_date\": \"2024-12-31\",\n         \"start_date\": \"2023-01-01\",\n         \"status\": \"Voting Rep\",\n         \"weight\": 1\n      }\n   }'")
+		}
+		if body.CommitteeUID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.committee_uid", *body.CommitteeUID, goa.FormatUUID))
 		}
 		if body.Username != nil {
 			if utf8.RuneCountInString(*body.Username) > 100 {
@@ -862,6 +1222,14 @@ func BuildUpdateCommitteeMemberPayload(committeeServiceUpdateCommitteeMemberBody
 			if body.Voting.EndDate != nil {
 				err = goa.MergeErrors(err, goa.ValidateFormat("body.voting.end_date", *body.Voting.EndDate, goa.FormatDate))
 			}
+			if body.Voting.Weight != nil {
+				if *body.Voting.Weight < 0 {
+					err = goa.MergeErrors(err, goa.InvalidRangeError("body.voting.weight", *body.Voting.Weight, 0, true))
+				}
+			}
+		}
+		if body.AlternateForUID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.alternate_for_uid", *body.AlternateForUID, goa.FormatUUID))
 		}
 		if body.Organization != nil {
 			if body.Organization.Name != nil {
@@ -925,6 +1293,7 @@ func BuildUpdateCommitteeMemberPayload(committeeServiceUpdateCommitteeMemberBody
 		}
 	}
 	v := &committeeservice.UpdateCommitteeMemberPayload{
+		CommitteeUID:    body.CommitteeUID,
 		Username:        body.Username,
 		Email:           body.Email,
 		FirstName:       body.FirstName,
@@ -933,6 +1302,7 @@ func BuildUpdateCommitteeMemberPayload(committeeServiceUpdateCommitteeMemberBody
 		LinkedinProfile: body.LinkedinProfile,
 		AppointedBy:     body.AppointedBy,
 		Status:          body.Status,
+		AlternateForUID: body.AlternateForUID,
 	}
 	if body.Role != nil {
 		v.Role = &struct {
@@ -974,10 +1344,13 @@ func BuildUpdateCommitteeMemberPayload(committeeServiceUpdateCommitteeMemberBody
 			StartDate *string
 			// Voting end date
 			EndDate *string
+			// Voting weight for weighted voting committees, defaults to 1
+			Weight *float64
 		}{
 			Status:    body.Voting.Status,
 			StartDate: body.Voting.StartDate,
 			EndDate:   body.Voting.EndDate,
+			Weight:    body.Voting.Weight,
 		}
 		{
 			var zero string
@@ -1010,6 +1383,216 @@ func BuildUpdateCommitteeMemberPayload(committeeServiceUpdateCommitteeMemberBody
 	return v, nil
 }
 
+// BuildPatchCommitteeMemberPayload builds the payload for the
+// committee-service patch-committee-member endpoint from CLI flags.
+func BuildPatchCommitteeMemberPayload(committeeServicePatchCommitteeMemberBody string, committeeServicePatchCommitteeMemberUID string, committeeServicePatchCommitteeMemberMemberUID string, committeeServicePatchCommitteeMemberVersion string, committeeServicePatchCommitteeMemberBearerToken string, committeeServicePatchCommitteeMemberIfMatch string, committeeServicePatchCommitteeMemberXSync string) (*committeeservice.PatchCommitteeMemberPayload, error) {
+	var err error
+	var body PatchCommitteeMemberRequestBody
+	{
+		err = json.Unmarshal([]byte(committeeServicePatchCommitteeMemberBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"alternate_for_uid\": \"2200b646-fbb2-4de7-ad80-fd195a874baf\",\n      \"appointed_by\": \"Community\",\n      \"email\": \"user@example.com\",\n      \"first_name\": \"John\",\n      \"job_title\": \"Chief Technology Officer\",\n      \"last_name\": \"Doe\",\n      \"linkedin_profile\": \"https://www.linkedin.com/in/johndoe\",\n      \"organization\": {\n         \"id\": \"org-123456\",\n         \"name\": \"The Linux Foundation\",\n         \"website\": \"https://linuxfoundation.org\"\n      },\n      \"role\": {\n         \"end_date\": \"2024-12-31\",\n         \"name\": \"Chair\",\n         \"start_date\": \"2023-01-01\"\n      },\n      \"status\": \"Active\",\n      \"username\": \"user123\",\n      \"voting\": {\n         \"end_date\": \"2024-12-31\",\n         \"start_date\": \"2023-01-01\",\n         \"status\": \"Voting Rep\",\n         \"weight\": 1\n      }\n   }'")
+		}
+		if body.Username != nil {
+			if utf8.RuneCountInString(*body.Username) > 100 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.username", *body.Username, utf8.RuneCountInString(*body.Username), 100, false))
+			}
+		}
+		if body.Email != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
+		}
+		if body.FirstName != nil {
+			if utf8.RuneCountInString(*body.FirstName) > 100 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.first_name", *body.FirstName, utf8.RuneCountInString(*body.FirstName), 100, false))
+			}
+		}
+		if body.LastName != nil {
+			if utf8.RuneCountInString(*body.LastName) > 100 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.last_name", *body.LastName, utf8.RuneCountInString(*body.LastName), 100, false))
+			}
+		}
+		if body.JobTitle != nil {
+			if utf8.RuneCountInString(*body.JobTitle) > 200 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.job_title", *body.JobTitle, utf8.RuneCountInString(*body.JobTitle), 200, false))
+			}
+		}
+		if body.LinkedinProfile != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.linkedin_profile", *body.LinkedinProfile, goa.FormatURI))
+		}
+		if body.LinkedinProfile != nil {
+			err = goa.MergeErrors(err, goa.ValidatePattern("body.linkedin_profile", *body.LinkedinProfile, "^(https?://)?([a-z]{2,3}\\.)?linkedin\\.com/.*$"))
+		}
+		if body.Role != nil {
+			if body.Role.Name != nil {
+				if !(*body.Role.Name == "Chair" || *body.Role.Name == "Counsel" || *body.Role.Name == "Developer Seat" || *body.Role.Name == "TAC/TOC Representative" || *body.Role.Name == "Director" || *body.Role.Name == "Lead" || *body.Role.Name == "None" || *body.Role.Name == "Secretary" || *body.Role.Name == "Treasurer" || *body.Role.Name == "Vice Chair" || *body.Role.Name == "LF Staff") {
+					err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.role.name", *body.Role.Name, []any{"Chair", "Counsel", "Developer Seat", "TAC/TOC Representative", "Director", "Lead", "None", "Secretary", "Treasurer", "Vice Chair", "LF Staff"}))
+				}
+			}
+			if body.Role.StartDate != nil {
+				err = goa.MergeErrors(err, goa.ValidateFormat("body.role.start_date", *body.Role.StartDate, goa.FormatDate))
+			}
+			if body.Role.EndDate != nil {
+				err = goa.MergeErrors(err, goa.ValidateFormat("body.role.end_date", *body.Role.EndDate, goa.FormatDate))
+			}
+		}
+		if body.AppointedBy != nil {
+			if !(*body.AppointedBy == "Community" || *body.AppointedBy == "Membership Entitlement" || *body.AppointedBy == "Vote of End User Member Class" || *body.AppointedBy == "Vote of TSC Committee" || *body.AppointedBy == "Vote of TAC Committee" || *body.AppointedBy == "Vote of Academic Member Class" || *body.AppointedBy == "Vote of Lab Member Class" || *body.AppointedBy == "Vote of Marketing Committee" || *body.AppointedBy == "Vote of Governing Board" || *body.AppointedBy == "Vote of General Member Class" || *body.AppointedBy == "Vote of End User Committee" || *body.AppointedBy == "Vote of TOC Committee" || *body.AppointedBy == "Vote of Gold Member Class" || *body.AppointedBy == "Vote of Silver Member Class" || *body.AppointedBy == "Vote of Strategic Membership Class" || *body.AppointedBy == "None") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.appointed_by", *body.AppointedBy, []any{"Community", "Membership Entitlement", "Vote of End User Member Class", "Vote of TSC Committee", "Vote of TAC Committee", "Vote of Academic Member Class", "Vote of Lab Member Class", "Vote of Marketing Committee", "Vote of Governing Board", "Vote of General Member Class", "Vote of End User Committee", "Vote of TOC Committee", "Vote of Gold Member Class", "Vote of Silver Member Class", "Vote of Strategic Membership Class", "None"}))
+			}
+		}
+		if body.Status != nil {
+			if !(*body.Status == "Active" || *body.Status == "Inactive") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"Active", "Inactive"}))
+			}
+		}
+		if body.Voting != nil {
+			if body.Voting.Status != nil {
+				if !(*body.Voting.Status == "Alternate Voting Rep" || *body.Voting.Status == "Observer" || *body.Voting.Status == "Voting Rep" || *body.Voting.Status == "Emeritus" || *body.Voting.Status == "None") {
+					err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.voting.status", *body.Voting.Status, []any{"Alternate Voting Rep", "Observer", "Voting Rep", "Emeritus", "None"}))
+				}
+			}
+			if body.Voting.StartDate != nil {
+				err = goa.MergeErrors(err, goa.ValidateFormat("body.voting.start_date", *body.Voting.StartDate, goa.FormatDate))
+			}
+			if body.Voting.EndDate != nil {
+				err = goa.MergeErrors(err, goa.ValidateFormat("body.voting.end_date", *body.Voting.EndDate, goa.FormatDate))
+			}
+			if body.Voting.Weight != nil {
+				if *body.Voting.Weight < 0 {
+					err = goa.MergeErrors(err, goa.InvalidRangeError("body.voting.weight", *body.Voting.Weight, 0, true))
+				}
+			}
+		}
+		if body.AlternateForUID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.alternate_for_uid", *body.AlternateForUID, goa.FormatUUID))
+		}
+		if body.Organization != nil {
+			if body.Organization.Name != nil {
+				if utf8.RuneCountInString(*body.Organization.Name) > 200 {
+					err = goa.MergeErrors(err, goa.InvalidLengthError("body.organization.name", *body.Organization.Name, utf8.RuneCountInString(*body.Organization.Name), 200, false))
+				}
+			}
+			if body.Organization.Website != nil {
+				err = goa.MergeErrors(err, goa.ValidateFormat("body.organization.website", *body.Organization.Website, goa.FormatURI))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var uid string
+	{
+		uid = committeeServicePatchCommitteeMemberUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var memberUID string
+	{
+		memberUID = committeeServicePatchCommitteeMemberMemberUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("member_uid", memberUID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version string
+	{
+		version = committeeServicePatchCommitteeMemberVersion
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var bearerToken *string
+	{
+		if committeeServicePatchCommitteeMemberBearerToken != "" {
+			bearerToken = &committeeServicePatchCommitteeMemberBearerToken
+		}
+	}
+	var ifMatch *string
+	{
+		if committeeServicePatchCommitteeMemberIfMatch != "" {
+			ifMatch = &committeeServicePatchCommitteeMemberIfMatch
+		}
+	}
+	var xSync bool
+	{
+		if committeeServicePatchCommitteeMemberXSync != "" {
+			xSync, err = strconv.ParseBool(committeeServicePatchCommitteeMemberXSync)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for xSync, must be BOOL")
+			}
+		}
+	}
+	v := &committeeservice.PatchCommitteeMemberPayload{
+		Username:        body.Username,
+		Email:           body.Email,
+		FirstName:       body.FirstName,
+		LastName:        body.LastName,
+		JobTitle:        body.JobTitle,
+		LinkedinProfile: body.LinkedinProfile,
+		AppointedBy:     body.AppointedBy,
+		Status:          body.Status,
+		AlternateForUID: body.AlternateForUID,
+	}
+	if body.Role != nil {
+		v.Role = &struct {
+			// Committee role name
+			Name *string
+			// Role start date
+			StartDate *string
+			// Role end date
+			EndDate *string
+		}{
+			Name:      body.Role.Name,
+			StartDate: body.Role.StartDate,
+			EndDate:   body.Role.EndDate,
+		}
+	}
+	if body.Voting != nil {
+		v.Voting = &struct {
+			// Voting status
+			Status *string
+			// Voting start date
+			StartDate *string
+			// Voting end date
+			EndDate *string
+			// Voting weight for weighted voting committees, defaults to 1
+			Weight *float64
+		}{
+			Status:    body.Voting.Status,
+			StartDate: body.Voting.StartDate,
+			EndDate:   body.Voting.EndDate,
+			Weight:    body.Voting.Weight,
+		}
+	}
+	if body.Organization != nil {
+		v.Organization = &struct {
+			// Organization ID
+			ID *string
+			// Organization name
+			Name *string
+			// Organization website URL
+			Website *string
+		}{
+			ID:      body.Organization.ID,
+			Name:    body.Organization.Name,
+			Website: body.Organization.Website,
+		}
+	}
+	v.UID = uid
+	v.MemberUID = memberUID
+	v.Version = version
+	v.BearerToken = bearerToken
+	v.IfMatch = ifMatch
+	v.XSync = xSync
+
+	return v, nil
+}
+
 // BuildDeleteCommitteeMemberPayload builds the payload for the
 // committee-service delete-committee-member endpoint from CLI flags.
 func BuildDeleteCommitteeMemberPayload(committeeServiceDeleteCommitteeMemberUID string, committeeServiceDeleteCommitteeMemberMemberUID string, committeeServiceDeleteCommitteeMemberVersion string, committeeServiceDeleteCommitteeMemberBearerToken string, committeeServiceDeleteCommitteeMemberIfMatch string, committeeServiceDeleteCommitteeMemberXSync string) (*committeeservice.DeleteCommitteeMemberPayload, error) {
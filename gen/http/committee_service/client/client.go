@@ -26,6 +26,14 @@ type Client struct {
 	// get-committee-base endpoint.
 	GetCommitteeBaseDoer goahttp.Doer
 
+	// GetCommitteeWithMembers Doer is the HTTP client used to make requests to the
+	// get-committee-with-members endpoint.
+	GetCommitteeWithMembersDoer goahttp.Doer
+
+	// ListCommitteeChildren Doer is the HTTP client used to make requests to the
+	// list-committee-children endpoint.
+	ListCommitteeChildrenDoer goahttp.Doer
+
 	// UpdateCommitteeBase Doer is the HTTP client used to make requests to the
 	// update-committee-base endpoint.
 	UpdateCommitteeBaseDoer goahttp.Doer
@@ -52,14 +60,30 @@ type Client struct {
 	// create-committee-member endpoint.
 	CreateCommitteeMemberDoer goahttp.Doer
 
+	// ReassignCommitteeMembersOrganization Doer is the HTTP client used to make
+	// requests to the reassign-committee-members-organization endpoint.
+	ReassignCommitteeMembersOrganizationDoer goahttp.Doer
+
+	// ListCommitteeMembersByOrganization Doer is the HTTP client used to make
+	// requests to the list-committee-members-by-organization endpoint.
+	ListCommitteeMembersByOrganizationDoer goahttp.Doer
+
 	// GetCommitteeMember Doer is the HTTP client used to make requests to the
 	// get-committee-member endpoint.
 	GetCommitteeMemberDoer goahttp.Doer
 
+	// GetCommitteeMemberHistory Doer is the HTTP client used to make requests to
+	// the get-committee-member-history endpoint.
+	GetCommitteeMemberHistoryDoer goahttp.Doer
+
 	// UpdateCommitteeMember Doer is the HTTP client used to make requests to the
 	// update-committee-member endpoint.
 	UpdateCommitteeMemberDoer goahttp.Doer
 
+	// PatchCommitteeMember Doer is the HTTP client used to make requests to the
+	// patch-committee-member endpoint.
+	PatchCommitteeMemberDoer goahttp.Doer
+
 	// DeleteCommitteeMember Doer is the HTTP client used to make requests to the
 	// delete-committee-member endpoint.
 	DeleteCommitteeMemberDoer goahttp.Doer
@@ -85,23 +109,29 @@ func NewClient(
 	restoreBody bool,
 ) *Client {
 	return &Client{
-		CreateCommitteeDoer:         doer,
-		GetCommitteeBaseDoer:        doer,
-		UpdateCommitteeBaseDoer:     doer,
-		DeleteCommitteeDoer:         doer,
-		GetCommitteeSettingsDoer:    doer,
-		UpdateCommitteeSettingsDoer: doer,
-		ReadyzDoer:                  doer,
-		LivezDoer:                   doer,
-		CreateCommitteeMemberDoer:   doer,
-		GetCommitteeMemberDoer:      doer,
-		UpdateCommitteeMemberDoer:   doer,
-		DeleteCommitteeMemberDoer:   doer,
-		RestoreResponseBody:         restoreBody,
-		scheme:                      scheme,
-		host:                        host,
-		decoder:                     dec,
-		encoder:                     enc,
+		CreateCommitteeDoer:                      doer,
+		GetCommitteeBaseDoer:                     doer,
+		GetCommitteeWithMembersDoer:              doer,
+		ListCommitteeChildrenDoer:                doer,
+		UpdateCommitteeBaseDoer:                  doer,
+		DeleteCommitteeDoer:                      doer,
+		GetCommitteeSettingsDoer:                 doer,
+		UpdateCommitteeSettingsDoer:              doer,
+		ReadyzDoer:                               doer,
+		LivezDoer:                                doer,
+		CreateCommitteeMemberDoer:                doer,
+		ReassignCommitteeMembersOrganizationDoer: doer,
+		ListCommitteeMembersByOrganizationDoer:   doer,
+		GetCommitteeMemberDoer:                   doer,
+		GetCommitteeMemberHistoryDoer:            doer,
+		UpdateCommitteeMemberDoer:                doer,
+		PatchCommitteeMemberDoer:                 doer,
+		DeleteCommitteeMemberDoer:                doer,
+		RestoreResponseBody:                      restoreBody,
+		scheme:                                   scheme,
+		host:                                     host,
+		decoder:                                  dec,
+		encoder:                                  enc,
 	}
 }
 
@@ -153,6 +183,54 @@ func (c *Client) GetCommitteeBase() goa.Endpoint {
 	}
 }
 
+// GetCommitteeWithMembers returns an endpoint that makes HTTP requests to the
+// committee-service service get-committee-with-members server.
+func (c *Client) GetCommitteeWithMembers() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetCommitteeWithMembersRequest(c.encoder)
+		decodeResponse = DecodeGetCommitteeWithMembersResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetCommitteeWithMembersRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetCommitteeWithMembersDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("committee-service", "get-committee-with-members", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListCommitteeChildren returns an endpoint that makes HTTP requests to the
+// committee-service service list-committee-children server.
+func (c *Client) ListCommitteeChildren() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListCommitteeChildrenRequest(c.encoder)
+		decodeResponse = DecodeListCommitteeChildrenResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListCommitteeChildrenRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListCommitteeChildrenDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("committee-service", "list-committee-children", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // UpdateCommitteeBase returns an endpoint that makes HTTP requests to the
 // committee-service service update-committee-base server.
 func (c *Client) UpdateCommitteeBase() goa.Endpoint {
@@ -311,6 +389,56 @@ func (c *Client) CreateCommitteeMember() goa.Endpoint {
 	}
 }
 
+// ReassignCommitteeMembersOrganization returns an endpoint that makes HTTP
+// requests to the committee-service service
+// reassign-committee-members-organization server.
+func (c *Client) ReassignCommitteeMembersOrganization() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeReassignCommitteeMembersOrganizationRequest(c.encoder)
+		decodeResponse = DecodeReassignCommitteeMembersOrganizationResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildReassignCommitteeMembersOrganizationRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ReassignCommitteeMembersOrganizationDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("committee-service", "reassign-committee-members-organization", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListCommitteeMembersByOrganization returns an endpoint that makes HTTP
+// requests to the committee-service service
+// list-committee-members-by-organization server.
+func (c *Client) ListCommitteeMembersByOrganization() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListCommitteeMembersByOrganizationRequest(c.encoder)
+		decodeResponse = DecodeListCommitteeMembersByOrganizationResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListCommitteeMembersByOrganizationRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListCommitteeMembersByOrganizationDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("committee-service", "list-committee-members-by-organization", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // GetCommitteeMember returns an endpoint that makes HTTP requests to the
 // committee-service service get-committee-member server.
 func (c *Client) GetCommitteeMember() goa.Endpoint {
@@ -335,6 +463,30 @@ func (c *Client) GetCommitteeMember() goa.Endpoint {
 	}
 }
 
+// GetCommitteeMemberHistory returns an endpoint that makes HTTP requests to
+// the committee-service service get-committee-member-history server.
+func (c *Client) GetCommitteeMemberHistory() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetCommitteeMemberHistoryRequest(c.encoder)
+		decodeResponse = DecodeGetCommitteeMemberHistoryResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetCommitteeMemberHistoryRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetCommitteeMemberHistoryDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("committee-service", "get-committee-member-history", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // UpdateCommitteeMember returns an endpoint that makes HTTP requests to the
 // committee-service service update-committee-member server.
 func (c *Client) UpdateCommitteeMember() goa.Endpoint {
@@ -359,6 +511,30 @@ func (c *Client) UpdateCommitteeMember() goa.Endpoint {
 	}
 }
 
+// PatchCommitteeMember returns an endpoint that makes HTTP requests to the
+// committee-service service patch-committee-member server.
+func (c *Client) PatchCommitteeMember() goa.Endpoint {
+	var (
+		encodeRequest  = EncodePatchCommitteeMemberRequest(c.encoder)
+		decodeResponse = DecodePatchCommitteeMemberResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildPatchCommitteeMemberRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.PatchCommitteeMemberDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("committee-service", "patch-committee-member", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // DeleteCommitteeMember returns an endpoint that makes HTTP requests to the
 // committee-service service delete-committee-member server.
 func (c *Client) DeleteCommitteeMember() goa.Endpoint {
@@ -11,6 +11,7 @@ package client
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -335,6 +336,325 @@ func DecodeGetCommitteeBaseResponse(decoder func(*http.Response) goahttp.Decoder
 	}
 }
 
+// BuildGetCommitteeWithMembersRequest instantiates a HTTP request object with
+// method and path set to call the "committee-service" service
+// "get-committee-with-members" endpoint
+func (c *Client) BuildGetCommitteeWithMembersRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uid string
+	)
+	{
+		p, ok := v.(*committeeservice.GetCommitteeWithMembersPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("committee-service", "get-committee-with-members", "*committeeservice.GetCommitteeWithMembersPayload", v)
+		}
+		if p.UID != nil {
+			uid = *p.UID
+		}
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetCommitteeWithMembersCommitteeServicePath(uid)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("committee-service", "get-committee-with-members", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetCommitteeWithMembersRequest returns an encoder for requests sent to
+// the committee-service get-committee-with-members server.
+func EncodeGetCommitteeWithMembersRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*committeeservice.GetCommitteeWithMembersPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("committee-service", "get-committee-with-members", "*committeeservice.GetCommitteeWithMembersPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		values.Add("page_size", fmt.Sprintf("%v", p.PageSize))
+		if p.PageToken != nil {
+			values.Add("page_token", *p.PageToken)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetCommitteeWithMembersResponse returns a decoder for responses
+// returned by the committee-service get-committee-with-members endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeGetCommitteeWithMembersResponse may return the following errors:
+//   - "BadRequest" (type *committeeservice.BadRequestError): http.StatusBadRequest
+//   - "InternalServerError" (type *committeeservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *committeeservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *committeeservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeGetCommitteeWithMembersResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetCommitteeWithMembersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("committee-service", "get-committee-with-members", err)
+			}
+			err = ValidateGetCommitteeWithMembersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("committee-service", "get-committee-with-members", err)
+			}
+			res := NewGetCommitteeWithMembersResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetCommitteeWithMembersBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("committee-service", "get-committee-with-members", err)
+			}
+			err = ValidateGetCommitteeWithMembersBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("committee-service", "get-committee-with-members", err)
+			}
+			return nil, NewGetCommitteeWithMembersBadRequest(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetCommitteeWithMembersInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("committee-service", "get-committee-with-members", err)
+			}
+			err = ValidateGetCommitteeWithMembersInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("committee-service", "get-committee-with-members", err)
+			}
+			return nil, NewGetCommitteeWithMembersInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body GetCommitteeWithMembersNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("committee-service", "get-committee-with-members", err)
+			}
+			err = ValidateGetCommitteeWithMembersNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("committee-service", "get-committee-with-members", err)
+			}
+			return nil, NewGetCommitteeWithMembersNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetCommitteeWithMembersServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("committee-service", "get-committee-with-members", err)
+			}
+			err = ValidateGetCommitteeWithMembersServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("committee-service", "get-committee-with-members", err)
+			}
+			return nil, NewGetCommitteeWithMembersServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("committee-service", "get-committee-with-members", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListCommitteeChildrenRequest instantiates a HTTP request object with
+// method and path set to call the "committee-service" service
+// "list-committee-children" endpoint
+func (c *Client) BuildListCommitteeChildrenRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uid string
+	)
+	{
+		p, ok := v.(*committeeservice.ListCommitteeChildrenPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("committee-service", "list-committee-children", "*committeeservice.ListCommitteeChildrenPayload", v)
+		}
+		if p.UID != nil {
+			uid = *p.UID
+		}
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListCommitteeChildrenCommitteeServicePath(uid)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("committee-service", "list-committee-children", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListCommitteeChildrenRequest returns an encoder for requests sent to
+// the committee-service list-committee-children server.
+func EncodeListCommitteeChildrenRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*committeeservice.ListCommitteeChildrenPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("committee-service", "list-committee-children", "*committeeservice.ListCommitteeChildrenPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		values.Add("page_size", fmt.Sprintf("%v", p.PageSize))
+		if p.PageToken != nil {
+			values.Add("page_token", *p.PageToken)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListCommitteeChildrenResponse returns a decoder for responses returned
+// by the committee-service list-committee-children endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeListCommitteeChildrenResponse may return the following errors:
+//   - "BadRequest" (type *committeeservice.BadRequestError): http.StatusBadRequest
+//   - "InternalServerError" (type *committeeservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *committeeservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *committeeservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeListCommitteeChildrenResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListCommitteeChildrenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("committee-service", "list-committee-children", err)
+			}
+			err = ValidateListCommitteeChildrenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("committee-service", "list-committee-children", err)
+			}
+			res := NewListCommitteeChildrenResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListCommitteeChildrenBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("committee-service", "list-committee-children", err)
+			}
+			err = ValidateListCommitteeChildrenBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("committee-service", "list-committee-children", err)
+			}
+			return nil, NewListCommitteeChildrenBadRequest(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListCommitteeChildrenInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("committee-service", "list-committee-children", err)
+			}
+			err = ValidateListCommitteeChildrenInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("committee-service", "list-committee-children", err)
+			}
+			return nil, NewListCommitteeChildrenInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body ListCommitteeChildrenNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("committee-service", "list-committee-children", err)
+			}
+			err = ValidateListCommitteeChildrenNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("committee-service", "list-committee-children", err)
+			}
+			return nil, NewListCommitteeChildrenNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body ListCommitteeChildrenServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("committee-service", "list-committee-children", err)
+			}
+			err = ValidateListCommitteeChildrenServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("committee-service", "list-committee-children", err)
+			}
+			return nil, NewListCommitteeChildrenServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("committee-service", "list-committee-children", resp.StatusCode, string(body))
+		}
+	}
+}
+
 // BuildUpdateCommitteeBaseRequest instantiates a HTTP request object with
 // method and path set to call the "committee-service" service
 // "update-committee-base" endpoint
@@ -1307,26 +1627,24 @@ func DecodeCreateCommitteeMemberResponse(decoder func(*http.Response) goahttp.De
 	}
 }
 
-// BuildGetCommitteeMemberRequest instantiates a HTTP request object with
-// method and path set to call the "committee-service" service
-// "get-committee-member" endpoint
-func (c *Client) BuildGetCommitteeMemberRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildReassignCommitteeMembersOrganizationRequest instantiates a HTTP request
+// object with method and path set to call the "committee-service" service
+// "reassign-committee-members-organization" endpoint
+func (c *Client) BuildReassignCommitteeMembersOrganizationRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
-		uid       string
-		memberUID string
+		uid string
 	)
 	{
-		p, ok := v.(*committeeservice.GetCommitteeMemberPayload)
+		p, ok := v.(*committeeservice.ReassignCommitteeMembersOrganizationPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("committee-service", "get-committee-member", "*committeeservice.GetCommitteeMemberPayload", v)
+			return nil, goahttp.ErrInvalidType("committee-service", "reassign-committee-members-organization", "*committeeservice.ReassignCommitteeMembersOrganizationPayload", v)
 		}
 		uid = p.UID
-		memberUID = p.MemberUID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetCommitteeMemberCommitteeServicePath(uid, memberUID)}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ReassignCommitteeMembersOrganizationCommitteeServicePath(uid)}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("committee-service", "get-committee-member", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("committee-service", "reassign-committee-members-organization", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -1335,13 +1653,14 @@ func (c *Client) BuildGetCommitteeMemberRequest(ctx context.Context, v any) (*ht
 	return req, nil
 }
 
-// EncodeGetCommitteeMemberRequest returns an encoder for requests sent to the
-// committee-service get-committee-member server.
-func EncodeGetCommitteeMemberRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeReassignCommitteeMembersOrganizationRequest returns an encoder for
+// requests sent to the committee-service
+// reassign-committee-members-organization server.
+func EncodeReassignCommitteeMembersOrganizationRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*committeeservice.GetCommitteeMemberPayload)
+		p, ok := v.(*committeeservice.ReassignCommitteeMembersOrganizationPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("committee-service", "get-committee-member", "*committeeservice.GetCommitteeMemberPayload", v)
+			return goahttp.ErrInvalidType("committee-service", "reassign-committee-members-organization", "*committeeservice.ReassignCommitteeMembersOrganizationPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -1351,14 +1670,333 @@ func EncodeGetCommitteeMemberRequest(encoder func(*http.Request) goahttp.Encoder
 				req.Header.Set("Authorization", head)
 			}
 		}
+		{
+			head := p.XSync
+			headStr := strconv.FormatBool(head)
+			req.Header.Set("X-Sync", headStr)
+		}
 		values := req.URL.Query()
 		values.Add("v", p.Version)
 		req.URL.RawQuery = values.Encode()
+		body := NewReassignCommitteeMembersOrganizationRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("committee-service", "reassign-committee-members-organization", err)
+		}
 		return nil
 	}
 }
 
-// DecodeGetCommitteeMemberResponse returns a decoder for responses returned by
+// DecodeReassignCommitteeMembersOrganizationResponse returns a decoder for
+// responses returned by the committee-service
+// reassign-committee-members-organization endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeReassignCommitteeMembersOrganizationResponse may return the following
+// errors:
+//   - "BadRequest" (type *committeeservice.BadRequestError): http.StatusBadRequest
+//   - "InternalServerError" (type *committeeservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *committeeservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *committeeservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeReassignCommitteeMembersOrganizationResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ReassignCommitteeMembersOrganizationResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("committee-service", "reassign-committee-members-organization", err)
+			}
+			err = ValidateReassignCommitteeMembersOrganizationResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("committee-service", "reassign-committee-members-organization", err)
+			}
+			res := NewReassignCommitteeMembersOrganizationResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ReassignCommitteeMembersOrganizationBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("committee-service", "reassign-committee-members-organization", err)
+			}
+			err = ValidateReassignCommitteeMembersOrganizationBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("committee-service", "reassign-committee-members-organization", err)
+			}
+			return nil, NewReassignCommitteeMembersOrganizationBadRequest(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ReassignCommitteeMembersOrganizationInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("committee-service", "reassign-committee-members-organization", err)
+			}
+			err = ValidateReassignCommitteeMembersOrganizationInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("committee-service", "reassign-committee-members-organization", err)
+			}
+			return nil, NewReassignCommitteeMembersOrganizationInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body ReassignCommitteeMembersOrganizationNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("committee-service", "reassign-committee-members-organization", err)
+			}
+			err = ValidateReassignCommitteeMembersOrganizationNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("committee-service", "reassign-committee-members-organization", err)
+			}
+			return nil, NewReassignCommitteeMembersOrganizationNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body ReassignCommitteeMembersOrganizationServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("committee-service", "reassign-committee-members-organization", err)
+			}
+			err = ValidateReassignCommitteeMembersOrganizationServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("committee-service", "reassign-committee-members-organization", err)
+			}
+			return nil, NewReassignCommitteeMembersOrganizationServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("committee-service", "reassign-committee-members-organization", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListCommitteeMembersByOrganizationRequest instantiates a HTTP request
+// object with method and path set to call the "committee-service" service
+// "list-committee-members-by-organization" endpoint
+func (c *Client) BuildListCommitteeMembersByOrganizationRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uid string
+	)
+	{
+		p, ok := v.(*committeeservice.ListCommitteeMembersByOrganizationPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("committee-service", "list-committee-members-by-organization", "*committeeservice.ListCommitteeMembersByOrganizationPayload", v)
+		}
+		uid = p.UID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListCommitteeMembersByOrganizationCommitteeServicePath(uid)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("committee-service", "list-committee-members-by-organization", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListCommitteeMembersByOrganizationRequest returns an encoder for
+// requests sent to the committee-service
+// list-committee-members-by-organization server.
+func EncodeListCommitteeMembersByOrganizationRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*committeeservice.ListCommitteeMembersByOrganizationPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("committee-service", "list-committee-members-by-organization", "*committeeservice.ListCommitteeMembersByOrganizationPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("v", p.Version)
+		values.Add("organization", p.Organization)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListCommitteeMembersByOrganizationResponse returns a decoder for
+// responses returned by the committee-service
+// list-committee-members-by-organization endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeListCommitteeMembersByOrganizationResponse may return the following
+// errors:
+//   - "BadRequest" (type *committeeservice.BadRequestError): http.StatusBadRequest
+//   - "InternalServerError" (type *committeeservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *committeeservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *committeeservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeListCommitteeMembersByOrganizationResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListCommitteeMembersByOrganizationResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("committee-service", "list-committee-members-by-organization", err)
+			}
+			err = ValidateListCommitteeMembersByOrganizationResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("committee-service", "list-committee-members-by-organization", err)
+			}
+			res := NewListCommitteeMembersByOrganizationResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListCommitteeMembersByOrganizationBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("committee-service", "list-committee-members-by-organization", err)
+			}
+			err = ValidateListCommitteeMembersByOrganizationBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("committee-service", "list-committee-members-by-organization", err)
+			}
+			return nil, NewListCommitteeMembersByOrganizationBadRequest(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListCommitteeMembersByOrganizationInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("committee-service", "list-committee-members-by-organization", err)
+			}
+			err = ValidateListCommitteeMembersByOrganizationInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("committee-service", "list-committee-members-by-organization", err)
+			}
+			return nil, NewListCommitteeMembersByOrganizationInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body ListCommitteeMembersByOrganizationNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("committee-service", "list-committee-members-by-organization", err)
+			}
+			err = ValidateListCommitteeMembersByOrganizationNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("committee-service", "list-committee-members-by-organization", err)
+			}
+			return nil, NewListCommitteeMembersByOrganizationNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body ListCommitteeMembersByOrganizationServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("committee-service", "list-committee-members-by-organization", err)
+			}
+			err = ValidateListCommitteeMembersByOrganizationServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("committee-service", "list-committee-members-by-organization", err)
+			}
+			return nil, NewListCommitteeMembersByOrganizationServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("committee-service", "list-committee-members-by-organization", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetCommitteeMemberRequest instantiates a HTTP request object with
+// method and path set to call the "committee-service" service
+// "get-committee-member" endpoint
+func (c *Client) BuildGetCommitteeMemberRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uid       string
+		memberUID string
+	)
+	{
+		p, ok := v.(*committeeservice.GetCommitteeMemberPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("committee-service", "get-committee-member", "*committeeservice.GetCommitteeMemberPayload", v)
+		}
+		uid = p.UID
+		memberUID = p.MemberUID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetCommitteeMemberCommitteeServicePath(uid, memberUID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("committee-service", "get-committee-member", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetCommitteeMemberRequest returns an encoder for requests sent to the
+// committee-service get-committee-member server.
+func EncodeGetCommitteeMemberRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*committeeservice.GetCommitteeMemberPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("committee-service", "get-committee-member", "*committeeservice.GetCommitteeMemberPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("v", p.Version)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetCommitteeMemberResponse returns a decoder for responses returned by
 // the committee-service get-committee-member endpoint. restoreBody controls
 // whether the response body should be restored after having been read.
 // DecodeGetCommitteeMemberResponse may return the following errors:
@@ -1367,7 +2005,169 @@ func EncodeGetCommitteeMemberRequest(encoder func(*http.Request) goahttp.Encoder
 //   - "NotFound" (type *committeeservice.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *committeeservice.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeGetCommitteeMemberResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeGetCommitteeMemberResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetCommitteeMemberResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("committee-service", "get-committee-member", err)
+			}
+			err = ValidateGetCommitteeMemberResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("committee-service", "get-committee-member", err)
+			}
+			var (
+				etag *string
+			)
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw != "" {
+				etag = &etagRaw
+			}
+			res := NewGetCommitteeMemberResultOK(&body, etag)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetCommitteeMemberBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("committee-service", "get-committee-member", err)
+			}
+			err = ValidateGetCommitteeMemberBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("committee-service", "get-committee-member", err)
+			}
+			return nil, NewGetCommitteeMemberBadRequest(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetCommitteeMemberInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("committee-service", "get-committee-member", err)
+			}
+			err = ValidateGetCommitteeMemberInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("committee-service", "get-committee-member", err)
+			}
+			return nil, NewGetCommitteeMemberInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body GetCommitteeMemberNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("committee-service", "get-committee-member", err)
+			}
+			err = ValidateGetCommitteeMemberNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("committee-service", "get-committee-member", err)
+			}
+			return nil, NewGetCommitteeMemberNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetCommitteeMemberServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("committee-service", "get-committee-member", err)
+			}
+			err = ValidateGetCommitteeMemberServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("committee-service", "get-committee-member", err)
+			}
+			return nil, NewGetCommitteeMemberServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("committee-service", "get-committee-member", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetCommitteeMemberHistoryRequest instantiates a HTTP request object
+// with method and path set to call the "committee-service" service
+// "get-committee-member-history" endpoint
+func (c *Client) BuildGetCommitteeMemberHistoryRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uid       string
+		memberUID string
+	)
+	{
+		p, ok := v.(*committeeservice.GetCommitteeMemberHistoryPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("committee-service", "get-committee-member-history", "*committeeservice.GetCommitteeMemberHistoryPayload", v)
+		}
+		uid = p.UID
+		memberUID = p.MemberUID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetCommitteeMemberHistoryCommitteeServicePath(uid, memberUID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("committee-service", "get-committee-member-history", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetCommitteeMemberHistoryRequest returns an encoder for requests sent
+// to the committee-service get-committee-member-history server.
+func EncodeGetCommitteeMemberHistoryRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*committeeservice.GetCommitteeMemberHistoryPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("committee-service", "get-committee-member-history", "*committeeservice.GetCommitteeMemberHistoryPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("v", p.Version)
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetCommitteeMemberHistoryResponse returns a decoder for responses
+// returned by the committee-service get-committee-member-history endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeGetCommitteeMemberHistoryResponse may return the following errors:
+//   - "BadRequest" (type *committeeservice.BadRequestError): http.StatusBadRequest
+//   - "InternalServerError" (type *committeeservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *committeeservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *committeeservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeGetCommitteeMemberHistoryResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -1384,85 +2184,78 @@ func DecodeGetCommitteeMemberResponse(decoder func(*http.Response) goahttp.Decod
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body GetCommitteeMemberResponseBody
+				body GetCommitteeMemberHistoryResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("committee-service", "get-committee-member", err)
+				return nil, goahttp.ErrDecodingError("committee-service", "get-committee-member-history", err)
 			}
-			err = ValidateGetCommitteeMemberResponseBody(&body)
+			err = ValidateGetCommitteeMemberHistoryResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("committee-service", "get-committee-member", err)
-			}
-			var (
-				etag *string
-			)
-			etagRaw := resp.Header.Get("Etag")
-			if etagRaw != "" {
-				etag = &etagRaw
+				return nil, goahttp.ErrValidationError("committee-service", "get-committee-member-history", err)
 			}
-			res := NewGetCommitteeMemberResultOK(&body, etag)
+			res := NewGetCommitteeMemberHistoryResultOK(&body)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
-				body GetCommitteeMemberBadRequestResponseBody
+				body GetCommitteeMemberHistoryBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("committee-service", "get-committee-member", err)
+				return nil, goahttp.ErrDecodingError("committee-service", "get-committee-member-history", err)
 			}
-			err = ValidateGetCommitteeMemberBadRequestResponseBody(&body)
+			err = ValidateGetCommitteeMemberHistoryBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("committee-service", "get-committee-member", err)
+				return nil, goahttp.ErrValidationError("committee-service", "get-committee-member-history", err)
 			}
-			return nil, NewGetCommitteeMemberBadRequest(&body)
+			return nil, NewGetCommitteeMemberHistoryBadRequest(&body)
 		case http.StatusInternalServerError:
 			var (
-				body GetCommitteeMemberInternalServerErrorResponseBody
+				body GetCommitteeMemberHistoryInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("committee-service", "get-committee-member", err)
+				return nil, goahttp.ErrDecodingError("committee-service", "get-committee-member-history", err)
 			}
-			err = ValidateGetCommitteeMemberInternalServerErrorResponseBody(&body)
+			err = ValidateGetCommitteeMemberHistoryInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("committee-service", "get-committee-member", err)
+				return nil, goahttp.ErrValidationError("committee-service", "get-committee-member-history", err)
 			}
-			return nil, NewGetCommitteeMemberInternalServerError(&body)
+			return nil, NewGetCommitteeMemberHistoryInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body GetCommitteeMemberNotFoundResponseBody
+				body GetCommitteeMemberHistoryNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("committee-service", "get-committee-member", err)
+				return nil, goahttp.ErrDecodingError("committee-service", "get-committee-member-history", err)
 			}
-			err = ValidateGetCommitteeMemberNotFoundResponseBody(&body)
+			err = ValidateGetCommitteeMemberHistoryNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("committee-service", "get-committee-member", err)
+				return nil, goahttp.ErrValidationError("committee-service", "get-committee-member-history", err)
 			}
-			return nil, NewGetCommitteeMemberNotFound(&body)
+			return nil, NewGetCommitteeMemberHistoryNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body GetCommitteeMemberServiceUnavailableResponseBody
+				body GetCommitteeMemberHistoryServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("committee-service", "get-committee-member", err)
+				return nil, goahttp.ErrDecodingError("committee-service", "get-committee-member-history", err)
 			}
-			err = ValidateGetCommitteeMemberServiceUnavailableResponseBody(&body)
+			err = ValidateGetCommitteeMemberHistoryServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("committee-service", "get-committee-member", err)
+				return nil, goahttp.ErrValidationError("committee-service", "get-committee-member-history", err)
 			}
-			return nil, NewGetCommitteeMemberServiceUnavailable(&body)
+			return nil, NewGetCommitteeMemberHistoryServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("committee-service", "get-committee-member", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("committee-service", "get-committee-member-history", resp.StatusCode, string(body))
 		}
 	}
 }
@@ -1648,6 +2441,187 @@ func DecodeUpdateCommitteeMemberResponse(decoder func(*http.Response) goahttp.De
 	}
 }
 
+// BuildPatchCommitteeMemberRequest instantiates a HTTP request object with
+// method and path set to call the "committee-service" service
+// "patch-committee-member" endpoint
+func (c *Client) BuildPatchCommitteeMemberRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uid       string
+		memberUID string
+	)
+	{
+		p, ok := v.(*committeeservice.PatchCommitteeMemberPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("committee-service", "patch-committee-member", "*committeeservice.PatchCommitteeMemberPayload", v)
+		}
+		uid = p.UID
+		memberUID = p.MemberUID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: PatchCommitteeMemberCommitteeServicePath(uid, memberUID)}
+	req, err := http.NewRequest("PATCH", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("committee-service", "patch-committee-member", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodePatchCommitteeMemberRequest returns an encoder for requests sent to
+// the committee-service patch-committee-member server.
+func EncodePatchCommitteeMemberRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*committeeservice.PatchCommitteeMemberPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("committee-service", "patch-committee-member", "*committeeservice.PatchCommitteeMemberPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		if p.IfMatch != nil {
+			head := *p.IfMatch
+			req.Header.Set("If-Match", head)
+		}
+		{
+			head := p.XSync
+			headStr := strconv.FormatBool(head)
+			req.Header.Set("X-Sync", headStr)
+		}
+		values := req.URL.Query()
+		values.Add("v", p.Version)
+		req.URL.RawQuery = values.Encode()
+		body := NewPatchCommitteeMemberRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("committee-service", "patch-committee-member", err)
+		}
+		return nil
+	}
+}
+
+// DecodePatchCommitteeMemberResponse returns a decoder for responses returned
+// by the committee-service patch-committee-member endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodePatchCommitteeMemberResponse may return the following errors:
+//   - "BadRequest" (type *committeeservice.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *committeeservice.ConflictError): http.StatusConflict
+//   - "InternalServerError" (type *committeeservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *committeeservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *committeeservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodePatchCommitteeMemberResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body PatchCommitteeMemberResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("committee-service", "patch-committee-member", err)
+			}
+			err = ValidatePatchCommitteeMemberResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("committee-service", "patch-committee-member", err)
+			}
+			res := NewPatchCommitteeMemberCommitteeMemberFullWithReadonlyAttributesOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body PatchCommitteeMemberBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("committee-service", "patch-committee-member", err)
+			}
+			err = ValidatePatchCommitteeMemberBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("committee-service", "patch-committee-member", err)
+			}
+			return nil, NewPatchCommitteeMemberBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body PatchCommitteeMemberConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("committee-service", "patch-committee-member", err)
+			}
+			err = ValidatePatchCommitteeMemberConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("committee-service", "patch-committee-member", err)
+			}
+			return nil, NewPatchCommitteeMemberConflict(&body)
+		case http.StatusInternalServerError:
+			var (
+				body PatchCommitteeMemberInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("committee-service", "patch-committee-member", err)
+			}
+			err = ValidatePatchCommitteeMemberInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("committee-service", "patch-committee-member", err)
+			}
+			return nil, NewPatchCommitteeMemberInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body PatchCommitteeMemberNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("committee-service", "patch-committee-member", err)
+			}
+			err = ValidatePatchCommitteeMemberNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("committee-service", "patch-committee-member", err)
+			}
+			return nil, NewPatchCommitteeMemberNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body PatchCommitteeMemberServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("committee-service", "patch-committee-member", err)
+			}
+			err = ValidatePatchCommitteeMemberServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("committee-service", "patch-committee-member", err)
+			}
+			return nil, NewPatchCommitteeMemberServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("committee-service", "patch-committee-member", resp.StatusCode, string(body))
+		}
+	}
+}
+
 // BuildDeleteCommitteeMemberRequest instantiates a HTTP request object with
 // method and path set to call the "committee-service" service
 // "delete-committee-member" endpoint
@@ -1811,3 +2785,342 @@ func DecodeDeleteCommitteeMemberResponse(decoder func(*http.Response) goahttp.De
 		}
 	}
 }
+
+// unmarshalCommitteeFullWithReadonlyAttributesResponseBodyToCommitteeserviceCommitteeFullWithReadonlyAttributes
+// builds a value of type *committeeservice.CommitteeFullWithReadonlyAttributes
+// from a value of type *CommitteeFullWithReadonlyAttributesResponseBody.
+func unmarshalCommitteeFullWithReadonlyAttributesResponseBodyToCommitteeserviceCommitteeFullWithReadonlyAttributes(v *CommitteeFullWithReadonlyAttributesResponseBody) *committeeservice.CommitteeFullWithReadonlyAttributes {
+	res := &committeeservice.CommitteeFullWithReadonlyAttributes{
+		UID:              v.UID,
+		ProjectUID:       v.ProjectUID,
+		Name:             v.Name,
+		Category:         v.Category,
+		Description:      v.Description,
+		Website:          v.Website,
+		DisplayName:      v.DisplayName,
+		ParentUID:        v.ParentUID,
+		SsoGroupName:     v.SsoGroupName,
+		TotalMembers:     v.TotalMembers,
+		TotalVotingRepos: v.TotalVotingRepos,
+		IndexStatus:      v.IndexStatus,
+		LastIndexedAt:    v.LastIndexedAt,
+		LastReviewedAt:   v.LastReviewedAt,
+		LastReviewedBy:   v.LastReviewedBy,
+	}
+	if v.EnableVoting != nil {
+		res.EnableVoting = *v.EnableVoting
+	}
+	if v.SsoGroupEnabled != nil {
+		res.SsoGroupEnabled = *v.SsoGroupEnabled
+	}
+	if v.RequiresReview != nil {
+		res.RequiresReview = *v.RequiresReview
+	}
+	if v.Public != nil {
+		res.Public = *v.Public
+	}
+	if v.BusinessEmailRequired != nil {
+		res.BusinessEmailRequired = *v.BusinessEmailRequired
+	}
+	if v.MemberVisibility != nil {
+		res.MemberVisibility = *v.MemberVisibility
+	}
+	if v.ShowMeetingAttendees != nil {
+		res.ShowMeetingAttendees = *v.ShowMeetingAttendees
+	}
+	if v.EnableVoting == nil {
+		res.EnableVoting = false
+	}
+	if v.SsoGroupEnabled == nil {
+		res.SsoGroupEnabled = false
+	}
+	if v.RequiresReview == nil {
+		res.RequiresReview = false
+	}
+	if v.Public == nil {
+		res.Public = false
+	}
+	if v.Calendar != nil {
+		res.Calendar = &struct {
+			// Whether the committee calendar is publicly visible
+			Public bool
+		}{}
+		if v.Calendar.Public != nil {
+			res.Calendar.Public = *v.Calendar.Public
+		}
+		if v.Calendar.Public == nil {
+			res.Calendar.Public = false
+		}
+	}
+	if v.Labels != nil {
+		res.Labels = make(map[string]string, len(v.Labels))
+		for key, val := range v.Labels {
+			tk := key
+			tv := val
+			res.Labels[tk] = tv
+		}
+	}
+	if v.BusinessEmailRequired == nil {
+		res.BusinessEmailRequired = false
+	}
+	if v.AllowedEmailDomains != nil {
+		res.AllowedEmailDomains = make([]string, len(v.AllowedEmailDomains))
+		for i, val := range v.AllowedEmailDomains {
+			res.AllowedEmailDomains[i] = val
+		}
+	}
+	if v.MemberVisibility == nil {
+		res.MemberVisibility = "hidden"
+	}
+	if v.ShowMeetingAttendees == nil {
+		res.ShowMeetingAttendees = false
+	}
+	if v.Writers != nil {
+		res.Writers = make([]string, len(v.Writers))
+		for i, val := range v.Writers {
+			res.Writers[i] = val
+		}
+	}
+	if v.Auditors != nil {
+		res.Auditors = make([]string, len(v.Auditors))
+		for i, val := range v.Auditors {
+			res.Auditors[i] = val
+		}
+	}
+
+	return res
+}
+
+// unmarshalCommitteeMemberFullWithReadonlyAttributesResponseBodyToCommitteeserviceCommitteeMemberFullWithReadonlyAttributes
+// builds a value of type
+// *committeeservice.CommitteeMemberFullWithReadonlyAttributes from a value of
+// type *CommitteeMemberFullWithReadonlyAttributesResponseBody.
+func unmarshalCommitteeMemberFullWithReadonlyAttributesResponseBodyToCommitteeserviceCommitteeMemberFullWithReadonlyAttributes(v *CommitteeMemberFullWithReadonlyAttributesResponseBody) *committeeservice.CommitteeMemberFullWithReadonlyAttributes {
+	res := &committeeservice.CommitteeMemberFullWithReadonlyAttributes{
+		UID:               v.UID,
+		CommitteeUID:      v.CommitteeUID,
+		CommitteeName:     v.CommitteeName,
+		CommitteeCategory: v.CommitteeCategory,
+		Username:          v.Username,
+		Email:             v.Email,
+		FirstName:         v.FirstName,
+		LastName:          v.LastName,
+		JobTitle:          v.JobTitle,
+		LinkedinProfile:   v.LinkedinProfile,
+		AlternateForUID:   v.AlternateForUID,
+		CreatedAt:         v.CreatedAt,
+		UpdatedAt:         v.UpdatedAt,
+	}
+	if v.AppointedBy != nil {
+		res.AppointedBy = *v.AppointedBy
+	}
+	if v.Status != nil {
+		res.Status = *v.Status
+	}
+	if v.Role != nil {
+		res.Role = &struct {
+			// Committee role name
+			Name string
+			// Role start date
+			StartDate *string
+			// Role end date
+			EndDate *string
+		}{
+			StartDate: v.Role.StartDate,
+			EndDate:   v.Role.EndDate,
+		}
+		if v.Role.Name != nil {
+			res.Role.Name = *v.Role.Name
+		}
+		if v.Role.Name == nil {
+			res.Role.Name = "None"
+		}
+	}
+	if v.AppointedBy == nil {
+		res.AppointedBy = "None"
+	}
+	if v.Status == nil {
+		res.Status = "Active"
+	}
+	if v.Voting != nil {
+		res.Voting = &struct {
+			// Voting status
+			Status string
+			// Voting start date
+			StartDate *string
+			// Voting end date
+			EndDate *string
+			// Voting weight for weighted voting committees, defaults to 1
+			Weight *float64
+		}{
+			StartDate: v.Voting.StartDate,
+			EndDate:   v.Voting.EndDate,
+			Weight:    v.Voting.Weight,
+		}
+		if v.Voting.Status != nil {
+			res.Voting.Status = *v.Voting.Status
+		}
+		if v.Voting.Status == nil {
+			res.Voting.Status = "None"
+		}
+	}
+	if v.Organization != nil {
+		res.Organization = &struct {
+			// Organization ID
+			ID *string
+			// Organization name
+			Name *string
+			// Organization website URL
+			Website *string
+		}{
+			ID:      v.Organization.ID,
+			Name:    v.Organization.Name,
+			Website: v.Organization.Website,
+		}
+	}
+	if v.RoleHistory != nil {
+		res.RoleHistory = make([]*committeeservice.CommitteeMemberRoleChange, len(v.RoleHistory))
+		for i, val := range v.RoleHistory {
+			res.RoleHistory[i] = unmarshalCommitteeMemberRoleChangeResponseBodyToCommitteeserviceCommitteeMemberRoleChange(val)
+		}
+	}
+	if v.StatusHistory != nil {
+		res.StatusHistory = make([]*committeeservice.CommitteeMemberStatusChange, len(v.StatusHistory))
+		for i, val := range v.StatusHistory {
+			res.StatusHistory[i] = unmarshalCommitteeMemberStatusChangeResponseBodyToCommitteeserviceCommitteeMemberStatusChange(val)
+		}
+	}
+
+	return res
+}
+
+// unmarshalCommitteeMemberRoleChangeResponseBodyToCommitteeserviceCommitteeMemberRoleChange
+// builds a value of type *committeeservice.CommitteeMemberRoleChange from a
+// value of type *CommitteeMemberRoleChangeResponseBody.
+func unmarshalCommitteeMemberRoleChangeResponseBodyToCommitteeserviceCommitteeMemberRoleChange(v *CommitteeMemberRoleChangeResponseBody) *committeeservice.CommitteeMemberRoleChange {
+	if v == nil {
+		return nil
+	}
+	res := &committeeservice.CommitteeMemberRoleChange{
+		OldRole:   *v.OldRole,
+		NewRole:   *v.NewRole,
+		ChangedAt: *v.ChangedAt,
+		Actor:     v.Actor,
+	}
+
+	return res
+}
+
+// unmarshalCommitteeMemberStatusChangeResponseBodyToCommitteeserviceCommitteeMemberStatusChange
+// builds a value of type *committeeservice.CommitteeMemberStatusChange from a
+// value of type *CommitteeMemberStatusChangeResponseBody.
+func unmarshalCommitteeMemberStatusChangeResponseBodyToCommitteeserviceCommitteeMemberStatusChange(v *CommitteeMemberStatusChangeResponseBody) *committeeservice.CommitteeMemberStatusChange {
+	if v == nil {
+		return nil
+	}
+	res := &committeeservice.CommitteeMemberStatusChange{
+		OldStatus: *v.OldStatus,
+		NewStatus: *v.NewStatus,
+		ChangedAt: *v.ChangedAt,
+		Actor:     v.Actor,
+	}
+
+	return res
+}
+
+// unmarshalCommitteeBaseWithReadonlyAttributesResponseBodyToCommitteeserviceCommitteeBaseWithReadonlyAttributes
+// builds a value of type *committeeservice.CommitteeBaseWithReadonlyAttributes
+// from a value of type *CommitteeBaseWithReadonlyAttributesResponseBody.
+func unmarshalCommitteeBaseWithReadonlyAttributesResponseBodyToCommitteeserviceCommitteeBaseWithReadonlyAttributes(v *CommitteeBaseWithReadonlyAttributesResponseBody) *committeeservice.CommitteeBaseWithReadonlyAttributes {
+	res := &committeeservice.CommitteeBaseWithReadonlyAttributes{
+		UID:              v.UID,
+		ProjectUID:       v.ProjectUID,
+		Name:             v.Name,
+		Category:         v.Category,
+		Description:      v.Description,
+		Website:          v.Website,
+		DisplayName:      v.DisplayName,
+		ParentUID:        v.ParentUID,
+		ProjectName:      v.ProjectName,
+		SsoGroupName:     v.SsoGroupName,
+		TotalMembers:     v.TotalMembers,
+		TotalVotingRepos: v.TotalVotingRepos,
+		IndexStatus:      v.IndexStatus,
+		LastIndexedAt:    v.LastIndexedAt,
+	}
+	if v.EnableVoting != nil {
+		res.EnableVoting = *v.EnableVoting
+	}
+	if v.SsoGroupEnabled != nil {
+		res.SsoGroupEnabled = *v.SsoGroupEnabled
+	}
+	if v.RequiresReview != nil {
+		res.RequiresReview = *v.RequiresReview
+	}
+	if v.Public != nil {
+		res.Public = *v.Public
+	}
+	if v.EnableVoting == nil {
+		res.EnableVoting = false
+	}
+	if v.SsoGroupEnabled == nil {
+		res.SsoGroupEnabled = false
+	}
+	if v.RequiresReview == nil {
+		res.RequiresReview = false
+	}
+	if v.Public == nil {
+		res.Public = false
+	}
+	if v.Calendar != nil {
+		res.Calendar = &struct {
+			// Whether the committee calendar is publicly visible
+			Public bool
+		}{}
+		if v.Calendar.Public != nil {
+			res.Calendar.Public = *v.Calendar.Public
+		}
+		if v.Calendar.Public == nil {
+			res.Calendar.Public = false
+		}
+	}
+	if v.Labels != nil {
+		res.Labels = make(map[string]string, len(v.Labels))
+		for key, val := range v.Labels {
+			tk := key
+			tv := val
+			res.Labels[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// unmarshalCommitteeMemberReassignResultResponseBodyToCommitteeserviceCommitteeMemberReassignResult
+// builds a value of type *committeeservice.CommitteeMemberReassignResult from
+// a value of type *CommitteeMemberReassignResultResponseBody.
+func unmarshalCommitteeMemberReassignResultResponseBodyToCommitteeserviceCommitteeMemberReassignResult(v *CommitteeMemberReassignResultResponseBody) *committeeservice.CommitteeMemberReassignResult {
+	res := &committeeservice.CommitteeMemberReassignResult{
+		UID:   *v.UID,
+		Email: *v.Email,
+		Error: v.Error,
+	}
+	if v.Member != nil {
+		res.Member = unmarshalCommitteeMemberFullWithReadonlyAttributesResponseBodyToCommitteeserviceCommitteeMemberFullWithReadonlyAttributes(v.Member)
+	}
+
+	return res
+}
+
+// unmarshalCommitteeMemberRevisionResponseBodyToCommitteeserviceCommitteeMemberRevision
+// builds a value of type *committeeservice.CommitteeMemberRevision from a
+// value of type *CommitteeMemberRevisionResponseBody.
+func unmarshalCommitteeMemberRevisionResponseBodyToCommitteeserviceCommitteeMemberRevision(v *CommitteeMemberRevisionResponseBody) *committeeservice.CommitteeMemberRevision {
+	res := &committeeservice.CommitteeMemberRevision{
+		Revision:  *v.Revision,
+		CreatedAt: *v.CreatedAt,
+	}
+	res.Member = unmarshalCommitteeMemberFullWithReadonlyAttributesResponseBodyToCommitteeserviceCommitteeMemberFullWithReadonlyAttributes(v.Member)
+
+	return res
+}
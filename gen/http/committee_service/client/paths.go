@@ -22,6 +22,16 @@ func GetCommitteeBaseCommitteeServicePath(uid string) string {
 	return fmt.Sprintf("/committees/%v", uid)
 }
 
+// GetCommitteeWithMembersCommitteeServicePath returns the URL path to the committee-service service get-committee-with-members HTTP endpoint.
+func GetCommitteeWithMembersCommitteeServicePath(uid string) string {
+	return fmt.Sprintf("/committees/%v/with-members", uid)
+}
+
+// ListCommitteeChildrenCommitteeServicePath returns the URL path to the committee-service service list-committee-children HTTP endpoint.
+func ListCommitteeChildrenCommitteeServicePath(uid string) string {
+	return fmt.Sprintf("/committees/%v/children", uid)
+}
+
 // UpdateCommitteeBaseCommitteeServicePath returns the URL path to the committee-service service update-committee-base HTTP endpoint.
 func UpdateCommitteeBaseCommitteeServicePath(uid string) string {
 	return fmt.Sprintf("/committees/%v", uid)
@@ -57,16 +67,36 @@ func CreateCommitteeMemberCommitteeServicePath(uid string) string {
 	return fmt.Sprintf("/committees/%v/members", uid)
 }
 
+// ReassignCommitteeMembersOrganizationCommitteeServicePath returns the URL path to the committee-service service reassign-committee-members-organization HTTP endpoint.
+func ReassignCommitteeMembersOrganizationCommitteeServicePath(uid string) string {
+	return fmt.Sprintf("/committees/%v/members/reassign-organization", uid)
+}
+
+// ListCommitteeMembersByOrganizationCommitteeServicePath returns the URL path to the committee-service service list-committee-members-by-organization HTTP endpoint.
+func ListCommitteeMembersByOrganizationCommitteeServicePath(uid string) string {
+	return fmt.Sprintf("/committees/%v/members-by-organization", uid)
+}
+
 // GetCommitteeMemberCommitteeServicePath returns the URL path to the committee-service service get-committee-member HTTP endpoint.
 func GetCommitteeMemberCommitteeServicePath(uid string, memberUID string) string {
 	return fmt.Sprintf("/committees/%v/members/%v", uid, memberUID)
 }
 
+// GetCommitteeMemberHistoryCommitteeServicePath returns the URL path to the committee-service service get-committee-member-history HTTP endpoint.
+func GetCommitteeMemberHistoryCommitteeServicePath(uid string, memberUID string) string {
+	return fmt.Sprintf("/committees/%v/members/%v/history", uid, memberUID)
+}
+
 // UpdateCommitteeMemberCommitteeServicePath returns the URL path to the committee-service service update-committee-member HTTP endpoint.
 func UpdateCommitteeMemberCommitteeServicePath(uid string, memberUID string) string {
 	return fmt.Sprintf("/committees/%v/members/%v", uid, memberUID)
 }
 
+// PatchCommitteeMemberCommitteeServicePath returns the URL path to the committee-service service patch-committee-member HTTP endpoint.
+func PatchCommitteeMemberCommitteeServicePath(uid string, memberUID string) string {
+	return fmt.Sprintf("/committees/%v/members/%v", uid, memberUID)
+}
+
 // DeleteCommitteeMemberCommitteeServicePath returns the URL path to the committee-service service delete-committee-member HTTP endpoint.
 func DeleteCommitteeMemberCommitteeServicePath(uid string, memberUID string) string {
 	return fmt.Sprintf("/committees/%v/members/%v", uid, memberUID)
@@ -47,8 +47,13 @@ type CreateCommitteeRequestBody struct {
 	// The UID of the parent committee -- v2 uid, not related to v1 id directly,
 	// should be empty if there is none
 	ParentUID *string `form:"parent_uid,omitempty" json:"parent_uid,omitempty" xml:"parent_uid,omitempty"`
+	// Arbitrary key/value labels used to filter committees
+	Labels map[string]string `form:"labels,omitempty" json:"labels,omitempty" xml:"labels,omitempty"`
 	// Whether business email is required for committee members
 	BusinessEmailRequired bool `form:"business_email_required" json:"business_email_required" xml:"business_email_required"`
+	// Email domains allowed for committee members, overriding the business email
+	// requirement when not empty
+	AllowedEmailDomains []string `form:"allowed_email_domains,omitempty" json:"allowed_email_domains,omitempty" xml:"allowed_email_domains,omitempty"`
 	// The timestamp when the committee was last reviewed in RFC3339 format
 	LastReviewedAt *string `form:"last_reviewed_at,omitempty" json:"last_reviewed_at,omitempty" xml:"last_reviewed_at,omitempty"`
 	// The user ID who last reviewed this committee
@@ -97,6 +102,8 @@ type UpdateCommitteeBaseRequestBody struct {
 	// The UID of the parent committee -- v2 uid, not related to v1 id directly,
 	// should be empty if there is none
 	ParentUID *string `form:"parent_uid,omitempty" json:"parent_uid,omitempty" xml:"parent_uid,omitempty"`
+	// Arbitrary key/value labels used to filter committees
+	Labels map[string]string `form:"labels,omitempty" json:"labels,omitempty" xml:"labels,omitempty"`
 }
 
 // UpdateCommitteeSettingsRequestBody is the type of the "committee-service"
@@ -104,6 +111,9 @@ type UpdateCommitteeBaseRequestBody struct {
 type UpdateCommitteeSettingsRequestBody struct {
 	// Whether business email is required for committee members
 	BusinessEmailRequired bool `form:"business_email_required" json:"business_email_required" xml:"business_email_required"`
+	// Email domains allowed for committee members, overriding the business email
+	// requirement when not empty
+	AllowedEmailDomains []string `form:"allowed_email_domains,omitempty" json:"allowed_email_domains,omitempty" xml:"allowed_email_domains,omitempty"`
 	// The timestamp when the committee was last reviewed in RFC3339 format
 	LastReviewedAt *string `form:"last_reviewed_at,omitempty" json:"last_reviewed_at,omitempty" xml:"last_reviewed_at,omitempty"`
 	// The user ID who last reviewed this committee
@@ -156,7 +166,11 @@ type CreateCommitteeMemberRequestBody struct {
 		StartDate *string `form:"start_date" json:"start_date" xml:"start_date"`
 		// Voting end date
 		EndDate *string `form:"end_date" json:"end_date" xml:"end_date"`
+		// Voting weight for weighted voting committees, defaults to 1
+		Weight *float64 `form:"weight" json:"weight" xml:"weight"`
 	} `form:"voting,omitempty" json:"voting,omitempty" xml:"voting,omitempty"`
+	// UID of the member of the same committee this member is the alternate of
+	AlternateForUID *string `form:"alternate_for_uid,omitempty" json:"alternate_for_uid,omitempty" xml:"alternate_for_uid,omitempty"`
 	// Organization information for the committee member
 	Organization *struct {
 		// Organization ID
@@ -168,9 +182,21 @@ type CreateCommitteeMemberRequestBody struct {
 	} `form:"organization,omitempty" json:"organization,omitempty" xml:"organization,omitempty"`
 }
 
+// ReassignCommitteeMembersOrganizationRequestBody is the type of the
+// "committee-service" service "reassign-committee-members-organization"
+// endpoint HTTP request body.
+type ReassignCommitteeMembersOrganizationRequestBody struct {
+	// The name of the organization the members are moved from
+	FromOrganization string `form:"from_organization" json:"from_organization" xml:"from_organization"`
+	// The name of the organization the members are moved to, empty to clear it
+	ToOrganization *string `form:"to_organization,omitempty" json:"to_organization,omitempty" xml:"to_organization,omitempty"`
+}
+
 // UpdateCommitteeMemberRequestBody is the type of the "committee-service"
 // service "update-committee-member" endpoint HTTP request body.
 type UpdateCommitteeMemberRequestBody struct {
+	// Committee UID -- v2 uid, not related to v1 id directly
+	CommitteeUID *string `form:"committee_uid,omitempty" json:"committee_uid,omitempty" xml:"committee_uid,omitempty"`
 	// User's LF ID
 	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
 	// Primary email address
@@ -204,7 +230,11 @@ type UpdateCommitteeMemberRequestBody struct {
 		StartDate *string `form:"start_date" json:"start_date" xml:"start_date"`
 		// Voting end date
 		EndDate *string `form:"end_date" json:"end_date" xml:"end_date"`
+		// Voting weight for weighted voting committees, defaults to 1
+		Weight *float64 `form:"weight" json:"weight" xml:"weight"`
 	} `form:"voting,omitempty" json:"voting,omitempty" xml:"voting,omitempty"`
+	// UID of the member of the same committee this member is the alternate of
+	AlternateForUID *string `form:"alternate_for_uid,omitempty" json:"alternate_for_uid,omitempty" xml:"alternate_for_uid,omitempty"`
 	// Organization information for the committee member
 	Organization *struct {
 		// Organization ID
@@ -216,6 +246,60 @@ type UpdateCommitteeMemberRequestBody struct {
 	} `form:"organization,omitempty" json:"organization,omitempty" xml:"organization,omitempty"`
 }
 
+// PatchCommitteeMemberRequestBody is the type of the "committee-service"
+// service "patch-committee-member" endpoint HTTP request body.
+type PatchCommitteeMemberRequestBody struct {
+	// User's LF ID
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+	// Primary email address
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// First name
+	FirstName *string `form:"first_name,omitempty" json:"first_name,omitempty" xml:"first_name,omitempty"`
+	// Last name
+	LastName *string `form:"last_name,omitempty" json:"last_name,omitempty" xml:"last_name,omitempty"`
+	// Job title at organization
+	JobTitle *string `form:"job_title,omitempty" json:"job_title,omitempty" xml:"job_title,omitempty"`
+	// LinkedIn profile URL
+	LinkedinProfile *string `form:"linkedin_profile,omitempty" json:"linkedin_profile,omitempty" xml:"linkedin_profile,omitempty"`
+	// Committee role information, omitted fields are left unchanged
+	Role *struct {
+		// Committee role name
+		Name *string `form:"name" json:"name" xml:"name"`
+		// Role start date
+		StartDate *string `form:"start_date" json:"start_date" xml:"start_date"`
+		// Role end date
+		EndDate *string `form:"end_date" json:"end_date" xml:"end_date"`
+	} `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
+	// How the member was appointed
+	AppointedBy *string `form:"appointed_by,omitempty" json:"appointed_by,omitempty" xml:"appointed_by,omitempty"`
+	// Member status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Voting information for the committee member, omitted fields are left
+	// unchanged
+	Voting *struct {
+		// Voting status
+		Status *string `form:"status" json:"status" xml:"status"`
+		// Voting start date
+		StartDate *string `form:"start_date" json:"start_date" xml:"start_date"`
+		// Voting end date
+		EndDate *string `form:"end_date" json:"end_date" xml:"end_date"`
+		// Voting weight for weighted voting committees, defaults to 1
+		Weight *float64 `form:"weight" json:"weight" xml:"weight"`
+	} `form:"voting,omitempty" json:"voting,omitempty" xml:"voting,omitempty"`
+	// UID of the member of the same committee this member is the alternate of
+	AlternateForUID *string `form:"alternate_for_uid,omitempty" json:"alternate_for_uid,omitempty" xml:"alternate_for_uid,omitempty"`
+	// Organization information for the committee member, omitted fields are left
+	// unchanged
+	Organization *struct {
+		// Organization ID
+		ID *string `form:"id" json:"id" xml:"id"`
+		// Organization name
+		Name *string `form:"name" json:"name" xml:"name"`
+		// Organization website URL
+		Website *string `form:"website" json:"website" xml:"website"`
+	} `form:"organization,omitempty" json:"organization,omitempty" xml:"organization,omitempty"`
+}
+
 // CreateCommitteeResponseBody is the type of the "committee-service" service
 // "create-committee" endpoint HTTP response body.
 type CreateCommitteeResponseBody struct {
@@ -250,14 +334,25 @@ type CreateCommitteeResponseBody struct {
 	// The UID of the parent committee -- v2 uid, not related to v1 id directly,
 	// should be empty if there is none
 	ParentUID *string `form:"parent_uid,omitempty" json:"parent_uid,omitempty" xml:"parent_uid,omitempty"`
+	// Arbitrary key/value labels used to filter committees
+	Labels map[string]string `form:"labels,omitempty" json:"labels,omitempty" xml:"labels,omitempty"`
 	// The name of the SSO group - read-only
 	SsoGroupName *string `form:"sso_group_name,omitempty" json:"sso_group_name,omitempty" xml:"sso_group_name,omitempty"`
 	// The total number of members in this committee
 	TotalMembers *int `form:"total_members,omitempty" json:"total_members,omitempty" xml:"total_members,omitempty"`
 	// The total number of repositories with voting permissions for this committee
 	TotalVotingRepos *int `form:"total_voting_repos,omitempty" json:"total_voting_repos,omitempty" xml:"total_voting_repos,omitempty"`
+	// Whether the committee search record reflects the latest changes, pending
+	// means a reindex is needed (read-only)
+	IndexStatus *string `form:"index_status,omitempty" json:"index_status,omitempty" xml:"index_status,omitempty"`
+	// The timestamp when the committee was last published to the search index
+	// (read-only)
+	LastIndexedAt *string `form:"last_indexed_at,omitempty" json:"last_indexed_at,omitempty" xml:"last_indexed_at,omitempty"`
 	// Whether business email is required for committee members
 	BusinessEmailRequired *bool `form:"business_email_required,omitempty" json:"business_email_required,omitempty" xml:"business_email_required,omitempty"`
+	// Email domains allowed for committee members, overriding the business email
+	// requirement when not empty
+	AllowedEmailDomains []string `form:"allowed_email_domains,omitempty" json:"allowed_email_domains,omitempty" xml:"allowed_email_domains,omitempty"`
 	// The timestamp when the committee was last reviewed in RFC3339 format
 	LastReviewedAt *string `form:"last_reviewed_at,omitempty" json:"last_reviewed_at,omitempty" xml:"last_reviewed_at,omitempty"`
 	// The user ID who last reviewed this committee
@@ -278,6 +373,28 @@ type CreateCommitteeResponseBody struct {
 // "get-committee-base" endpoint HTTP response body.
 type GetCommitteeBaseResponseBody CommitteeBaseWithReadonlyAttributesResponseBody
 
+// GetCommitteeWithMembersResponseBody is the type of the "committee-service"
+// service "get-committee-with-members" endpoint HTTP response body.
+type GetCommitteeWithMembersResponseBody struct {
+	// Committee base and settings
+	Committee *CommitteeFullWithReadonlyAttributesResponseBody `form:"committee,omitempty" json:"committee,omitempty" xml:"committee,omitempty"`
+	// Committee members of the page, ordered by last name and first name
+	Members []*CommitteeMemberFullWithReadonlyAttributesResponseBody `form:"members,omitempty" json:"members,omitempty" xml:"members,omitempty"`
+	// Number of committee members across every page
+	TotalMembers *int `form:"total_members,omitempty" json:"total_members,omitempty" xml:"total_members,omitempty"`
+	// Token of the next page, absent on the last page
+	NextPageToken *string `form:"next_page_token,omitempty" json:"next_page_token,omitempty" xml:"next_page_token,omitempty"`
+}
+
+// ListCommitteeChildrenResponseBody is the type of the "committee-service"
+// service "list-committee-children" endpoint HTTP response body.
+type ListCommitteeChildrenResponseBody struct {
+	// Direct sub-committees, ordered by name
+	Children []*CommitteeBaseWithReadonlyAttributesResponseBody `form:"children,omitempty" json:"children,omitempty" xml:"children,omitempty"`
+	// Token of the next page, absent on the last page
+	NextPageToken *string `form:"next_page_token,omitempty" json:"next_page_token,omitempty" xml:"next_page_token,omitempty"`
+}
+
 // UpdateCommitteeBaseResponseBody is the type of the "committee-service"
 // service "update-committee-base" endpoint HTTP response body.
 type UpdateCommitteeBaseResponseBody struct {
@@ -312,6 +429,8 @@ type UpdateCommitteeBaseResponseBody struct {
 	// The UID of the parent committee -- v2 uid, not related to v1 id directly,
 	// should be empty if there is none
 	ParentUID *string `form:"parent_uid,omitempty" json:"parent_uid,omitempty" xml:"parent_uid,omitempty"`
+	// Arbitrary key/value labels used to filter committees
+	Labels map[string]string `form:"labels,omitempty" json:"labels,omitempty" xml:"labels,omitempty"`
 	// The name of the project this committee belongs to
 	ProjectName *string `form:"project_name,omitempty" json:"project_name,omitempty" xml:"project_name,omitempty"`
 	// The name of the SSO group - read-only
@@ -320,6 +439,12 @@ type UpdateCommitteeBaseResponseBody struct {
 	TotalMembers *int `form:"total_members,omitempty" json:"total_members,omitempty" xml:"total_members,omitempty"`
 	// The total number of repositories with voting permissions for this committee
 	TotalVotingRepos *int `form:"total_voting_repos,omitempty" json:"total_voting_repos,omitempty" xml:"total_voting_repos,omitempty"`
+	// Whether the committee search record reflects the latest changes, pending
+	// means a reindex is needed (read-only)
+	IndexStatus *string `form:"index_status,omitempty" json:"index_status,omitempty" xml:"index_status,omitempty"`
+	// The timestamp when the committee was last published to the search index
+	// (read-only)
+	LastIndexedAt *string `form:"last_indexed_at,omitempty" json:"last_indexed_at,omitempty" xml:"last_indexed_at,omitempty"`
 }
 
 // GetCommitteeSettingsResponseBody is the type of the "committee-service"
@@ -333,6 +458,9 @@ type UpdateCommitteeSettingsResponseBody struct {
 	UID *string `form:"uid,omitempty" json:"uid,omitempty" xml:"uid,omitempty"`
 	// Whether business email is required for committee members
 	BusinessEmailRequired *bool `form:"business_email_required,omitempty" json:"business_email_required,omitempty" xml:"business_email_required,omitempty"`
+	// Email domains allowed for committee members, overriding the business email
+	// requirement when not empty
+	AllowedEmailDomains []string `form:"allowed_email_domains,omitempty" json:"allowed_email_domains,omitempty" xml:"allowed_email_domains,omitempty"`
 	// The timestamp when the committee was last reviewed in RFC3339 format
 	LastReviewedAt *string `form:"last_reviewed_at,omitempty" json:"last_reviewed_at,omitempty" xml:"last_reviewed_at,omitempty"`
 	// The user ID who last reviewed this committee
@@ -393,7 +521,11 @@ type CreateCommitteeMemberResponseBody struct {
 		StartDate *string `form:"start_date" json:"start_date" xml:"start_date"`
 		// Voting end date
 		EndDate *string `form:"end_date" json:"end_date" xml:"end_date"`
+		// Voting weight for weighted voting committees, defaults to 1
+		Weight *float64 `form:"weight" json:"weight" xml:"weight"`
 	} `form:"voting,omitempty" json:"voting,omitempty" xml:"voting,omitempty"`
+	// UID of the member of the same committee this member is the alternate of
+	AlternateForUID *string `form:"alternate_for_uid,omitempty" json:"alternate_for_uid,omitempty" xml:"alternate_for_uid,omitempty"`
 	// Organization information for the committee member
 	Organization *struct {
 		// Organization ID
@@ -407,12 +539,39 @@ type CreateCommitteeMemberResponseBody struct {
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
 	// The timestamp when the resource was last updated (read-only)
 	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// The changes of the member role, oldest first (read-only)
+	RoleHistory []*CommitteeMemberRoleChangeResponseBody `form:"role_history,omitempty" json:"role_history,omitempty" xml:"role_history,omitempty"`
+	// The changes of the member status, oldest first (read-only)
+	StatusHistory []*CommitteeMemberStatusChangeResponseBody `form:"status_history,omitempty" json:"status_history,omitempty" xml:"status_history,omitempty"`
+}
+
+// ReassignCommitteeMembersOrganizationResponseBody is the type of the
+// "committee-service" service "reassign-committee-members-organization"
+// endpoint HTTP response body.
+type ReassignCommitteeMembersOrganizationResponseBody struct {
+	// The outcome for each member of the organization
+	Results []*CommitteeMemberReassignResultResponseBody `form:"results,omitempty" json:"results,omitempty" xml:"results,omitempty"`
+}
+
+// ListCommitteeMembersByOrganizationResponseBody is the type of the
+// "committee-service" service "list-committee-members-by-organization"
+// endpoint HTTP response body.
+type ListCommitteeMembersByOrganizationResponseBody struct {
+	// Committee members of the organization, ordered by last name and first name
+	Members []*CommitteeMemberFullWithReadonlyAttributesResponseBody `form:"members,omitempty" json:"members,omitempty" xml:"members,omitempty"`
 }
 
 // GetCommitteeMemberResponseBody is the type of the "committee-service"
 // service "get-committee-member" endpoint HTTP response body.
 type GetCommitteeMemberResponseBody CommitteeMemberFullWithReadonlyAttributesResponseBody
 
+// GetCommitteeMemberHistoryResponseBody is the type of the "committee-service"
+// service "get-committee-member-history" endpoint HTTP response body.
+type GetCommitteeMemberHistoryResponseBody struct {
+	// Revisions of the committee member, newest first
+	Revisions []*CommitteeMemberRevisionResponseBody `form:"revisions,omitempty" json:"revisions,omitempty" xml:"revisions,omitempty"`
+}
+
 // UpdateCommitteeMemberResponseBody is the type of the "committee-service"
 // service "update-committee-member" endpoint HTTP response body.
 type UpdateCommitteeMemberResponseBody struct {
@@ -457,7 +616,79 @@ type UpdateCommitteeMemberResponseBody struct {
 		StartDate *string `form:"start_date" json:"start_date" xml:"start_date"`
 		// Voting end date
 		EndDate *string `form:"end_date" json:"end_date" xml:"end_date"`
+		// Voting weight for weighted voting committees, defaults to 1
+		Weight *float64 `form:"weight" json:"weight" xml:"weight"`
+	} `form:"voting,omitempty" json:"voting,omitempty" xml:"voting,omitempty"`
+	// UID of the member of the same committee this member is the alternate of
+	AlternateForUID *string `form:"alternate_for_uid,omitempty" json:"alternate_for_uid,omitempty" xml:"alternate_for_uid,omitempty"`
+	// Organization information for the committee member
+	Organization *struct {
+		// Organization ID
+		ID *string `form:"id" json:"id" xml:"id"`
+		// Organization name
+		Name *string `form:"name" json:"name" xml:"name"`
+		// Organization website URL
+		Website *string `form:"website" json:"website" xml:"website"`
+	} `form:"organization,omitempty" json:"organization,omitempty" xml:"organization,omitempty"`
+	// The timestamp when the resource was created (read-only)
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// The timestamp when the resource was last updated (read-only)
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// The changes of the member role, oldest first (read-only)
+	RoleHistory []*CommitteeMemberRoleChangeResponseBody `form:"role_history,omitempty" json:"role_history,omitempty" xml:"role_history,omitempty"`
+	// The changes of the member status, oldest first (read-only)
+	StatusHistory []*CommitteeMemberStatusChangeResponseBody `form:"status_history,omitempty" json:"status_history,omitempty" xml:"status_history,omitempty"`
+}
+
+// PatchCommitteeMemberResponseBody is the type of the "committee-service"
+// service "patch-committee-member" endpoint HTTP response body.
+type PatchCommitteeMemberResponseBody struct {
+	// Committee member UID -- v2 uid, not related to v1 id directly
+	UID *string `form:"uid,omitempty" json:"uid,omitempty" xml:"uid,omitempty"`
+	// Committee UID -- v2 uid, not related to v1 id directly
+	CommitteeUID *string `form:"committee_uid,omitempty" json:"committee_uid,omitempty" xml:"committee_uid,omitempty"`
+	// The name of the committee this member belongs to
+	CommitteeName *string `form:"committee_name,omitempty" json:"committee_name,omitempty" xml:"committee_name,omitempty"`
+	// The category of the committee this member belongs to
+	CommitteeCategory *string `form:"committee_category,omitempty" json:"committee_category,omitempty" xml:"committee_category,omitempty"`
+	// User's LF ID
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+	// Primary email address
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// First name
+	FirstName *string `form:"first_name,omitempty" json:"first_name,omitempty" xml:"first_name,omitempty"`
+	// Last name
+	LastName *string `form:"last_name,omitempty" json:"last_name,omitempty" xml:"last_name,omitempty"`
+	// Job title at organization
+	JobTitle *string `form:"job_title,omitempty" json:"job_title,omitempty" xml:"job_title,omitempty"`
+	// LinkedIn profile URL
+	LinkedinProfile *string `form:"linkedin_profile,omitempty" json:"linkedin_profile,omitempty" xml:"linkedin_profile,omitempty"`
+	// Committee role information
+	Role *struct {
+		// Committee role name
+		Name *string `form:"name" json:"name" xml:"name"`
+		// Role start date
+		StartDate *string `form:"start_date" json:"start_date" xml:"start_date"`
+		// Role end date
+		EndDate *string `form:"end_date" json:"end_date" xml:"end_date"`
+	} `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
+	// How the member was appointed
+	AppointedBy *string `form:"appointed_by,omitempty" json:"appointed_by,omitempty" xml:"appointed_by,omitempty"`
+	// Member status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Voting information for the committee member
+	Voting *struct {
+		// Voting status
+		Status *string `form:"status" json:"status" xml:"status"`
+		// Voting start date
+		StartDate *string `form:"start_date" json:"start_date" xml:"start_date"`
+		// Voting end date
+		EndDate *string `form:"end_date" json:"end_date" xml:"end_date"`
+		// Voting weight for weighted voting committees, defaults to 1
+		Weight *float64 `form:"weight" json:"weight" xml:"weight"`
 	} `form:"voting,omitempty" json:"voting,omitempty" xml:"voting,omitempty"`
+	// UID of the member of the same committee this member is the alternate of
+	AlternateForUID *string `form:"alternate_for_uid,omitempty" json:"alternate_for_uid,omitempty" xml:"alternate_for_uid,omitempty"`
 	// Organization information for the committee member
 	Organization *struct {
 		// Organization ID
@@ -471,6 +702,10 @@ type UpdateCommitteeMemberResponseBody struct {
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
 	// The timestamp when the resource was last updated (read-only)
 	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// The changes of the member role, oldest first (read-only)
+	RoleHistory []*CommitteeMemberRoleChangeResponseBody `form:"role_history,omitempty" json:"role_history,omitempty" xml:"role_history,omitempty"`
+	// The changes of the member status, oldest first (read-only)
+	StatusHistory []*CommitteeMemberStatusChangeResponseBody `form:"status_history,omitempty" json:"status_history,omitempty" xml:"status_history,omitempty"`
 }
 
 // CreateCommitteeBadRequestResponseBody is the type of the "committee-service"
@@ -537,6 +772,70 @@ type GetCommitteeBaseServiceUnavailableResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
+// GetCommitteeWithMembersBadRequestResponseBody is the type of the
+// "committee-service" service "get-committee-with-members" endpoint HTTP
+// response body for the "BadRequest" error.
+type GetCommitteeWithMembersBadRequestResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetCommitteeWithMembersInternalServerErrorResponseBody is the type of the
+// "committee-service" service "get-committee-with-members" endpoint HTTP
+// response body for the "InternalServerError" error.
+type GetCommitteeWithMembersInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetCommitteeWithMembersNotFoundResponseBody is the type of the
+// "committee-service" service "get-committee-with-members" endpoint HTTP
+// response body for the "NotFound" error.
+type GetCommitteeWithMembersNotFoundResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetCommitteeWithMembersServiceUnavailableResponseBody is the type of the
+// "committee-service" service "get-committee-with-members" endpoint HTTP
+// response body for the "ServiceUnavailable" error.
+type GetCommitteeWithMembersServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListCommitteeChildrenBadRequestResponseBody is the type of the
+// "committee-service" service "list-committee-children" endpoint HTTP response
+// body for the "BadRequest" error.
+type ListCommitteeChildrenBadRequestResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListCommitteeChildrenInternalServerErrorResponseBody is the type of the
+// "committee-service" service "list-committee-children" endpoint HTTP response
+// body for the "InternalServerError" error.
+type ListCommitteeChildrenInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListCommitteeChildrenNotFoundResponseBody is the type of the
+// "committee-service" service "list-committee-children" endpoint HTTP response
+// body for the "NotFound" error.
+type ListCommitteeChildrenNotFoundResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListCommitteeChildrenServiceUnavailableResponseBody is the type of the
+// "committee-service" service "list-committee-children" endpoint HTTP response
+// body for the "ServiceUnavailable" error.
+type ListCommitteeChildrenServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
 // UpdateCommitteeBaseBadRequestResponseBody is the type of the
 // "committee-service" service "update-committee-base" endpoint HTTP response
 // body for the "BadRequest" error.
@@ -729,6 +1028,73 @@ type CreateCommitteeMemberServiceUnavailableResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
+// ReassignCommitteeMembersOrganizationBadRequestResponseBody is the type of
+// the "committee-service" service "reassign-committee-members-organization"
+// endpoint HTTP response body for the "BadRequest" error.
+type ReassignCommitteeMembersOrganizationBadRequestResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ReassignCommitteeMembersOrganizationInternalServerErrorResponseBody is the
+// type of the "committee-service" service
+// "reassign-committee-members-organization" endpoint HTTP response body for
+// the "InternalServerError" error.
+type ReassignCommitteeMembersOrganizationInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ReassignCommitteeMembersOrganizationNotFoundResponseBody is the type of the
+// "committee-service" service "reassign-committee-members-organization"
+// endpoint HTTP response body for the "NotFound" error.
+type ReassignCommitteeMembersOrganizationNotFoundResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ReassignCommitteeMembersOrganizationServiceUnavailableResponseBody is the
+// type of the "committee-service" service
+// "reassign-committee-members-organization" endpoint HTTP response body for
+// the "ServiceUnavailable" error.
+type ReassignCommitteeMembersOrganizationServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListCommitteeMembersByOrganizationBadRequestResponseBody is the type of the
+// "committee-service" service "list-committee-members-by-organization"
+// endpoint HTTP response body for the "BadRequest" error.
+type ListCommitteeMembersByOrganizationBadRequestResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListCommitteeMembersByOrganizationInternalServerErrorResponseBody is the
+// type of the "committee-service" service
+// "list-committee-members-by-organization" endpoint HTTP response body for the
+// "InternalServerError" error.
+type ListCommitteeMembersByOrganizationInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListCommitteeMembersByOrganizationNotFoundResponseBody is the type of the
+// "committee-service" service "list-committee-members-by-organization"
+// endpoint HTTP response body for the "NotFound" error.
+type ListCommitteeMembersByOrganizationNotFoundResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListCommitteeMembersByOrganizationServiceUnavailableResponseBody is the type
+// of the "committee-service" service "list-committee-members-by-organization"
+// endpoint HTTP response body for the "ServiceUnavailable" error.
+type ListCommitteeMembersByOrganizationServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
 // GetCommitteeMemberBadRequestResponseBody is the type of the
 // "committee-service" service "get-committee-member" endpoint HTTP response
 // body for the "BadRequest" error.
@@ -761,6 +1127,38 @@ type GetCommitteeMemberServiceUnavailableResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
+// GetCommitteeMemberHistoryBadRequestResponseBody is the type of the
+// "committee-service" service "get-committee-member-history" endpoint HTTP
+// response body for the "BadRequest" error.
+type GetCommitteeMemberHistoryBadRequestResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetCommitteeMemberHistoryInternalServerErrorResponseBody is the type of the
+// "committee-service" service "get-committee-member-history" endpoint HTTP
+// response body for the "InternalServerError" error.
+type GetCommitteeMemberHistoryInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetCommitteeMemberHistoryNotFoundResponseBody is the type of the
+// "committee-service" service "get-committee-member-history" endpoint HTTP
+// response body for the "NotFound" error.
+type GetCommitteeMemberHistoryNotFoundResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetCommitteeMemberHistoryServiceUnavailableResponseBody is the type of the
+// "committee-service" service "get-committee-member-history" endpoint HTTP
+// response body for the "ServiceUnavailable" error.
+type GetCommitteeMemberHistoryServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
 // UpdateCommitteeMemberBadRequestResponseBody is the type of the
 // "committee-service" service "update-committee-member" endpoint HTTP response
 // body for the "BadRequest" error.
@@ -801,6 +1199,46 @@ type UpdateCommitteeMemberServiceUnavailableResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
+// PatchCommitteeMemberBadRequestResponseBody is the type of the
+// "committee-service" service "patch-committee-member" endpoint HTTP response
+// body for the "BadRequest" error.
+type PatchCommitteeMemberBadRequestResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// PatchCommitteeMemberConflictResponseBody is the type of the
+// "committee-service" service "patch-committee-member" endpoint HTTP response
+// body for the "Conflict" error.
+type PatchCommitteeMemberConflictResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// PatchCommitteeMemberInternalServerErrorResponseBody is the type of the
+// "committee-service" service "patch-committee-member" endpoint HTTP response
+// body for the "InternalServerError" error.
+type PatchCommitteeMemberInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// PatchCommitteeMemberNotFoundResponseBody is the type of the
+// "committee-service" service "patch-committee-member" endpoint HTTP response
+// body for the "NotFound" error.
+type PatchCommitteeMemberNotFoundResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// PatchCommitteeMemberServiceUnavailableResponseBody is the type of the
+// "committee-service" service "patch-committee-member" endpoint HTTP response
+// body for the "ServiceUnavailable" error.
+type PatchCommitteeMemberServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
 // DeleteCommitteeMemberBadRequestResponseBody is the type of the
 // "committee-service" service "delete-committee-member" endpoint HTTP response
 // body for the "BadRequest" error.
@@ -875,6 +1313,8 @@ type CommitteeBaseWithReadonlyAttributesResponseBody struct {
 	// The UID of the parent committee -- v2 uid, not related to v1 id directly,
 	// should be empty if there is none
 	ParentUID *string `form:"parent_uid,omitempty" json:"parent_uid,omitempty" xml:"parent_uid,omitempty"`
+	// Arbitrary key/value labels used to filter committees
+	Labels map[string]string `form:"labels,omitempty" json:"labels,omitempty" xml:"labels,omitempty"`
 	// The name of the project this committee belongs to
 	ProjectName *string `form:"project_name,omitempty" json:"project_name,omitempty" xml:"project_name,omitempty"`
 	// The name of the SSO group - read-only
@@ -883,34 +1323,86 @@ type CommitteeBaseWithReadonlyAttributesResponseBody struct {
 	TotalMembers *int `form:"total_members,omitempty" json:"total_members,omitempty" xml:"total_members,omitempty"`
 	// The total number of repositories with voting permissions for this committee
 	TotalVotingRepos *int `form:"total_voting_repos,omitempty" json:"total_voting_repos,omitempty" xml:"total_voting_repos,omitempty"`
+	// Whether the committee search record reflects the latest changes, pending
+	// means a reindex is needed (read-only)
+	IndexStatus *string `form:"index_status,omitempty" json:"index_status,omitempty" xml:"index_status,omitempty"`
+	// The timestamp when the committee was last published to the search index
+	// (read-only)
+	LastIndexedAt *string `form:"last_indexed_at,omitempty" json:"last_indexed_at,omitempty" xml:"last_indexed_at,omitempty"`
 }
 
-// CommitteeSettingsWithReadonlyAttributesResponseBody is used to define fields
-// on response body types.
-type CommitteeSettingsWithReadonlyAttributesResponseBody struct {
+// CommitteeFullWithReadonlyAttributesResponseBody is used to define fields on
+// response body types.
+type CommitteeFullWithReadonlyAttributesResponseBody struct {
 	// Committee UID -- v2 uid, not related to v1 id directly
 	UID *string `form:"uid,omitempty" json:"uid,omitempty" xml:"uid,omitempty"`
-	// Whether business email is required for committee members
-	BusinessEmailRequired *bool `form:"business_email_required,omitempty" json:"business_email_required,omitempty" xml:"business_email_required,omitempty"`
-	// The timestamp when the committee was last reviewed in RFC3339 format
-	LastReviewedAt *string `form:"last_reviewed_at,omitempty" json:"last_reviewed_at,omitempty" xml:"last_reviewed_at,omitempty"`
-	// The user ID who last reviewed this committee
-	LastReviewedBy *string `form:"last_reviewed_by,omitempty" json:"last_reviewed_by,omitempty" xml:"last_reviewed_by,omitempty"`
-	// Dertermines the visibility level of members profiles to other members of the
-	// same committee
-	MemberVisibility *string `form:"member_visibility,omitempty" json:"member_visibility,omitempty" xml:"member_visibility,omitempty"`
-	// Determines the default show_meeting_attendees setting on meetings this
-	// committee is connected to
-	ShowMeetingAttendees *bool `form:"show_meeting_attendees,omitempty" json:"show_meeting_attendees,omitempty" xml:"show_meeting_attendees,omitempty"`
-	// The timestamp when the resource was created (read-only)
-	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
-	// The timestamp when the resource was last updated (read-only)
-	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
-}
-
-// CommitteeMemberFullWithReadonlyAttributesResponseBody is used to define
-// fields on response body types.
-type CommitteeMemberFullWithReadonlyAttributesResponseBody struct {
+	// Project UID this committee belongs to -- v2 uid, not related to v1 id
+	// directly
+	ProjectUID *string `form:"project_uid,omitempty" json:"project_uid,omitempty" xml:"project_uid,omitempty"`
+	// The name of the committee
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// The category of the committee
+	Category *string `form:"category,omitempty" json:"category,omitempty" xml:"category,omitempty"`
+	// The description of the committee
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// The website URL of the committee
+	Website *string `form:"website,omitempty" json:"website,omitempty" xml:"website,omitempty"`
+	// Whether voting is enabled for this committee
+	EnableVoting *bool `form:"enable_voting,omitempty" json:"enable_voting,omitempty" xml:"enable_voting,omitempty"`
+	// Whether SSO group integration is enabled
+	SsoGroupEnabled *bool `form:"sso_group_enabled,omitempty" json:"sso_group_enabled,omitempty" xml:"sso_group_enabled,omitempty"`
+	// Whether this committee is expected to be reviewed
+	RequiresReview *bool `form:"requires_review,omitempty" json:"requires_review,omitempty" xml:"requires_review,omitempty"`
+	// General committee visibility/access permissions
+	Public *bool `form:"public,omitempty" json:"public,omitempty" xml:"public,omitempty"`
+	// Settings related to the committee calendar
+	Calendar *struct {
+		// Whether the committee calendar is publicly visible
+		Public *bool `form:"public" json:"public" xml:"public"`
+	} `form:"calendar,omitempty" json:"calendar,omitempty" xml:"calendar,omitempty"`
+	// The display name of the committee
+	DisplayName *string `form:"display_name,omitempty" json:"display_name,omitempty" xml:"display_name,omitempty"`
+	// The UID of the parent committee -- v2 uid, not related to v1 id directly,
+	// should be empty if there is none
+	ParentUID *string `form:"parent_uid,omitempty" json:"parent_uid,omitempty" xml:"parent_uid,omitempty"`
+	// Arbitrary key/value labels used to filter committees
+	Labels map[string]string `form:"labels,omitempty" json:"labels,omitempty" xml:"labels,omitempty"`
+	// The name of the SSO group - read-only
+	SsoGroupName *string `form:"sso_group_name,omitempty" json:"sso_group_name,omitempty" xml:"sso_group_name,omitempty"`
+	// The total number of members in this committee
+	TotalMembers *int `form:"total_members,omitempty" json:"total_members,omitempty" xml:"total_members,omitempty"`
+	// The total number of repositories with voting permissions for this committee
+	TotalVotingRepos *int `form:"total_voting_repos,omitempty" json:"total_voting_repos,omitempty" xml:"total_voting_repos,omitempty"`
+	// Whether the committee search record reflects the latest changes, pending
+	// means a reindex is needed (read-only)
+	IndexStatus *string `form:"index_status,omitempty" json:"index_status,omitempty" xml:"index_status,omitempty"`
+	// The timestamp when the committee was last published to the search index
+	// (read-only)
+	LastIndexedAt *string `form:"last_indexed_at,omitempty" json:"last_indexed_at,omitempty" xml:"last_indexed_at,omitempty"`
+	// Whether business email is required for committee members
+	BusinessEmailRequired *bool `form:"business_email_required,omitempty" json:"business_email_required,omitempty" xml:"business_email_required,omitempty"`
+	// Email domains allowed for committee members, overriding the business email
+	// requirement when not empty
+	AllowedEmailDomains []string `form:"allowed_email_domains,omitempty" json:"allowed_email_domains,omitempty" xml:"allowed_email_domains,omitempty"`
+	// The timestamp when the committee was last reviewed in RFC3339 format
+	LastReviewedAt *string `form:"last_reviewed_at,omitempty" json:"last_reviewed_at,omitempty" xml:"last_reviewed_at,omitempty"`
+	// The user ID who last reviewed this committee
+	LastReviewedBy *string `form:"last_reviewed_by,omitempty" json:"last_reviewed_by,omitempty" xml:"last_reviewed_by,omitempty"`
+	// Dertermines the visibility level of members profiles to other members of the
+	// same committee
+	MemberVisibility *string `form:"member_visibility,omitempty" json:"member_visibility,omitempty" xml:"member_visibility,omitempty"`
+	// Determines the default show_meeting_attendees setting on meetings this
+	// committee is connected to
+	ShowMeetingAttendees *bool `form:"show_meeting_attendees,omitempty" json:"show_meeting_attendees,omitempty" xml:"show_meeting_attendees,omitempty"`
+	// Manager user IDs who can edit/modify this committee
+	Writers []string `form:"writers,omitempty" json:"writers,omitempty" xml:"writers,omitempty"`
+	// Auditor user IDs who can audit this committee
+	Auditors []string `form:"auditors,omitempty" json:"auditors,omitempty" xml:"auditors,omitempty"`
+}
+
+// CommitteeMemberFullWithReadonlyAttributesResponseBody is used to define
+// fields on response body types.
+type CommitteeMemberFullWithReadonlyAttributesResponseBody struct {
 	// Committee member UID -- v2 uid, not related to v1 id directly
 	UID *string `form:"uid,omitempty" json:"uid,omitempty" xml:"uid,omitempty"`
 	// Committee UID -- v2 uid, not related to v1 id directly
@@ -952,7 +1444,11 @@ type CommitteeMemberFullWithReadonlyAttributesResponseBody struct {
 		StartDate *string `form:"start_date" json:"start_date" xml:"start_date"`
 		// Voting end date
 		EndDate *string `form:"end_date" json:"end_date" xml:"end_date"`
+		// Voting weight for weighted voting committees, defaults to 1
+		Weight *float64 `form:"weight" json:"weight" xml:"weight"`
 	} `form:"voting,omitempty" json:"voting,omitempty" xml:"voting,omitempty"`
+	// UID of the member of the same committee this member is the alternate of
+	AlternateForUID *string `form:"alternate_for_uid,omitempty" json:"alternate_for_uid,omitempty" xml:"alternate_for_uid,omitempty"`
 	// Organization information for the committee member
 	Organization *struct {
 		// Organization ID
@@ -966,6 +1462,86 @@ type CommitteeMemberFullWithReadonlyAttributesResponseBody struct {
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
 	// The timestamp when the resource was last updated (read-only)
 	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// The changes of the member role, oldest first (read-only)
+	RoleHistory []*CommitteeMemberRoleChangeResponseBody `form:"role_history,omitempty" json:"role_history,omitempty" xml:"role_history,omitempty"`
+	// The changes of the member status, oldest first (read-only)
+	StatusHistory []*CommitteeMemberStatusChangeResponseBody `form:"status_history,omitempty" json:"status_history,omitempty" xml:"status_history,omitempty"`
+}
+
+// CommitteeMemberRoleChangeResponseBody is used to define fields on response
+// body types.
+type CommitteeMemberRoleChangeResponseBody struct {
+	// The role before the change
+	OldRole *string `form:"old_role,omitempty" json:"old_role,omitempty" xml:"old_role,omitempty"`
+	// The role after the change
+	NewRole *string `form:"new_role,omitempty" json:"new_role,omitempty" xml:"new_role,omitempty"`
+	// The timestamp when the role changed
+	ChangedAt *string `form:"changed_at,omitempty" json:"changed_at,omitempty" xml:"changed_at,omitempty"`
+	// The principal who changed the role
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty" xml:"actor,omitempty"`
+}
+
+// CommitteeMemberStatusChangeResponseBody is used to define fields on response
+// body types.
+type CommitteeMemberStatusChangeResponseBody struct {
+	// The status before the change
+	OldStatus *string `form:"old_status,omitempty" json:"old_status,omitempty" xml:"old_status,omitempty"`
+	// The status after the change
+	NewStatus *string `form:"new_status,omitempty" json:"new_status,omitempty" xml:"new_status,omitempty"`
+	// The timestamp when the status changed
+	ChangedAt *string `form:"changed_at,omitempty" json:"changed_at,omitempty" xml:"changed_at,omitempty"`
+	// The principal who changed the status
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty" xml:"actor,omitempty"`
+}
+
+// CommitteeSettingsWithReadonlyAttributesResponseBody is used to define fields
+// on response body types.
+type CommitteeSettingsWithReadonlyAttributesResponseBody struct {
+	// Committee UID -- v2 uid, not related to v1 id directly
+	UID *string `form:"uid,omitempty" json:"uid,omitempty" xml:"uid,omitempty"`
+	// Whether business email is required for committee members
+	BusinessEmailRequired *bool `form:"business_email_required,omitempty" json:"business_email_required,omitempty" xml:"business_email_required,omitempty"`
+	// Email domains allowed for committee members, overriding the business email
+	// requirement when not empty
+	AllowedEmailDomains []string `form:"allowed_email_domains,omitempty" json:"allowed_email_domains,omitempty" xml:"allowed_email_domains,omitempty"`
+	// The timestamp when the committee was last reviewed in RFC3339 format
+	LastReviewedAt *string `form:"last_reviewed_at,omitempty" json:"last_reviewed_at,omitempty" xml:"last_reviewed_at,omitempty"`
+	// The user ID who last reviewed this committee
+	LastReviewedBy *string `form:"last_reviewed_by,omitempty" json:"last_reviewed_by,omitempty" xml:"last_reviewed_by,omitempty"`
+	// Dertermines the visibility level of members profiles to other members of the
+	// same committee
+	MemberVisibility *string `form:"member_visibility,omitempty" json:"member_visibility,omitempty" xml:"member_visibility,omitempty"`
+	// Determines the default show_meeting_attendees setting on meetings this
+	// committee is connected to
+	ShowMeetingAttendees *bool `form:"show_meeting_attendees,omitempty" json:"show_meeting_attendees,omitempty" xml:"show_meeting_attendees,omitempty"`
+	// The timestamp when the resource was created (read-only)
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// The timestamp when the resource was last updated (read-only)
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// CommitteeMemberReassignResultResponseBody is used to define fields on
+// response body types.
+type CommitteeMemberReassignResultResponseBody struct {
+	// Committee member UID -- v2 uid, not related to v1 id directly
+	UID *string `form:"uid,omitempty" json:"uid,omitempty" xml:"uid,omitempty"`
+	// Primary email address
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// The updated member, omitted when the reassignment failed
+	Member *CommitteeMemberFullWithReadonlyAttributesResponseBody `form:"member,omitempty" json:"member,omitempty" xml:"member,omitempty"`
+	// The reason the member could not be reassigned
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// CommitteeMemberRevisionResponseBody is used to define fields on response
+// body types.
+type CommitteeMemberRevisionResponseBody struct {
+	// The revision number of the member record
+	Revision *uint64 `form:"revision,omitempty" json:"revision,omitempty" xml:"revision,omitempty"`
+	// The timestamp when the revision was stored
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// The committee member as stored at the revision
+	Member *CommitteeMemberFullWithReadonlyAttributesResponseBody `form:"member,omitempty" json:"member,omitempty" xml:"member,omitempty"`
 }
 
 // NewCreateCommitteeRequestBody builds the HTTP request body from the payload
@@ -1027,12 +1603,26 @@ func NewCreateCommitteeRequestBody(p *committeeservice.CreateCommitteePayload) *
 			}
 		}
 	}
+	if p.Labels != nil {
+		body.Labels = make(map[string]string, len(p.Labels))
+		for key, val := range p.Labels {
+			tk := key
+			tv := val
+			body.Labels[tk] = tv
+		}
+	}
 	{
 		var zero bool
 		if body.BusinessEmailRequired == zero {
 			body.BusinessEmailRequired = false
 		}
 	}
+	if p.AllowedEmailDomains != nil {
+		body.AllowedEmailDomains = make([]string, len(p.AllowedEmailDomains))
+		for i, val := range p.AllowedEmailDomains {
+			body.AllowedEmailDomains[i] = val
+		}
+	}
 	{
 		var zero string
 		if body.MemberVisibility == zero {
@@ -1115,6 +1705,14 @@ func NewUpdateCommitteeBaseRequestBody(p *committeeservice.UpdateCommitteeBasePa
 			}
 		}
 	}
+	if p.Labels != nil {
+		body.Labels = make(map[string]string, len(p.Labels))
+		for key, val := range p.Labels {
+			tk := key
+			tv := val
+			body.Labels[tk] = tv
+		}
+	}
 	return body
 }
 
@@ -1129,6 +1727,12 @@ func NewUpdateCommitteeSettingsRequestBody(p *committeeservice.UpdateCommitteeSe
 		MemberVisibility:      p.MemberVisibility,
 		ShowMeetingAttendees:  p.ShowMeetingAttendees,
 	}
+	if p.AllowedEmailDomains != nil {
+		body.AllowedEmailDomains = make([]string, len(p.AllowedEmailDomains))
+		for i, val := range p.AllowedEmailDomains {
+			body.AllowedEmailDomains[i] = val
+		}
+	}
 	{
 		var zero string
 		if body.MemberVisibility == zero {
@@ -1169,6 +1773,7 @@ func NewCreateCommitteeMemberRequestBody(p *committeeservice.CreateCommitteeMemb
 		LinkedinProfile: p.LinkedinProfile,
 		AppointedBy:     p.AppointedBy,
 		Status:          p.Status,
+		AlternateForUID: p.AlternateForUID,
 	}
 	if p.Role != nil {
 		body.Role = &struct {
@@ -1210,10 +1815,13 @@ func NewCreateCommitteeMemberRequestBody(p *committeeservice.CreateCommitteeMemb
 			StartDate *string `form:"start_date" json:"start_date" xml:"start_date"`
 			// Voting end date
 			EndDate *string `form:"end_date" json:"end_date" xml:"end_date"`
+			// Voting weight for weighted voting committees, defaults to 1
+			Weight *float64 `form:"weight" json:"weight" xml:"weight"`
 		}{
 			Status:    p.Voting.Status,
 			StartDate: p.Voting.StartDate,
 			EndDate:   p.Voting.EndDate,
+			Weight:    p.Voting.Weight,
 		}
 		{
 			var zero string
@@ -1239,11 +1847,23 @@ func NewCreateCommitteeMemberRequestBody(p *committeeservice.CreateCommitteeMemb
 	return body
 }
 
+// NewReassignCommitteeMembersOrganizationRequestBody builds the HTTP request
+// body from the payload of the "reassign-committee-members-organization"
+// endpoint of the "committee-service" service.
+func NewReassignCommitteeMembersOrganizationRequestBody(p *committeeservice.ReassignCommitteeMembersOrganizationPayload) *ReassignCommitteeMembersOrganizationRequestBody {
+	body := &ReassignCommitteeMembersOrganizationRequestBody{
+		FromOrganization: p.FromOrganization,
+		ToOrganization:   p.ToOrganization,
+	}
+	return body
+}
+
 // NewUpdateCommitteeMemberRequestBody builds the HTTP request body from the
 // payload of the "update-committee-member" endpoint of the "committee-service"
 // service.
 func NewUpdateCommitteeMemberRequestBody(p *committeeservice.UpdateCommitteeMemberPayload) *UpdateCommitteeMemberRequestBody {
 	body := &UpdateCommitteeMemberRequestBody{
+		CommitteeUID:    p.CommitteeUID,
 		Username:        p.Username,
 		Email:           p.Email,
 		FirstName:       p.FirstName,
@@ -1252,6 +1872,7 @@ func NewUpdateCommitteeMemberRequestBody(p *committeeservice.UpdateCommitteeMemb
 		LinkedinProfile: p.LinkedinProfile,
 		AppointedBy:     p.AppointedBy,
 		Status:          p.Status,
+		AlternateForUID: p.AlternateForUID,
 	}
 	if p.Role != nil {
 		body.Role = &struct {
@@ -1293,10 +1914,13 @@ func NewUpdateCommitteeMemberRequestBody(p *committeeservice.UpdateCommitteeMemb
 			StartDate *string `form:"start_date" json:"start_date" xml:"start_date"`
 			// Voting end date
 			EndDate *string `form:"end_date" json:"end_date" xml:"end_date"`
+			// Voting weight for weighted voting committees, defaults to 1
+			Weight *float64 `form:"weight" json:"weight" xml:"weight"`
 		}{
 			Status:    p.Voting.Status,
 			StartDate: p.Voting.StartDate,
 			EndDate:   p.Voting.EndDate,
+			Weight:    p.Voting.Weight,
 		}
 		{
 			var zero string
@@ -1322,6 +1946,69 @@ func NewUpdateCommitteeMemberRequestBody(p *committeeservice.UpdateCommitteeMemb
 	return body
 }
 
+// NewPatchCommitteeMemberRequestBody builds the HTTP request body from the
+// payload of the "patch-committee-member" endpoint of the "committee-service"
+// service.
+func NewPatchCommitteeMemberRequestBody(p *committeeservice.PatchCommitteeMemberPayload) *PatchCommitteeMemberRequestBody {
+	body := &PatchCommitteeMemberRequestBody{
+		Username:        p.Username,
+		Email:           p.Email,
+		FirstName:       p.FirstName,
+		LastName:        p.LastName,
+		JobTitle:        p.JobTitle,
+		LinkedinProfile: p.LinkedinProfile,
+		AppointedBy:     p.AppointedBy,
+		Status:          p.Status,
+		AlternateForUID: p.AlternateForUID,
+	}
+	if p.Role != nil {
+		body.Role = &struct {
+			// Committee role name
+			Name *string `form:"name" json:"name" xml:"name"`
+			// Role start date
+			StartDate *string `form:"start_date" json:"start_date" xml:"start_date"`
+			// Role end date
+			EndDate *string `form:"end_date" json:"end_date" xml:"end_date"`
+		}{
+			Name:      p.Role.Name,
+			StartDate: p.Role.StartDate,
+			EndDate:   p.Role.EndDate,
+		}
+	}
+	if p.Voting != nil {
+		body.Voting = &struct {
+			// Voting status
+			Status *string `form:"status" json:"status" xml:"status"`
+			// Voting start date
+			StartDate *string `form:"start_date" json:"start_date" xml:"start_date"`
+			// Voting end date
+			EndDate *string `form:"end_date" json:"end_date" xml:"end_date"`
+			// Voting weight for weighted voting committees, defaults to 1
+			Weight *float64 `form:"weight" json:"weight" xml:"weight"`
+		}{
+			Status:    p.Voting.Status,
+			StartDate: p.Voting.StartDate,
+			EndDate:   p.Voting.EndDate,
+			Weight:    p.Voting.Weight,
+		}
+	}
+	if p.Organization != nil {
+		body.Organization = &struct {
+			// Organization ID
+			ID *string `form:"id" json:"id" xml:"id"`
+			// Organization name
+			Name *string `form:"name" json:"name" xml:"name"`
+			// Organization website URL
+			Website *string `form:"website" json:"website" xml:"website"`
+		}{
+			ID:      p.Organization.ID,
+			Name:    p.Organization.Name,
+			Website: p.Organization.Website,
+		}
+	}
+	return body
+}
+
 // NewCreateCommitteeCommitteeFullWithReadonlyAttributesCreated builds a
 // "committee-service" service "create-committee" endpoint result from a HTTP
 // "Created" response.
@@ -1338,6 +2025,8 @@ func NewCreateCommitteeCommitteeFullWithReadonlyAttributesCreated(body *CreateCo
 		SsoGroupName:     body.SsoGroupName,
 		TotalMembers:     body.TotalMembers,
 		TotalVotingRepos: body.TotalVotingRepos,
+		IndexStatus:      body.IndexStatus,
+		LastIndexedAt:    body.LastIndexedAt,
 		LastReviewedAt:   body.LastReviewedAt,
 		LastReviewedBy:   body.LastReviewedBy,
 	}
@@ -1386,9 +2075,23 @@ func NewCreateCommitteeCommitteeFullWithReadonlyAttributesCreated(body *CreateCo
 			v.Calendar.Public = false
 		}
 	}
+	if body.Labels != nil {
+		v.Labels = make(map[string]string, len(body.Labels))
+		for key, val := range body.Labels {
+			tk := key
+			tv := val
+			v.Labels[tk] = tv
+		}
+	}
 	if body.BusinessEmailRequired == nil {
 		v.BusinessEmailRequired = false
 	}
+	if body.AllowedEmailDomains != nil {
+		v.AllowedEmailDomains = make([]string, len(body.AllowedEmailDomains))
+		for i, val := range body.AllowedEmailDomains {
+			v.AllowedEmailDomains[i] = val
+		}
+	}
 	if body.MemberVisibility == nil {
 		v.MemberVisibility = "hidden"
 	}
@@ -1477,6 +2180,8 @@ func NewGetCommitteeBaseResultOK(body *GetCommitteeBaseResponseBody, etag *strin
 		SsoGroupName:     body.SsoGroupName,
 		TotalMembers:     body.TotalMembers,
 		TotalVotingRepos: body.TotalVotingRepos,
+		IndexStatus:      body.IndexStatus,
+		LastIndexedAt:    body.LastIndexedAt,
 	}
 	if body.EnableVoting != nil {
 		v.EnableVoting = *body.EnableVoting
@@ -1514,6 +2219,14 @@ func NewGetCommitteeBaseResultOK(body *GetCommitteeBaseResponseBody, etag *strin
 			v.Calendar.Public = false
 		}
 	}
+	if body.Labels != nil {
+		v.Labels = make(map[string]string, len(body.Labels))
+		for key, val := range body.Labels {
+			tk := key
+			tv := val
+			v.Labels[tk] = tv
+		}
+	}
 	res := &committeeservice.GetCommitteeBaseResult{
 		CommitteeBase: v,
 	}
@@ -1552,6 +2265,116 @@ func NewGetCommitteeBaseServiceUnavailable(body *GetCommitteeBaseServiceUnavaila
 	return v
 }
 
+// NewGetCommitteeWithMembersResultOK builds a "committee-service" service
+// "get-committee-with-members" endpoint result from a HTTP "OK" response.
+func NewGetCommitteeWithMembersResultOK(body *GetCommitteeWithMembersResponseBody) *committeeservice.GetCommitteeWithMembersResult {
+	v := &committeeservice.GetCommitteeWithMembersResult{
+		TotalMembers:  *body.TotalMembers,
+		NextPageToken: body.NextPageToken,
+	}
+	v.Committee = unmarshalCommitteeFullWithReadonlyAttributesResponseBodyToCommitteeserviceCommitteeFullWithReadonlyAttributes(body.Committee)
+	v.Members = make([]*committeeservice.CommitteeMemberFullWithReadonlyAttributes, len(body.Members))
+	for i, val := range body.Members {
+		v.Members[i] = unmarshalCommitteeMemberFullWithReadonlyAttributesResponseBodyToCommitteeserviceCommitteeMemberFullWithReadonlyAttributes(val)
+	}
+
+	return v
+}
+
+// NewGetCommitteeWithMembersBadRequest builds a committee-service service
+// get-committee-with-members endpoint BadRequest error.
+func NewGetCommitteeWithMembersBadRequest(body *GetCommitteeWithMembersBadRequestResponseBody) *committeeservice.BadRequestError {
+	v := &committeeservice.BadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetCommitteeWithMembersInternalServerError builds a committee-service
+// service get-committee-with-members endpoint InternalServerError error.
+func NewGetCommitteeWithMembersInternalServerError(body *GetCommitteeWithMembersInternalServerErrorResponseBody) *committeeservice.InternalServerError {
+	v := &committeeservice.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetCommitteeWithMembersNotFound builds a committee-service service
+// get-committee-with-members endpoint NotFound error.
+func NewGetCommitteeWithMembersNotFound(body *GetCommitteeWithMembersNotFoundResponseBody) *committeeservice.NotFoundError {
+	v := &committeeservice.NotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetCommitteeWithMembersServiceUnavailable builds a committee-service
+// service get-committee-with-members endpoint ServiceUnavailable error.
+func NewGetCommitteeWithMembersServiceUnavailable(body *GetCommitteeWithMembersServiceUnavailableResponseBody) *committeeservice.ServiceUnavailableError {
+	v := &committeeservice.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListCommitteeChildrenResultOK builds a "committee-service" service
+// "list-committee-children" endpoint result from a HTTP "OK" response.
+func NewListCommitteeChildrenResultOK(body *ListCommitteeChildrenResponseBody) *committeeservice.ListCommitteeChildrenResult {
+	v := &committeeservice.ListCommitteeChildrenResult{
+		NextPageToken: body.NextPageToken,
+	}
+	v.Children = make([]*committeeservice.CommitteeBaseWithReadonlyAttributes, len(body.Children))
+	for i, val := range body.Children {
+		v.Children[i] = unmarshalCommitteeBaseWithReadonlyAttributesResponseBodyToCommitteeserviceCommitteeBaseWithReadonlyAttributes(val)
+	}
+
+	return v
+}
+
+// NewListCommitteeChildrenBadRequest builds a committee-service service
+// list-committee-children endpoint BadRequest error.
+func NewListCommitteeChildrenBadRequest(body *ListCommitteeChildrenBadRequestResponseBody) *committeeservice.BadRequestError {
+	v := &committeeservice.BadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListCommitteeChildrenInternalServerError builds a committee-service
+// service list-committee-children endpoint InternalServerError error.
+func NewListCommitteeChildrenInternalServerError(body *ListCommitteeChildrenInternalServerErrorResponseBody) *committeeservice.InternalServerError {
+	v := &committeeservice.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListCommitteeChildrenNotFound builds a committee-service service
+// list-committee-children endpoint NotFound error.
+func NewListCommitteeChildrenNotFound(body *ListCommitteeChildrenNotFoundResponseBody) *committeeservice.NotFoundError {
+	v := &committeeservice.NotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListCommitteeChildrenServiceUnavailable builds a committee-service
+// service list-committee-children endpoint ServiceUnavailable error.
+func NewListCommitteeChildrenServiceUnavailable(body *ListCommitteeChildrenServiceUnavailableResponseBody) *committeeservice.ServiceUnavailableError {
+	v := &committeeservice.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
 // NewUpdateCommitteeBaseCommitteeBaseWithReadonlyAttributesOK builds a
 // "committee-service" service "update-committee-base" endpoint result from a
 // HTTP "OK" response.
@@ -1569,6 +2392,8 @@ func NewUpdateCommitteeBaseCommitteeBaseWithReadonlyAttributesOK(body *UpdateCom
 		SsoGroupName:     body.SsoGroupName,
 		TotalMembers:     body.TotalMembers,
 		TotalVotingRepos: body.TotalVotingRepos,
+		IndexStatus:      body.IndexStatus,
+		LastIndexedAt:    body.LastIndexedAt,
 	}
 	if body.EnableVoting != nil {
 		v.EnableVoting = *body.EnableVoting
@@ -1606,6 +2431,14 @@ func NewUpdateCommitteeBaseCommitteeBaseWithReadonlyAttributesOK(body *UpdateCom
 			v.Calendar.Public = false
 		}
 	}
+	if body.Labels != nil {
+		v.Labels = make(map[string]string, len(body.Labels))
+		for key, val := range body.Labels {
+			tk := key
+			tv := val
+			v.Labels[tk] = tv
+		}
+	}
 
 	return v
 }
@@ -1732,6 +2565,12 @@ func NewGetCommitteeSettingsResultOK(body *GetCommitteeSettingsResponseBody, eta
 	if body.BusinessEmailRequired == nil {
 		v.BusinessEmailRequired = false
 	}
+	if body.AllowedEmailDomains != nil {
+		v.AllowedEmailDomains = make([]string, len(body.AllowedEmailDomains))
+		for i, val := range body.AllowedEmailDomains {
+			v.AllowedEmailDomains[i] = val
+		}
+	}
 	if body.MemberVisibility == nil {
 		v.MemberVisibility = "hidden"
 	}
@@ -1799,6 +2638,12 @@ func NewUpdateCommitteeSettingsCommitteeSettingsWithReadonlyAttributesOK(body *U
 	if body.BusinessEmailRequired == nil {
 		v.BusinessEmailRequired = false
 	}
+	if body.AllowedEmailDomains != nil {
+		v.AllowedEmailDomains = make([]string, len(body.AllowedEmailDomains))
+		for i, val := range body.AllowedEmailDomains {
+			v.AllowedEmailDomains[i] = val
+		}
+	}
 	if body.MemberVisibility == nil {
 		v.MemberVisibility = "hidden"
 	}
@@ -1884,6 +2729,7 @@ func NewCreateCommitteeMemberCommitteeMemberFullWithReadonlyAttributesCreated(bo
 		LastName:          body.LastName,
 		JobTitle:          body.JobTitle,
 		LinkedinProfile:   body.LinkedinProfile,
+		AlternateForUID:   body.AlternateForUID,
 		CreatedAt:         body.CreatedAt,
 		UpdatedAt:         body.UpdatedAt,
 	}
@@ -1926,9 +2772,12 @@ func NewCreateCommitteeMemberCommitteeMemberFullWithReadonlyAttributesCreated(bo
 			StartDate *string
 			// Voting end date
 			EndDate *string
+			// Voting weight for weighted voting committees, defaults to 1
+			Weight *float64
 		}{
 			StartDate: body.Voting.StartDate,
 			EndDate:   body.Voting.EndDate,
+			Weight:    body.Voting.Weight,
 		}
 		if body.Voting.Status != nil {
 			v.Voting.Status = *body.Voting.Status
@@ -1951,6 +2800,18 @@ func NewCreateCommitteeMemberCommitteeMemberFullWithReadonlyAttributesCreated(bo
 			Website: body.Organization.Website,
 		}
 	}
+	if body.RoleHistory != nil {
+		v.RoleHistory = make([]*committeeservice.CommitteeMemberRoleChange, len(body.RoleHistory))
+		for i, val := range body.RoleHistory {
+			v.RoleHistory[i] = unmarshalCommitteeMemberRoleChangeResponseBodyToCommitteeserviceCommitteeMemberRoleChange(val)
+		}
+	}
+	if body.StatusHistory != nil {
+		v.StatusHistory = make([]*committeeservice.CommitteeMemberStatusChange, len(body.StatusHistory))
+		for i, val := range body.StatusHistory {
+			v.StatusHistory[i] = unmarshalCommitteeMemberStatusChangeResponseBodyToCommitteeserviceCommitteeMemberStatusChange(val)
+		}
+	}
 
 	return v
 }
@@ -2005,25 +2866,136 @@ func NewCreateCommitteeMemberServiceUnavailable(body *CreateCommitteeMemberServi
 	return v
 }
 
-// NewGetCommitteeMemberResultOK builds a "committee-service" service
-// "get-committee-member" endpoint result from a HTTP "OK" response.
-func NewGetCommitteeMemberResultOK(body *GetCommitteeMemberResponseBody, etag *string) *committeeservice.GetCommitteeMemberResult {
-	v := &committeeservice.CommitteeMemberFullWithReadonlyAttributes{
-		UID:               body.UID,
-		CommitteeUID:      body.CommitteeUID,
-		CommitteeName:     body.CommitteeName,
-		CommitteeCategory: body.CommitteeCategory,
-		Username:          body.Username,
-		Email:             body.Email,
-		FirstName:         body.FirstName,
-		LastName:          body.LastName,
-		JobTitle:          body.JobTitle,
-		LinkedinProfile:   body.LinkedinProfile,
-		CreatedAt:         body.CreatedAt,
-		UpdatedAt:         body.UpdatedAt,
+// NewReassignCommitteeMembersOrganizationResultOK builds a "committee-service"
+// service "reassign-committee-members-organization" endpoint result from a
+// HTTP "OK" response.
+func NewReassignCommitteeMembersOrganizationResultOK(body *ReassignCommitteeMembersOrganizationResponseBody) *committeeservice.ReassignCommitteeMembersOrganizationResult {
+	v := &committeeservice.ReassignCommitteeMembersOrganizationResult{}
+	v.Results = make([]*committeeservice.CommitteeMemberReassignResult, len(body.Results))
+	for i, val := range body.Results {
+		v.Results[i] = unmarshalCommitteeMemberReassignResultResponseBodyToCommitteeserviceCommitteeMemberReassignResult(val)
 	}
-	if body.AppointedBy != nil {
-		v.AppointedBy = *body.AppointedBy
+
+	return v
+}
+
+// NewReassignCommitteeMembersOrganizationBadRequest builds a committee-service
+// service reassign-committee-members-organization endpoint BadRequest error.
+func NewReassignCommitteeMembersOrganizationBadRequest(body *ReassignCommitteeMembersOrganizationBadRequestResponseBody) *committeeservice.BadRequestError {
+	v := &committeeservice.BadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewReassignCommitteeMembersOrganizationInternalServerError builds a
+// committee-service service reassign-committee-members-organization endpoint
+// InternalServerError error.
+func NewReassignCommitteeMembersOrganizationInternalServerError(body *ReassignCommitteeMembersOrganizationInternalServerErrorResponseBody) *committeeservice.InternalServerError {
+	v := &committeeservice.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewReassignCommitteeMembersOrganizationNotFound builds a committee-service
+// service reassign-committee-members-organization endpoint NotFound error.
+func NewReassignCommitteeMembersOrganizationNotFound(body *ReassignCommitteeMembersOrganizationNotFoundResponseBody) *committeeservice.NotFoundError {
+	v := &committeeservice.NotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewReassignCommitteeMembersOrganizationServiceUnavailable builds a
+// committee-service service reassign-committee-members-organization endpoint
+// ServiceUnavailable error.
+func NewReassignCommitteeMembersOrganizationServiceUnavailable(body *ReassignCommitteeMembersOrganizationServiceUnavailableResponseBody) *committeeservice.ServiceUnavailableError {
+	v := &committeeservice.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListCommitteeMembersByOrganizationResultOK builds a "committee-service"
+// service "list-committee-members-by-organization" endpoint result from a HTTP
+// "OK" response.
+func NewListCommitteeMembersByOrganizationResultOK(body *ListCommitteeMembersByOrganizationResponseBody) *committeeservice.ListCommitteeMembersByOrganizationResult {
+	v := &committeeservice.ListCommitteeMembersByOrganizationResult{}
+	v.Members = make([]*committeeservice.CommitteeMemberFullWithReadonlyAttributes, len(body.Members))
+	for i, val := range body.Members {
+		v.Members[i] = unmarshalCommitteeMemberFullWithReadonlyAttributesResponseBodyToCommitteeserviceCommitteeMemberFullWithReadonlyAttributes(val)
+	}
+
+	return v
+}
+
+// NewListCommitteeMembersByOrganizationBadRequest builds a committee-service
+// service list-committee-members-by-organization endpoint BadRequest error.
+func NewListCommitteeMembersByOrganizationBadRequest(body *ListCommitteeMembersByOrganizationBadRequestResponseBody) *committeeservice.BadRequestError {
+	v := &committeeservice.BadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListCommitteeMembersByOrganizationInternalServerError builds a
+// committee-service service list-committee-members-by-organization endpoint
+// InternalServerError error.
+func NewListCommitteeMembersByOrganizationInternalServerError(body *ListCommitteeMembersByOrganizationInternalServerErrorResponseBody) *committeeservice.InternalServerError {
+	v := &committeeservice.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListCommitteeMembersByOrganizationNotFound builds a committee-service
+// service list-committee-members-by-organization endpoint NotFound error.
+func NewListCommitteeMembersByOrganizationNotFound(body *ListCommitteeMembersByOrganizationNotFoundResponseBody) *committeeservice.NotFoundError {
+	v := &committeeservice.NotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListCommitteeMembersByOrganizationServiceUnavailable builds a
+// committee-service service list-committee-members-by-organization endpoint
+// ServiceUnavailable error.
+func NewListCommitteeMembersByOrganizationServiceUnavailable(body *ListCommitteeMembersByOrganizationServiceUnavailableResponseBody) *committeeservice.ServiceUnavailableError {
+	v := &committeeservice.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetCommitteeMemberResultOK builds a "committee-service" service
+// "get-committee-member" endpoint result from a HTTP "OK" response.
+func NewGetCommitteeMemberResultOK(body *GetCommitteeMemberResponseBody, etag *string) *committeeservice.GetCommitteeMemberResult {
+	v := &committeeservice.CommitteeMemberFullWithReadonlyAttributes{
+		UID:               body.UID,
+		CommitteeUID:      body.CommitteeUID,
+		CommitteeName:     body.CommitteeName,
+		CommitteeCategory: body.CommitteeCategory,
+		Username:          body.Username,
+		Email:             body.Email,
+		FirstName:         body.FirstName,
+		LastName:          body.LastName,
+		JobTitle:          body.JobTitle,
+		LinkedinProfile:   body.LinkedinProfile,
+		AlternateForUID:   body.AlternateForUID,
+		CreatedAt:         body.CreatedAt,
+		UpdatedAt:         body.UpdatedAt,
+	}
+	if body.AppointedBy != nil {
+		v.AppointedBy = *body.AppointedBy
 	}
 	if body.Status != nil {
 		v.Status = *body.Status
@@ -2061,9 +3033,12 @@ func NewGetCommitteeMemberResultOK(body *GetCommitteeMemberResponseBody, etag *s
 			StartDate *string
 			// Voting end date
 			EndDate *string
+			// Voting weight for weighted voting committees, defaults to 1
+			Weight *float64
 		}{
 			StartDate: body.Voting.StartDate,
 			EndDate:   body.Voting.EndDate,
+			Weight:    body.Voting.Weight,
 		}
 		if body.Voting.Status != nil {
 			v.Voting.Status = *body.Voting.Status
@@ -2086,6 +3061,18 @@ func NewGetCommitteeMemberResultOK(body *GetCommitteeMemberResponseBody, etag *s
 			Website: body.Organization.Website,
 		}
 	}
+	if body.RoleHistory != nil {
+		v.RoleHistory = make([]*committeeservice.CommitteeMemberRoleChange, len(body.RoleHistory))
+		for i, val := range body.RoleHistory {
+			v.RoleHistory[i] = unmarshalCommitteeMemberRoleChangeResponseBodyToCommitteeserviceCommitteeMemberRoleChange(val)
+		}
+	}
+	if body.StatusHistory != nil {
+		v.StatusHistory = make([]*committeeservice.CommitteeMemberStatusChange, len(body.StatusHistory))
+		for i, val := range body.StatusHistory {
+			v.StatusHistory[i] = unmarshalCommitteeMemberStatusChangeResponseBodyToCommitteeserviceCommitteeMemberStatusChange(val)
+		}
+	}
 	res := &committeeservice.GetCommitteeMemberResult{
 		Member: v,
 	}
@@ -2134,6 +3121,58 @@ func NewGetCommitteeMemberServiceUnavailable(body *GetCommitteeMemberServiceUnav
 	return v
 }
 
+// NewGetCommitteeMemberHistoryResultOK builds a "committee-service" service
+// "get-committee-member-history" endpoint result from a HTTP "OK" response.
+func NewGetCommitteeMemberHistoryResultOK(body *GetCommitteeMemberHistoryResponseBody) *committeeservice.GetCommitteeMemberHistoryResult {
+	v := &committeeservice.GetCommitteeMemberHistoryResult{}
+	v.Revisions = make([]*committeeservice.CommitteeMemberRevision, len(body.Revisions))
+	for i, val := range body.Revisions {
+		v.Revisions[i] = unmarshalCommitteeMemberRevisionResponseBodyToCommitteeserviceCommitteeMemberRevision(val)
+	}
+
+	return v
+}
+
+// NewGetCommitteeMemberHistoryBadRequest builds a committee-service service
+// get-committee-member-history endpoint BadRequest error.
+func NewGetCommitteeMemberHistoryBadRequest(body *GetCommitteeMemberHistoryBadRequestResponseBody) *committeeservice.BadRequestError {
+	v := &committeeservice.BadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetCommitteeMemberHistoryInternalServerError builds a committee-service
+// service get-committee-member-history endpoint InternalServerError error.
+func NewGetCommitteeMemberHistoryInternalServerError(body *GetCommitteeMemberHistoryInternalServerErrorResponseBody) *committeeservice.InternalServerError {
+	v := &committeeservice.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetCommitteeMemberHistoryNotFound builds a committee-service service
+// get-committee-member-history endpoint NotFound error.
+func NewGetCommitteeMemberHistoryNotFound(body *GetCommitteeMemberHistoryNotFoundResponseBody) *committeeservice.NotFoundError {
+	v := &committeeservice.NotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetCommitteeMemberHistoryServiceUnavailable builds a committee-service
+// service get-committee-member-history endpoint ServiceUnavailable error.
+func NewGetCommitteeMemberHistoryServiceUnavailable(body *GetCommitteeMemberHistoryServiceUnavailableResponseBody) *committeeservice.ServiceUnavailableError {
+	v := &committeeservice.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
 // NewUpdateCommitteeMemberCommitteeMemberFullWithReadonlyAttributesOK builds a
 // "committee-service" service "update-committee-member" endpoint result from a
 // HTTP "OK" response.
@@ -2149,6 +3188,7 @@ func NewUpdateCommitteeMemberCommitteeMemberFullWithReadonlyAttributesOK(body *U
 		LastName:          body.LastName,
 		JobTitle:          body.JobTitle,
 		LinkedinProfile:   body.LinkedinProfile,
+		AlternateForUID:   body.AlternateForUID,
 		CreatedAt:         body.CreatedAt,
 		UpdatedAt:         body.UpdatedAt,
 	}
@@ -2191,9 +3231,12 @@ func NewUpdateCommitteeMemberCommitteeMemberFullWithReadonlyAttributesOK(body *U
 			StartDate *string
 			// Voting end date
 			EndDate *string
+			// Voting weight for weighted voting committees, defaults to 1
+			Weight *float64
 		}{
 			StartDate: body.Voting.StartDate,
 			EndDate:   body.Voting.EndDate,
+			Weight:    body.Voting.Weight,
 		}
 		if body.Voting.Status != nil {
 			v.Voting.Status = *body.Voting.Status
@@ -2216,6 +3259,18 @@ func NewUpdateCommitteeMemberCommitteeMemberFullWithReadonlyAttributesOK(body *U
 			Website: body.Organization.Website,
 		}
 	}
+	if body.RoleHistory != nil {
+		v.RoleHistory = make([]*committeeservice.CommitteeMemberRoleChange, len(body.RoleHistory))
+		for i, val := range body.RoleHistory {
+			v.RoleHistory[i] = unmarshalCommitteeMemberRoleChangeResponseBodyToCommitteeserviceCommitteeMemberRoleChange(val)
+		}
+	}
+	if body.StatusHistory != nil {
+		v.StatusHistory = make([]*committeeservice.CommitteeMemberStatusChange, len(body.StatusHistory))
+		for i, val := range body.StatusHistory {
+			v.StatusHistory[i] = unmarshalCommitteeMemberStatusChangeResponseBodyToCommitteeserviceCommitteeMemberStatusChange(val)
+		}
+	}
 
 	return v
 }
@@ -2270,6 +3325,158 @@ func NewUpdateCommitteeMemberServiceUnavailable(body *UpdateCommitteeMemberServi
 	return v
 }
 
+// NewPatchCommitteeMemberCommitteeMemberFullWithReadonlyAttributesOK builds a
+// "committee-service" service "patch-committee-member" endpoint result from a
+// HTTP "OK" response.
+func NewPatchCommitteeMemberCommitteeMemberFullWithReadonlyAttributesOK(body *PatchCommitteeMemberResponseBody) *committeeservice.CommitteeMemberFullWithReadonlyAttributes {
+	v := &committeeservice.CommitteeMemberFullWithReadonlyAttributes{
+		UID:               body.UID,
+		CommitteeUID:      body.CommitteeUID,
+		CommitteeName:     body.CommitteeName,
+		CommitteeCategory: body.CommitteeCategory,
+		Username:          body.Username,
+		Email:             body.Email,
+		FirstName:         body.FirstName,
+		LastName:          body.LastName,
+		JobTitle:          body.JobTitle,
+		LinkedinProfile:   body.LinkedinProfile,
+		AlternateForUID:   body.AlternateForUID,
+		CreatedAt:         body.CreatedAt,
+		UpdatedAt:         body.UpdatedAt,
+	}
+	if body.AppointedBy != nil {
+		v.AppointedBy = *body.AppointedBy
+	}
+	if body.Status != nil {
+		v.Status = *body.Status
+	}
+	if body.Role != nil {
+		v.Role = &struct {
+			// Committee role name
+			Name string
+			// Role start date
+			StartDate *string
+			// Role end date
+			EndDate *string
+		}{
+			StartDate: body.Role.StartDate,
+			EndDate:   body.Role.EndDate,
+		}
+		if body.Role.Name != nil {
+			v.Role.Name = *body.Role.Name
+		}
+		if body.Role.Name == nil {
+			v.Role.Name = "None"
+		}
+	}
+	if body.AppointedBy == nil {
+		v.AppointedBy = "None"
+	}
+	if body.Status == nil {
+		v.Status = "Active"
+	}
+	if body.Voting != nil {
+		v.Voting = &struct {
+			// Voting status
+			Status string
+			// Voting start date
+			StartDate *string
+			// Voting end date
+			EndDate *string
+			// Voting weight for weighted voting committees, defaults to 1
+			Weight *float64
+		}{
+			StartDate: body.Voting.StartDate,
+			EndDate:   body.Voting.EndDate,
+			Weight:    body.Voting.Weight,
+		}
+		if body.Voting.Status != nil {
+			v.Voting.Status = *body.Voting.Status
+		}
+		if body.Voting.Status == nil {
+			v.Voting.Status = "None"
+		}
+	}
+	if body.Organization != nil {
+		v.Organization = &struct {
+			// Organization ID
+			ID *string
+			// Organization name
+			Name *string
+			// Organization website URL
+			Website *string
+		}{
+			ID:      body.Organization.ID,
+			Name:    body.Organization.Name,
+			Website: body.Organization.Website,
+		}
+	}
+	if body.RoleHistory != nil {
+		v.RoleHistory = make([]*committeeservice.CommitteeMemberRoleChange, len(body.RoleHistory))
+		for i, val := range body.RoleHistory {
+			v.RoleHistory[i] = unmarshalCommitteeMemberRoleChangeResponseBodyToCommitteeserviceCommitteeMemberRoleChange(val)
+		}
+	}
+	if body.StatusHistory != nil {
+		v.StatusHistory = make([]*committeeservice.CommitteeMemberStatusChange, len(body.StatusHistory))
+		for i, val := range body.StatusHistory {
+			v.StatusHistory[i] = unmarshalCommitteeMemberStatusChangeResponseBodyToCommitteeserviceCommitteeMemberStatusChange(val)
+		}
+	}
+
+	return v
+}
+
+// NewPatchCommitteeMemberBadRequest builds a committee-service service
+// patch-committee-member endpoint BadRequest error.
+func NewPatchCommitteeMemberBadRequest(body *PatchCommitteeMemberBadRequestResponseBody) *committeeservice.BadRequestError {
+	v := &committeeservice.BadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewPatchCommitteeMemberConflict builds a committee-service service
+// patch-committee-member endpoint Conflict error.
+func NewPatchCommitteeMemberConflict(body *PatchCommitteeMemberConflictResponseBody) *committeeservice.ConflictError {
+	v := &committeeservice.ConflictError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewPatchCommitteeMemberInternalServerError builds a committee-service
+// service patch-committee-member endpoint InternalServerError error.
+func NewPatchCommitteeMemberInternalServerError(body *PatchCommitteeMemberInternalServerErrorResponseBody) *committeeservice.InternalServerError {
+	v := &committeeservice.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewPatchCommitteeMemberNotFound builds a committee-service service
+// patch-committee-member endpoint NotFound error.
+func NewPatchCommitteeMemberNotFound(body *PatchCommitteeMemberNotFoundResponseBody) *committeeservice.NotFoundError {
+	v := &committeeservice.NotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewPatchCommitteeMemberServiceUnavailable builds a committee-service service
+// patch-committee-member endpoint ServiceUnavailable error.
+func NewPatchCommitteeMemberServiceUnavailable(body *PatchCommitteeMemberServiceUnavailableResponseBody) *committeeservice.ServiceUnavailableError {
+	v := &committeeservice.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
 // NewDeleteCommitteeMemberBadRequest builds a committee-service service
 // delete-committee-member endpoint BadRequest error.
 func NewDeleteCommitteeMemberBadRequest(body *DeleteCommitteeMemberBadRequestResponseBody) *committeeservice.BadRequestError {
@@ -2368,6 +3575,14 @@ func ValidateCreateCommitteeResponseBody(body *CreateCommitteeResponseBody) (err
 			err = goa.MergeErrors(err, goa.InvalidRangeError("body.total_voting_repos", *body.TotalVotingRepos, 0, true))
 		}
 	}
+	if body.IndexStatus != nil {
+		if !(*body.IndexStatus == "synced" || *body.IndexStatus == "pending") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.index_status", *body.IndexStatus, []any{"synced", "pending"}))
+		}
+	}
+	if body.LastIndexedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.last_indexed_at", *body.LastIndexedAt, goa.FormatDateTime))
+	}
 	if body.LastReviewedAt != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.last_reviewed_at", *body.LastReviewedAt, goa.FormatDateTime))
 	}
@@ -2432,6 +3647,57 @@ func ValidateGetCommitteeBaseResponseBody(body *GetCommitteeBaseResponseBody) (e
 			err = goa.MergeErrors(err, goa.InvalidRangeError("body.total_voting_repos", *body.TotalVotingRepos, 0, true))
 		}
 	}
+	if body.IndexStatus != nil {
+		if !(*body.IndexStatus == "synced" || *body.IndexStatus == "pending") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.index_status", *body.IndexStatus, []any{"synced", "pending"}))
+		}
+	}
+	if body.LastIndexedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.last_indexed_at", *body.LastIndexedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateGetCommitteeWithMembersResponseBody runs the validations defined on
+// Get-Committee-With-MembersResponseBody
+func ValidateGetCommitteeWithMembersResponseBody(body *GetCommitteeWithMembersResponseBody) (err error) {
+	if body.Committee == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("committee", "body"))
+	}
+	if body.Members == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("members", "body"))
+	}
+	if body.TotalMembers == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("total_members", "body"))
+	}
+	if body.Committee != nil {
+		if err2 := ValidateCommitteeFullWithReadonlyAttributesResponseBody(body.Committee); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	for _, e := range body.Members {
+		if e != nil {
+			if err2 := ValidateCommitteeMemberFullWithReadonlyAttributesResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateListCommitteeChildrenResponseBody runs the validations defined on
+// List-Committee-ChildrenResponseBody
+func ValidateListCommitteeChildrenResponseBody(body *ListCommitteeChildrenResponseBody) (err error) {
+	if body.Children == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("children", "body"))
+	}
+	for _, e := range body.Children {
+		if e != nil {
+			if err2 := ValidateCommitteeBaseWithReadonlyAttributesResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	return
 }
 
@@ -2488,6 +3754,14 @@ func ValidateUpdateCommitteeBaseResponseBody(body *UpdateCommitteeBaseResponseBo
 			err = goa.MergeErrors(err, goa.InvalidRangeError("body.total_voting_repos", *body.TotalVotingRepos, 0, true))
 		}
 	}
+	if body.IndexStatus != nil {
+		if !(*body.IndexStatus == "synced" || *body.IndexStatus == "pending") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.index_status", *body.IndexStatus, []any{"synced", "pending"}))
+		}
+	}
+	if body.LastIndexedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.last_indexed_at", *body.LastIndexedAt, goa.FormatDateTime))
+	}
 	return
 }
 
@@ -2620,6 +3894,14 @@ func ValidateCreateCommitteeMemberResponseBody(body *CreateCommitteeMemberRespon
 		if body.Voting.EndDate != nil {
 			err = goa.MergeErrors(err, goa.ValidateFormat("body.voting.end_date", *body.Voting.EndDate, goa.FormatDate))
 		}
+		if body.Voting.Weight != nil {
+			if *body.Voting.Weight < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.voting.weight", *body.Voting.Weight, 0, true))
+			}
+		}
+	}
+	if body.AlternateForUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.alternate_for_uid", *body.AlternateForUID, goa.FormatUUID))
 	}
 	if body.Organization != nil {
 		if body.Organization.Name != nil {
@@ -2637,6 +3919,52 @@ func ValidateCreateCommitteeMemberResponseBody(body *CreateCommitteeMemberRespon
 	if body.UpdatedAt != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
 	}
+	for _, e := range body.RoleHistory {
+		if e != nil {
+			if err2 := ValidateCommitteeMemberRoleChangeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.StatusHistory {
+		if e != nil {
+			if err2 := ValidateCommitteeMemberStatusChangeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateReassignCommitteeMembersOrganizationResponseBody runs the
+// validations defined on Reassign-Committee-Members-OrganizationResponseBody
+func ValidateReassignCommitteeMembersOrganizationResponseBody(body *ReassignCommitteeMembersOrganizationResponseBody) (err error) {
+	if body.Results == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("results", "body"))
+	}
+	for _, e := range body.Results {
+		if e != nil {
+			if err2 := ValidateCommitteeMemberReassignResultResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateListCommitteeMembersByOrganizationResponseBody runs the validations
+// defined on List-Committee-Members-By-OrganizationResponseBody
+func ValidateListCommitteeMembersByOrganizationResponseBody(body *ListCommitteeMembersByOrganizationResponseBody) (err error) {
+	if body.Members == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("members", "body"))
+	}
+	for _, e := range body.Members {
+		if e != nil {
+			if err2 := ValidateCommitteeMemberFullWithReadonlyAttributesResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	return
 }
 
@@ -2723,6 +4051,14 @@ func ValidateGetCommitteeMemberResponseBody(body *GetCommitteeMemberResponseBody
 		if body.Voting.EndDate != nil {
 			err = goa.MergeErrors(err, goa.ValidateFormat("body.voting.end_date", *body.Voting.EndDate, goa.FormatDate))
 		}
+		if body.Voting.Weight != nil {
+			if *body.Voting.Weight < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.voting.weight", *body.Voting.Weight, 0, true))
+			}
+		}
+	}
+	if body.AlternateForUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.alternate_for_uid", *body.AlternateForUID, goa.FormatUUID))
 	}
 	if body.Organization != nil {
 		if body.Organization.Name != nil {
@@ -2740,6 +4076,36 @@ func ValidateGetCommitteeMemberResponseBody(body *GetCommitteeMemberResponseBody
 	if body.UpdatedAt != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
 	}
+	for _, e := range body.RoleHistory {
+		if e != nil {
+			if err2 := ValidateCommitteeMemberRoleChangeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.StatusHistory {
+		if e != nil {
+			if err2 := ValidateCommitteeMemberStatusChangeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetCommitteeMemberHistoryResponseBody runs the validations defined
+// on Get-Committee-Member-HistoryResponseBody
+func ValidateGetCommitteeMemberHistoryResponseBody(body *GetCommitteeMemberHistoryResponseBody) (err error) {
+	if body.Revisions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("revisions", "body"))
+	}
+	for _, e := range body.Revisions {
+		if e != nil {
+			if err2 := ValidateCommitteeMemberRevisionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	return
 }
 
@@ -2826,6 +4192,14 @@ func ValidateUpdateCommitteeMemberResponseBody(body *UpdateCommitteeMemberRespon
 		if body.Voting.EndDate != nil {
 			err = goa.MergeErrors(err, goa.ValidateFormat("body.voting.end_date", *body.Voting.EndDate, goa.FormatDate))
 		}
+		if body.Voting.Weight != nil {
+			if *body.Voting.Weight < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.voting.weight", *body.Voting.Weight, 0, true))
+			}
+		}
+	}
+	if body.AlternateForUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.alternate_for_uid", *body.AlternateForUID, goa.FormatUUID))
 	}
 	if body.Organization != nil {
 		if body.Organization.Name != nil {
@@ -2843,6 +4217,145 @@ func ValidateUpdateCommitteeMemberResponseBody(body *UpdateCommitteeMemberRespon
 	if body.UpdatedAt != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
 	}
+	for _, e := range body.RoleHistory {
+		if e != nil {
+			if err2 := ValidateCommitteeMemberRoleChangeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.StatusHistory {
+		if e != nil {
+			if err2 := ValidateCommitteeMemberStatusChangeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidatePatchCommitteeMemberResponseBody runs the validations defined on
+// Patch-Committee-MemberResponseBody
+func ValidatePatchCommitteeMemberResponseBody(body *PatchCommitteeMemberResponseBody) (err error) {
+	if body.UID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.uid", *body.UID, goa.FormatUUID))
+	}
+	if body.CommitteeUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.committee_uid", *body.CommitteeUID, goa.FormatUUID))
+	}
+	if body.CommitteeName != nil {
+		if utf8.RuneCountInString(*body.CommitteeName) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.committee_name", *body.CommitteeName, utf8.RuneCountInString(*body.CommitteeName), 100, false))
+		}
+	}
+	if body.CommitteeCategory != nil {
+		if utf8.RuneCountInString(*body.CommitteeCategory) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.committee_category", *body.CommitteeCategory, utf8.RuneCountInString(*body.CommitteeCategory), 100, false))
+		}
+	}
+	if body.Username != nil {
+		if utf8.RuneCountInString(*body.Username) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.username", *body.Username, utf8.RuneCountInString(*body.Username), 100, false))
+		}
+	}
+	if body.Email != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
+	}
+	if body.FirstName != nil {
+		if utf8.RuneCountInString(*body.FirstName) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.first_name", *body.FirstName, utf8.RuneCountInString(*body.FirstName), 100, false))
+		}
+	}
+	if body.LastName != nil {
+		if utf8.RuneCountInString(*body.LastName) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.last_name", *body.LastName, utf8.RuneCountInString(*body.LastName), 100, false))
+		}
+	}
+	if body.JobTitle != nil {
+		if utf8.RuneCountInString(*body.JobTitle) > 200 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.job_title", *body.JobTitle, utf8.RuneCountInString(*body.JobTitle), 200, false))
+		}
+	}
+	if body.LinkedinProfile != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.linkedin_profile", *body.LinkedinProfile, goa.FormatURI))
+	}
+	if body.LinkedinProfile != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.linkedin_profile", *body.LinkedinProfile, "^(https?://)?([a-z]{2,3}\\.)?linkedin\\.com/.*$"))
+	}
+	if body.Role != nil {
+		if body.Role.Name != nil {
+			if !(*body.Role.Name == "Chair" || *body.Role.Name == "Counsel" || *body.Role.Name == "Developer Seat" || *body.Role.Name == "TAC/TOC Representative" || *body.Role.Name == "Director" || *body.Role.Name == "Lead" || *body.Role.Name == "None" || *body.Role.Name == "Secretary" || *body.Role.Name == "Treasurer" || *body.Role.Name == "Vice Chair" || *body.Role.Name == "LF Staff") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.role.name", *body.Role.Name, []any{"Chair", "Counsel", "Developer Seat", "TAC/TOC Representative", "Director", "Lead", "None", "Secretary", "Treasurer", "Vice Chair", "LF Staff"}))
+			}
+		}
+		if body.Role.StartDate != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.role.start_date", *body.Role.StartDate, goa.FormatDate))
+		}
+		if body.Role.EndDate != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.role.end_date", *body.Role.EndDate, goa.FormatDate))
+		}
+	}
+	if body.AppointedBy != nil {
+		if !(*body.AppointedBy == "Community" || *body.AppointedBy == "Membership Entitlement" || *body.AppointedBy == "Vote of End User Member Class" || *body.AppointedBy == "Vote of TSC Committee" || *body.AppointedBy == "Vote of TAC Committee" || *body.AppointedBy == "Vote of Academic Member Class" || *body.AppointedBy == "Vote of Lab Member Class" || *body.AppointedBy == "Vote of Marketing Committee" || *body.AppointedBy == "Vote of Governing Board" || *body.AppointedBy == "Vote of General Member Class" || *body.AppointedBy == "Vote of End User Committee" || *body.AppointedBy == "Vote of TOC Committee" || *body.AppointedBy == "Vote of Gold Member Class" || *body.AppointedBy == "Vote of Silver Member Class" || *body.AppointedBy == "Vote of Strategic Membership Class" || *body.AppointedBy == "None") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.appointed_by", *body.AppointedBy, []any{"Community", "Membership Entitlement", "Vote of End User Member Class", "Vote of TSC Committee", "Vote of TAC Committee", "Vote of Academic Member Class", "Vote of Lab Member Class", "Vote of Marketing Committee", "Vote of Governing Board", "Vote of General Member Class", "Vote of End User Committee", "Vote of TOC Committee", "Vote of Gold Member Class", "Vote of Silver Member Class", "Vote of Strategic Membership Class", "None"}))
+		}
+	}
+	if body.Status != nil {
+		if !(*body.Status == "Active" || *body.Status == "Inactive") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"Active", "Inactive"}))
+		}
+	}
+	if body.Voting != nil {
+		if body.Voting.Status != nil {
+			if !(*body.Voting.Status == "Alternate Voting Rep" || *body.Voting.Status == "Observer" || *body.Voting.Status == "Voting Rep" || *body.Voting.Status == "Emeritus" || *body.Voting.Status == "None") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.voting.status", *body.Voting.Status, []any{"Alternate Voting Rep", "Observer", "Voting Rep", "Emeritus", "None"}))
+			}
+		}
+		if body.Voting.StartDate != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.voting.start_date", *body.Voting.StartDate, goa.FormatDate))
+		}
+		if body.Voting.EndDate != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.voting.end_date", *body.Voting.EndDate, goa.FormatDate))
+		}
+		if body.Voting.Weight != nil {
+			if *body.Voting.Weight < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.voting.weight", *body.Voting.Weight, 0, true))
+			}
+		}
+	}
+	if body.AlternateForUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.alternate_for_uid", *body.AlternateForUID, goa.FormatUUID))
+	}
+	if body.Organization != nil {
+		if body.Organization.Name != nil {
+			if utf8.RuneCountInString(*body.Organization.Name) > 200 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.organization.name", *body.Organization.Name, utf8.RuneCountInString(*body.Organization.Name), 200, false))
+			}
+		}
+		if body.Organization.Website != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.organization.website", *body.Organization.Website, goa.FormatURI))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	for _, e := range body.RoleHistory {
+		if e != nil {
+			if err2 := ValidateCommitteeMemberRoleChangeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.StatusHistory {
+		if e != nil {
+			if err2 := ValidateCommitteeMemberStatusChangeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	return
 }
 
@@ -2882,36 +4395,112 @@ func ValidateCreateCommitteeNotFoundResponseBody(body *CreateCommitteeNotFoundRe
 	return
 }
 
-// ValidateCreateCommitteeServiceUnavailableResponseBody runs the validations
-// defined on create-committee_ServiceUnavailable_response_body
-func ValidateCreateCommitteeServiceUnavailableResponseBody(body *CreateCommitteeServiceUnavailableResponseBody) (err error) {
+// ValidateCreateCommitteeServiceUnavailableResponseBody runs the validations
+// defined on create-committee_ServiceUnavailable_response_body
+func ValidateCreateCommitteeServiceUnavailableResponseBody(body *CreateCommitteeServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetCommitteeBaseInternalServerErrorResponseBody runs the validations
+// defined on get-committee-base_InternalServerError_response_body
+func ValidateGetCommitteeBaseInternalServerErrorResponseBody(body *GetCommitteeBaseInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetCommitteeBaseNotFoundResponseBody runs the validations defined on
+// get-committee-base_NotFound_response_body
+func ValidateGetCommitteeBaseNotFoundResponseBody(body *GetCommitteeBaseNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetCommitteeBaseServiceUnavailableResponseBody runs the validations
+// defined on get-committee-base_ServiceUnavailable_response_body
+func ValidateGetCommitteeBaseServiceUnavailableResponseBody(body *GetCommitteeBaseServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetCommitteeWithMembersBadRequestResponseBody runs the validations
+// defined on get-committee-with-members_BadRequest_response_body
+func ValidateGetCommitteeWithMembersBadRequestResponseBody(body *GetCommitteeWithMembersBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetCommitteeWithMembersInternalServerErrorResponseBody runs the
+// validations defined on
+// get-committee-with-members_InternalServerError_response_body
+func ValidateGetCommitteeWithMembersInternalServerErrorResponseBody(body *GetCommitteeWithMembersInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetCommitteeWithMembersNotFoundResponseBody runs the validations
+// defined on get-committee-with-members_NotFound_response_body
+func ValidateGetCommitteeWithMembersNotFoundResponseBody(body *GetCommitteeWithMembersNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetCommitteeWithMembersServiceUnavailableResponseBody runs the
+// validations defined on
+// get-committee-with-members_ServiceUnavailable_response_body
+func ValidateGetCommitteeWithMembersServiceUnavailableResponseBody(body *GetCommitteeWithMembersServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListCommitteeChildrenBadRequestResponseBody runs the validations
+// defined on list-committee-children_BadRequest_response_body
+func ValidateListCommitteeChildrenBadRequestResponseBody(body *ListCommitteeChildrenBadRequestResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetCommitteeBaseInternalServerErrorResponseBody runs the validations
-// defined on get-committee-base_InternalServerError_response_body
-func ValidateGetCommitteeBaseInternalServerErrorResponseBody(body *GetCommitteeBaseInternalServerErrorResponseBody) (err error) {
+// ValidateListCommitteeChildrenInternalServerErrorResponseBody runs the
+// validations defined on
+// list-committee-children_InternalServerError_response_body
+func ValidateListCommitteeChildrenInternalServerErrorResponseBody(body *ListCommitteeChildrenInternalServerErrorResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetCommitteeBaseNotFoundResponseBody runs the validations defined on
-// get-committee-base_NotFound_response_body
-func ValidateGetCommitteeBaseNotFoundResponseBody(body *GetCommitteeBaseNotFoundResponseBody) (err error) {
+// ValidateListCommitteeChildrenNotFoundResponseBody runs the validations
+// defined on list-committee-children_NotFound_response_body
+func ValidateListCommitteeChildrenNotFoundResponseBody(body *ListCommitteeChildrenNotFoundResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetCommitteeBaseServiceUnavailableResponseBody runs the validations
-// defined on get-committee-base_ServiceUnavailable_response_body
-func ValidateGetCommitteeBaseServiceUnavailableResponseBody(body *GetCommitteeBaseServiceUnavailableResponseBody) (err error) {
+// ValidateListCommitteeChildrenServiceUnavailableResponseBody runs the
+// validations defined on
+// list-committee-children_ServiceUnavailable_response_body
+func ValidateListCommitteeChildrenServiceUnavailableResponseBody(body *ListCommitteeChildrenServiceUnavailableResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
@@ -3141,6 +4730,86 @@ func ValidateCreateCommitteeMemberServiceUnavailableResponseBody(body *CreateCom
 	return
 }
 
+// ValidateReassignCommitteeMembersOrganizationBadRequestResponseBody runs the
+// validations defined on
+// reassign-committee-members-organization_BadRequest_response_body
+func ValidateReassignCommitteeMembersOrganizationBadRequestResponseBody(body *ReassignCommitteeMembersOrganizationBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateReassignCommitteeMembersOrganizationInternalServerErrorResponseBody
+// runs the validations defined on
+// reassign-committee-members-organization_InternalServerError_response_body
+func ValidateReassignCommitteeMembersOrganizationInternalServerErrorResponseBody(body *ReassignCommitteeMembersOrganizationInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateReassignCommitteeMembersOrganizationNotFoundResponseBody runs the
+// validations defined on
+// reassign-committee-members-organization_NotFound_response_body
+func ValidateReassignCommitteeMembersOrganizationNotFoundResponseBody(body *ReassignCommitteeMembersOrganizationNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateReassignCommitteeMembersOrganizationServiceUnavailableResponseBody
+// runs the validations defined on
+// reassign-committee-members-organization_ServiceUnavailable_response_body
+func ValidateReassignCommitteeMembersOrganizationServiceUnavailableResponseBody(body *ReassignCommitteeMembersOrganizationServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListCommitteeMembersByOrganizationBadRequestResponseBody runs the
+// validations defined on
+// list-committee-members-by-organization_BadRequest_response_body
+func ValidateListCommitteeMembersByOrganizationBadRequestResponseBody(body *ListCommitteeMembersByOrganizationBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListCommitteeMembersByOrganizationInternalServerErrorResponseBody
+// runs the validations defined on
+// list-committee-members-by-organization_InternalServerError_response_body
+func ValidateListCommitteeMembersByOrganizationInternalServerErrorResponseBody(body *ListCommitteeMembersByOrganizationInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListCommitteeMembersByOrganizationNotFoundResponseBody runs the
+// validations defined on
+// list-committee-members-by-organization_NotFound_response_body
+func ValidateListCommitteeMembersByOrganizationNotFoundResponseBody(body *ListCommitteeMembersByOrganizationNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListCommitteeMembersByOrganizationServiceUnavailableResponseBody
+// runs the validations defined on
+// list-committee-members-by-organization_ServiceUnavailable_response_body
+func ValidateListCommitteeMembersByOrganizationServiceUnavailableResponseBody(body *ListCommitteeMembersByOrganizationServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
 // ValidateGetCommitteeMemberBadRequestResponseBody runs the validations
 // defined on get-committee-member_BadRequest_response_body
 func ValidateGetCommitteeMemberBadRequestResponseBody(body *GetCommitteeMemberBadRequestResponseBody) (err error) {
@@ -3177,6 +4846,44 @@ func ValidateGetCommitteeMemberServiceUnavailableResponseBody(body *GetCommittee
 	return
 }
 
+// ValidateGetCommitteeMemberHistoryBadRequestResponseBody runs the validations
+// defined on get-committee-member-history_BadRequest_response_body
+func ValidateGetCommitteeMemberHistoryBadRequestResponseBody(body *GetCommitteeMemberHistoryBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetCommitteeMemberHistoryInternalServerErrorResponseBody runs the
+// validations defined on
+// get-committee-member-history_InternalServerError_response_body
+func ValidateGetCommitteeMemberHistoryInternalServerErrorResponseBody(body *GetCommitteeMemberHistoryInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetCommitteeMemberHistoryNotFoundResponseBody runs the validations
+// defined on get-committee-member-history_NotFound_response_body
+func ValidateGetCommitteeMemberHistoryNotFoundResponseBody(body *GetCommitteeMemberHistoryNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetCommitteeMemberHistoryServiceUnavailableResponseBody runs the
+// validations defined on
+// get-committee-member-history_ServiceUnavailable_response_body
+func ValidateGetCommitteeMemberHistoryServiceUnavailableResponseBody(body *GetCommitteeMemberHistoryServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
 // ValidateUpdateCommitteeMemberBadRequestResponseBody runs the validations
 // defined on update-committee-member_BadRequest_response_body
 func ValidateUpdateCommitteeMemberBadRequestResponseBody(body *UpdateCommitteeMemberBadRequestResponseBody) (err error) {
@@ -3224,6 +4931,53 @@ func ValidateUpdateCommitteeMemberServiceUnavailableResponseBody(body *UpdateCom
 	return
 }
 
+// ValidatePatchCommitteeMemberBadRequestResponseBody runs the validations
+// defined on patch-committee-member_BadRequest_response_body
+func ValidatePatchCommitteeMemberBadRequestResponseBody(body *PatchCommitteeMemberBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidatePatchCommitteeMemberConflictResponseBody runs the validations
+// defined on patch-committee-member_Conflict_response_body
+func ValidatePatchCommitteeMemberConflictResponseBody(body *PatchCommitteeMemberConflictResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidatePatchCommitteeMemberInternalServerErrorResponseBody runs the
+// validations defined on
+// patch-committee-member_InternalServerError_response_body
+func ValidatePatchCommitteeMemberInternalServerErrorResponseBody(body *PatchCommitteeMemberInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidatePatchCommitteeMemberNotFoundResponseBody runs the validations
+// defined on patch-committee-member_NotFound_response_body
+func ValidatePatchCommitteeMemberNotFoundResponseBody(body *PatchCommitteeMemberNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidatePatchCommitteeMemberServiceUnavailableResponseBody runs the
+// validations defined on
+// patch-committee-member_ServiceUnavailable_response_body
+func ValidatePatchCommitteeMemberServiceUnavailableResponseBody(body *PatchCommitteeMemberServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
 // ValidateDeleteCommitteeMemberBadRequestResponseBody runs the validations
 // defined on delete-committee-member_BadRequest_response_body
 func ValidateDeleteCommitteeMemberBadRequestResponseBody(body *DeleteCommitteeMemberBadRequestResponseBody) (err error) {
@@ -3324,16 +5078,73 @@ func ValidateCommitteeBaseWithReadonlyAttributesResponseBody(body *CommitteeBase
 			err = goa.MergeErrors(err, goa.InvalidRangeError("body.total_voting_repos", *body.TotalVotingRepos, 0, true))
 		}
 	}
+	if body.IndexStatus != nil {
+		if !(*body.IndexStatus == "synced" || *body.IndexStatus == "pending") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.index_status", *body.IndexStatus, []any{"synced", "pending"}))
+		}
+	}
+	if body.LastIndexedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.last_indexed_at", *body.LastIndexedAt, goa.FormatDateTime))
+	}
 	return
 }
 
-// ValidateCommitteeSettingsWithReadonlyAttributesResponseBody runs the
-// validations defined on
-// committee-settings-with-readonly-attributesResponseBody
-func ValidateCommitteeSettingsWithReadonlyAttributesResponseBody(body *CommitteeSettingsWithReadonlyAttributesResponseBody) (err error) {
+// ValidateCommitteeFullWithReadonlyAttributesResponseBody runs the validations
+// defined on committee-full-with-readonly-attributesResponseBody
+func ValidateCommitteeFullWithReadonlyAttributesResponseBody(body *CommitteeFullWithReadonlyAttributesResponseBody) (err error) {
 	if body.UID != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.uid", *body.UID, goa.FormatUUID))
 	}
+	if body.ProjectUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.project_uid", *body.ProjectUID, goa.FormatUUID))
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 100, false))
+		}
+	}
+	if body.Category != nil {
+		if !(*body.Category == "Ambassador" || *body.Category == "Board" || *body.Category == "Code of Conduct" || *body.Category == "Committers" || *body.Category == "Expert Group" || *body.Category == "Finance Committee" || *body.Category == "Government Advisory Council" || *body.Category == "Legal Committee" || *body.Category == "Maintainers" || *body.Category == "Marketing Committee/Sub Committee" || *body.Category == "Marketing Mailing List" || *body.Category == "Marketing Oversight Committee/Marketing Advisory Committee" || *body.Category == "Other" || *body.Category == "Product Security" || *body.Category == "Special Interest Group" || *body.Category == "Technical Advisory Committee" || *body.Category == "Technical Mailing List" || *body.Category == "Technical Oversight Committee" || *body.Category == "Technical Steering Committee" || *body.Category == "Working Group") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.category", *body.Category, []any{"Ambassador", "Board", "Code of Conduct", "Committers", "Expert Group", "Finance Committee", "Government Advisory Council", "Legal Committee", "Maintainers", "Marketing Committee/Sub Committee", "Marketing Mailing List", "Marketing Oversight Committee/Marketing Advisory Committee", "Other", "Product Security", "Special Interest Group", "Technical Advisory Committee", "Technical Mailing List", "Technical Oversight Committee", "Technical Steering Committee", "Working Group"}))
+		}
+	}
+	if body.Description != nil {
+		if utf8.RuneCountInString(*body.Description) > 2000 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.description", *body.Description, utf8.RuneCountInString(*body.Description), 2000, false))
+		}
+	}
+	if body.Website != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.website", *body.Website, goa.FormatURI))
+	}
+	if body.Website != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.website", *body.Website, "^(https?://)?[^\\s/$.?#].[^\\s]*$"))
+	}
+	if body.DisplayName != nil {
+		if utf8.RuneCountInString(*body.DisplayName) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.display_name", *body.DisplayName, utf8.RuneCountInString(*body.DisplayName), 100, false))
+		}
+	}
+	if body.ParentUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.parent_uid", *body.ParentUID, goa.FormatUUID))
+	}
+	if body.TotalMembers != nil {
+		if *body.TotalMembers < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.total_members", *body.TotalMembers, 0, true))
+		}
+	}
+	if body.TotalVotingRepos != nil {
+		if *body.TotalVotingRepos < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.total_voting_repos", *body.TotalVotingRepos, 0, true))
+		}
+	}
+	if body.IndexStatus != nil {
+		if !(*body.IndexStatus == "synced" || *body.IndexStatus == "pending") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.index_status", *body.IndexStatus, []any{"synced", "pending"}))
+		}
+	}
+	if body.LastIndexedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.last_indexed_at", *body.LastIndexedAt, goa.FormatDateTime))
+	}
 	if body.LastReviewedAt != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.last_reviewed_at", *body.LastReviewedAt, goa.FormatDateTime))
 	}
@@ -3342,12 +5153,6 @@ func ValidateCommitteeSettingsWithReadonlyAttributesResponseBody(body *Committee
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.member_visibility", *body.MemberVisibility, []any{"hidden", "basic_profile"}))
 		}
 	}
-	if body.CreatedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
-	}
-	if body.UpdatedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
-	}
 	return
 }
 
@@ -3435,6 +5240,14 @@ func ValidateCommitteeMemberFullWithReadonlyAttributesResponseBody(body *Committ
 		if body.Voting.EndDate != nil {
 			err = goa.MergeErrors(err, goa.ValidateFormat("body.voting.end_date", *body.Voting.EndDate, goa.FormatDate))
 		}
+		if body.Voting.Weight != nil {
+			if *body.Voting.Weight < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.voting.weight", *body.Voting.Weight, 0, true))
+			}
+		}
+	}
+	if body.AlternateForUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.alternate_for_uid", *body.AlternateForUID, goa.FormatUUID))
 	}
 	if body.Organization != nil {
 		if body.Organization.Name != nil {
@@ -3452,5 +5265,125 @@ func ValidateCommitteeMemberFullWithReadonlyAttributesResponseBody(body *Committ
 	if body.UpdatedAt != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
 	}
+	for _, e := range body.RoleHistory {
+		if e != nil {
+			if err2 := ValidateCommitteeMemberRoleChangeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.StatusHistory {
+		if e != nil {
+			if err2 := ValidateCommitteeMemberStatusChangeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateCommitteeMemberRoleChangeResponseBody runs the validations defined
+// on committee-member-role-changeResponseBody
+func ValidateCommitteeMemberRoleChangeResponseBody(body *CommitteeMemberRoleChangeResponseBody) (err error) {
+	if body.OldRole == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("old_role", "body"))
+	}
+	if body.NewRole == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("new_role", "body"))
+	}
+	if body.ChangedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("changed_at", "body"))
+	}
+	if body.ChangedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.changed_at", *body.ChangedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateCommitteeMemberStatusChangeResponseBody runs the validations defined
+// on committee-member-status-changeResponseBody
+func ValidateCommitteeMemberStatusChangeResponseBody(body *CommitteeMemberStatusChangeResponseBody) (err error) {
+	if body.OldStatus == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("old_status", "body"))
+	}
+	if body.NewStatus == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("new_status", "body"))
+	}
+	if body.ChangedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("changed_at", "body"))
+	}
+	if body.ChangedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.changed_at", *body.ChangedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateCommitteeSettingsWithReadonlyAttributesResponseBody runs the
+// validations defined on
+// committee-settings-with-readonly-attributesResponseBody
+func ValidateCommitteeSettingsWithReadonlyAttributesResponseBody(body *CommitteeSettingsWithReadonlyAttributesResponseBody) (err error) {
+	if body.UID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.uid", *body.UID, goa.FormatUUID))
+	}
+	if body.LastReviewedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.last_reviewed_at", *body.LastReviewedAt, goa.FormatDateTime))
+	}
+	if body.MemberVisibility != nil {
+		if !(*body.MemberVisibility == "hidden" || *body.MemberVisibility == "basic_profile") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.member_visibility", *body.MemberVisibility, []any{"hidden", "basic_profile"}))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateCommitteeMemberReassignResultResponseBody runs the validations
+// defined on committee-member-reassign-resultResponseBody
+func ValidateCommitteeMemberReassignResultResponseBody(body *CommitteeMemberReassignResultResponseBody) (err error) {
+	if body.UID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uid", "body"))
+	}
+	if body.Email == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("email", "body"))
+	}
+	if body.UID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.uid", *body.UID, goa.FormatUUID))
+	}
+	if body.Email != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
+	}
+	if body.Member != nil {
+		if err2 := ValidateCommitteeMemberFullWithReadonlyAttributesResponseBody(body.Member); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateCommitteeMemberRevisionResponseBody runs the validations defined on
+// committee-member-revisionResponseBody
+func ValidateCommitteeMemberRevisionResponseBody(body *CommitteeMemberRevisionResponseBody) (err error) {
+	if body.Revision == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("revision", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.Member == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("member", "body"))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.Member != nil {
+		if err2 := ValidateCommitteeMemberFullWithReadonlyAttributesResponseBody(body.Member); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
 	return
 }
@@ -15,6 +15,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	committeeservice "github.com/linuxfoundation/lfx-v2-committee-service/gen/committee_service"
 	goahttp "goa.design/goa/v3/http"
@@ -295,6 +296,302 @@ func EncodeGetCommitteeBaseError(encoder func(context.Context, http.ResponseWrit
 	}
 }
 
+// EncodeGetCommitteeWithMembersResponse returns an encoder for responses
+// returned by the committee-service get-committee-with-members endpoint.
+func EncodeGetCommitteeWithMembersResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*committeeservice.GetCommitteeWithMembersResult)
+		enc := encoder(ctx, w)
+		body := NewGetCommitteeWithMembersResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetCommitteeWithMembersRequest returns a decoder for requests sent to
+// the committee-service get-committee-with-members endpoint.
+func DecodeGetCommitteeWithMembersRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*committeeservice.GetCommitteeWithMembersPayload, error) {
+	return func(r *http.Request) (*committeeservice.GetCommitteeWithMembersPayload, error) {
+		var (
+			uid         string
+			version     *string
+			pageSize    int
+			pageToken   *string
+			bearerToken *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		uid = params["uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		qp := r.URL.Query()
+		versionRaw := qp.Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		{
+			pageSizeRaw := qp.Get("page_size")
+			if pageSizeRaw == "" {
+				pageSize = 50
+			} else {
+				v, err2 := strconv.ParseInt(pageSizeRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("page_size", pageSizeRaw, "integer"))
+				}
+				pageSize = int(v)
+			}
+		}
+		if pageSize < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("page_size", pageSize, 1, true))
+		}
+		if pageSize > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("page_size", pageSize, 100, false))
+		}
+		pageTokenRaw := qp.Get("page_token")
+		if pageTokenRaw != "" {
+			pageToken = &pageTokenRaw
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetCommitteeWithMembersPayload(uid, version, pageSize, pageToken, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetCommitteeWithMembersError returns an encoder for errors returned by
+// the get-committee-with-members committee-service endpoint.
+func EncodeGetCommitteeWithMembersError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *committeeservice.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetCommitteeWithMembersBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *committeeservice.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetCommitteeWithMembersInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *committeeservice.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetCommitteeWithMembersNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *committeeservice.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetCommitteeWithMembersServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListCommitteeChildrenResponse returns an encoder for responses
+// returned by the committee-service list-committee-children endpoint.
+func EncodeListCommitteeChildrenResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*committeeservice.ListCommitteeChildrenResult)
+		enc := encoder(ctx, w)
+		body := NewListCommitteeChildrenResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListCommitteeChildrenRequest returns a decoder for requests sent to
+// the committee-service list-committee-children endpoint.
+func DecodeListCommitteeChildrenRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*committeeservice.ListCommitteeChildrenPayload, error) {
+	return func(r *http.Request) (*committeeservice.ListCommitteeChildrenPayload, error) {
+		var (
+			uid         string
+			version     *string
+			pageSize    int
+			pageToken   *string
+			bearerToken *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		uid = params["uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		qp := r.URL.Query()
+		versionRaw := qp.Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		{
+			pageSizeRaw := qp.Get("page_size")
+			if pageSizeRaw == "" {
+				pageSize = 50
+			} else {
+				v, err2 := strconv.ParseInt(pageSizeRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("page_size", pageSizeRaw, "integer"))
+				}
+				pageSize = int(v)
+			}
+		}
+		if pageSize < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("page_size", pageSize, 1, true))
+		}
+		if pageSize > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("page_size", pageSize, 100, false))
+		}
+		pageTokenRaw := qp.Get("page_token")
+		if pageTokenRaw != "" {
+			pageToken = &pageTokenRaw
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListCommitteeChildrenPayload(uid, version, pageSize, pageToken, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeListCommitteeChildrenError returns an encoder for errors returned by
+// the list-committee-children committee-service endpoint.
+func EncodeListCommitteeChildrenError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *committeeservice.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListCommitteeChildrenBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *committeeservice.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListCommitteeChildrenInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *committeeservice.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListCommitteeChildrenNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *committeeservice.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListCommitteeChildrenServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
 // EncodeUpdateCommitteeBaseResponse returns an encoder for responses returned
 // by the committee-service update-committee-base endpoint.
 func EncodeUpdateCommitteeBaseResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
@@ -1118,42 +1415,332 @@ func EncodeCreateCommitteeMemberError(encoder func(context.Context, http.Respons
 	}
 }
 
-// EncodeGetCommitteeMemberResponse returns an encoder for responses returned
-// by the committee-service get-committee-member endpoint.
-func EncodeGetCommitteeMemberResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeReassignCommitteeMembersOrganizationResponse returns an encoder for
+// responses returned by the committee-service
+// reassign-committee-members-organization endpoint.
+func EncodeReassignCommitteeMembersOrganizationResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*committeeservice.GetCommitteeMemberResult)
+		res, _ := v.(*committeeservice.ReassignCommitteeMembersOrganizationResult)
 		enc := encoder(ctx, w)
-		body := NewGetCommitteeMemberResponseBody(res)
-		if res.Etag != nil {
-			w.Header().Set("Etag", *res.Etag)
-		}
+		body := NewReassignCommitteeMembersOrganizationResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeGetCommitteeMemberRequest returns a decoder for requests sent to the
-// committee-service get-committee-member endpoint.
-func DecodeGetCommitteeMemberRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*committeeservice.GetCommitteeMemberPayload, error) {
-	return func(r *http.Request) (*committeeservice.GetCommitteeMemberPayload, error) {
+// DecodeReassignCommitteeMembersOrganizationRequest returns a decoder for
+// requests sent to the committee-service
+// reassign-committee-members-organization endpoint.
+func DecodeReassignCommitteeMembersOrganizationRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*committeeservice.ReassignCommitteeMembersOrganizationPayload, error) {
+	return func(r *http.Request) (*committeeservice.ReassignCommitteeMembersOrganizationPayload, error) {
 		var (
-			uid         string
-			memberUID   string
-			version     string
-			bearerToken *string
-			err         error
-
-			params = mux.Vars(r)
+			body ReassignCommitteeMembersOrganizationRequestBody
+			err  error
 		)
-		uid = params["uid"]
-		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
-		memberUID = params["member_uid"]
-		err = goa.MergeErrors(err, goa.ValidateFormat("member_uid", memberUID, goa.FormatUUID))
-		version = r.URL.Query().Get("v")
-		if version == "" {
-			err = goa.MergeErrors(err, goa.MissingFieldError("version", "query string"))
-		}
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateReassignCommitteeMembersOrganizationRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			uid         string
+			version     string
+			bearerToken *string
+			xSync       bool
+
+			params = mux.Vars(r)
+		)
+		uid = params["uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		version = r.URL.Query().Get("v")
+		if version == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("version", "query string"))
+		}
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		{
+			xSyncRaw := r.Header.Get("X-Sync")
+			if xSyncRaw != "" {
+				v, err2 := strconv.ParseBool(xSyncRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("x_sync", xSyncRaw, "boolean"))
+				}
+				xSync = v
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewReassignCommitteeMembersOrganizationPayload(&body, uid, version, bearerToken, xSync)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeReassignCommitteeMembersOrganizationError returns an encoder for
+// errors returned by the reassign-committee-members-organization
+// committee-service endpoint.
+func EncodeReassignCommitteeMembersOrganizationError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *committeeservice.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewReassignCommitteeMembersOrganizationBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *committeeservice.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewReassignCommitteeMembersOrganizationInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *committeeservice.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewReassignCommitteeMembersOrganizationNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *committeeservice.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewReassignCommitteeMembersOrganizationServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListCommitteeMembersByOrganizationResponse returns an encoder for
+// responses returned by the committee-service
+// list-committee-members-by-organization endpoint.
+func EncodeListCommitteeMembersByOrganizationResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*committeeservice.ListCommitteeMembersByOrganizationResult)
+		enc := encoder(ctx, w)
+		body := NewListCommitteeMembersByOrganizationResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListCommitteeMembersByOrganizationRequest returns a decoder for
+// requests sent to the committee-service
+// list-committee-members-by-organization endpoint.
+func DecodeListCommitteeMembersByOrganizationRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*committeeservice.ListCommitteeMembersByOrganizationPayload, error) {
+	return func(r *http.Request) (*committeeservice.ListCommitteeMembersByOrganizationPayload, error) {
+		var (
+			uid          string
+			version      string
+			organization string
+			bearerToken  *string
+			err          error
+
+			params = mux.Vars(r)
+		)
+		uid = params["uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		qp := r.URL.Query()
+		version = qp.Get("v")
+		if version == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("version", "query string"))
+		}
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		organization = qp.Get("organization")
+		if organization == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("organization", "query string"))
+		}
+		if utf8.RuneCountInString(organization) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("organization", organization, utf8.RuneCountInString(organization), 1, true))
+		}
+		if utf8.RuneCountInString(organization) > 200 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("organization", organization, utf8.RuneCountInString(organization), 200, false))
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListCommitteeMembersByOrganizationPayload(uid, version, organization, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeListCommitteeMembersByOrganizationError returns an encoder for errors
+// returned by the list-committee-members-by-organization committee-service
+// endpoint.
+func EncodeListCommitteeMembersByOrganizationError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *committeeservice.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListCommitteeMembersByOrganizationBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *committeeservice.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListCommitteeMembersByOrganizationInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *committeeservice.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListCommitteeMembersByOrganizationNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *committeeservice.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListCommitteeMembersByOrganizationServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetCommitteeMemberResponse returns an encoder for responses returned
+// by the committee-service get-committee-member endpoint.
+func EncodeGetCommitteeMemberResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*committeeservice.GetCommitteeMemberResult)
+		enc := encoder(ctx, w)
+		body := NewGetCommitteeMemberResponseBody(res)
+		if res.Etag != nil {
+			w.Header().Set("Etag", *res.Etag)
+		}
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetCommitteeMemberRequest returns a decoder for requests sent to the
+// committee-service get-committee-member endpoint.
+func DecodeGetCommitteeMemberRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*committeeservice.GetCommitteeMemberPayload, error) {
+	return func(r *http.Request) (*committeeservice.GetCommitteeMemberPayload, error) {
+		var (
+			uid         string
+			memberUID   string
+			version     string
+			bearerToken *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		uid = params["uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		memberUID = params["member_uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("member_uid", memberUID, goa.FormatUUID))
+		version = r.URL.Query().Get("v")
+		if version == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("version", "query string"))
+		}
 		if !(version == "1") {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
 		}
@@ -1177,9 +1764,153 @@ func DecodeGetCommitteeMemberRequest(mux goahttp.Muxer, decoder func(*http.Reque
 	}
 }
 
-// EncodeGetCommitteeMemberError returns an encoder for errors returned by the
-// get-committee-member committee-service endpoint.
-func EncodeGetCommitteeMemberError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeGetCommitteeMemberError returns an encoder for errors returned by the
+// get-committee-member committee-service endpoint.
+func EncodeGetCommitteeMemberError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *committeeservice.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetCommitteeMemberBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *committeeservice.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetCommitteeMemberInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *committeeservice.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetCommitteeMemberNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *committeeservice.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetCommitteeMemberServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetCommitteeMemberHistoryResponse returns an encoder for responses
+// returned by the committee-service get-committee-member-history endpoint.
+func EncodeGetCommitteeMemberHistoryResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*committeeservice.GetCommitteeMemberHistoryResult)
+		enc := encoder(ctx, w)
+		body := NewGetCommitteeMemberHistoryResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetCommitteeMemberHistoryRequest returns a decoder for requests sent
+// to the committee-service get-committee-member-history endpoint.
+func DecodeGetCommitteeMemberHistoryRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*committeeservice.GetCommitteeMemberHistoryPayload, error) {
+	return func(r *http.Request) (*committeeservice.GetCommitteeMemberHistoryPayload, error) {
+		var (
+			uid         string
+			memberUID   string
+			version     string
+			limit       int
+			bearerToken *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		uid = params["uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		memberUID = params["member_uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("member_uid", memberUID, goa.FormatUUID))
+		qp := r.URL.Query()
+		version = qp.Get("v")
+		if version == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("version", "query string"))
+		}
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw == "" {
+				limit = 20
+			} else {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = int(v)
+			}
+		}
+		if limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+		}
+		if limit > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetCommitteeMemberHistoryPayload(uid, memberUID, version, limit, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetCommitteeMemberHistoryError returns an encoder for errors returned
+// by the get-committee-member-history committee-service endpoint.
+func EncodeGetCommitteeMemberHistoryError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1195,7 +1926,7 @@ func EncodeGetCommitteeMemberError(encoder func(context.Context, http.ResponseWr
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetCommitteeMemberBadRequestResponseBody(res)
+				body = NewGetCommitteeMemberHistoryBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -1208,7 +1939,7 @@ func EncodeGetCommitteeMemberError(encoder func(context.Context, http.ResponseWr
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetCommitteeMemberInternalServerErrorResponseBody(res)
+				body = NewGetCommitteeMemberHistoryInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -1221,7 +1952,7 @@ func EncodeGetCommitteeMemberError(encoder func(context.Context, http.ResponseWr
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetCommitteeMemberNotFoundResponseBody(res)
+				body = NewGetCommitteeMemberHistoryNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -1234,7 +1965,7 @@ func EncodeGetCommitteeMemberError(encoder func(context.Context, http.ResponseWr
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetCommitteeMemberServiceUnavailableResponseBody(res)
+				body = NewGetCommitteeMemberHistoryServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -1417,6 +2148,178 @@ func EncodeUpdateCommitteeMemberError(encoder func(context.Context, http.Respons
 	}
 }
 
+// EncodePatchCommitteeMemberResponse returns an encoder for responses returned
+// by the committee-service patch-committee-member endpoint.
+func EncodePatchCommitteeMemberResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*committeeservice.CommitteeMemberFullWithReadonlyAttributes)
+		enc := encoder(ctx, w)
+		body := NewPatchCommitteeMemberResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodePatchCommitteeMemberRequest returns a decoder for requests sent to the
+// committee-service patch-committee-member endpoint.
+func DecodePatchCommitteeMemberRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*committeeservice.PatchCommitteeMemberPayload, error) {
+	return func(r *http.Request) (*committeeservice.PatchCommitteeMemberPayload, error) {
+		var (
+			body PatchCommitteeMemberRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidatePatchCommitteeMemberRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			uid         string
+			memberUID   string
+			version     string
+			bearerToken *string
+			ifMatch     *string
+			xSync       bool
+
+			params = mux.Vars(r)
+		)
+		uid = params["uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		memberUID = params["member_uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("member_uid", memberUID, goa.FormatUUID))
+		version = r.URL.Query().Get("v")
+		if version == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("version", "query string"))
+		}
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		ifMatchRaw := r.Header.Get("If-Match")
+		if ifMatchRaw != "" {
+			ifMatch = &ifMatchRaw
+		}
+		{
+			xSyncRaw := r.Header.Get("X-Sync")
+			if xSyncRaw != "" {
+				v, err2 := strconv.ParseBool(xSyncRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("x_sync", xSyncRaw, "boolean"))
+				}
+				xSync = v
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewPatchCommitteeMemberPayload(&body, uid, memberUID, version, bearerToken, ifMatch, xSync)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodePatchCommitteeMemberError returns an encoder for errors returned by
+// the patch-committee-member committee-service endpoint.
+func EncodePatchCommitteeMemberError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *committeeservice.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPatchCommitteeMemberBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Conflict":
+			var res *committeeservice.ConflictError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPatchCommitteeMemberConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *committeeservice.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPatchCommitteeMemberInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *committeeservice.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPatchCommitteeMemberNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *committeeservice.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPatchCommitteeMemberServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
 // EncodeDeleteCommitteeMemberResponse returns an encoder for responses
 // returned by the committee-service delete-committee-member endpoint.
 func EncodeDeleteCommitteeMemberResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
@@ -1566,3 +2469,363 @@ func EncodeDeleteCommitteeMemberError(encoder func(context.Context, http.Respons
 		}
 	}
 }
+
+// marshalCommitteeserviceCommitteeFullWithReadonlyAttributesToCommitteeFullWithReadonlyAttributesResponseBody
+// builds a value of type *CommitteeFullWithReadonlyAttributesResponseBody from
+// a value of type *committeeservice.CommitteeFullWithReadonlyAttributes.
+func marshalCommitteeserviceCommitteeFullWithReadonlyAttributesToCommitteeFullWithReadonlyAttributesResponseBody(v *committeeservice.CommitteeFullWithReadonlyAttributes) *CommitteeFullWithReadonlyAttributesResponseBody {
+	res := &CommitteeFullWithReadonlyAttributesResponseBody{
+		UID:                   v.UID,
+		ProjectUID:            v.ProjectUID,
+		Name:                  v.Name,
+		Category:              v.Category,
+		Description:           v.Description,
+		Website:               v.Website,
+		EnableVoting:          v.EnableVoting,
+		SsoGroupEnabled:       v.SsoGroupEnabled,
+		RequiresReview:        v.RequiresReview,
+		Public:                v.Public,
+		DisplayName:           v.DisplayName,
+		ParentUID:             v.ParentUID,
+		SsoGroupName:          v.SsoGroupName,
+		TotalMembers:          v.TotalMembers,
+		TotalVotingRepos:      v.TotalVotingRepos,
+		IndexStatus:           v.IndexStatus,
+		LastIndexedAt:         v.LastIndexedAt,
+		BusinessEmailRequired: v.BusinessEmailRequired,
+		LastReviewedAt:        v.LastReviewedAt,
+		LastReviewedBy:        v.LastReviewedBy,
+		MemberVisibility:      v.MemberVisibility,
+		ShowMeetingAttendees:  v.ShowMeetingAttendees,
+	}
+	{
+		var zero bool
+		if res.EnableVoting == zero {
+			res.EnableVoting = false
+		}
+	}
+	{
+		var zero bool
+		if res.SsoGroupEnabled == zero {
+			res.SsoGroupEnabled = false
+		}
+	}
+	{
+		var zero bool
+		if res.RequiresReview == zero {
+			res.RequiresReview = false
+		}
+	}
+	{
+		var zero bool
+		if res.Public == zero {
+			res.Public = false
+		}
+	}
+	if v.Calendar != nil {
+		res.Calendar = &struct {
+			// Whether the committee calendar is publicly visible
+			Public bool `form:"public" json:"public" xml:"public"`
+		}{
+			Public: v.Calendar.Public,
+		}
+		{
+			var zero bool
+			if res.Calendar.Public == zero {
+				res.Calendar.Public = false
+			}
+		}
+	}
+	if v.Labels != nil {
+		res.Labels = make(map[string]string, len(v.Labels))
+		for key, val := range v.Labels {
+			tk := key
+			tv := val
+			res.Labels[tk] = tv
+		}
+	}
+	{
+		var zero bool
+		if res.BusinessEmailRequired == zero {
+			res.BusinessEmailRequired = false
+		}
+	}
+	if v.AllowedEmailDomains != nil {
+		res.AllowedEmailDomains = make([]string, len(v.AllowedEmailDomains))
+		for i, val := range v.AllowedEmailDomains {
+			res.AllowedEmailDomains[i] = val
+		}
+	}
+	{
+		var zero string
+		if res.MemberVisibility == zero {
+			res.MemberVisibility = "hidden"
+		}
+	}
+	{
+		var zero bool
+		if res.ShowMeetingAttendees == zero {
+			res.ShowMeetingAttendees = false
+		}
+	}
+	if v.Writers != nil {
+		res.Writers = make([]string, len(v.Writers))
+		for i, val := range v.Writers {
+			res.Writers[i] = val
+		}
+	}
+	if v.Auditors != nil {
+		res.Auditors = make([]string, len(v.Auditors))
+		for i, val := range v.Auditors {
+			res.Auditors[i] = val
+		}
+	}
+
+	return res
+}
+
+// marshalCommitteeserviceCommitteeMemberFullWithReadonlyAttributesToCommitteeMemberFullWithReadonlyAttributesResponseBody
+// builds a value of type
+// *CommitteeMemberFullWithReadonlyAttributesResponseBody from a value of type
+// *committeeservice.CommitteeMemberFullWithReadonlyAttributes.
+func marshalCommitteeserviceCommitteeMemberFullWithReadonlyAttributesToCommitteeMemberFullWithReadonlyAttributesResponseBody(v *committeeservice.CommitteeMemberFullWithReadonlyAttributes) *CommitteeMemberFullWithReadonlyAttributesResponseBody {
+	res := &CommitteeMemberFullWithReadonlyAttributesResponseBody{
+		UID:               v.UID,
+		CommitteeUID:      v.CommitteeUID,
+		CommitteeName:     v.CommitteeName,
+		CommitteeCategory: v.CommitteeCategory,
+		Username:          v.Username,
+		Email:             v.Email,
+		FirstName:         v.FirstName,
+		LastName:          v.LastName,
+		JobTitle:          v.JobTitle,
+		LinkedinProfile:   v.LinkedinProfile,
+		AppointedBy:       v.AppointedBy,
+		Status:            v.Status,
+		AlternateForUID:   v.AlternateForUID,
+		CreatedAt:         v.CreatedAt,
+		UpdatedAt:         v.UpdatedAt,
+	}
+	if v.Role != nil {
+		res.Role = &struct {
+			// Committee role name
+			Name string `form:"name" json:"name" xml:"name"`
+			// Role start date
+			StartDate *string `form:"start_date" json:"start_date" xml:"start_date"`
+			// Role end date
+			EndDate *string `form:"end_date" json:"end_date" xml:"end_date"`
+		}{
+			Name:      v.Role.Name,
+			StartDate: v.Role.StartDate,
+			EndDate:   v.Role.EndDate,
+		}
+		{
+			var zero string
+			if res.Role.Name == zero {
+				res.Role.Name = "None"
+			}
+		}
+	}
+	{
+		var zero string
+		if res.AppointedBy == zero {
+			res.AppointedBy = "None"
+		}
+	}
+	{
+		var zero string
+		if res.Status == zero {
+			res.Status = "Active"
+		}
+	}
+	if v.Voting != nil {
+		res.Voting = &struct {
+			// Voting status
+			Status string `form:"status" json:"status" xml:"status"`
+			// Voting start date
+			StartDate *string `form:"start_date" json:"start_date" xml:"start_date"`
+			// Voting end date
+			EndDate *string `form:"end_date" json:"end_date" xml:"end_date"`
+			// Voting weight for weighted voting committees, defaults to 1
+			Weight *float64 `form:"weight" json:"weight" xml:"weight"`
+		}{
+			Status:    v.Voting.Status,
+			StartDate: v.Voting.StartDate,
+			EndDate:   v.Voting.EndDate,
+			Weight:    v.Voting.Weight,
+		}
+		{
+			var zero string
+			if res.Voting.Status == zero {
+				res.Voting.Status = "None"
+			}
+		}
+	}
+	if v.Organization != nil {
+		res.Organization = &struct {
+			// Organization ID
+			ID *string `form:"id" json:"id" xml:"id"`
+			// Organization name
+			Name *string `form:"name" json:"name" xml:"name"`
+			// Organization website URL
+			Website *string `form:"website" json:"website" xml:"website"`
+		}{
+			ID:      v.Organization.ID,
+			Name:    v.Organization.Name,
+			Website: v.Organization.Website,
+		}
+	}
+	if v.RoleHistory != nil {
+		res.RoleHistory = make([]*CommitteeMemberRoleChangeResponseBody, len(v.RoleHistory))
+		for i, val := range v.RoleHistory {
+			res.RoleHistory[i] = marshalCommitteeserviceCommitteeMemberRoleChangeToCommitteeMemberRoleChangeResponseBody(val)
+		}
+	}
+	if v.StatusHistory != nil {
+		res.StatusHistory = make([]*CommitteeMemberStatusChangeResponseBody, len(v.StatusHistory))
+		for i, val := range v.StatusHistory {
+			res.StatusHistory[i] = marshalCommitteeserviceCommitteeMemberStatusChangeToCommitteeMemberStatusChangeResponseBody(val)
+		}
+	}
+
+	return res
+}
+
+// marshalCommitteeserviceCommitteeMemberRoleChangeToCommitteeMemberRoleChangeResponseBody
+// builds a value of type *CommitteeMemberRoleChangeResponseBody from a value
+// of type *committeeservice.CommitteeMemberRoleChange.
+func marshalCommitteeserviceCommitteeMemberRoleChangeToCommitteeMemberRoleChangeResponseBody(v *committeeservice.CommitteeMemberRoleChange) *CommitteeMemberRoleChangeResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &CommitteeMemberRoleChangeResponseBody{
+		OldRole:   v.OldRole,
+		NewRole:   v.NewRole,
+		ChangedAt: v.ChangedAt,
+		Actor:     v.Actor,
+	}
+
+	return res
+}
+
+// marshalCommitteeserviceCommitteeMemberStatusChangeToCommitteeMemberStatusChangeResponseBody
+// builds a value of type *CommitteeMemberStatusChangeResponseBody from a value
+// of type *committeeservice.CommitteeMemberStatusChange.
+func marshalCommitteeserviceCommitteeMemberStatusChangeToCommitteeMemberStatusChangeResponseBody(v *committeeservice.CommitteeMemberStatusChange) *CommitteeMemberStatusChangeResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &CommitteeMemberStatusChangeResponseBody{
+		OldStatus: v.OldStatus,
+		NewStatus: v.NewStatus,
+		ChangedAt: v.ChangedAt,
+		Actor:     v.Actor,
+	}
+
+	return res
+}
+
+// marshalCommitteeserviceCommitteeBaseWithReadonlyAttributesToCommitteeBaseWithReadonlyAttributesResponseBody
+// builds a value of type *CommitteeBaseWithReadonlyAttributesResponseBody from
+// a value of type *committeeservice.CommitteeBaseWithReadonlyAttributes.
+func marshalCommitteeserviceCommitteeBaseWithReadonlyAttributesToCommitteeBaseWithReadonlyAttributesResponseBody(v *committeeservice.CommitteeBaseWithReadonlyAttributes) *CommitteeBaseWithReadonlyAttributesResponseBody {
+	res := &CommitteeBaseWithReadonlyAttributesResponseBody{
+		UID:              v.UID,
+		ProjectUID:       v.ProjectUID,
+		Name:             v.Name,
+		Category:         v.Category,
+		Description:      v.Description,
+		Website:          v.Website,
+		EnableVoting:     v.EnableVoting,
+		SsoGroupEnabled:  v.SsoGroupEnabled,
+		RequiresReview:   v.RequiresReview,
+		Public:           v.Public,
+		DisplayName:      v.DisplayName,
+		ParentUID:        v.ParentUID,
+		ProjectName:      v.ProjectName,
+		SsoGroupName:     v.SsoGroupName,
+		TotalMembers:     v.TotalMembers,
+		TotalVotingRepos: v.TotalVotingRepos,
+		IndexStatus:      v.IndexStatus,
+		LastIndexedAt:    v.LastIndexedAt,
+	}
+	{
+		var zero bool
+		if res.EnableVoting == zero {
+			res.EnableVoting = false
+		}
+	}
+	{
+		var zero bool
+		if res.SsoGroupEnabled == zero {
+			res.SsoGroupEnabled = false
+		}
+	}
+	{
+		var zero bool
+		if res.RequiresReview == zero {
+			res.RequiresReview = false
+		}
+	}
+	{
+		var zero bool
+		if res.Public == zero {
+			res.Public = false
+		}
+	}
+	if v.Calendar != nil {
+		res.Calendar = &struct {
+			// Whether the committee calendar is publicly visible
+			Public bool `form:"public" json:"public" xml:"public"`
+		}{
+			Public: v.Calendar.Public,
+		}
+		{
+			var zero bool
+			if res.Calendar.Public == zero {
+				res.Calendar.Public = false
+			}
+		}
+	}
+	if v.Labels != nil {
+		res.Labels = make(map[string]string, len(v.Labels))
+		for key, val := range v.Labels {
+			tk := key
+			tv := val
+			res.Labels[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// marshalCommitteeserviceCommitteeMemberReassignResultToCommitteeMemberReassignResultResponseBody
+// builds a value of type *CommitteeMemberReassignResultResponseBody from a
+// value of type *committeeservice.CommitteeMemberReassignResult.
+func marshalCommitteeserviceCommitteeMemberReassignResultToCommitteeMemberReassignResultResponseBody(v *committeeservice.CommitteeMemberReassignResult) *CommitteeMemberReassignResultResponseBody {
+	res := &CommitteeMemberReassignResultResponseBody{
+		UID:   v.UID,
+		Email: v.Email,
+		Error: v.Error,
+	}
+	if v.Member != nil {
+		res.Member = marshalCommitteeserviceCommitteeMemberFullWithReadonlyAttributesToCommitteeMemberFullWithReadonlyAttributesResponseBody(v.Member)
+	}
+
+	return res
+}
+
+// marshalCommitteeserviceCommitteeMemberRevisionToCommitteeMemberRevisionResponseBody
+// builds a value of type *CommitteeMemberRevisionResponseBody from a value of
+// type *committeeservice.CommitteeMemberRevision.
+func marshalCommitteeserviceCommitteeMemberRevisionToCommitteeMemberRevisionResponseBody(v *committeeservice.CommitteeMemberRevision) *CommitteeMemberRevisionResponseBody {
+	res := &CommitteeMemberRevisionResponseBody{
+		Revision:  v.Revision,
+		CreatedAt: v.CreatedAt,
+	}
+	if v.Member != nil {
+		res.Member = marshalCommitteeserviceCommitteeMemberFullWithReadonlyAttributesToCommitteeMemberFullWithReadonlyAttributesResponseBody(v.Member)
+	}
+
+	return res
+}
@@ -20,23 +20,29 @@ import (
 
 // Server lists the committee-service service endpoint HTTP handlers.
 type Server struct {
-	Mounts                  []*MountPoint
-	CreateCommittee         http.Handler
-	GetCommitteeBase        http.Handler
-	UpdateCommitteeBase     http.Handler
-	DeleteCommittee         http.Handler
-	GetCommitteeSettings    http.Handler
-	UpdateCommitteeSettings http.Handler
-	Readyz                  http.Handler
-	Livez                   http.Handler
-	CreateCommitteeMember   http.Handler
-	GetCommitteeMember      http.Handler
-	UpdateCommitteeMember   http.Handler
-	DeleteCommitteeMember   http.Handler
-	GenHTTPOpenapiJSON      http.Handler
-	GenHTTPOpenapiYaml      http.Handler
-	GenHTTPOpenapi3JSON     http.Handler
-	GenHTTPOpenapi3Yaml     http.Handler
+	Mounts                               []*MountPoint
+	CreateCommittee                      http.Handler
+	GetCommitteeBase                     http.Handler
+	GetCommitteeWithMembers              http.Handler
+	ListCommitteeChildren                http.Handler
+	UpdateCommitteeBase                  http.Handler
+	DeleteCommittee                      http.Handler
+	GetCommitteeSettings                 http.Handler
+	UpdateCommitteeSettings              http.Handler
+	Readyz                               http.Handler
+	Livez                                http.Handler
+	CreateCommitteeMember                http.Handler
+	ReassignCommitteeMembersOrganization http.Handler
+	ListCommitteeMembersByOrganization   http.Handler
+	GetCommitteeMember                   http.Handler
+	GetCommitteeMemberHistory            http.Handler
+	UpdateCommitteeMember                http.Handler
+	PatchCommitteeMember                 http.Handler
+	DeleteCommitteeMember                http.Handler
+	GenHTTPOpenapiJSON                   http.Handler
+	GenHTTPOpenapiYaml                   http.Handler
+	GenHTTPOpenapi3JSON                  http.Handler
+	GenHTTPOpenapi3Yaml                  http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -88,6 +94,8 @@ func New(
 		Mounts: []*MountPoint{
 			{"CreateCommittee", "POST", "/committees"},
 			{"GetCommitteeBase", "GET", "/committees/{uid}"},
+			{"GetCommitteeWithMembers", "GET", "/committees/{uid}/with-members"},
+			{"ListCommitteeChildren", "GET", "/committees/{uid}/children"},
 			{"UpdateCommitteeBase", "PUT", "/committees/{uid}"},
 			{"DeleteCommittee", "DELETE", "/committees/{uid}"},
 			{"GetCommitteeSettings", "GET", "/committees/{uid}/settings"},
@@ -95,30 +103,40 @@ func New(
 			{"Readyz", "GET", "/readyz"},
 			{"Livez", "GET", "/livez"},
 			{"CreateCommitteeMember", "POST", "/committees/{uid}/members"},
+			{"ReassignCommitteeMembersOrganization", "POST", "/committees/{uid}/members/reassign-organization"},
+			{"ListCommitteeMembersByOrganization", "GET", "/committees/{uid}/members-by-organization"},
 			{"GetCommitteeMember", "GET", "/committees/{uid}/members/{member_uid}"},
+			{"GetCommitteeMemberHistory", "GET", "/committees/{uid}/members/{member_uid}/history"},
 			{"UpdateCommitteeMember", "PUT", "/committees/{uid}/members/{member_uid}"},
+			{"PatchCommitteeMember", "PATCH", "/committees/{uid}/members/{member_uid}"},
 			{"DeleteCommitteeMember", "DELETE", "/committees/{uid}/members/{member_uid}"},
 			{"Serve gen/http/openapi.json", "GET", "/_committees/openapi.json"},
 			{"Serve gen/http/openapi.yaml", "GET", "/_committees/openapi.yaml"},
 			{"Serve gen/http/openapi3.json", "GET", "/_committees/openapi3.json"},
 			{"Serve gen/http/openapi3.yaml", "GET", "/_committees/openapi3.yaml"},
 		},
-		CreateCommittee:         NewCreateCommitteeHandler(e.CreateCommittee, mux, decoder, encoder, errhandler, formatter),
-		GetCommitteeBase:        NewGetCommitteeBaseHandler(e.GetCommitteeBase, mux, decoder, encoder, errhandler, formatter),
-		UpdateCommitteeBase:     NewUpdateCommitteeBaseHandler(e.UpdateCommitteeBase, mux, decoder, encoder, errhandler, formatter),
-		DeleteCommittee:         NewDeleteCommitteeHandler(e.DeleteCommittee, mux, decoder, encoder, errhandler, formatter),
-		GetCommitteeSettings:    NewGetCommitteeSettingsHandler(e.GetCommitteeSettings, mux, decoder, encoder, errhandler, formatter),
-		UpdateCommitteeSettings: NewUpdateCommitteeSettingsHandler(e.UpdateCommitteeSettings, mux, decoder, encoder, errhandler, formatter),
-		Readyz:                  NewReadyzHandler(e.Readyz, mux, decoder, encoder, errhandler, formatter),
-		Livez:                   NewLivezHandler(e.Livez, mux, decoder, encoder, errhandler, formatter),
-		CreateCommitteeMember:   NewCreateCommitteeMemberHandler(e.CreateCommitteeMember, mux, decoder, encoder, errhandler, formatter),
-		GetCommitteeMember:      NewGetCommitteeMemberHandler(e.GetCommitteeMember, mux, decoder, encoder, errhandler, formatter),
-		UpdateCommitteeMember:   NewUpdateCommitteeMemberHandler(e.UpdateCommitteeMember, mux, decoder, encoder, errhandler, formatter),
-		DeleteCommitteeMember:   NewDeleteCommitteeMemberHandler(e.DeleteCommitteeMember, mux, decoder, encoder, errhandler, formatter),
-		GenHTTPOpenapiJSON:      http.FileServer(fileSystemGenHTTPOpenapiJSON),
-		GenHTTPOpenapiYaml:      http.FileServer(fileSystemGenHTTPOpenapiYaml),
-		GenHTTPOpenapi3JSON:     http.FileServer(fileSystemGenHTTPOpenapi3JSON),
-		GenHTTPOpenapi3Yaml:     http.FileServer(fileSystemGenHTTPOpenapi3Yaml),
+		CreateCommittee:                      NewCreateCommitteeHandler(e.CreateCommittee, mux, decoder, encoder, errhandler, formatter),
+		GetCommitteeBase:                     NewGetCommitteeBaseHandler(e.GetCommitteeBase, mux, decoder, encoder, errhandler, formatter),
+		GetCommitteeWithMembers:              NewGetCommitteeWithMembersHandler(e.GetCommitteeWithMembers, mux, decoder, encoder, errhandler, formatter),
+		ListCommitteeChildren:                NewListCommitteeChildrenHandler(e.ListCommitteeChildren, mux, decoder, encoder, errhandler, formatter),
+		UpdateCommitteeBase:                  NewUpdateCommitteeBaseHandler(e.UpdateCommitteeBase, mux, decoder, encoder, errhandler, formatter),
+		DeleteCommittee:                      NewDeleteCommitteeHandler(e.DeleteCommittee, mux, decoder, encoder, errhandler, formatter),
+		GetCommitteeSettings:                 NewGetCommitteeSettingsHandler(e.GetCommitteeSettings, mux, decoder, encoder, errhandler, formatter),
+		UpdateCommitteeSettings:              NewUpdateCommitteeSettingsHandler(e.UpdateCommitteeSettings, mux, decoder, encoder, errhandler, formatter),
+		Readyz:                               NewReadyzHandler(e.Readyz, mux, decoder, encoder, errhandler, formatter),
+		Livez:                                NewLivezHandler(e.Livez, mux, decoder, encoder, errhandler, formatter),
+		CreateCommitteeMember:                NewCreateCommitteeMemberHandler(e.CreateCommitteeMember, mux, decoder, encoder, errhandler, formatter),
+		ReassignCommitteeMembersOrganization: NewReassignCommitteeMembersOrganizationHandler(e.ReassignCommitteeMembersOrganization, mux, decoder, encoder, errhandler, formatter),
+		ListCommitteeMembersByOrganization:   NewListCommitteeMembersByOrganizationHandler(e.ListCommitteeMembersByOrganization, mux, decoder, encoder, errhandler, formatter),
+		GetCommitteeMember:                   NewGetCommitteeMemberHandler(e.GetCommitteeMember, mux, decoder, encoder, errhandler, formatter),
+		GetCommitteeMemberHistory:            NewGetCommitteeMemberHistoryHandler(e.GetCommitteeMemberHistory, mux, decoder, encoder, errhandler, formatter),
+		UpdateCommitteeMember:                NewUpdateCommitteeMemberHandler(e.UpdateCommitteeMember, mux, decoder, encoder, errhandler, formatter),
+		PatchCommitteeMember:                 NewPatchCommitteeMemberHandler(e.PatchCommitteeMember, mux, decoder, encoder, errhandler, formatter),
+		DeleteCommitteeMember:                NewDeleteCommitteeMemberHandler(e.DeleteCommitteeMember, mux, decoder, encoder, errhandler, formatter),
+		GenHTTPOpenapiJSON:                   http.FileServer(fileSystemGenHTTPOpenapiJSON),
+		GenHTTPOpenapiYaml:                   http.FileServer(fileSystemGenHTTPOpenapiYaml),
+		GenHTTPOpenapi3JSON:                  http.FileServer(fileSystemGenHTTPOpenapi3JSON),
+		GenHTTPOpenapi3Yaml:                  http.FileServer(fileSystemGenHTTPOpenapi3Yaml),
 	}
 }
 
@@ -129,6 +147,8 @@ func (s *Server) Service() string { return "committee-service" }
 func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.CreateCommittee = m(s.CreateCommittee)
 	s.GetCommitteeBase = m(s.GetCommitteeBase)
+	s.GetCommitteeWithMembers = m(s.GetCommitteeWithMembers)
+	s.ListCommitteeChildren = m(s.ListCommitteeChildren)
 	s.UpdateCommitteeBase = m(s.UpdateCommitteeBase)
 	s.DeleteCommittee = m(s.DeleteCommittee)
 	s.GetCommitteeSettings = m(s.GetCommitteeSettings)
@@ -136,8 +156,12 @@ func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.Readyz = m(s.Readyz)
 	s.Livez = m(s.Livez)
 	s.CreateCommitteeMember = m(s.CreateCommitteeMember)
+	s.ReassignCommitteeMembersOrganization = m(s.ReassignCommitteeMembersOrganization)
+	s.ListCommitteeMembersByOrganization = m(s.ListCommitteeMembersByOrganization)
 	s.GetCommitteeMember = m(s.GetCommitteeMember)
+	s.GetCommitteeMemberHistory = m(s.GetCommitteeMemberHistory)
 	s.UpdateCommitteeMember = m(s.UpdateCommitteeMember)
+	s.PatchCommitteeMember = m(s.PatchCommitteeMember)
 	s.DeleteCommitteeMember = m(s.DeleteCommitteeMember)
 }
 
@@ -148,6 +172,8 @@ func (s *Server) MethodNames() []string { return committeeservice.MethodNames[:]
 func Mount(mux goahttp.Muxer, h *Server) {
 	MountCreateCommitteeHandler(mux, h.CreateCommittee)
 	MountGetCommitteeBaseHandler(mux, h.GetCommitteeBase)
+	MountGetCommitteeWithMembersHandler(mux, h.GetCommitteeWithMembers)
+	MountListCommitteeChildrenHandler(mux, h.ListCommitteeChildren)
 	MountUpdateCommitteeBaseHandler(mux, h.UpdateCommitteeBase)
 	MountDeleteCommitteeHandler(mux, h.DeleteCommittee)
 	MountGetCommitteeSettingsHandler(mux, h.GetCommitteeSettings)
@@ -155,8 +181,12 @@ func Mount(mux goahttp.Muxer, h *Server) {
 	MountReadyzHandler(mux, h.Readyz)
 	MountLivezHandler(mux, h.Livez)
 	MountCreateCommitteeMemberHandler(mux, h.CreateCommitteeMember)
+	MountReassignCommitteeMembersOrganizationHandler(mux, h.ReassignCommitteeMembersOrganization)
+	MountListCommitteeMembersByOrganizationHandler(mux, h.ListCommitteeMembersByOrganization)
 	MountGetCommitteeMemberHandler(mux, h.GetCommitteeMember)
+	MountGetCommitteeMemberHistoryHandler(mux, h.GetCommitteeMemberHistory)
 	MountUpdateCommitteeMemberHandler(mux, h.UpdateCommitteeMember)
+	MountPatchCommitteeMemberHandler(mux, h.PatchCommitteeMember)
 	MountDeleteCommitteeMemberHandler(mux, h.DeleteCommitteeMember)
 	MountGenHTTPOpenapiJSON(mux, http.StripPrefix("/_committees", h.GenHTTPOpenapiJSON))
 	MountGenHTTPOpenapiYaml(mux, http.StripPrefix("/_committees", h.GenHTTPOpenapiYaml))
@@ -277,6 +307,114 @@ func NewGetCommitteeBaseHandler(
 	})
 }
 
+// MountGetCommitteeWithMembersHandler configures the mux to serve the
+// "committee-service" service "get-committee-with-members" endpoint.
+func MountGetCommitteeWithMembersHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/committees/{uid}/with-members", f)
+}
+
+// NewGetCommitteeWithMembersHandler creates a HTTP handler which loads the
+// HTTP request and calls the "committee-service" service
+// "get-committee-with-members" endpoint.
+func NewGetCommitteeWithMembersHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetCommitteeWithMembersRequest(mux, decoder)
+		encodeResponse = EncodeGetCommitteeWithMembersResponse(encoder)
+		encodeError    = EncodeGetCommitteeWithMembersError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-committee-with-members")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "committee-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListCommitteeChildrenHandler configures the mux to serve the
+// "committee-service" service "list-committee-children" endpoint.
+func MountListCommitteeChildrenHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/committees/{uid}/children", f)
+}
+
+// NewListCommitteeChildrenHandler creates a HTTP handler which loads the HTTP
+// request and calls the "committee-service" service "list-committee-children"
+// endpoint.
+func NewListCommitteeChildrenHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListCommitteeChildrenRequest(mux, decoder)
+		encodeResponse = EncodeListCommitteeChildrenResponse(encoder)
+		encodeError    = EncodeListCommitteeChildrenError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list-committee-children")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "committee-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountUpdateCommitteeBaseHandler configures the mux to serve the
 // "committee-service" service "update-committee-base" endpoint.
 func MountUpdateCommitteeBaseHandler(mux goahttp.Muxer, h http.Handler) {
@@ -639,6 +777,116 @@ func NewCreateCommitteeMemberHandler(
 	})
 }
 
+// MountReassignCommitteeMembersOrganizationHandler configures the mux to serve
+// the "committee-service" service "reassign-committee-members-organization"
+// endpoint.
+func MountReassignCommitteeMembersOrganizationHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/committees/{uid}/members/reassign-organization", f)
+}
+
+// NewReassignCommitteeMembersOrganizationHandler creates a HTTP handler which
+// loads the HTTP request and calls the "committee-service" service
+// "reassign-committee-members-organization" endpoint.
+func NewReassignCommitteeMembersOrganizationHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeReassignCommitteeMembersOrganizationRequest(mux, decoder)
+		encodeResponse = EncodeReassignCommitteeMembersOrganizationResponse(encoder)
+		encodeError    = EncodeReassignCommitteeMembersOrganizationError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "reassign-committee-members-organization")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "committee-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListCommitteeMembersByOrganizationHandler configures the mux to serve
+// the "committee-service" service "list-committee-members-by-organization"
+// endpoint.
+func MountListCommitteeMembersByOrganizationHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/committees/{uid}/members-by-organization", f)
+}
+
+// NewListCommitteeMembersByOrganizationHandler creates a HTTP handler which
+// loads the HTTP request and calls the "committee-service" service
+// "list-committee-members-by-organization" endpoint.
+func NewListCommitteeMembersByOrganizationHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListCommitteeMembersByOrganizationRequest(mux, decoder)
+		encodeResponse = EncodeListCommitteeMembersByOrganizationResponse(encoder)
+		encodeError    = EncodeListCommitteeMembersByOrganizationError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list-committee-members-by-organization")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "committee-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountGetCommitteeMemberHandler configures the mux to serve the
 // "committee-service" service "get-committee-member" endpoint.
 func MountGetCommitteeMemberHandler(mux goahttp.Muxer, h http.Handler) {
@@ -693,6 +941,60 @@ func NewGetCommitteeMemberHandler(
 	})
 }
 
+// MountGetCommitteeMemberHistoryHandler configures the mux to serve the
+// "committee-service" service "get-committee-member-history" endpoint.
+func MountGetCommitteeMemberHistoryHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/committees/{uid}/members/{member_uid}/history", f)
+}
+
+// NewGetCommitteeMemberHistoryHandler creates a HTTP handler which loads the
+// HTTP request and calls the "committee-service" service
+// "get-committee-member-history" endpoint.
+func NewGetCommitteeMemberHistoryHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetCommitteeMemberHistoryRequest(mux, decoder)
+		encodeResponse = EncodeGetCommitteeMemberHistoryResponse(encoder)
+		encodeError    = EncodeGetCommitteeMemberHistoryError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-committee-member-history")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "committee-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountUpdateCommitteeMemberHandler configures the mux to serve the
 // "committee-service" service "update-committee-member" endpoint.
 func MountUpdateCommitteeMemberHandler(mux goahttp.Muxer, h http.Handler) {
@@ -747,6 +1049,60 @@ func NewUpdateCommitteeMemberHandler(
 	})
 }
 
+// MountPatchCommitteeMemberHandler configures the mux to serve the
+// "committee-service" service "patch-committee-member" endpoint.
+func MountPatchCommitteeMemberHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PATCH", "/committees/{uid}/members/{member_uid}", f)
+}
+
+// NewPatchCommitteeMemberHandler creates a HTTP handler which loads the HTTP
+// request and calls the "committee-service" service "patch-committee-member"
+// endpoint.
+func NewPatchCommitteeMemberHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodePatchCommitteeMemberRequest(mux, decoder)
+		encodeResponse = EncodePatchCommitteeMemberResponse(encoder)
+		encodeError    = EncodePatchCommitteeMemberError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "patch-committee-member")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "committee-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountDeleteCommitteeMemberHandler configures the mux to serve the
 // "committee-service" service "delete-committee-member" endpoint.
 func MountDeleteCommitteeMemberHandler(mux goahttp.Muxer, h http.Handler) {
@@ -47,8 +47,13 @@ type CreateCommitteeRequestBody struct {
 	// The UID of the parent committee -- v2 uid, not related to v1 id directly,
 	// should be empty if there is none
 	ParentUID *string `form:"parent_uid,omitempty" json:"parent_uid,omitempty" xml:"parent_uid,omitempty"`
+	// Arbitrary key/value labels used to filter committees
+	Labels map[string]string `form:"labels,omitempty" json:"labels,omitempty" xml:"labels,omitempty"`
 	// Whether business email is required for committee members
 	BusinessEmailRequired *bool `form:"business_email_required,omitempty" json:"business_email_required,omitempty" xml:"business_email_required,omitempty"`
+	// Email domains allowed for committee members, overriding the business email
+	// requirement when not empty
+	AllowedEmailDomains []string `form:"allowed_email_domains,omitempty" json:"allowed_email_domains,omitempty" xml:"allowed_email_domains,omitempty"`
 	// The timestamp when the committee was last reviewed in RFC3339 format
 	LastReviewedAt *string `form:"last_reviewed_at,omitempty" json:"last_reviewed_at,omitempty" xml:"last_reviewed_at,omitempty"`
 	// The user ID who last reviewed this committee
@@ -97,6 +102,8 @@ type UpdateCommitteeBaseRequestBody struct {
 	// The UID of the parent committee -- v2 uid, not related to v1 id directly,
 	// should be empty if there is none
 	ParentUID *string `form:"parent_uid,omitempty" json:"parent_uid,omitempty" xml:"parent_uid,omitempty"`
+	// Arbitrary key/value labels used to filter committees
+	Labels map[string]string `form:"labels,omitempty" json:"labels,omitempty" xml:"labels,omitempty"`
 }
 
 // UpdateCommitteeSettingsRequestBody is the type of the "committee-service"
@@ -104,6 +111,9 @@ type UpdateCommitteeBaseRequestBody struct {
 type UpdateCommitteeSettingsRequestBody struct {
 	// Whether business email is required for committee members
 	BusinessEmailRequired *bool `form:"business_email_required,omitempty" json:"business_email_required,omitempty" xml:"business_email_required,omitempty"`
+	// Email domains allowed for committee members, overriding the business email
+	// requirement when not empty
+	AllowedEmailDomains []string `form:"allowed_email_domains,omitempty" json:"allowed_email_domains,omitempty" xml:"allowed_email_domains,omitempty"`
 	// The timestamp when the committee was last reviewed in RFC3339 format
 	LastReviewedAt *string `form:"last_reviewed_at,omitempty" json:"last_reviewed_at,omitempty" xml:"last_reviewed_at,omitempty"`
 	// The user ID who last reviewed this committee
@@ -156,7 +166,11 @@ type CreateCommitteeMemberRequestBody struct {
 		StartDate *string `form:"start_date" json:"start_date" xml:"start_date"`
 		// Voting end date
 		EndDate *string `form:"end_date" json:"end_date" xml:"end_date"`
+		// Voting weight for weighted voting committees, defaults to 1
+		Weight *float64 `form:"weight" json:"weight" xml:"weight"`
 	} `form:"voting,omitempty" json:"voting,omitempty" xml:"voting,omitempty"`
+	// UID of the member of the same committee this member is the alternate of
+	AlternateForUID *string `form:"alternate_for_uid,omitempty" json:"alternate_for_uid,omitempty" xml:"alternate_for_uid,omitempty"`
 	// Organization information for the committee member
 	Organization *struct {
 		// Organization ID
@@ -168,9 +182,21 @@ type CreateCommitteeMemberRequestBody struct {
 	} `form:"organization,omitempty" json:"organization,omitempty" xml:"organization,omitempty"`
 }
 
+// ReassignCommitteeMembersOrganizationRequestBody is the type of the
+// "committee-service" service "reassign-committee-members-organization"
+// endpoint HTTP request body.
+type ReassignCommitteeMembersOrganizationRequestBody struct {
+	// The name of the organization the members are moved from
+	FromOrganization *string `form:"from_organization,omitempty" json:"from_organization,omitempty" xml:"from_organization,omitempty"`
+	// The name of the organization the members are moved to, empty to clear it
+	ToOrganization *string `form:"to_organization,omitempty" json:"to_organization,omitempty" xml:"to_organization,omitempty"`
+}
+
 // UpdateCommitteeMemberRequestBody is the type of the "committee-service"
 // service "update-committee-member" endpoint HTTP request body.
 type UpdateCommitteeMemberRequestBody struct {
+	// Committee UID -- v2 uid, not related to v1 id directly
+	CommitteeUID *string `form:"committee_uid,omitempty" json:"committee_uid,omitempty" xml:"committee_uid,omitempty"`
 	// User's LF ID
 	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
 	// Primary email address
@@ -204,7 +230,11 @@ type UpdateCommitteeMemberRequestBody struct {
 		StartDate *string `form:"start_date" json:"start_date" xml:"start_date"`
 		// Voting end date
 		EndDate *string `form:"end_date" json:"end_date" xml:"end_date"`
+		// Voting weight for weighted voting committees, defaults to 1
+		Weight *float64 `form:"weight" json:"weight" xml:"weight"`
 	} `form:"voting,omitempty" json:"voting,omitempty" xml:"voting,omitempty"`
+	// UID of the member of the same committee this member is the alternate of
+	AlternateForUID *string `form:"alternate_for_uid,omitempty" json:"alternate_for_uid,omitempty" xml:"alternate_for_uid,omitempty"`
 	// Organization information for the committee member
 	Organization *struct {
 		// Organization ID
@@ -216,6 +246,60 @@ type UpdateCommitteeMemberRequestBody struct {
 	} `form:"organization,omitempty" json:"organization,omitempty" xml:"organization,omitempty"`
 }
 
+// PatchCommitteeMemberRequestBody is the type of the "committee-service"
+// service "patch-committee-member" endpoint HTTP request body.
+type PatchCommitteeMemberRequestBody struct {
+	// User's LF ID
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+	// Primary email address
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// First name
+	FirstName *string `form:"first_name,omitempty" json:"first_name,omitempty" xml:"first_name,omitempty"`
+	// Last name
+	LastName *string `form:"last_name,omitempty" json:"last_name,omitempty" xml:"last_name,omitempty"`
+	// Job title at organization
+	JobTitle *string `form:"job_title,omitempty" json:"job_title,omitempty" xml:"job_title,omitempty"`
+	// LinkedIn profile URL
+	LinkedinProfile *string `form:"linkedin_profile,omitempty" json:"linkedin_profile,omitempty" xml:"linkedin_profile,omitempty"`
+	// Committee role information, omitted fields are left unchanged
+	Role *struct {
+		// Committee role name
+		Name *string `form:"name" json:"name" xml:"name"`
+		// Role start date
+		StartDate *string `form:"start_date" json:"start_date" xml:"start_date"`
+		// Role end date
+		EndDate *string `form:"end_date" json:"end_date" xml:"end_date"`
+	} `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
+	// How the member was appointed
+	AppointedBy *string `form:"appointed_by,omitempty" json:"appointed_by,omitempty" xml:"appointed_by,omitempty"`
+	// Member status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Voting information for the committee member, omitted fields are left
+	// unchanged
+	Voting *struct {
+		// Voting status
+		Status *string `form:"status" json:"status" xml:"status"`
+		// Voting start date
+		StartDate *string `form:"start_date" json:"start_date" xml:"start_date"`
+		// Voting end date
+		EndDate *string `form:"end_date" json:"end_date" xml:"end_date"`
+		// Voting weight for weighted voting committees, defaults to 1
+		Weight *float64 `form:"weight" json:"weight" xml:"weight"`
+	} `form:"voting,omitempty" json:"voting,omitempty" xml:"voting,omitempty"`
+	// UID of the member of the same committee this member is the alternate of
+	AlternateForUID *string `form:"alternate_for_uid,omitempty" json:"alternate_for_uid,omitempty" xml:"alternate_for_uid,omitempty"`
+	// Organization information for the committee member, omitted fields are left
+	// unchanged
+	Organization *struct {
+		// Organization ID
+		ID *string `form:"id" json:"id" xml:"id"`
+		// Organization name
+		Name *string `form:"name" json:"name" xml:"name"`
+		// Organization website URL
+		Website *string `form:"website" json:"website" xml:"website"`
+	} `form:"organization,omitempty" json:"organization,omitempty" xml:"organization,omitempty"`
+}
+
 // CreateCommitteeResponseBody is the type of the "committee-service" service
 // "create-committee" endpoint HTTP response body.
 type CreateCommitteeResponseBody struct {
@@ -250,14 +334,25 @@ type CreateCommitteeResponseBody struct {
 	// The UID of the parent committee -- v2 uid, not related to v1 id directly,
 	// should be empty if there is none
 	ParentUID *string `form:"parent_uid,omitempty" json:"parent_uid,omitempty" xml:"parent_uid,omitempty"`
+	// Arbitrary key/value labels used to filter committees
+	Labels map[string]string `form:"labels,omitempty" json:"labels,omitempty" xml:"labels,omitempty"`
 	// The name of the SSO group - read-only
 	SsoGroupName *string `form:"sso_group_name,omitempty" json:"sso_group_name,omitempty" xml:"sso_group_name,omitempty"`
 	// The total number of members in this committee
 	TotalMembers *int `form:"total_members,omitempty" json:"total_members,omitempty" xml:"total_members,omitempty"`
 	// The total number of repositories with voting permissions for this committee
 	TotalVotingRepos *int `form:"total_voting_repos,omitempty" json:"total_voting_repos,omitempty" xml:"total_voting_repos,omitempty"`
+	// Whether the committee search record reflects the latest changes, pending
+	// means a reindex is needed (read-only)
+	IndexStatus *string `form:"index_status,omitempty" json:"index_status,omitempty" xml:"index_status,omitempty"`
+	// The timestamp when the committee was last published to the search index
+	// (read-only)
+	LastIndexedAt *string `form:"last_indexed_at,omitempty" json:"last_indexed_at,omitempty" xml:"last_indexed_at,omitempty"`
 	// Whether business email is required for committee members
 	BusinessEmailRequired bool `form:"business_email_required" json:"business_email_required" xml:"business_email_required"`
+	// Email domains allowed for committee members, overriding the business email
+	// requirement when not empty
+	AllowedEmailDomains []string `form:"allowed_email_domains,omitempty" json:"allowed_email_domains,omitempty" xml:"allowed_email_domains,omitempty"`
 	// The timestamp when the committee was last reviewed in RFC3339 format
 	LastReviewedAt *string `form:"last_reviewed_at,omitempty" json:"last_reviewed_at,omitempty" xml:"last_reviewed_at,omitempty"`
 	// The user ID who last reviewed this committee
@@ -278,6 +373,28 @@ type CreateCommitteeResponseBody struct {
 // "get-committee-base" endpoint HTTP response body.
 type GetCommitteeBaseResponseBody CommitteeBaseWithReadonlyAttributesResponseBody
 
+// GetCommitteeWithMembersResponseBody is the type of the "committee-service"
+// service "get-committee-with-members" endpoint HTTP response body.
+type GetCommitteeWithMembersResponseBody struct {
+	// Committee base and settings
+	Committee *CommitteeFullWithReadonlyAttributesResponseBody `form:"committee" json:"committee" xml:"committee"`
+	// Committee members of the page, ordered by last name and first name
+	Members []*CommitteeMemberFullWithReadonlyAttributesResponseBody `form:"members" json:"members" xml:"members"`
+	// Number of committee members across every page
+	TotalMembers int `form:"total_members" json:"total_members" xml:"total_members"`
+	// Token of the next page, absent on the last page
+	NextPageToken *string `form:"next_page_token,omitempty" json:"next_page_token,omitempty" xml:"next_page_token,omitempty"`
+}
+
+// ListCommitteeChildrenResponseBody is the type of the "committee-service"
+// service "list-committee-children" endpoint HTTP response body.
+type ListCommitteeChildrenResponseBody struct {
+	// Direct sub-committees, ordered by name
+	Children []*CommitteeBaseWithReadonlyAttributesResponseBody `form:"children" json:"children" xml:"children"`
+	// Token of the next page, absent on the last page
+	NextPageToken *string `form:"next_page_token,omitempty" json:"next_page_token,omitempty" xml:"next_page_token,omitempty"`
+}
+
 // UpdateCommitteeBaseResponseBody is the type of the "committee-service"
 // service "update-committee-base" endpoint HTTP response body.
 type UpdateCommitteeBaseResponseBody struct {
@@ -312,6 +429,8 @@ type UpdateCommitteeBaseResponseBody struct {
 	// The UID of the parent committee -- v2 uid, not related to v1 id directly,
 	// should be empty if there is none
 	ParentUID *string `form:"parent_uid,omitempty" json:"parent_uid,omitempty" xml:"parent_uid,omitempty"`
+	// Arbitrary key/value labels used to filter committees
+	Labels map[string]string `form:"labels,omitempty" json:"labels,omitempty" xml:"labels,omitempty"`
 	// The name of the project this committee belongs to
 	ProjectName *string `form:"project_name,omitempty" json:"project_name,omitempty" xml:"project_name,omitempty"`
 	// The name of the SSO group - read-only
@@ -320,6 +439,12 @@ type UpdateCommitteeBaseResponseBody struct {
 	TotalMembers *int `form:"total_members,omitempty" json:"total_members,omitempty" xml:"total_members,omitempty"`
 	// The total number of repositories with voting permissions for this committee
 	TotalVotingRepos *int `form:"total_voting_repos,omitempty" json:"total_voting_repos,omitempty" xml:"total_voting_repos,omitempty"`
+	// Whether the committee search record reflects the latest changes, pending
+	// means a reindex is needed (read-only)
+	IndexStatus *string `form:"index_status,omitempty" json:"index_status,omitempty" xml:"index_status,omitempty"`
+	// The timestamp when the committee was last published to the search index
+	// (read-only)
+	LastIndexedAt *string `form:"last_indexed_at,omitempty" json:"last_indexed_at,omitempty" xml:"last_indexed_at,omitempty"`
 }
 
 // GetCommitteeSettingsResponseBody is the type of the "committee-service"
@@ -333,6 +458,9 @@ type UpdateCommitteeSettingsResponseBody struct {
 	UID *string `form:"uid,omitempty" json:"uid,omitempty" xml:"uid,omitempty"`
 	// Whether business email is required for committee members
 	BusinessEmailRequired bool `form:"business_email_required" json:"business_email_required" xml:"business_email_required"`
+	// Email domains allowed for committee members, overriding the business email
+	// requirement when not empty
+	AllowedEmailDomains []string `form:"allowed_email_domains,omitempty" json:"allowed_email_domains,omitempty" xml:"allowed_email_domains,omitempty"`
 	// The timestamp when the committee was last reviewed in RFC3339 format
 	LastReviewedAt *string `form:"last_reviewed_at,omitempty" json:"last_reviewed_at,omitempty" xml:"last_reviewed_at,omitempty"`
 	// The user ID who last reviewed this committee
@@ -393,7 +521,11 @@ type CreateCommitteeMemberResponseBody struct {
 		StartDate *string `form:"start_date" json:"start_date" xml:"start_date"`
 		// Voting end date
 		EndDate *string `form:"end_date" json:"end_date" xml:"end_date"`
+		// Voting weight for weighted voting committees, defaults to 1
+		Weight *float64 `form:"weight" json:"weight" xml:"weight"`
 	} `form:"voting,omitempty" json:"voting,omitempty" xml:"voting,omitempty"`
+	// UID of the member of the same committee this member is the alternate of
+	AlternateForUID *string `form:"alternate_for_uid,omitempty" json:"alternate_for_uid,omitempty" xml:"alternate_for_uid,omitempty"`
 	// Organization information for the committee member
 	Organization *struct {
 		// Organization ID
@@ -407,12 +539,39 @@ type CreateCommitteeMemberResponseBody struct {
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
 	// The timestamp when the resource was last updated (read-only)
 	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// The changes of the member role, oldest first (read-only)
+	RoleHistory []*CommitteeMemberRoleChangeResponseBody `form:"role_history,omitempty" json:"role_history,omitempty" xml:"role_history,omitempty"`
+	// The changes of the member status, oldest first (read-only)
+	StatusHistory []*CommitteeMemberStatusChangeResponseBody `form:"status_history,omitempty" json:"status_history,omitempty" xml:"status_history,omitempty"`
+}
+
+// ReassignCommitteeMembersOrganizationResponseBody is the type of the
+// "committee-service" service "reassign-committee-members-organization"
+// endpoint HTTP response body.
+type ReassignCommitteeMembersOrganizationResponseBody struct {
+	// The outcome for each member of the organization
+	Results []*CommitteeMemberReassignResultResponseBody `form:"results" json:"results" xml:"results"`
+}
+
+// ListCommitteeMembersByOrganizationResponseBody is the type of the
+// "committee-service" service "list-committee-members-by-organization"
+// endpoint HTTP response body.
+type ListCommitteeMembersByOrganizationResponseBody struct {
+	// Committee members of the organization, ordered by last name and first name
+	Members []*CommitteeMemberFullWithReadonlyAttributesResponseBody `form:"members" json:"members" xml:"members"`
 }
 
 // GetCommitteeMemberResponseBody is the type of the "committee-service"
 // service "get-committee-member" endpoint HTTP response body.
 type GetCommitteeMemberResponseBody CommitteeMemberFullWithReadonlyAttributesResponseBody
 
+// GetCommitteeMemberHistoryResponseBody is the type of the "committee-service"
+// service "get-committee-member-history" endpoint HTTP response body.
+type GetCommitteeMemberHistoryResponseBody struct {
+	// Revisions of the committee member, newest first
+	Revisions []*CommitteeMemberRevisionResponseBody `form:"revisions" json:"revisions" xml:"revisions"`
+}
+
 // UpdateCommitteeMemberResponseBody is the type of the "committee-service"
 // service "update-committee-member" endpoint HTTP response body.
 type UpdateCommitteeMemberResponseBody struct {
@@ -457,7 +616,11 @@ type UpdateCommitteeMemberResponseBody struct {
 		StartDate *string `form:"start_date" json:"start_date" xml:"start_date"`
 		// Voting end date
 		EndDate *string `form:"end_date" json:"end_date" xml:"end_date"`
+		// Voting weight for weighted voting committees, defaults to 1
+		Weight *float64 `form:"weight" json:"weight" xml:"weight"`
 	} `form:"voting,omitempty" json:"voting,omitempty" xml:"voting,omitempty"`
+	// UID of the member of the same committee this member is the alternate of
+	AlternateForUID *string `form:"alternate_for_uid,omitempty" json:"alternate_for_uid,omitempty" xml:"alternate_for_uid,omitempty"`
 	// Organization information for the committee member
 	Organization *struct {
 		// Organization ID
@@ -471,6 +634,78 @@ type UpdateCommitteeMemberResponseBody struct {
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
 	// The timestamp when the resource was last updated (read-only)
 	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// The changes of the member role, oldest first (read-only)
+	RoleHistory []*CommitteeMemberRoleChangeResponseBody `form:"role_history,omitempty" json:"role_history,omitempty" xml:"role_history,omitempty"`
+	// The changes of the member status, oldest first (read-only)
+	StatusHistory []*CommitteeMemberStatusChangeResponseBody `form:"status_history,omitempty" json:"status_history,omitempty" xml:"status_history,omitempty"`
+}
+
+// PatchCommitteeMemberResponseBody is the type of the "committee-service"
+// service "patch-committee-member" endpoint HTTP response body.
+type PatchCommitteeMemberResponseBody struct {
+	// Committee member UID -- v2 uid, not related to v1 id directly
+	UID *string `form:"uid,omitempty" json:"uid,omitempty" xml:"uid,omitempty"`
+	// Committee UID -- v2 uid, not related to v1 id directly
+	CommitteeUID *string `form:"committee_uid,omitempty" json:"committee_uid,omitempty" xml:"committee_uid,omitempty"`
+	// The name of the committee this member belongs to
+	CommitteeName *string `form:"committee_name,omitempty" json:"committee_name,omitempty" xml:"committee_name,omitempty"`
+	// The category of the committee this member belongs to
+	CommitteeCategory *string `form:"committee_category,omitempty" json:"committee_category,omitempty" xml:"committee_category,omitempty"`
+	// User's LF ID
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+	// Primary email address
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// First name
+	FirstName *string `form:"first_name,omitempty" json:"first_name,omitempty" xml:"first_name,omitempty"`
+	// Last name
+	LastName *string `form:"last_name,omitempty" json:"last_name,omitempty" xml:"last_name,omitempty"`
+	// Job title at organization
+	JobTitle *string `form:"job_title,omitempty" json:"job_title,omitempty" xml:"job_title,omitempty"`
+	// LinkedIn profile URL
+	LinkedinProfile *string `form:"linkedin_profile,omitempty" json:"linkedin_profile,omitempty" xml:"linkedin_profile,omitempty"`
+	// Committee role information
+	Role *struct {
+		// Committee role name
+		Name string `form:"name" json:"name" xml:"name"`
+		// Role start date
+		StartDate *string `form:"start_date" json:"start_date" xml:"start_date"`
+		// Role end date
+		EndDate *string `form:"end_date" json:"end_date" xml:"end_date"`
+	} `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
+	// How the member was appointed
+	AppointedBy string `form:"appointed_by" json:"appointed_by" xml:"appointed_by"`
+	// Member status
+	Status string `form:"status" json:"status" xml:"status"`
+	// Voting information for the committee member
+	Voting *struct {
+		// Voting status
+		Status string `form:"status" json:"status" xml:"status"`
+		// Voting start date
+		StartDate *string `form:"start_date" json:"start_date" xml:"start_date"`
+		// Voting end date
+		EndDate *string `form:"end_date" json:"end_date" xml:"end_date"`
+		// Voting weight for weighted voting committees, defaults to 1
+		Weight *float64 `form:"weight" json:"weight" xml:"weight"`
+	} `form:"voting,omitempty" json:"voting,omitempty" xml:"voting,omitempty"`
+	// UID of the member of the same committee this member is the alternate of
+	AlternateForUID *string `form:"alternate_for_uid,omitempty" json:"alternate_for_uid,omitempty" xml:"alternate_for_uid,omitempty"`
+	// Organization information for the committee member
+	Organization *struct {
+		// Organization ID
+		ID *string `form:"id" json:"id" xml:"id"`
+		// Organization name
+		Name *string `form:"name" json:"name" xml:"name"`
+		// Organization website URL
+		Website *string `form:"website" json:"website" xml:"website"`
+	} `form:"organization,omitempty" json:"organization,omitempty" xml:"organization,omitempty"`
+	// The timestamp when the resource was created (read-only)
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// The timestamp when the resource was last updated (read-only)
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// The changes of the member role, oldest first (read-only)
+	RoleHistory []*CommitteeMemberRoleChangeResponseBody `form:"role_history,omitempty" json:"role_history,omitempty" xml:"role_history,omitempty"`
+	// The changes of the member status, oldest first (read-only)
+	StatusHistory []*CommitteeMemberStatusChangeResponseBody `form:"status_history,omitempty" json:"status_history,omitempty" xml:"status_history,omitempty"`
 }
 
 // CreateCommitteeBadRequestResponseBody is the type of the "committee-service"
@@ -537,6 +772,70 @@ type GetCommitteeBaseServiceUnavailableResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
+// GetCommitteeWithMembersBadRequestResponseBody is the type of the
+// "committee-service" service "get-committee-with-members" endpoint HTTP
+// response body for the "BadRequest" error.
+type GetCommitteeWithMembersBadRequestResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetCommitteeWithMembersInternalServerErrorResponseBody is the type of the
+// "committee-service" service "get-committee-with-members" endpoint HTTP
+// response body for the "InternalServerError" error.
+type GetCommitteeWithMembersInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetCommitteeWithMembersNotFoundResponseBody is the type of the
+// "committee-service" service "get-committee-with-members" endpoint HTTP
+// response body for the "NotFound" error.
+type GetCommitteeWithMembersNotFoundResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetCommitteeWithMembersServiceUnavailableResponseBody is the type of the
+// "committee-service" service "get-committee-with-members" endpoint HTTP
+// response body for the "ServiceUnavailable" error.
+type GetCommitteeWithMembersServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListCommitteeChildrenBadRequestResponseBody is the type of the
+// "committee-service" service "list-committee-children" endpoint HTTP response
+// body for the "BadRequest" error.
+type ListCommitteeChildrenBadRequestResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListCommitteeChildrenInternalServerErrorResponseBody is the type of the
+// "committee-service" service "list-committee-children" endpoint HTTP response
+// body for the "InternalServerError" error.
+type ListCommitteeChildrenInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListCommitteeChildrenNotFoundResponseBody is the type of the
+// "committee-service" service "list-committee-children" endpoint HTTP response
+// body for the "NotFound" error.
+type ListCommitteeChildrenNotFoundResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListCommitteeChildrenServiceUnavailableResponseBody is the type of the
+// "committee-service" service "list-committee-children" endpoint HTTP response
+// body for the "ServiceUnavailable" error.
+type ListCommitteeChildrenServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
 // UpdateCommitteeBaseBadRequestResponseBody is the type of the
 // "committee-service" service "update-committee-base" endpoint HTTP response
 // body for the "BadRequest" error.
@@ -729,6 +1028,73 @@ type CreateCommitteeMemberServiceUnavailableResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
+// ReassignCommitteeMembersOrganizationBadRequestResponseBody is the type of
+// the "committee-service" service "reassign-committee-members-organization"
+// endpoint HTTP response body for the "BadRequest" error.
+type ReassignCommitteeMembersOrganizationBadRequestResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ReassignCommitteeMembersOrganizationInternalServerErrorResponseBody is the
+// type of the "committee-service" service
+// "reassign-committee-members-organization" endpoint HTTP response body for
+// the "InternalServerError" error.
+type ReassignCommitteeMembersOrganizationInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ReassignCommitteeMembersOrganizationNotFoundResponseBody is the type of the
+// "committee-service" service "reassign-committee-members-organization"
+// endpoint HTTP response body for the "NotFound" error.
+type ReassignCommitteeMembersOrganizationNotFoundResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ReassignCommitteeMembersOrganizationServiceUnavailableResponseBody is the
+// type of the "committee-service" service
+// "reassign-committee-members-organization" endpoint HTTP response body for
+// the "ServiceUnavailable" error.
+type ReassignCommitteeMembersOrganizationServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListCommitteeMembersByOrganizationBadRequestResponseBody is the type of the
+// "committee-service" service "list-committee-members-by-organization"
+// endpoint HTTP response body for the "BadRequest" error.
+type ListCommitteeMembersByOrganizationBadRequestResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListCommitteeMembersByOrganizationInternalServerErrorResponseBody is the
+// type of the "committee-service" service
+// "list-committee-members-by-organization" endpoint HTTP response body for the
+// "InternalServerError" error.
+type ListCommitteeMembersByOrganizationInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListCommitteeMembersByOrganizationNotFoundResponseBody is the type of the
+// "committee-service" service "list-committee-members-by-organization"
+// endpoint HTTP response body for the "NotFound" error.
+type ListCommitteeMembersByOrganizationNotFoundResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListCommitteeMembersByOrganizationServiceUnavailableResponseBody is the type
+// of the "committee-service" service "list-committee-members-by-organization"
+// endpoint HTTP response body for the "ServiceUnavailable" error.
+type ListCommitteeMembersByOrganizationServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
 // GetCommitteeMemberBadRequestResponseBody is the type of the
 // "committee-service" service "get-committee-member" endpoint HTTP response
 // body for the "BadRequest" error.
@@ -761,6 +1127,38 @@ type GetCommitteeMemberServiceUnavailableResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
+// GetCommitteeMemberHistoryBadRequestResponseBody is the type of the
+// "committee-service" service "get-committee-member-history" endpoint HTTP
+// response body for the "BadRequest" error.
+type GetCommitteeMemberHistoryBadRequestResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetCommitteeMemberHistoryInternalServerErrorResponseBody is the type of the
+// "committee-service" service "get-committee-member-history" endpoint HTTP
+// response body for the "InternalServerError" error.
+type GetCommitteeMemberHistoryInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetCommitteeMemberHistoryNotFoundResponseBody is the type of the
+// "committee-service" service "get-committee-member-history" endpoint HTTP
+// response body for the "NotFound" error.
+type GetCommitteeMemberHistoryNotFoundResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetCommitteeMemberHistoryServiceUnavailableResponseBody is the type of the
+// "committee-service" service "get-committee-member-history" endpoint HTTP
+// response body for the "ServiceUnavailable" error.
+type GetCommitteeMemberHistoryServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
 // UpdateCommitteeMemberBadRequestResponseBody is the type of the
 // "committee-service" service "update-committee-member" endpoint HTTP response
 // body for the "BadRequest" error.
@@ -801,6 +1199,46 @@ type UpdateCommitteeMemberServiceUnavailableResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
+// PatchCommitteeMemberBadRequestResponseBody is the type of the
+// "committee-service" service "patch-committee-member" endpoint HTTP response
+// body for the "BadRequest" error.
+type PatchCommitteeMemberBadRequestResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// PatchCommitteeMemberConflictResponseBody is the type of the
+// "committee-service" service "patch-committee-member" endpoint HTTP response
+// body for the "Conflict" error.
+type PatchCommitteeMemberConflictResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// PatchCommitteeMemberInternalServerErrorResponseBody is the type of the
+// "committee-service" service "patch-committee-member" endpoint HTTP response
+// body for the "InternalServerError" error.
+type PatchCommitteeMemberInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// PatchCommitteeMemberNotFoundResponseBody is the type of the
+// "committee-service" service "patch-committee-member" endpoint HTTP response
+// body for the "NotFound" error.
+type PatchCommitteeMemberNotFoundResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// PatchCommitteeMemberServiceUnavailableResponseBody is the type of the
+// "committee-service" service "patch-committee-member" endpoint HTTP response
+// body for the "ServiceUnavailable" error.
+type PatchCommitteeMemberServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
 // DeleteCommitteeMemberBadRequestResponseBody is the type of the
 // "committee-service" service "delete-committee-member" endpoint HTTP response
 // body for the "BadRequest" error.
@@ -875,6 +1313,8 @@ type CommitteeBaseWithReadonlyAttributesResponseBody struct {
 	// The UID of the parent committee -- v2 uid, not related to v1 id directly,
 	// should be empty if there is none
 	ParentUID *string `form:"parent_uid,omitempty" json:"parent_uid,omitempty" xml:"parent_uid,omitempty"`
+	// Arbitrary key/value labels used to filter committees
+	Labels map[string]string `form:"labels,omitempty" json:"labels,omitempty" xml:"labels,omitempty"`
 	// The name of the project this committee belongs to
 	ProjectName *string `form:"project_name,omitempty" json:"project_name,omitempty" xml:"project_name,omitempty"`
 	// The name of the SSO group - read-only
@@ -883,15 +1323,67 @@ type CommitteeBaseWithReadonlyAttributesResponseBody struct {
 	TotalMembers *int `form:"total_members,omitempty" json:"total_members,omitempty" xml:"total_members,omitempty"`
 	// The total number of repositories with voting permissions for this committee
 	TotalVotingRepos *int `form:"total_voting_repos,omitempty" json:"total_voting_repos,omitempty" xml:"total_voting_repos,omitempty"`
+	// Whether the committee search record reflects the latest changes, pending
+	// means a reindex is needed (read-only)
+	IndexStatus *string `form:"index_status,omitempty" json:"index_status,omitempty" xml:"index_status,omitempty"`
+	// The timestamp when the committee was last published to the search index
+	// (read-only)
+	LastIndexedAt *string `form:"last_indexed_at,omitempty" json:"last_indexed_at,omitempty" xml:"last_indexed_at,omitempty"`
 }
 
-// CommitteeSettingsWithReadonlyAttributesResponseBody is used to define fields
-// on response body types.
-type CommitteeSettingsWithReadonlyAttributesResponseBody struct {
+// CommitteeFullWithReadonlyAttributesResponseBody is used to define fields on
+// response body types.
+type CommitteeFullWithReadonlyAttributesResponseBody struct {
 	// Committee UID -- v2 uid, not related to v1 id directly
 	UID *string `form:"uid,omitempty" json:"uid,omitempty" xml:"uid,omitempty"`
+	// Project UID this committee belongs to -- v2 uid, not related to v1 id
+	// directly
+	ProjectUID *string `form:"project_uid,omitempty" json:"project_uid,omitempty" xml:"project_uid,omitempty"`
+	// The name of the committee
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// The category of the committee
+	Category *string `form:"category,omitempty" json:"category,omitempty" xml:"category,omitempty"`
+	// The description of the committee
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// The website URL of the committee
+	Website *string `form:"website,omitempty" json:"website,omitempty" xml:"website,omitempty"`
+	// Whether voting is enabled for this committee
+	EnableVoting bool `form:"enable_voting" json:"enable_voting" xml:"enable_voting"`
+	// Whether SSO group integration is enabled
+	SsoGroupEnabled bool `form:"sso_group_enabled" json:"sso_group_enabled" xml:"sso_group_enabled"`
+	// Whether this committee is expected to be reviewed
+	RequiresReview bool `form:"requires_review" json:"requires_review" xml:"requires_review"`
+	// General committee visibility/access permissions
+	Public bool `form:"public" json:"public" xml:"public"`
+	// Settings related to the committee calendar
+	Calendar *struct {
+		// Whether the committee calendar is publicly visible
+		Public bool `form:"public" json:"public" xml:"public"`
+	} `form:"calendar,omitempty" json:"calendar,omitempty" xml:"calendar,omitempty"`
+	// The display name of the committee
+	DisplayName *string `form:"display_name,omitempty" json:"display_name,omitempty" xml:"display_name,omitempty"`
+	// The UID of the parent committee -- v2 uid, not related to v1 id directly,
+	// should be empty if there is none
+	ParentUID *string `form:"parent_uid,omitempty" json:"parent_uid,omitempty" xml:"parent_uid,omitempty"`
+	// Arbitrary key/value labels used to filter committees
+	Labels map[string]string `form:"labels,omitempty" json:"labels,omitempty" xml:"labels,omitempty"`
+	// The name of the SSO group - read-only
+	SsoGroupName *string `form:"sso_group_name,omitempty" json:"sso_group_name,omitempty" xml:"sso_group_name,omitempty"`
+	// The total number of members in this committee
+	TotalMembers *int `form:"total_members,omitempty" json:"total_members,omitempty" xml:"total_members,omitempty"`
+	// The total number of repositories with voting permissions for this committee
+	TotalVotingRepos *int `form:"total_voting_repos,omitempty" json:"total_voting_repos,omitempty" xml:"total_voting_repos,omitempty"`
+	// Whether the committee search record reflects the latest changes, pending
+	// means a reindex is needed (read-only)
+	IndexStatus *string `form:"index_status,omitempty" json:"index_status,omitempty" xml:"index_status,omitempty"`
+	// The timestamp when the committee was last published to the search index
+	// (read-only)
+	LastIndexedAt *string `form:"last_indexed_at,omitempty" json:"last_indexed_at,omitempty" xml:"last_indexed_at,omitempty"`
 	// Whether business email is required for committee members
 	BusinessEmailRequired bool `form:"business_email_required" json:"business_email_required" xml:"business_email_required"`
+	// Email domains allowed for committee members, overriding the business email
+	// requirement when not empty
+	AllowedEmailDomains []string `form:"allowed_email_domains,omitempty" json:"allowed_email_domains,omitempty" xml:"allowed_email_domains,omitempty"`
 	// The timestamp when the committee was last reviewed in RFC3339 format
 	LastReviewedAt *string `form:"last_reviewed_at,omitempty" json:"last_reviewed_at,omitempty" xml:"last_reviewed_at,omitempty"`
 	// The user ID who last reviewed this committee
@@ -902,10 +1394,10 @@ type CommitteeSettingsWithReadonlyAttributesResponseBody struct {
 	// Determines the default show_meeting_attendees setting on meetings this
 	// committee is connected to
 	ShowMeetingAttendees bool `form:"show_meeting_attendees" json:"show_meeting_attendees" xml:"show_meeting_attendees"`
-	// The timestamp when the resource was created (read-only)
-	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
-	// The timestamp when the resource was last updated (read-only)
-	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Manager user IDs who can edit/modify this committee
+	Writers []string `form:"writers,omitempty" json:"writers,omitempty" xml:"writers,omitempty"`
+	// Auditor user IDs who can audit this committee
+	Auditors []string `form:"auditors,omitempty" json:"auditors,omitempty" xml:"auditors,omitempty"`
 }
 
 // CommitteeMemberFullWithReadonlyAttributesResponseBody is used to define
@@ -952,7 +1444,11 @@ type CommitteeMemberFullWithReadonlyAttributesResponseBody struct {
 		StartDate *string `form:"start_date" json:"start_date" xml:"start_date"`
 		// Voting end date
 		EndDate *string `form:"end_date" json:"end_date" xml:"end_date"`
+		// Voting weight for weighted voting committees, defaults to 1
+		Weight *float64 `form:"weight" json:"weight" xml:"weight"`
 	} `form:"voting,omitempty" json:"voting,omitempty" xml:"voting,omitempty"`
+	// UID of the member of the same committee this member is the alternate of
+	AlternateForUID *string `form:"alternate_for_uid,omitempty" json:"alternate_for_uid,omitempty" xml:"alternate_for_uid,omitempty"`
 	// Organization information for the committee member
 	Organization *struct {
 		// Organization ID
@@ -966,6 +1462,86 @@ type CommitteeMemberFullWithReadonlyAttributesResponseBody struct {
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
 	// The timestamp when the resource was last updated (read-only)
 	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// The changes of the member role, oldest first (read-only)
+	RoleHistory []*CommitteeMemberRoleChangeResponseBody `form:"role_history,omitempty" json:"role_history,omitempty" xml:"role_history,omitempty"`
+	// The changes of the member status, oldest first (read-only)
+	StatusHistory []*CommitteeMemberStatusChangeResponseBody `form:"status_history,omitempty" json:"status_history,omitempty" xml:"status_history,omitempty"`
+}
+
+// CommitteeMemberRoleChangeResponseBody is used to define fields on response
+// body types.
+type CommitteeMemberRoleChangeResponseBody struct {
+	// The role before the change
+	OldRole string `form:"old_role" json:"old_role" xml:"old_role"`
+	// The role after the change
+	NewRole string `form:"new_role" json:"new_role" xml:"new_role"`
+	// The timestamp when the role changed
+	ChangedAt string `form:"changed_at" json:"changed_at" xml:"changed_at"`
+	// The principal who changed the role
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty" xml:"actor,omitempty"`
+}
+
+// CommitteeMemberStatusChangeResponseBody is used to define fields on response
+// body types.
+type CommitteeMemberStatusChangeResponseBody struct {
+	// The status before the change
+	OldStatus string `form:"old_status" json:"old_status" xml:"old_status"`
+	// The status after the change
+	NewStatus string `form:"new_status" json:"new_status" xml:"new_status"`
+	// The timestamp when the status changed
+	ChangedAt string `form:"changed_at" json:"changed_at" xml:"changed_at"`
+	// The principal who changed the status
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty" xml:"actor,omitempty"`
+}
+
+// CommitteeSettingsWithReadonlyAttributesResponseBody is used to define fields
+// on response body types.
+type CommitteeSettingsWithReadonlyAttributesResponseBody struct {
+	// Committee UID -- v2 uid, not related to v1 id directly
+	UID *string `form:"uid,omitempty" json:"uid,omitempty" xml:"uid,omitempty"`
+	// Whether business email is required for committee members
+	BusinessEmailRequired bool `form:"business_email_required" json:"business_email_required" xml:"business_email_required"`
+	// Email domains allowed for committee members, overriding the business email
+	// requirement when not empty
+	AllowedEmailDomains []string `form:"allowed_email_domains,omitempty" json:"allowed_email_domains,omitempty" xml:"allowed_email_domains,omitempty"`
+	// The timestamp when the committee was last reviewed in RFC3339 format
+	LastReviewedAt *string `form:"last_reviewed_at,omitempty" json:"last_reviewed_at,omitempty" xml:"last_reviewed_at,omitempty"`
+	// The user ID who last reviewed this committee
+	LastReviewedBy *string `form:"last_reviewed_by,omitempty" json:"last_reviewed_by,omitempty" xml:"last_reviewed_by,omitempty"`
+	// Dertermines the visibility level of members profiles to other members of the
+	// same committee
+	MemberVisibility string `form:"member_visibility" json:"member_visibility" xml:"member_visibility"`
+	// Determines the default show_meeting_attendees setting on meetings this
+	// committee is connected to
+	ShowMeetingAttendees bool `form:"show_meeting_attendees" json:"show_meeting_attendees" xml:"show_meeting_attendees"`
+	// The timestamp when the resource was created (read-only)
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// The timestamp when the resource was last updated (read-only)
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// CommitteeMemberReassignResultResponseBody is used to define fields on
+// response body types.
+type CommitteeMemberReassignResultResponseBody struct {
+	// Committee member UID -- v2 uid, not related to v1 id directly
+	UID string `form:"uid" json:"uid" xml:"uid"`
+	// Primary email address
+	Email string `form:"email" json:"email" xml:"email"`
+	// The updated member, omitted when the reassignment failed
+	Member *CommitteeMemberFullWithReadonlyAttributesResponseBody `form:"member,omitempty" json:"member,omitempty" xml:"member,omitempty"`
+	// The reason the member could not be reassigned
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// CommitteeMemberRevisionResponseBody is used to define fields on response
+// body types.
+type CommitteeMemberRevisionResponseBody struct {
+	// The revision number of the member record
+	Revision uint64 `form:"revision" json:"revision" xml:"revision"`
+	// The timestamp when the revision was stored
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// The committee member as stored at the revision
+	Member *CommitteeMemberFullWithReadonlyAttributesResponseBody `form:"member" json:"member" xml:"member"`
 }
 
 // NewCreateCommitteeResponseBody builds the HTTP response body from the result
@@ -987,6 +1563,8 @@ func NewCreateCommitteeResponseBody(res *committeeservice.CommitteeFullWithReado
 		SsoGroupName:          res.SsoGroupName,
 		TotalMembers:          res.TotalMembers,
 		TotalVotingRepos:      res.TotalVotingRepos,
+		IndexStatus:           res.IndexStatus,
+		LastIndexedAt:         res.LastIndexedAt,
 		BusinessEmailRequired: res.BusinessEmailRequired,
 		LastReviewedAt:        res.LastReviewedAt,
 		LastReviewedBy:        res.LastReviewedBy,
@@ -1031,12 +1609,26 @@ func NewCreateCommitteeResponseBody(res *committeeservice.CommitteeFullWithReado
 			}
 		}
 	}
+	if res.Labels != nil {
+		body.Labels = make(map[string]string, len(res.Labels))
+		for key, val := range res.Labels {
+			tk := key
+			tv := val
+			body.Labels[tk] = tv
+		}
+	}
 	{
 		var zero bool
 		if body.BusinessEmailRequired == zero {
 			body.BusinessEmailRequired = false
 		}
 	}
+	if res.AllowedEmailDomains != nil {
+		body.AllowedEmailDomains = make([]string, len(res.AllowedEmailDomains))
+		for i, val := range res.AllowedEmailDomains {
+			body.AllowedEmailDomains[i] = val
+		}
+	}
 	{
 		var zero string
 		if body.MemberVisibility == zero {
@@ -1085,6 +1677,8 @@ func NewGetCommitteeBaseResponseBody(res *committeeservice.GetCommitteeBaseResul
 		SsoGroupName:     res.CommitteeBase.SsoGroupName,
 		TotalMembers:     res.CommitteeBase.TotalMembers,
 		TotalVotingRepos: res.CommitteeBase.TotalVotingRepos,
+		IndexStatus:      res.CommitteeBase.IndexStatus,
+		LastIndexedAt:    res.CommitteeBase.LastIndexedAt,
 	}
 	{
 		var zero bool
@@ -1124,6 +1718,54 @@ func NewGetCommitteeBaseResponseBody(res *committeeservice.GetCommitteeBaseResul
 			}
 		}
 	}
+	if res.CommitteeBase.Labels != nil {
+		body.Labels = make(map[string]string, len(res.CommitteeBase.Labels))
+		for key, val := range res.CommitteeBase.Labels {
+			tk := key
+			tv := val
+			body.Labels[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetCommitteeWithMembersResponseBody builds the HTTP response body from
+// the result of the "get-committee-with-members" endpoint of the
+// "committee-service" service.
+func NewGetCommitteeWithMembersResponseBody(res *committeeservice.GetCommitteeWithMembersResult) *GetCommitteeWithMembersResponseBody {
+	body := &GetCommitteeWithMembersResponseBody{
+		TotalMembers:  res.TotalMembers,
+		NextPageToken: res.NextPageToken,
+	}
+	if res.Committee != nil {
+		body.Committee = marshalCommitteeserviceCommitteeFullWithReadonlyAttributesToCommitteeFullWithReadonlyAttributesResponseBody(res.Committee)
+	}
+	if res.Members != nil {
+		body.Members = make([]*CommitteeMemberFullWithReadonlyAttributesResponseBody, len(res.Members))
+		for i, val := range res.Members {
+			body.Members[i] = marshalCommitteeserviceCommitteeMemberFullWithReadonlyAttributesToCommitteeMemberFullWithReadonlyAttributesResponseBody(val)
+		}
+	} else {
+		body.Members = []*CommitteeMemberFullWithReadonlyAttributesResponseBody{}
+	}
+	return body
+}
+
+// NewListCommitteeChildrenResponseBody builds the HTTP response body from the
+// result of the "list-committee-children" endpoint of the "committee-service"
+// service.
+func NewListCommitteeChildrenResponseBody(res *committeeservice.ListCommitteeChildrenResult) *ListCommitteeChildrenResponseBody {
+	body := &ListCommitteeChildrenResponseBody{
+		NextPageToken: res.NextPageToken,
+	}
+	if res.Children != nil {
+		body.Children = make([]*CommitteeBaseWithReadonlyAttributesResponseBody, len(res.Children))
+		for i, val := range res.Children {
+			body.Children[i] = marshalCommitteeserviceCommitteeBaseWithReadonlyAttributesToCommitteeBaseWithReadonlyAttributesResponseBody(val)
+		}
+	} else {
+		body.Children = []*CommitteeBaseWithReadonlyAttributesResponseBody{}
+	}
 	return body
 }
 
@@ -1148,6 +1790,8 @@ func NewUpdateCommitteeBaseResponseBody(res *committeeservice.CommitteeBaseWithR
 		SsoGroupName:     res.SsoGroupName,
 		TotalMembers:     res.TotalMembers,
 		TotalVotingRepos: res.TotalVotingRepos,
+		IndexStatus:      res.IndexStatus,
+		LastIndexedAt:    res.LastIndexedAt,
 	}
 	{
 		var zero bool
@@ -1187,6 +1831,14 @@ func NewUpdateCommitteeBaseResponseBody(res *committeeservice.CommitteeBaseWithR
 			}
 		}
 	}
+	if res.Labels != nil {
+		body.Labels = make(map[string]string, len(res.Labels))
+		for key, val := range res.Labels {
+			tk := key
+			tv := val
+			body.Labels[tk] = tv
+		}
+	}
 	return body
 }
 
@@ -1210,6 +1862,12 @@ func NewGetCommitteeSettingsResponseBody(res *committeeservice.GetCommitteeSetti
 			body.BusinessEmailRequired = false
 		}
 	}
+	if res.CommitteeSettings.AllowedEmailDomains != nil {
+		body.AllowedEmailDomains = make([]string, len(res.CommitteeSettings.AllowedEmailDomains))
+		for i, val := range res.CommitteeSettings.AllowedEmailDomains {
+			body.AllowedEmailDomains[i] = val
+		}
+	}
 	{
 		var zero string
 		if body.MemberVisibility == zero {
@@ -1245,6 +1903,12 @@ func NewUpdateCommitteeSettingsResponseBody(res *committeeservice.CommitteeSetti
 			body.BusinessEmailRequired = false
 		}
 	}
+	if res.AllowedEmailDomains != nil {
+		body.AllowedEmailDomains = make([]string, len(res.AllowedEmailDomains))
+		for i, val := range res.AllowedEmailDomains {
+			body.AllowedEmailDomains[i] = val
+		}
+	}
 	{
 		var zero string
 		if body.MemberVisibility == zero {
@@ -1277,6 +1941,7 @@ func NewCreateCommitteeMemberResponseBody(res *committeeservice.CommitteeMemberF
 		LinkedinProfile:   res.LinkedinProfile,
 		AppointedBy:       res.AppointedBy,
 		Status:            res.Status,
+		AlternateForUID:   res.AlternateForUID,
 		CreatedAt:         res.CreatedAt,
 		UpdatedAt:         res.UpdatedAt,
 	}
@@ -1320,10 +1985,13 @@ func NewCreateCommitteeMemberResponseBody(res *committeeservice.CommitteeMemberF
 			StartDate *string `form:"start_date" json:"start_date" xml:"start_date"`
 			// Voting end date
 			EndDate *string `form:"end_date" json:"end_date" xml:"end_date"`
+			// Voting weight for weighted voting committees, defaults to 1
+			Weight *float64 `form:"weight" json:"weight" xml:"weight"`
 		}{
 			Status:    res.Voting.Status,
 			StartDate: res.Voting.StartDate,
 			EndDate:   res.Voting.EndDate,
+			Weight:    res.Voting.Weight,
 		}
 		{
 			var zero string
@@ -1346,6 +2014,50 @@ func NewCreateCommitteeMemberResponseBody(res *committeeservice.CommitteeMemberF
 			Website: res.Organization.Website,
 		}
 	}
+	if res.RoleHistory != nil {
+		body.RoleHistory = make([]*CommitteeMemberRoleChangeResponseBody, len(res.RoleHistory))
+		for i, val := range res.RoleHistory {
+			body.RoleHistory[i] = marshalCommitteeserviceCommitteeMemberRoleChangeToCommitteeMemberRoleChangeResponseBody(val)
+		}
+	}
+	if res.StatusHistory != nil {
+		body.StatusHistory = make([]*CommitteeMemberStatusChangeResponseBody, len(res.StatusHistory))
+		for i, val := range res.StatusHistory {
+			body.StatusHistory[i] = marshalCommitteeserviceCommitteeMemberStatusChangeToCommitteeMemberStatusChangeResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewReassignCommitteeMembersOrganizationResponseBody builds the HTTP response
+// body from the result of the "reassign-committee-members-organization"
+// endpoint of the "committee-service" service.
+func NewReassignCommitteeMembersOrganizationResponseBody(res *committeeservice.ReassignCommitteeMembersOrganizationResult) *ReassignCommitteeMembersOrganizationResponseBody {
+	body := &ReassignCommitteeMembersOrganizationResponseBody{}
+	if res.Results != nil {
+		body.Results = make([]*CommitteeMemberReassignResultResponseBody, len(res.Results))
+		for i, val := range res.Results {
+			body.Results[i] = marshalCommitteeserviceCommitteeMemberReassignResultToCommitteeMemberReassignResultResponseBody(val)
+		}
+	} else {
+		body.Results = []*CommitteeMemberReassignResultResponseBody{}
+	}
+	return body
+}
+
+// NewListCommitteeMembersByOrganizationResponseBody builds the HTTP response
+// body from the result of the "list-committee-members-by-organization"
+// endpoint of the "committee-service" service.
+func NewListCommitteeMembersByOrganizationResponseBody(res *committeeservice.ListCommitteeMembersByOrganizationResult) *ListCommitteeMembersByOrganizationResponseBody {
+	body := &ListCommitteeMembersByOrganizationResponseBody{}
+	if res.Members != nil {
+		body.Members = make([]*CommitteeMemberFullWithReadonlyAttributesResponseBody, len(res.Members))
+		for i, val := range res.Members {
+			body.Members[i] = marshalCommitteeserviceCommitteeMemberFullWithReadonlyAttributesToCommitteeMemberFullWithReadonlyAttributesResponseBody(val)
+		}
+	} else {
+		body.Members = []*CommitteeMemberFullWithReadonlyAttributesResponseBody{}
+	}
 	return body
 }
 
@@ -1366,6 +2078,7 @@ func NewGetCommitteeMemberResponseBody(res *committeeservice.GetCommitteeMemberR
 		LinkedinProfile:   res.Member.LinkedinProfile,
 		AppointedBy:       res.Member.AppointedBy,
 		Status:            res.Member.Status,
+		AlternateForUID:   res.Member.AlternateForUID,
 		CreatedAt:         res.Member.CreatedAt,
 		UpdatedAt:         res.Member.UpdatedAt,
 	}
@@ -1409,10 +2122,13 @@ func NewGetCommitteeMemberResponseBody(res *committeeservice.GetCommitteeMemberR
 			StartDate *string `form:"start_date" json:"start_date" xml:"start_date"`
 			// Voting end date
 			EndDate *string `form:"end_date" json:"end_date" xml:"end_date"`
+			// Voting weight for weighted voting committees, defaults to 1
+			Weight *float64 `form:"weight" json:"weight" xml:"weight"`
 		}{
 			Status:    res.Member.Voting.Status,
 			StartDate: res.Member.Voting.StartDate,
 			EndDate:   res.Member.Voting.EndDate,
+			Weight:    res.Member.Voting.Weight,
 		}
 		{
 			var zero string
@@ -1435,6 +2151,34 @@ func NewGetCommitteeMemberResponseBody(res *committeeservice.GetCommitteeMemberR
 			Website: res.Member.Organization.Website,
 		}
 	}
+	if res.Member.RoleHistory != nil {
+		body.RoleHistory = make([]*CommitteeMemberRoleChangeResponseBody, len(res.Member.RoleHistory))
+		for i, val := range res.Member.RoleHistory {
+			body.RoleHistory[i] = marshalCommitteeserviceCommitteeMemberRoleChangeToCommitteeMemberRoleChangeResponseBody(val)
+		}
+	}
+	if res.Member.StatusHistory != nil {
+		body.StatusHistory = make([]*CommitteeMemberStatusChangeResponseBody, len(res.Member.StatusHistory))
+		for i, val := range res.Member.StatusHistory {
+			body.StatusHistory[i] = marshalCommitteeserviceCommitteeMemberStatusChangeToCommitteeMemberStatusChangeResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewGetCommitteeMemberHistoryResponseBody builds the HTTP response body from
+// the result of the "get-committee-member-history" endpoint of the
+// "committee-service" service.
+func NewGetCommitteeMemberHistoryResponseBody(res *committeeservice.GetCommitteeMemberHistoryResult) *GetCommitteeMemberHistoryResponseBody {
+	body := &GetCommitteeMemberHistoryResponseBody{}
+	if res.Revisions != nil {
+		body.Revisions = make([]*CommitteeMemberRevisionResponseBody, len(res.Revisions))
+		for i, val := range res.Revisions {
+			body.Revisions[i] = marshalCommitteeserviceCommitteeMemberRevisionToCommitteeMemberRevisionResponseBody(val)
+		}
+	} else {
+		body.Revisions = []*CommitteeMemberRevisionResponseBody{}
+	}
 	return body
 }
 
@@ -1455,6 +2199,112 @@ func NewUpdateCommitteeMemberResponseBody(res *committeeservice.CommitteeMemberF
 		LinkedinProfile:   res.LinkedinProfile,
 		AppointedBy:       res.AppointedBy,
 		Status:            res.Status,
+		AlternateForUID:   res.AlternateForUID,
+		CreatedAt:         res.CreatedAt,
+		UpdatedAt:         res.UpdatedAt,
+	}
+	if res.Role != nil {
+		body.Role = &struct {
+			// Committee role name
+			Name string `form:"name" json:"name" xml:"name"`
+			// Role start date
+			StartDate *string `form:"start_date" json:"start_date" xml:"start_date"`
+			// Role end date
+			EndDate *string `form:"end_date" json:"end_date" xml:"end_date"`
+		}{
+			Name:      res.Role.Name,
+			StartDate: res.Role.StartDate,
+			EndDate:   res.Role.EndDate,
+		}
+		{
+			var zero string
+			if body.Role.Name == zero {
+				body.Role.Name = "None"
+			}
+		}
+	}
+	{
+		var zero string
+		if body.AppointedBy == zero {
+			body.AppointedBy = "None"
+		}
+	}
+	{
+		var zero string
+		if body.Status == zero {
+			body.Status = "Active"
+		}
+	}
+	if res.Voting != nil {
+		body.Voting = &struct {
+			// Voting status
+			Status string `form:"status" json:"status" xml:"status"`
+			// Voting start date
+			StartDate *string `form:"start_date" json:"start_date" xml:"start_date"`
+			// Voting end date
+			EndDate *string `form:"end_date" json:"end_date" xml:"end_date"`
+			// Voting weight for weighted voting committees, defaults to 1
+			Weight *float64 `form:"weight" json:"weight" xml:"weight"`
+		}{
+			Status:    res.Voting.Status,
+			StartDate: res.Voting.StartDate,
+			EndDate:   res.Voting.EndDate,
+			Weight:    res.Voting.Weight,
+		}
+		{
+			var zero string
+			if body.Voting.Status == zero {
+				body.Voting.Status = "None"
+			}
+		}
+	}
+	if res.Organization != nil {
+		body.Organization = &struct {
+			// Organization ID
+			ID *string `form:"id" json:"id" xml:"id"`
+			// Organization name
+			Name *string `form:"name" json:"name" xml:"name"`
+			// Organization website URL
+			Website *string `form:"website" json:"website" xml:"website"`
+		}{
+			ID:      res.Organization.ID,
+			Name:    res.Organization.Name,
+			Website: res.Organization.Website,
+		}
+	}
+	if res.RoleHistory != nil {
+		body.RoleHistory = make([]*CommitteeMemberRoleChangeResponseBody, len(res.RoleHistory))
+		for i, val := range res.RoleHistory {
+			body.RoleHistory[i] = marshalCommitteeserviceCommitteeMemberRoleChangeToCommitteeMemberRoleChangeResponseBody(val)
+		}
+	}
+	if res.StatusHistory != nil {
+		body.StatusHistory = make([]*CommitteeMemberStatusChangeResponseBody, len(res.StatusHistory))
+		for i, val := range res.StatusHistory {
+			body.StatusHistory[i] = marshalCommitteeserviceCommitteeMemberStatusChangeToCommitteeMemberStatusChangeResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewPatchCommitteeMemberResponseBody builds the HTTP response body from the
+// result of the "patch-committee-member" endpoint of the "committee-service"
+// service.
+func NewPatchCommitteeMemberResponseBody(res *committeeservice.CommitteeMemberFullWithReadonlyAttributes) *PatchCommitteeMemberResponseBody {
+	body := &PatchCommitteeMemberResponseBody{
+		UID:               res.UID,
+		CommitteeUID:      res.CommitteeUID,
+		CommitteeName:     res.CommitteeName,
+		CommitteeCategory: res.CommitteeCategory,
+		Username:          res.Username,
+		Email:             res.Email,
+		FirstName:         res.FirstName,
+		LastName:          res.LastName,
+		JobTitle:          res.JobTitle,
+		LinkedinProfile:   res.LinkedinProfile,
+		AppointedBy:       res.AppointedBy,
+		Status:            res.Status,
+		AlternateForUID:   res.AlternateForUID,
 		CreatedAt:         res.CreatedAt,
 		UpdatedAt:         res.UpdatedAt,
 	}
@@ -1498,10 +2348,13 @@ func NewUpdateCommitteeMemberResponseBody(res *committeeservice.CommitteeMemberF
 			StartDate *string `form:"start_date" json:"start_date" xml:"start_date"`
 			// Voting end date
 			EndDate *string `form:"end_date" json:"end_date" xml:"end_date"`
+			// Voting weight for weighted voting committees, defaults to 1
+			Weight *float64 `form:"weight" json:"weight" xml:"weight"`
 		}{
 			Status:    res.Voting.Status,
 			StartDate: res.Voting.StartDate,
 			EndDate:   res.Voting.EndDate,
+			Weight:    res.Voting.Weight,
 		}
 		{
 			var zero string
@@ -1524,6 +2377,18 @@ func NewUpdateCommitteeMemberResponseBody(res *committeeservice.CommitteeMemberF
 			Website: res.Organization.Website,
 		}
 	}
+	if res.RoleHistory != nil {
+		body.RoleHistory = make([]*CommitteeMemberRoleChangeResponseBody, len(res.RoleHistory))
+		for i, val := range res.RoleHistory {
+			body.RoleHistory[i] = marshalCommitteeserviceCommitteeMemberRoleChangeToCommitteeMemberRoleChangeResponseBody(val)
+		}
+	}
+	if res.StatusHistory != nil {
+		body.StatusHistory = make([]*CommitteeMemberStatusChangeResponseBody, len(res.StatusHistory))
+		for i, val := range res.StatusHistory {
+			body.StatusHistory[i] = marshalCommitteeserviceCommitteeMemberStatusChangeToCommitteeMemberStatusChangeResponseBody(val)
+		}
+	}
 	return body
 }
 
@@ -1607,6 +2472,86 @@ func NewGetCommitteeBaseServiceUnavailableResponseBody(res *committeeservice.Ser
 	return body
 }
 
+// NewGetCommitteeWithMembersBadRequestResponseBody builds the HTTP response
+// body from the result of the "get-committee-with-members" endpoint of the
+// "committee-service" service.
+func NewGetCommitteeWithMembersBadRequestResponseBody(res *committeeservice.BadRequestError) *GetCommitteeWithMembersBadRequestResponseBody {
+	body := &GetCommitteeWithMembersBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetCommitteeWithMembersInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "get-committee-with-members" endpoint
+// of the "committee-service" service.
+func NewGetCommitteeWithMembersInternalServerErrorResponseBody(res *committeeservice.InternalServerError) *GetCommitteeWithMembersInternalServerErrorResponseBody {
+	body := &GetCommitteeWithMembersInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetCommitteeWithMembersNotFoundResponseBody builds the HTTP response body
+// from the result of the "get-committee-with-members" endpoint of the
+// "committee-service" service.
+func NewGetCommitteeWithMembersNotFoundResponseBody(res *committeeservice.NotFoundError) *GetCommitteeWithMembersNotFoundResponseBody {
+	body := &GetCommitteeWithMembersNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetCommitteeWithMembersServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "get-committee-with-members" endpoint
+// of the "committee-service" service.
+func NewGetCommitteeWithMembersServiceUnavailableResponseBody(res *committeeservice.ServiceUnavailableError) *GetCommitteeWithMembersServiceUnavailableResponseBody {
+	body := &GetCommitteeWithMembersServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListCommitteeChildrenBadRequestResponseBody builds the HTTP response body
+// from the result of the "list-committee-children" endpoint of the
+// "committee-service" service.
+func NewListCommitteeChildrenBadRequestResponseBody(res *committeeservice.BadRequestError) *ListCommitteeChildrenBadRequestResponseBody {
+	body := &ListCommitteeChildrenBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListCommitteeChildrenInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "list-committee-children" endpoint of
+// the "committee-service" service.
+func NewListCommitteeChildrenInternalServerErrorResponseBody(res *committeeservice.InternalServerError) *ListCommitteeChildrenInternalServerErrorResponseBody {
+	body := &ListCommitteeChildrenInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListCommitteeChildrenNotFoundResponseBody builds the HTTP response body
+// from the result of the "list-committee-children" endpoint of the
+// "committee-service" service.
+func NewListCommitteeChildrenNotFoundResponseBody(res *committeeservice.NotFoundError) *ListCommitteeChildrenNotFoundResponseBody {
+	body := &ListCommitteeChildrenNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListCommitteeChildrenServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "list-committee-children" endpoint of
+// the "committee-service" service.
+func NewListCommitteeChildrenServiceUnavailableResponseBody(res *committeeservice.ServiceUnavailableError) *ListCommitteeChildrenServiceUnavailableResponseBody {
+	body := &ListCommitteeChildrenServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
 // NewUpdateCommitteeBaseBadRequestResponseBody builds the HTTP response body
 // from the result of the "update-committee-base" endpoint of the
 // "committee-service" service.
@@ -1836,11 +2781,99 @@ func NewCreateCommitteeMemberNotFoundResponseBody(res *committeeservice.NotFound
 	return body
 }
 
-// NewCreateCommitteeMemberServiceUnavailableResponseBody builds the HTTP
-// response body from the result of the "create-committee-member" endpoint of
-// the "committee-service" service.
-func NewCreateCommitteeMemberServiceUnavailableResponseBody(res *committeeservice.ServiceUnavailableError) *CreateCommitteeMemberServiceUnavailableResponseBody {
-	body := &CreateCommitteeMemberServiceUnavailableResponseBody{
+// NewCreateCommitteeMemberServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "create-committee-member" endpoint of
+// the "committee-service" service.
+func NewCreateCommitteeMemberServiceUnavailableResponseBody(res *committeeservice.ServiceUnavailableError) *CreateCommitteeMemberServiceUnavailableResponseBody {
+	body := &CreateCommitteeMemberServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewReassignCommitteeMembersOrganizationBadRequestResponseBody builds the
+// HTTP response body from the result of the
+// "reassign-committee-members-organization" endpoint of the
+// "committee-service" service.
+func NewReassignCommitteeMembersOrganizationBadRequestResponseBody(res *committeeservice.BadRequestError) *ReassignCommitteeMembersOrganizationBadRequestResponseBody {
+	body := &ReassignCommitteeMembersOrganizationBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewReassignCommitteeMembersOrganizationInternalServerErrorResponseBody
+// builds the HTTP response body from the result of the
+// "reassign-committee-members-organization" endpoint of the
+// "committee-service" service.
+func NewReassignCommitteeMembersOrganizationInternalServerErrorResponseBody(res *committeeservice.InternalServerError) *ReassignCommitteeMembersOrganizationInternalServerErrorResponseBody {
+	body := &ReassignCommitteeMembersOrganizationInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewReassignCommitteeMembersOrganizationNotFoundResponseBody builds the HTTP
+// response body from the result of the
+// "reassign-committee-members-organization" endpoint of the
+// "committee-service" service.
+func NewReassignCommitteeMembersOrganizationNotFoundResponseBody(res *committeeservice.NotFoundError) *ReassignCommitteeMembersOrganizationNotFoundResponseBody {
+	body := &ReassignCommitteeMembersOrganizationNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewReassignCommitteeMembersOrganizationServiceUnavailableResponseBody builds
+// the HTTP response body from the result of the
+// "reassign-committee-members-organization" endpoint of the
+// "committee-service" service.
+func NewReassignCommitteeMembersOrganizationServiceUnavailableResponseBody(res *committeeservice.ServiceUnavailableError) *ReassignCommitteeMembersOrganizationServiceUnavailableResponseBody {
+	body := &ReassignCommitteeMembersOrganizationServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListCommitteeMembersByOrganizationBadRequestResponseBody builds the HTTP
+// response body from the result of the
+// "list-committee-members-by-organization" endpoint of the "committee-service"
+// service.
+func NewListCommitteeMembersByOrganizationBadRequestResponseBody(res *committeeservice.BadRequestError) *ListCommitteeMembersByOrganizationBadRequestResponseBody {
+	body := &ListCommitteeMembersByOrganizationBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListCommitteeMembersByOrganizationInternalServerErrorResponseBody builds
+// the HTTP response body from the result of the
+// "list-committee-members-by-organization" endpoint of the "committee-service"
+// service.
+func NewListCommitteeMembersByOrganizationInternalServerErrorResponseBody(res *committeeservice.InternalServerError) *ListCommitteeMembersByOrganizationInternalServerErrorResponseBody {
+	body := &ListCommitteeMembersByOrganizationInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListCommitteeMembersByOrganizationNotFoundResponseBody builds the HTTP
+// response body from the result of the
+// "list-committee-members-by-organization" endpoint of the "committee-service"
+// service.
+func NewListCommitteeMembersByOrganizationNotFoundResponseBody(res *committeeservice.NotFoundError) *ListCommitteeMembersByOrganizationNotFoundResponseBody {
+	body := &ListCommitteeMembersByOrganizationNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListCommitteeMembersByOrganizationServiceUnavailableResponseBody builds
+// the HTTP response body from the result of the
+// "list-committee-members-by-organization" endpoint of the "committee-service"
+// service.
+func NewListCommitteeMembersByOrganizationServiceUnavailableResponseBody(res *committeeservice.ServiceUnavailableError) *ListCommitteeMembersByOrganizationServiceUnavailableResponseBody {
+	body := &ListCommitteeMembersByOrganizationServiceUnavailableResponseBody{
 		Message: res.Message,
 	}
 	return body
@@ -1886,6 +2919,46 @@ func NewGetCommitteeMemberServiceUnavailableResponseBody(res *committeeservice.S
 	return body
 }
 
+// NewGetCommitteeMemberHistoryBadRequestResponseBody builds the HTTP response
+// body from the result of the "get-committee-member-history" endpoint of the
+// "committee-service" service.
+func NewGetCommitteeMemberHistoryBadRequestResponseBody(res *committeeservice.BadRequestError) *GetCommitteeMemberHistoryBadRequestResponseBody {
+	body := &GetCommitteeMemberHistoryBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetCommitteeMemberHistoryInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "get-committee-member-history" endpoint
+// of the "committee-service" service.
+func NewGetCommitteeMemberHistoryInternalServerErrorResponseBody(res *committeeservice.InternalServerError) *GetCommitteeMemberHistoryInternalServerErrorResponseBody {
+	body := &GetCommitteeMemberHistoryInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetCommitteeMemberHistoryNotFoundResponseBody builds the HTTP response
+// body from the result of the "get-committee-member-history" endpoint of the
+// "committee-service" service.
+func NewGetCommitteeMemberHistoryNotFoundResponseBody(res *committeeservice.NotFoundError) *GetCommitteeMemberHistoryNotFoundResponseBody {
+	body := &GetCommitteeMemberHistoryNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetCommitteeMemberHistoryServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "get-committee-member-history" endpoint
+// of the "committee-service" service.
+func NewGetCommitteeMemberHistoryServiceUnavailableResponseBody(res *committeeservice.ServiceUnavailableError) *GetCommitteeMemberHistoryServiceUnavailableResponseBody {
+	body := &GetCommitteeMemberHistoryServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
 // NewUpdateCommitteeMemberBadRequestResponseBody builds the HTTP response body
 // from the result of the "update-committee-member" endpoint of the
 // "committee-service" service.
@@ -1936,6 +3009,56 @@ func NewUpdateCommitteeMemberServiceUnavailableResponseBody(res *committeeservic
 	return body
 }
 
+// NewPatchCommitteeMemberBadRequestResponseBody builds the HTTP response body
+// from the result of the "patch-committee-member" endpoint of the
+// "committee-service" service.
+func NewPatchCommitteeMemberBadRequestResponseBody(res *committeeservice.BadRequestError) *PatchCommitteeMemberBadRequestResponseBody {
+	body := &PatchCommitteeMemberBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewPatchCommitteeMemberConflictResponseBody builds the HTTP response body
+// from the result of the "patch-committee-member" endpoint of the
+// "committee-service" service.
+func NewPatchCommitteeMemberConflictResponseBody(res *committeeservice.ConflictError) *PatchCommitteeMemberConflictResponseBody {
+	body := &PatchCommitteeMemberConflictResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewPatchCommitteeMemberInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "patch-committee-member" endpoint of
+// the "committee-service" service.
+func NewPatchCommitteeMemberInternalServerErrorResponseBody(res *committeeservice.InternalServerError) *PatchCommitteeMemberInternalServerErrorResponseBody {
+	body := &PatchCommitteeMemberInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewPatchCommitteeMemberNotFoundResponseBody builds the HTTP response body
+// from the result of the "patch-committee-member" endpoint of the
+// "committee-service" service.
+func NewPatchCommitteeMemberNotFoundResponseBody(res *committeeservice.NotFoundError) *PatchCommitteeMemberNotFoundResponseBody {
+	body := &PatchCommitteeMemberNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewPatchCommitteeMemberServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "patch-committee-member" endpoint of
+// the "committee-service" service.
+func NewPatchCommitteeMemberServiceUnavailableResponseBody(res *committeeservice.ServiceUnavailableError) *PatchCommitteeMemberServiceUnavailableResponseBody {
+	body := &PatchCommitteeMemberServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
 // NewDeleteCommitteeMemberBadRequestResponseBody builds the HTTP response body
 // from the result of the "delete-committee-member" endpoint of the
 // "committee-service" service.
@@ -2045,9 +3168,23 @@ func NewCreateCommitteePayload(body *CreateCommitteeRequestBody, version *string
 			v.Calendar.Public = false
 		}
 	}
+	if body.Labels != nil {
+		v.Labels = make(map[string]string, len(body.Labels))
+		for key, val := range body.Labels {
+			tk := key
+			tv := val
+			v.Labels[tk] = tv
+		}
+	}
 	if body.BusinessEmailRequired == nil {
 		v.BusinessEmailRequired = false
 	}
+	if body.AllowedEmailDomains != nil {
+		v.AllowedEmailDomains = make([]string, len(body.AllowedEmailDomains))
+		for i, val := range body.AllowedEmailDomains {
+			v.AllowedEmailDomains[i] = val
+		}
+	}
 	if body.MemberVisibility == nil {
 		v.MemberVisibility = "hidden"
 	}
@@ -2084,6 +3221,32 @@ func NewGetCommitteeBasePayload(uid string, version *string, bearerToken *string
 	return v
 }
 
+// NewGetCommitteeWithMembersPayload builds a committee-service service
+// get-committee-with-members endpoint payload.
+func NewGetCommitteeWithMembersPayload(uid string, version *string, pageSize int, pageToken *string, bearerToken *string) *committeeservice.GetCommitteeWithMembersPayload {
+	v := &committeeservice.GetCommitteeWithMembersPayload{}
+	v.UID = &uid
+	v.Version = version
+	v.PageSize = pageSize
+	v.PageToken = pageToken
+	v.BearerToken = bearerToken
+
+	return v
+}
+
+// NewListCommitteeChildrenPayload builds a committee-service service
+// list-committee-children endpoint payload.
+func NewListCommitteeChildrenPayload(uid string, version *string, pageSize int, pageToken *string, bearerToken *string) *committeeservice.ListCommitteeChildrenPayload {
+	v := &committeeservice.ListCommitteeChildrenPayload{}
+	v.UID = &uid
+	v.Version = version
+	v.PageSize = pageSize
+	v.PageToken = pageToken
+	v.BearerToken = bearerToken
+
+	return v
+}
+
 // NewUpdateCommitteeBasePayload builds a committee-service service
 // update-committee-base endpoint payload.
 func NewUpdateCommitteeBasePayload(body *UpdateCommitteeBaseRequestBody, uid string, version *string, bearerToken *string, ifMatch *string, xSync bool) *committeeservice.UpdateCommitteeBasePayload {
@@ -2132,6 +3295,14 @@ func NewUpdateCommitteeBasePayload(body *UpdateCommitteeBaseRequestBody, uid str
 			v.Calendar.Public = false
 		}
 	}
+	if body.Labels != nil {
+		v.Labels = make(map[string]string, len(body.Labels))
+		for key, val := range body.Labels {
+			tk := key
+			tv := val
+			v.Labels[tk] = tv
+		}
+	}
 	v.UID = &uid
 	v.Version = version
 	v.BearerToken = bearerToken
@@ -2179,6 +3350,12 @@ func NewUpdateCommitteeSettingsPayload(body *UpdateCommitteeSettingsRequestBody,
 	if body.ShowMeetingAttendees != nil {
 		v.ShowMeetingAttendees = *body.ShowMeetingAttendees
 	}
+	if body.AllowedEmailDomains != nil {
+		v.AllowedEmailDomains = make([]string, len(body.AllowedEmailDomains))
+		for i, val := range body.AllowedEmailDomains {
+			v.AllowedEmailDomains[i] = val
+		}
+	}
 	if body.MemberVisibility == nil {
 		v.MemberVisibility = "hidden"
 	}
@@ -2216,6 +3393,7 @@ func NewCreateCommitteeMemberPayload(body *CreateCommitteeMemberRequestBody, uid
 		LastName:        body.LastName,
 		JobTitle:        body.JobTitle,
 		LinkedinProfile: body.LinkedinProfile,
+		AlternateForUID: body.AlternateForUID,
 	}
 	if body.AppointedBy != nil {
 		v.AppointedBy = *body.AppointedBy
@@ -2256,9 +3434,12 @@ func NewCreateCommitteeMemberPayload(body *CreateCommitteeMemberRequestBody, uid
 			StartDate *string
 			// Voting end date
 			EndDate *string
+			// Voting weight for weighted voting committees, defaults to 1
+			Weight *float64
 		}{
 			StartDate: body.Voting.StartDate,
 			EndDate:   body.Voting.EndDate,
+			Weight:    body.Voting.Weight,
 		}
 		if body.Voting.Status != nil {
 			v.Voting.Status = *body.Voting.Status
@@ -2289,6 +3470,33 @@ func NewCreateCommitteeMemberPayload(body *CreateCommitteeMemberRequestBody, uid
 	return v
 }
 
+// NewReassignCommitteeMembersOrganizationPayload builds a committee-service
+// service reassign-committee-members-organization endpoint payload.
+func NewReassignCommitteeMembersOrganizationPayload(body *ReassignCommitteeMembersOrganizationRequestBody, uid string, version string, bearerToken *string, xSync bool) *committeeservice.ReassignCommitteeMembersOrganizationPayload {
+	v := &committeeservice.ReassignCommitteeMembersOrganizationPayload{
+		FromOrganization: *body.FromOrganization,
+		ToOrganization:   body.ToOrganization,
+	}
+	v.UID = uid
+	v.Version = version
+	v.BearerToken = bearerToken
+	v.XSync = xSync
+
+	return v
+}
+
+// NewListCommitteeMembersByOrganizationPayload builds a committee-service
+// service list-committee-members-by-organization endpoint payload.
+func NewListCommitteeMembersByOrganizationPayload(uid string, version string, organization string, bearerToken *string) *committeeservice.ListCommitteeMembersByOrganizationPayload {
+	v := &committeeservice.ListCommitteeMembersByOrganizationPayload{}
+	v.UID = uid
+	v.Version = version
+	v.Organization = organization
+	v.BearerToken = bearerToken
+
+	return v
+}
+
 // NewGetCommitteeMemberPayload builds a committee-service service
 // get-committee-member endpoint payload.
 func NewGetCommitteeMemberPayload(uid string, memberUID string, version string, bearerToken *string) *committeeservice.GetCommitteeMemberPayload {
@@ -2301,16 +3509,31 @@ func NewGetCommitteeMemberPayload(uid string, memberUID string, version string,
 	return v
 }
 
+// NewGetCommitteeMemberHistoryPayload builds a committee-service service
+// get-committee-member-history endpoint payload.
+func NewGetCommitteeMemberHistoryPayload(uid string, memberUID string, version string, limit int, bearerToken *string) *committeeservice.GetCommitteeMemberHistoryPayload {
+	v := &committeeservice.GetCommitteeMemberHistoryPayload{}
+	v.UID = uid
+	v.MemberUID = memberUID
+	v.Version = version
+	v.Limit = limit
+	v.BearerToken = bearerToken
+
+	return v
+}
+
 // NewUpdateCommitteeMemberPayload builds a committee-service service
 // update-committee-member endpoint payload.
 func NewUpdateCommitteeMemberPayload(body *UpdateCommitteeMemberRequestBody, uid string, memberUID string, version string, bearerToken *string, ifMatch *string, xSync bool) *committeeservice.UpdateCommitteeMemberPayload {
 	v := &committeeservice.UpdateCommitteeMemberPayload{
+		CommitteeUID:    body.CommitteeUID,
 		Username:        body.Username,
 		Email:           *body.Email,
 		FirstName:       body.FirstName,
 		LastName:        body.LastName,
 		JobTitle:        body.JobTitle,
 		LinkedinProfile: body.LinkedinProfile,
+		AlternateForUID: body.AlternateForUID,
 	}
 	if body.AppointedBy != nil {
 		v.AppointedBy = *body.AppointedBy
@@ -2351,9 +3574,12 @@ func NewUpdateCommitteeMemberPayload(body *UpdateCommitteeMemberRequestBody, uid
 			StartDate *string
 			// Voting end date
 			EndDate *string
+			// Voting weight for weighted voting committees, defaults to 1
+			Weight *float64
 		}{
 			StartDate: body.Voting.StartDate,
 			EndDate:   body.Voting.EndDate,
+			Weight:    body.Voting.Weight,
 		}
 		if body.Voting.Status != nil {
 			v.Voting.Status = *body.Voting.Status
@@ -2386,6 +3612,75 @@ func NewUpdateCommitteeMemberPayload(body *UpdateCommitteeMemberRequestBody, uid
 	return v
 }
 
+// NewPatchCommitteeMemberPayload builds a committee-service service
+// patch-committee-member endpoint payload.
+func NewPatchCommitteeMemberPayload(body *PatchCommitteeMemberRequestBody, uid string, memberUID string, version string, bearerToken *string, ifMatch *string, xSync bool) *committeeservice.PatchCommitteeMemberPayload {
+	v := &committeeservice.PatchCommitteeMemberPayload{
+		Username:        body.Username,
+		Email:           body.Email,
+		FirstName:       body.FirstName,
+		LastName:        body.LastName,
+		JobTitle:        body.JobTitle,
+		LinkedinProfile: body.LinkedinProfile,
+		AppointedBy:     body.AppointedBy,
+		Status:          body.Status,
+		AlternateForUID: body.AlternateForUID,
+	}
+	if body.Role != nil {
+		v.Role = &struct {
+			// Committee role name
+			Name *string
+			// Role start date
+			StartDate *string
+			// Role end date
+			EndDate *string
+		}{
+			Name:      body.Role.Name,
+			StartDate: body.Role.StartDate,
+			EndDate:   body.Role.EndDate,
+		}
+	}
+	if body.Voting != nil {
+		v.Voting = &struct {
+			// Voting status
+			Status *string
+			// Voting start date
+			StartDate *string
+			// Voting end date
+			EndDate *string
+			// Voting weight for weighted voting committees, defaults to 1
+			Weight *float64
+		}{
+			Status:    body.Voting.Status,
+			StartDate: body.Voting.StartDate,
+			EndDate:   body.Voting.EndDate,
+			Weight:    body.Voting.Weight,
+		}
+	}
+	if body.Organization != nil {
+		v.Organization = &struct {
+			// Organization ID
+			ID *string
+			// Organization name
+			Name *string
+			// Organization website URL
+			Website *string
+		}{
+			ID:      body.Organization.ID,
+			Name:    body.Organization.Name,
+			Website: body.Organization.Website,
+		}
+	}
+	v.UID = uid
+	v.MemberUID = memberUID
+	v.Version = version
+	v.BearerToken = bearerToken
+	v.IfMatch = ifMatch
+	v.XSync = xSync
+
+	return v
+}
+
 // NewDeleteCommitteeMemberPayload builds a committee-service service
 // delete-committee-member endpoint payload.
 func NewDeleteCommitteeMemberPayload(uid string, memberUID string, version string, bearerToken *string, ifMatch *string, xSync bool) *committeeservice.DeleteCommitteeMemberPayload {
@@ -2589,6 +3884,14 @@ func ValidateCreateCommitteeMemberRequestBody(body *CreateCommitteeMemberRequest
 		if body.Voting.EndDate != nil {
 			err = goa.MergeErrors(err, goa.ValidateFormat("body.voting.end_date", *body.Voting.EndDate, goa.FormatDate))
 		}
+		if body.Voting.Weight != nil {
+			if *body.Voting.Weight < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.voting.weight", *body.Voting.Weight, 0, true))
+			}
+		}
+	}
+	if body.AlternateForUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.alternate_for_uid", *body.AlternateForUID, goa.FormatUUID))
 	}
 	if body.Organization != nil {
 		if body.Organization.Name != nil {
@@ -2603,12 +3906,123 @@ func ValidateCreateCommitteeMemberRequestBody(body *CreateCommitteeMemberRequest
 	return
 }
 
+// ValidateReassignCommitteeMembersOrganizationRequestBody runs the validations
+// defined on Reassign-Committee-Members-OrganizationRequestBody
+func ValidateReassignCommitteeMembersOrganizationRequestBody(body *ReassignCommitteeMembersOrganizationRequestBody) (err error) {
+	if body.FromOrganization == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("from_organization", "body"))
+	}
+	if body.FromOrganization != nil {
+		if utf8.RuneCountInString(*body.FromOrganization) > 200 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.from_organization", *body.FromOrganization, utf8.RuneCountInString(*body.FromOrganization), 200, false))
+		}
+	}
+	if body.ToOrganization != nil {
+		if utf8.RuneCountInString(*body.ToOrganization) > 200 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.to_organization", *body.ToOrganization, utf8.RuneCountInString(*body.ToOrganization), 200, false))
+		}
+	}
+	return
+}
+
 // ValidateUpdateCommitteeMemberRequestBody runs the validations defined on
 // Update-Committee-MemberRequestBody
 func ValidateUpdateCommitteeMemberRequestBody(body *UpdateCommitteeMemberRequestBody) (err error) {
 	if body.Email == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("email", "body"))
 	}
+	if body.CommitteeUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.committee_uid", *body.CommitteeUID, goa.FormatUUID))
+	}
+	if body.Username != nil {
+		if utf8.RuneCountInString(*body.Username) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.username", *body.Username, utf8.RuneCountInString(*body.Username), 100, false))
+		}
+	}
+	if body.Email != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
+	}
+	if body.FirstName != nil {
+		if utf8.RuneCountInString(*body.FirstName) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.first_name", *body.FirstName, utf8.RuneCountInString(*body.FirstName), 100, false))
+		}
+	}
+	if body.LastName != nil {
+		if utf8.RuneCountInString(*body.LastName) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.last_name", *body.LastName, utf8.RuneCountInString(*body.LastName), 100, false))
+		}
+	}
+	if body.JobTitle != nil {
+		if utf8.RuneCountInString(*body.JobTitle) > 200 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.job_title", *body.JobTitle, utf8.RuneCountInString(*body.JobTitle), 200, false))
+		}
+	}
+	if body.LinkedinProfile != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.linkedin_profile", *body.LinkedinProfile, goa.FormatURI))
+	}
+	if body.LinkedinProfile != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.linkedin_profile", *body.LinkedinProfile, "^(https?://)?([a-z]{2,3}\\.)?linkedin\\.com/.*$"))
+	}
+	if body.Role != nil {
+		if body.Role.Name != nil {
+			if !(*body.Role.Name == "Chair" || *body.Role.Name == "Counsel" || *body.Role.Name == "Developer Seat" || *body.Role.Name == "TAC/TOC Representative" || *body.Role.Name == "Director" || *body.Role.Name == "Lead" || *body.Role.Name == "None" || *body.Role.Name == "Secretary" || *body.Role.Name == "Treasurer" || *body.Role.Name == "Vice Chair" || *body.Role.Name == "LF Staff") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.role.name", *body.Role.Name, []any{"Chair", "Counsel", "Developer Seat", "TAC/TOC Representative", "Director", "Lead", "None", "Secretary", "Treasurer", "Vice Chair", "LF Staff"}))
+			}
+		}
+		if body.Role.StartDate != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.role.start_date", *body.Role.StartDate, goa.FormatDate))
+		}
+		if body.Role.EndDate != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.role.end_date", *body.Role.EndDate, goa.FormatDate))
+		}
+	}
+	if body.AppointedBy != nil {
+		if !(*body.AppointedBy == "Community" || *body.AppointedBy == "Membership Entitlement" || *body.AppointedBy == "Vote of End User Member Class" || *body.AppointedBy == "Vote of TSC Committee" || *body.AppointedBy == "Vote of TAC Committee" || *body.AppointedBy == "Vote of Academic Member Class" || *body.AppointedBy == "Vote of Lab Member Class" || *body.AppointedBy == "Vote of Marketing Committee" || *body.AppointedBy == "Vote of Governing Board" || *body.AppointedBy == "Vote of General Member Class" || *body.AppointedBy == "Vote of End User Committee" || *body.AppointedBy == "Vote of TOC Committee" || *body.AppointedBy == "Vote of Gold Member Class" || *body.AppointedBy == "Vote of Silver Member Class" || *body.AppointedBy == "Vote of Strategic Membership Class" || *body.AppointedBy == "None") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.appointed_by", *body.AppointedBy, []any{"Community", "Membership Entitlement", "Vote of End User Member Class", "Vote of TSC Committee", "Vote of TAC Committee", "Vote of Academic Member Class", "Vote of Lab Member Class", "Vote of Marketing Committee", "Vote of Governing Board", "Vote of General Member Class", "Vote of End User Committee", "Vote of TOC Committee", "Vote of Gold Member Class", "Vote of Silver Member Class", "Vote of Strategic Membership Class", "None"}))
+		}
+	}
+	if body.Status != nil {
+		if !(*body.Status == "Active" || *body.Status == "Inactive") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"Active", "Inactive"}))
+		}
+	}
+	if body.Voting != nil {
+		if body.Voting.Status != nil {
+			if !(*body.Voting.Status == "Alternate Voting Rep" || *body.Voting.Status == "Observer" || *body.Voting.Status == "Voting Rep" || *body.Voting.Status == "Emeritus" || *body.Voting.Status == "None") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.voting.status", *body.Voting.Status, []any{"Alternate Voting Rep", "Observer", "Voting Rep", "Emeritus", "None"}))
+			}
+		}
+		if body.Voting.StartDate != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.voting.start_date", *body.Voting.StartDate, goa.FormatDate))
+		}
+		if body.Voting.EndDate != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.voting.end_date", *body.Voting.EndDate, goa.FormatDate))
+		}
+		if body.Voting.Weight != nil {
+			if *body.Voting.Weight < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.voting.weight", *body.Voting.Weight, 0, true))
+			}
+		}
+	}
+	if body.AlternateForUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.alternate_for_uid", *body.AlternateForUID, goa.FormatUUID))
+	}
+	if body.Organization != nil {
+		if body.Organization.Name != nil {
+			if utf8.RuneCountInString(*body.Organization.Name) > 200 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.organization.name", *body.Organization.Name, utf8.RuneCountInString(*body.Organization.Name), 200, false))
+			}
+		}
+		if body.Organization.Website != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.organization.website", *body.Organization.Website, goa.FormatURI))
+		}
+	}
+	return
+}
+
+// ValidatePatchCommitteeMemberRequestBody runs the validations defined on
+// Patch-Committee-MemberRequestBody
+func ValidatePatchCommitteeMemberRequestBody(body *PatchCommitteeMemberRequestBody) (err error) {
 	if body.Username != nil {
 		if utf8.RuneCountInString(*body.Username) > 100 {
 			err = goa.MergeErrors(err, goa.InvalidLengthError("body.username", *body.Username, utf8.RuneCountInString(*body.Username), 100, false))
@@ -2673,6 +4087,14 @@ func ValidateUpdateCommitteeMemberRequestBody(body *UpdateCommitteeMemberRequest
 		if body.Voting.EndDate != nil {
 			err = goa.MergeErrors(err, goa.ValidateFormat("body.voting.end_date", *body.Voting.EndDate, goa.FormatDate))
 		}
+		if body.Voting.Weight != nil {
+			if *body.Voting.Weight < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.voting.weight", *body.Voting.Weight, 0, true))
+			}
+		}
+	}
+	if body.AlternateForUID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.alternate_for_uid", *body.AlternateForUID, goa.FormatUUID))
 	}
 	if body.Organization != nil {
 		if body.Organization.Name != nil {
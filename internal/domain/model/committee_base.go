@@ -9,17 +9,52 @@ import (
 	"encoding/hex"
 	"fmt"
 	"log/slog"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gosimple/slug"
+
+	errs "github.com/linuxfoundation/lfx-v2-committee-service/pkg/errors"
 )
 
 const (
 	categoryGovernmentAdvisoryCouncil = "Government Advisory Council"
+
+	// maxLabelKeyLength and maxLabelValueLength bound the size of committee labels
+	maxLabelKeyLength   = 63
+	maxLabelValueLength = 63
 )
 
+// labelPattern restricts label keys and values to alphanumerics separated by dashes, underscores or dots
+var labelPattern = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9._-]*[A-Za-z0-9])?$`)
+
+// committeeCategories is the canonical set of committee categories.
+// IMPORTANT: keep it in sync with the category enum of the API design.
+var committeeCategories = map[string]struct{}{
+	"Ambassador":                        {},
+	"Board":                             {},
+	"Code of Conduct":                   {},
+	"Committers":                        {},
+	"Expert Group":                      {},
+	"Finance Committee":                 {},
+	categoryGovernmentAdvisoryCouncil:   {},
+	"Legal Committee":                   {},
+	"Maintainers":                       {},
+	"Marketing Committee/Sub Committee": {},
+	"Marketing Mailing List":            {},
+	"Marketing Oversight Committee/Marketing Advisory Committee": {},
+	"Other":                         {},
+	"Product Security":              {},
+	"Special Interest Group":        {},
+	"Technical Advisory Committee":  {},
+	"Technical Mailing List":        {},
+	"Technical Oversight Committee": {},
+	"Technical Steering Committee":  {},
+	"Working Group":                 {},
+}
+
 // Committee represents the core committee business entity
 type Committee struct {
 	CommitteeBase
@@ -28,28 +63,40 @@ type Committee struct {
 
 // CommitteeBase represents the base committee attributes without settings
 type CommitteeBase struct {
-	UID              string    `json:"uid"`
-	ProjectUID       string    `json:"project_uid"`
-	ProjectName      string    `json:"project_name,omitempty"`
-	ProjectSlug      string    `json:"project_slug,omitempty"`
-	Name             string    `json:"name"`
-	Category         string    `json:"category"`
-	Description      string    `json:"description,omitempty"`
-	Website          *string   `json:"website,omitempty"`
-	EnableVoting     bool      `json:"enable_voting"`
-	SSOGroupEnabled  bool      `json:"sso_group_enabled"`
-	SSOGroupName     string    `json:"sso_group_name,omitempty"`
-	RequiresReview   bool      `json:"requires_review"`
-	Public           bool      `json:"public"`
-	Calendar         Calendar  `json:"calendar,omitempty"`
-	DisplayName      string    `json:"display_name,omitempty"`
-	ParentUID        *string   `json:"parent_uid,omitempty"`
-	TotalMembers     int       `json:"total_members"`
-	TotalVotingRepos int       `json:"total_voting_repos"`
-	CreatedAt        time.Time `json:"created_at"`
-	UpdatedAt        time.Time `json:"updated_at"`
+	UID             string   `json:"uid"`
+	ProjectUID      string   `json:"project_uid"`
+	ProjectName     string   `json:"project_name,omitempty"`
+	ProjectSlug     string   `json:"project_slug,omitempty"`
+	Name            string   `json:"name"`
+	Category        string   `json:"category"`
+	Description     string   `json:"description,omitempty"`
+	Website         *string  `json:"website,omitempty"`
+	EnableVoting    bool     `json:"enable_voting"`
+	SSOGroupEnabled bool     `json:"sso_group_enabled"`
+	SSOGroupName    string   `json:"sso_group_name,omitempty"`
+	RequiresReview  bool     `json:"requires_review"`
+	Public          bool     `json:"public"`
+	Calendar        Calendar `json:"calendar,omitempty"`
+	DisplayName     string   `json:"display_name,omitempty"`
+	ParentUID       *string  `json:"parent_uid,omitempty"`
+	// Labels are arbitrary key/value pairs used to filter committees
+	Labels           map[string]string `json:"labels,omitempty"`
+	TotalMembers     int               `json:"total_members"`
+	TotalVotingRepos int               `json:"total_voting_repos"`
+	CreatedAt        time.Time         `json:"created_at"`
+	UpdatedAt        time.Time         `json:"updated_at"`
+	// LastIndexedAt is stored apart from the committee record, see IndexStatus
+	LastIndexedAt *time.Time `json:"-"`
 }
 
+// Committee search index statuses
+const (
+	// IndexStatusSynced means the search index reflects the latest committee changes
+	IndexStatusSynced = "synced"
+	// IndexStatusPending means the latest committee changes were not published to the search index
+	IndexStatusPending = "pending"
+)
+
 // Calendar represents committee calendar settings
 type Calendar struct {
 	Public bool `json:"public"`
@@ -151,6 +198,74 @@ func (c *Committee) Tags() []string {
 	return tags
 }
 
+// ValidateCategory checks the committee category is one of the canonical categories.
+// Legacy categories are still accepted while existing data is migrated to the canonical set.
+func (c *CommitteeBase) ValidateCategory(legacyCategories []string) error {
+	if strings.TrimSpace(c.Category) == "" {
+		return errs.NewValidation("category is required")
+	}
+
+	if _, ok := committeeCategories[c.Category]; ok {
+		return nil
+	}
+
+	for _, legacy := range legacyCategories {
+		if c.Category == legacy {
+			return nil
+		}
+	}
+
+	return errs.NewValidation(fmt.Sprintf("unsupported committee category %q", c.Category))
+}
+
+// ValidateVoting checks voting is only enabled for committee categories permitting it
+func (c *CommitteeBase) ValidateVoting(votingDisallowedCategories []string) error {
+	if !c.EnableVoting {
+		return nil
+	}
+
+	for _, category := range votingDisallowedCategories {
+		if c.Category == category {
+			return errs.NewValidation(fmt.Sprintf("voting cannot be enabled for %q committees", c.Category))
+		}
+	}
+
+	return nil
+}
+
+// ValidateReviewPolicy checks public committees require review when the policy is enforced
+func (c *CommitteeBase) ValidateReviewPolicy(publicRequiresReview bool) error {
+	if publicRequiresReview && c.Public && !c.RequiresReview {
+		return errs.NewValidation("public committees must require review")
+	}
+	return nil
+}
+
+// ValidateLabels checks every label key and value has a supported length and characters.
+// Keys are required while values may be empty.
+func (c *CommitteeBase) ValidateLabels() error {
+	for key, value := range c.Labels {
+		if key == "" || len(key) > maxLabelKeyLength || !labelPattern.MatchString(key) {
+			return errs.NewValidation(fmt.Sprintf("invalid label key %q: must be 1-%d alphanumeric characters, '-', '_' or '.', starting and ending with an alphanumeric", key, maxLabelKeyLength))
+		}
+		if value == "" {
+			continue
+		}
+		if len(value) > maxLabelValueLength || !labelPattern.MatchString(value) {
+			return errs.NewValidation(fmt.Sprintf("invalid value for label %q: must be at most %d alphanumeric characters, '-', '_' or '.', starting and ending with an alphanumeric", key, maxLabelValueLength))
+		}
+	}
+	return nil
+}
+
+// IndexStatus reports whether the last successful index publish covers the latest committee changes
+func (c *CommitteeBase) IndexStatus() string {
+	if c.LastIndexedAt == nil || c.LastIndexedAt.Before(c.UpdatedAt) {
+		return IndexStatusPending
+	}
+	return IndexStatusSynced
+}
+
 // IsGovernmentAdvisoryCouncil returns true if the committee is a Government Advisory Council
 func (c *Committee) IsGovernmentAdvisoryCouncil() bool {
 	return c.Category == categoryGovernmentAdvisoryCouncil
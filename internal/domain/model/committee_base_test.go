@@ -5,9 +5,12 @@ package model
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	errs "github.com/linuxfoundation/lfx-v2-committee-service/pkg/errors"
 )
 
 func TestCommitteeSSOGroupNameBuild(t *testing.T) {
@@ -175,6 +178,205 @@ func TestCommitteeSSOGroupNameBuildIdempotent(t *testing.T) {
 	assert.NotEqual(t, firstSSOGroupName, secondSSOGroupName)
 }
 
+func TestCommitteeValidateCategory(t *testing.T) {
+	tests := []struct {
+		name             string
+		category         string
+		legacyCategories []string
+		expectError      bool
+	}{
+		{
+			name:     "canonical category",
+			category: "Technical Steering Committee",
+		},
+		{
+			name:        "unknown category",
+			category:    "governance",
+			expectError: true,
+		},
+		{
+			name:        "category is case sensitive",
+			category:    "technical steering committee",
+			expectError: true,
+		},
+		{
+			name:             "whitelisted legacy category",
+			category:         "governance",
+			legacyCategories: []string{"governance", "technical"},
+		},
+		{
+			name:             "empty category",
+			category:         "",
+			legacyCategories: []string{""},
+			expectError:      true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			committee := CommitteeBase{Category: tc.category}
+			err := committee.ValidateCategory(tc.legacyCategories)
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.IsType(t, errs.Validation{}, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCommitteeValidateVoting(t *testing.T) {
+	disallowed := []string{"Marketing Mailing List", "Technical Mailing List"}
+
+	tests := []struct {
+		name         string
+		category     string
+		enableVoting bool
+		expectError  bool
+	}{
+		{
+			name:         "voting enabled on a technical steering committee",
+			category:     "Technical Steering Committee",
+			enableVoting: true,
+		},
+		{
+			name:         "voting enabled on a mailing list",
+			category:     "Marketing Mailing List",
+			enableVoting: true,
+			expectError:  true,
+		},
+		{
+			name:     "voting disabled on a mailing list",
+			category: "Marketing Mailing List",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			committee := CommitteeBase{Category: tc.category, EnableVoting: tc.enableVoting}
+			err := committee.ValidateVoting(disallowed)
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.IsType(t, errs.Validation{}, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCommitteeValidateReviewPolicy(t *testing.T) {
+	tests := []struct {
+		name           string
+		policyEnabled  bool
+		public         bool
+		requiresReview bool
+		expectError    bool
+	}{
+		{
+			name:   "policy disabled allows a public committee without review",
+			public: true,
+		},
+		{
+			name:           "policy disabled allows a public committee with review",
+			public:         true,
+			requiresReview: true,
+		},
+		{
+			name: "policy disabled allows a private committee without review",
+		},
+		{
+			name:          "policy enabled rejects a public committee without review",
+			policyEnabled: true,
+			public:        true,
+			expectError:   true,
+		},
+		{
+			name:           "policy enabled allows a public committee with review",
+			policyEnabled:  true,
+			public:         true,
+			requiresReview: true,
+		},
+		{
+			name:          "policy enabled allows a private committee without review",
+			policyEnabled: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			committee := CommitteeBase{Public: tc.public, RequiresReview: tc.requiresReview}
+			err := committee.ValidateReviewPolicy(tc.policyEnabled)
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.IsType(t, errs.Validation{}, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCommitteeValidateLabels(t *testing.T) {
+	tests := []struct {
+		name        string
+		labels      map[string]string
+		expectError bool
+	}{
+		{
+			name: "no labels",
+		},
+		{
+			name:   "valid labels",
+			labels: map[string]string{"region": "emea", "tier.level": "gold_1", "draft": ""},
+		},
+		{
+			name:        "empty key",
+			labels:      map[string]string{"": "emea"},
+			expectError: true,
+		},
+		{
+			name:        "key with unsupported characters",
+			labels:      map[string]string{"region code": "emea"},
+			expectError: true,
+		},
+		{
+			name:        "key ending with a separator",
+			labels:      map[string]string{"region-": "emea"},
+			expectError: true,
+		},
+		{
+			name:        "key too long",
+			labels:      map[string]string{strings.Repeat("k", 64): "emea"},
+			expectError: true,
+		},
+		{
+			name:        "value with unsupported characters",
+			labels:      map[string]string{"region": "emea/west"},
+			expectError: true,
+		},
+		{
+			name:        "value too long",
+			labels:      map[string]string{"region": strings.Repeat("v", 64)},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			committee := CommitteeBase{Labels: tc.labels}
+			err := committee.ValidateLabels()
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.IsType(t, errs.Validation{}, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
 func TestCommitteeGovernmentAdvisoryCouncil(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -0,0 +1,38 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package model
+
+// CommitteeFilter represents the criteria used to narrow down a committee listing.
+// Nil fields are not applied.
+type CommitteeFilter struct {
+	// Public, when set, only matches committees with the same visibility
+	Public *bool
+	// EnableVoting, when set, only matches committees with voting enabled or disabled accordingly
+	EnableVoting *bool
+	// Labels, when set, only matches committees having every label with the same value
+	Labels map[string]string
+}
+
+// Matches reports whether the committee base satisfies every criteria of the filter
+func (f CommitteeFilter) Matches(base *CommitteeBase) bool {
+	if base == nil {
+		return false
+	}
+
+	if f.Public != nil && base.Public != *f.Public {
+		return false
+	}
+
+	if f.EnableVoting != nil && base.EnableVoting != *f.EnableVoting {
+		return false
+	}
+
+	for key, value := range f.Labels {
+		if labelValue, ok := base.Labels[key]; !ok || labelValue != value {
+			return false
+		}
+	}
+
+	return true
+}
@@ -16,6 +16,28 @@ import (
 	"github.com/linuxfoundation/lfx-v2-committee-service/pkg/redaction"
 )
 
+// MemberUniquenessScope defines the boundary in which a member email must be unique
+type MemberUniquenessScope string
+
+const (
+	// MemberUniquenessScopeCommittee enforces a unique email per committee (default)
+	MemberUniquenessScopeCommittee MemberUniquenessScope = "committee"
+	// MemberUniquenessScopeProject enforces a unique email across all committees of the same project
+	MemberUniquenessScopeProject MemberUniquenessScope = "project"
+)
+
+// Member defaults, matching the defaults of the API schema
+const (
+	// MemberRoleNone is the role name of members without a committee role
+	MemberRoleNone = "None"
+	// MemberVotingStatusNone is the voting status of members without voting rights
+	MemberVotingStatusNone = "None"
+	// MemberVotingStatusVotingRep is the voting status of the voting representatives
+	MemberVotingStatusVotingRep = "Voting Rep"
+	// MemberStatusActive is the status of active members
+	MemberStatusActive = "Active"
+)
+
 // CommitteeMember represents the complete committee member business entity
 type CommitteeMember struct {
 	CommitteeMemberBase
@@ -23,23 +45,28 @@ type CommitteeMember struct {
 
 // CommitteeMemberBase represents the base committee member attributes
 type CommitteeMemberBase struct {
-	UID               string                      `json:"uid"`
-	Username          string                      `json:"username"`
-	Email             string                      `json:"email"`
-	FirstName         string                      `json:"first_name"`
-	LastName          string                      `json:"last_name"`
-	JobTitle          string                      `json:"job_title,omitempty"`
-	LinkedInProfile   string                      `json:"linkedin_profile,omitempty"`
-	Role              CommitteeMemberRole         `json:"role"`
-	AppointedBy       string                      `json:"appointed_by"`
-	Status            string                      `json:"status"`
-	Voting            CommitteeMemberVotingInfo   `json:"voting"`
-	Organization      CommitteeMemberOrganization `json:"organization"`
-	CommitteeUID      string                      `json:"committee_uid"`
-	CommitteeName     string                      `json:"committee_name"`
-	CommitteeCategory string                      `json:"committee_category"`
-	CreatedAt         time.Time                   `json:"created_at"`
-	UpdatedAt         time.Time                   `json:"updated_at"`
+	UID               string                        `json:"uid"`
+	Username          string                        `json:"username"`
+	Email             string                        `json:"email"`
+	FirstName         string                        `json:"first_name"`
+	LastName          string                        `json:"last_name"`
+	JobTitle          string                        `json:"job_title,omitempty"`
+	LinkedInProfile   string                        `json:"linkedin_profile,omitempty"`
+	Role              CommitteeMemberRole           `json:"role"`
+	AppointedBy       string                        `json:"appointed_by"`
+	Status            string                        `json:"status"`
+	Voting            CommitteeMemberVotingInfo     `json:"voting"`
+	AlternateForUID   *string                       `json:"alternate_for_uid,omitempty"`
+	Organization      CommitteeMemberOrganization   `json:"organization"`
+	Organizations     []CommitteeMemberOrganization `json:"organizations,omitempty"`
+	RoleHistory       []CommitteeMemberRoleChange   `json:"role_history,omitempty"`
+	StatusHistory     []CommitteeMemberStatusChange `json:"status_history,omitempty"`
+	CommitteeUID      string                        `json:"committee_uid"`
+	CommitteeName     string                        `json:"committee_name"`
+	CommitteeCategory string                        `json:"committee_category"`
+	ProjectUID        string                        `json:"project_uid,omitempty"`
+	CreatedAt         time.Time                     `json:"created_at"`
+	UpdatedAt         time.Time                     `json:"updated_at"`
 }
 
 // Role represents committee role information
@@ -49,11 +76,40 @@ type CommitteeMemberRole struct {
 	EndDate   string `json:"end_date,omitempty"`
 }
 
+// CommitteeMemberRoleChange records a change of the member role and the principal who made it
+type CommitteeMemberRoleChange struct {
+	OldRole   string    `json:"old_role"`
+	NewRole   string    `json:"new_role"`
+	ChangedAt time.Time `json:"changed_at"`
+	Actor     string    `json:"actor,omitempty"`
+}
+
+// CommitteeMemberStatusChange records a change of the member status and the principal who made it
+type CommitteeMemberStatusChange struct {
+	OldStatus string    `json:"old_status"`
+	NewStatus string    `json:"new_status"`
+	ChangedAt time.Time `json:"changed_at"`
+	Actor     string    `json:"actor,omitempty"`
+}
+
 // VotingInfo represents voting information for the committee member
 type CommitteeMemberVotingInfo struct {
 	Status    string `json:"status"`
 	StartDate string `json:"start_date,omitempty"`
 	EndDate   string `json:"end_date,omitempty"`
+	// Weight is the member vote weight in weighted voting committees, nil means DefaultVotingWeight
+	Weight *float64 `json:"weight,omitempty"`
+}
+
+// DefaultVotingWeight is the vote weight of members without an explicit weight
+const DefaultVotingWeight = 1.0
+
+// EffectiveWeight returns the vote weight of the member, falling back to DefaultVotingWeight
+func (v CommitteeMemberVotingInfo) EffectiveWeight() float64 {
+	if v.Weight == nil {
+		return DefaultVotingWeight
+	}
+	return *v.Weight
 }
 
 // Organization represents organization information for the committee member
@@ -61,6 +117,8 @@ type CommitteeMemberOrganization struct {
 	ID      string `json:"id,omitempty"`
 	Name    string `json:"name"`
 	Website string `json:"website,omitempty"`
+	// Primary flags the organization shown for the member when it has several
+	Primary bool `json:"primary,omitempty"`
 }
 
 // BuildIndexKey generates a SHA-256 hash for use as a NATS KV key.
@@ -89,6 +147,161 @@ func (cm *CommitteeMember) BuildIndexKey(ctx context.Context) string {
 	return key
 }
 
+// BuildScopedIndexKey generates the member index key for the given uniqueness scope.
+// The committee scope is the same key as BuildIndexKey, while the project scope hashes
+// the project UID and the member's email (i.e., project_uid + email), so the same email
+// can only be used once across all committees of a project.
+func (cm *CommitteeMember) BuildScopedIndexKey(ctx context.Context, scope MemberUniquenessScope) string {
+
+	if scope != MemberUniquenessScopeProject {
+		return cm.BuildIndexKey(ctx)
+	}
+
+	project := strings.TrimSpace(strings.ToLower(cm.ProjectUID))
+	email := strings.TrimSpace(strings.ToLower(cm.Email))
+	// The scope is part of the data to avoid clashing with committee scoped keys
+	data := fmt.Sprintf("project:%s|%s", project, email)
+
+	hash := sha256.Sum256([]byte(data))
+
+	key := hex.EncodeToString(hash[:])
+
+	slog.DebugContext(ctx, "member project scoped index key built",
+		"project_uid", cm.ProjectUID,
+		"email", redaction.RedactEmail(cm.Email),
+		"key", key,
+	)
+
+	return key
+}
+
+// CommitteeMemberValidationResult is the outcome of validating a single member of a preflight batch
+type CommitteeMemberValidationResult struct {
+	// Index is the position of the member in the validated batch
+	Index int
+	Email string
+	Valid bool
+	// Err holds the first validation failure, nil when the member is valid
+	Err error
+	// OrganizationSuggestion is the spelling the committee members already use for the member organization,
+	// set when the member writes it differently
+	OrganizationSuggestion string
+}
+
+// CommitteeMemberReassignResult is the outcome of moving a single member to another organization
+type CommitteeMemberReassignResult struct {
+	MemberUID string
+	Email     string
+	// Member is the updated member, nil when the reassignment failed
+	Member *CommitteeMember
+	// Err holds the reason the member could not be reassigned, nil on success
+	Err error
+}
+
+// CommitteeMembershipSummary describes a person's membership in one committee
+type CommitteeMembershipSummary struct {
+	CommitteeUID  string
+	CommitteeName string
+	Role          string
+	VotingStatus  string
+}
+
+// CommitteeMemberAccess describes a committee member along with the access relations they hold on the committee
+type CommitteeMemberAccess struct {
+	Member    *CommitteeMember
+	Relations []string
+}
+
+// MemberDateLayout is the layout of the role and voting start and end dates
+const MemberDateLayout = "2006-01-02"
+
+// memberWindowContains reports whether the date falls within the start and end dates, both inclusive.
+// An empty start or end date leaves that side of the window open.
+func memberWindowContains(startDate, endDate string, date time.Time) (bool, error) {
+
+	day := date.UTC().Format(MemberDateLayout)
+
+	if startDate != "" {
+		start, err := time.Parse(MemberDateLayout, startDate)
+		if err != nil {
+			return false, errs.NewValidation(fmt.Sprintf("invalid start date %q", startDate), err)
+		}
+		if day < start.Format(MemberDateLayout) {
+			return false, nil
+		}
+	}
+
+	if endDate != "" {
+		end, err := time.Parse(MemberDateLayout, endDate)
+		if err != nil {
+			return false, errs.NewValidation(fmt.Sprintf("invalid end date %q", endDate), err)
+		}
+		if day > end.Format(MemberDateLayout) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// AsOf returns a copy of the committee member with the role and voting status effective on the given date.
+// The role and voting status are reset to "None" when their window does not cover the date,
+// and the returned flag is false when neither window covers it, meaning the member's tenure
+// does not include the date.
+func (cm *CommitteeMember) AsOf(date time.Time) (*CommitteeMember, bool, error) {
+	if cm == nil {
+		return nil, false, nil
+	}
+
+	roleActive, err := memberWindowContains(cm.Role.StartDate, cm.Role.EndDate, date)
+	if err != nil {
+		return nil, false, err
+	}
+
+	votingActive, err := memberWindowContains(cm.Voting.StartDate, cm.Voting.EndDate, date)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !roleActive && !votingActive {
+		return nil, false, nil
+	}
+
+	snapshot := *cm
+	if !roleActive {
+		snapshot.Role.Name = MemberRoleNone
+	}
+	if !votingActive {
+		snapshot.Voting.Status = MemberVotingStatusNone
+	}
+
+	return &snapshot, true, nil
+}
+
+// ExpiresBetween reports whether the role or voting end date falls between from and until, both inclusive.
+// Empty end dates mean an open-ended tenure and never expire.
+func (cm *CommitteeMember) ExpiresBetween(from, until time.Time) (bool, error) {
+	if cm == nil {
+		return false, nil
+	}
+
+	first, last := from.UTC().Format(MemberDateLayout), until.UTC().Format(MemberDateLayout)
+	for _, endDate := range []string{cm.Role.EndDate, cm.Voting.EndDate} {
+		if endDate == "" {
+			continue
+		}
+		end, err := time.Parse(MemberDateLayout, endDate)
+		if err != nil {
+			return false, errs.NewValidation(fmt.Sprintf("invalid end date %q", endDate), err)
+		}
+		if day := end.Format(MemberDateLayout); day >= first && day <= last {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 // Tags generates a consistent set of tags for the committee member.
 // IMPORTANT: If you modify this method, please update the Committee Tags documentation in the README.md
 // to ensure consumers understand how to use these tags for searching.
@@ -151,8 +364,27 @@ func (cm *CommitteeMember) Tags() []string {
 	return tags
 }
 
-// Validate validates the committee member against the committee's requirements
-func (cm *CommitteeMember) Validate(committee *Committee) error {
+// ApplyDefaults sets the role name, voting status and status left empty to the schema defaults,
+// so members created without them are stored the same way as through the API defaults
+func (cm *CommitteeMember) ApplyDefaults() {
+	if cm == nil {
+		return
+	}
+	if cm.Role.Name == "" {
+		cm.Role.Name = MemberRoleNone
+	}
+	if cm.Voting.Status == "" {
+		cm.Voting.Status = MemberVotingStatusNone
+	}
+	if cm.Status == "" {
+		cm.Status = MemberStatusActive
+	}
+}
+
+// Validate validates the committee member against the committee's requirements,
+// including the rules the policy sets for the committee category.
+// When the member lists several organizations, Organization is set to the primary one.
+func (cm *CommitteeMember) Validate(committee *Committee, policy MemberPolicy) error {
 	if cm == nil {
 		return errs.NewValidation("committee member cannot be nil")
 	}
@@ -161,11 +393,71 @@ func (cm *CommitteeMember) Validate(committee *Committee) error {
 		return errs.NewValidation("committee cannot be nil")
 	}
 
+	// Derive the organization from the primary one before the field rules apply
+	if err := cm.applyPrimaryOrganization(); err != nil {
+		return err
+	}
+
 	// Validate basic required fields
 	if err := cm.validateRequiredFields(); err != nil {
 		return err
 	}
 
+	// Validate category specific rules
+	if err := policy.validate(cm, committee.Category); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateAlternateFor checks the primary member the alternate link of the member points to,
+// which must be another member of the same committee that is not an alternate itself
+func (cm *CommitteeMember) ValidateAlternateFor(primary *CommitteeMember) error {
+	if cm.AlternateForUID == nil {
+		return nil
+	}
+	if primary == nil || primary.UID != *cm.AlternateForUID {
+		return errs.NewValidation(fmt.Sprintf("alternate_for_uid %q does not reference an existing member", *cm.AlternateForUID))
+	}
+	if primary.UID == cm.UID {
+		return errs.NewValidation("a member cannot be its own alternate")
+	}
+	if primary.CommitteeUID != cm.CommitteeUID {
+		return errs.NewValidation("alternate_for_uid must reference a member of the same committee")
+	}
+	if primary.AlternateForUID != nil {
+		return errs.NewValidation("alternate_for_uid cannot reference a member that is an alternate itself")
+	}
+	return nil
+}
+
+// applyPrimaryOrganization checks that exactly one of the member organizations is primary and
+// sets Organization to it. Members without Organizations keep their single Organization.
+func (cm *CommitteeMember) applyPrimaryOrganization() error {
+	if len(cm.Organizations) == 0 {
+		return nil
+	}
+
+	var (
+		primary      CommitteeMemberOrganization
+		primaryCount int
+	)
+	for _, organization := range cm.Organizations {
+		if organization.Primary {
+			primary = organization
+			primaryCount++
+		}
+	}
+
+	if primaryCount != 1 {
+		return errs.NewValidation(fmt.Sprintf("exactly one organization must be primary, got %d", primaryCount))
+	}
+
+	// the single organization keeps its original shape, without the primary flag
+	primary.Primary = false
+	cm.Organization = primary
+
 	return nil
 }
 
@@ -175,5 +467,9 @@ func (cm *CommitteeMember) validateRequiredFields() error {
 		return errs.NewValidation("email is required")
 	}
 
+	if cm.Voting.Weight != nil && *cm.Voting.Weight < 0 {
+		return errs.NewValidation("voting weight must be non-negative")
+	}
+
 	return nil
 }
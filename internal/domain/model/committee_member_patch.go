@@ -0,0 +1,99 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package model
+
+// CommitteeMemberPatch holds a partial committee member update.
+// Nil fields are left unchanged while pointers to empty values clear the field.
+type CommitteeMemberPatch struct {
+	Username        *string
+	Email           *string
+	FirstName       *string
+	LastName        *string
+	JobTitle        *string
+	LinkedInProfile *string
+	AppointedBy     *string
+	Status          *string
+	AlternateForUID *string
+	Role            *CommitteeMemberRolePatch
+	Voting          *CommitteeMemberVotingPatch
+	Organization    *CommitteeMemberOrganizationPatch
+}
+
+// CommitteeMemberRolePatch holds a partial update of the member role, merged field-wise
+type CommitteeMemberRolePatch struct {
+	Name      *string
+	StartDate *string
+	EndDate   *string
+}
+
+// CommitteeMemberVotingPatch holds a partial update of the member voting information, merged field-wise
+type CommitteeMemberVotingPatch struct {
+	Status    *string
+	StartDate *string
+	EndDate   *string
+	Weight    *float64
+}
+
+// CommitteeMemberOrganizationPatch holds a partial update of the member organization, merged field-wise
+type CommitteeMemberOrganizationPatch struct {
+	ID      *string
+	Name    *string
+	Website *string
+}
+
+// Apply returns a copy of the member with the patch fields set, the member itself is left untouched
+func (p *CommitteeMemberPatch) Apply(member *CommitteeMember) *CommitteeMember {
+	if member == nil {
+		return nil
+	}
+
+	patched := *member
+	if p == nil {
+		return &patched
+	}
+
+	patchString(&patched.Username, p.Username)
+	patchString(&patched.Email, p.Email)
+	patchString(&patched.FirstName, p.FirstName)
+	patchString(&patched.LastName, p.LastName)
+	patchString(&patched.JobTitle, p.JobTitle)
+	patchString(&patched.LinkedInProfile, p.LinkedInProfile)
+	patchString(&patched.AppointedBy, p.AppointedBy)
+	patchString(&patched.Status, p.Status)
+	if p.AlternateForUID != nil {
+		alternateForUID := *p.AlternateForUID
+		patched.AlternateForUID = &alternateForUID
+	}
+
+	if p.Role != nil {
+		patchString(&patched.Role.Name, p.Role.Name)
+		patchString(&patched.Role.StartDate, p.Role.StartDate)
+		patchString(&patched.Role.EndDate, p.Role.EndDate)
+	}
+
+	if p.Voting != nil {
+		patchString(&patched.Voting.Status, p.Voting.Status)
+		patchString(&patched.Voting.StartDate, p.Voting.StartDate)
+		patchString(&patched.Voting.EndDate, p.Voting.EndDate)
+		if p.Voting.Weight != nil {
+			weight := *p.Voting.Weight
+			patched.Voting.Weight = &weight
+		}
+	}
+
+	if p.Organization != nil {
+		patchString(&patched.Organization.ID, p.Organization.ID)
+		patchString(&patched.Organization.Name, p.Organization.Name)
+		patchString(&patched.Organization.Website, p.Organization.Website)
+	}
+
+	return &patched
+}
+
+// patchString sets the field to the patch value when it is present
+func patchString(field *string, value *string) {
+	if value != nil {
+		*field = *value
+	}
+}
@@ -0,0 +1,239 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	errs "github.com/linuxfoundation/lfx-v2-committee-service/pkg/errors"
+)
+
+// MemberPolicy groups the configurable, category scoped rules a committee member is validated against
+type MemberPolicy struct {
+	RequiredFields MemberRequiredFields
+	AppointedBy    AppointedByPolicy
+}
+
+// validate checks the member against every rule of the policy for the committee category
+func (p MemberPolicy) validate(cm *CommitteeMember, category string) error {
+	if err := p.RequiredFields.validate(cm, category); err != nil {
+		return err
+	}
+	return p.AppointedBy.validate(cm, category)
+}
+
+// MemberRequiredFields declares, per committee category, the member fields required
+// on top of the ones every member must have. Fields are referenced by their JSON name.
+type MemberRequiredFields map[string][]string
+
+// memberRequiredFieldValues resolves the value of every member field a policy can require
+var memberRequiredFieldValues = map[string]func(cm *CommitteeMember) string{
+	"username":             func(cm *CommitteeMember) string { return cm.Username },
+	"first_name":           func(cm *CommitteeMember) string { return cm.FirstName },
+	"last_name":            func(cm *CommitteeMember) string { return cm.LastName },
+	"job_title":            func(cm *CommitteeMember) string { return cm.JobTitle },
+	"linkedin_profile":     func(cm *CommitteeMember) string { return cm.LinkedInProfile },
+	"appointed_by":         func(cm *CommitteeMember) string { return cm.AppointedBy },
+	"organization":         func(cm *CommitteeMember) string { return cm.Organization.Name },
+	"organization_website": func(cm *CommitteeMember) string { return cm.Organization.Website },
+}
+
+// ParseMemberRequiredFields parses a policy in the form
+// "Board=job_title,organization;Technical Steering Committee=job_title".
+// An empty value returns an empty policy.
+func ParseMemberRequiredFields(value string) (MemberRequiredFields, error) {
+	policy, err := parseCategoryPolicy(value, func(field string) error {
+		if _, ok := memberRequiredFieldValues[field]; !ok {
+			return errs.NewValidation(fmt.Sprintf("unsupported member required field %q, expected one of %s", field, strings.Join(supportedMemberRequiredFields(), ", ")))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return MemberRequiredFields(policy), nil
+}
+
+// parseCategoryPolicy parses a list of values per committee category in the form
+// "category=value[,value];category=value", checking every value with the given function
+func parseCategoryPolicy(value string, check func(value string) error) (map[string][]string, error) {
+	policy := make(map[string][]string)
+
+	for _, entry := range strings.Split(value, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		category, valueList, found := strings.Cut(entry, "=")
+		category = strings.TrimSpace(category)
+		if !found || category == "" {
+			return nil, errs.NewValidation(fmt.Sprintf("invalid policy entry %q, expected category=value[,value]", entry))
+		}
+
+		for _, item := range strings.Split(valueList, ",") {
+			item = strings.TrimSpace(item)
+			if item == "" {
+				continue
+			}
+			if err := check(item); err != nil {
+				return nil, err
+			}
+			policy[category] = append(policy[category], item)
+		}
+	}
+
+	return policy, nil
+}
+
+// supportedMemberRequiredFields returns the sorted list of fields a policy can require
+func supportedMemberRequiredFields() []string {
+	fields := make([]string, 0, len(memberRequiredFieldValues))
+	for field := range memberRequiredFieldValues {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// validate checks the member has every field the policy requires for the committee category
+func (p MemberRequiredFields) validate(cm *CommitteeMember, category string) error {
+	for _, field := range p[category] {
+		value, ok := memberRequiredFieldValues[field]
+		if !ok {
+			return errs.NewValidation(fmt.Sprintf("unsupported member required field %q", field))
+		}
+		if strings.TrimSpace(value(cm)) == "" {
+			return errs.NewValidation(fmt.Sprintf("%s is required for %s committees", field, category))
+		}
+	}
+	return nil
+}
+
+// appointedByValues is the canonical set of appointment mechanisms.
+// IMPORTANT: keep it in sync with the appointed_by enum of the API design.
+var appointedByValues = map[string]struct{}{
+	"Community":                          {},
+	"Membership Entitlement":             {},
+	"Vote of End User Member Class":      {},
+	"Vote of TSC Committee":              {},
+	"Vote of TAC Committee":              {},
+	"Vote of Academic Member Class":      {},
+	"Vote of Lab Member Class":           {},
+	"Vote of Marketing Committee":        {},
+	"Vote of Governing Board":            {},
+	"Vote of General Member Class":       {},
+	"Vote of End User Committee":         {},
+	"Vote of TOC Committee":              {},
+	"Vote of Gold Member Class":          {},
+	"Vote of Silver Member Class":        {},
+	"Vote of Strategic Membership Class": {},
+	appointedByNone:                      {},
+}
+
+// appointedByNone is the appointed_by value of members without an appointment
+const appointedByNone = "None"
+
+// AppointedByPolicy declares, per committee category, the appointment mechanisms allowed for its members.
+// Categories without an entry accept any appointment mechanism.
+type AppointedByPolicy map[string][]string
+
+// ParseAppointedByPolicy parses a policy in the form
+// "Board=Vote of Governing Board,Membership Entitlement;Technical Steering Committee=Vote of TSC Committee".
+// An empty value returns an empty policy.
+func ParseAppointedByPolicy(value string) (AppointedByPolicy, error) {
+	policy, err := parseCategoryPolicy(value, func(appointedBy string) error {
+		if _, ok := appointedByValues[appointedBy]; !ok {
+			return errs.NewValidation(fmt.Sprintf("unsupported appointed_by value %q", appointedBy))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return AppointedByPolicy(policy), nil
+}
+
+// validate checks the member appointment mechanism is allowed for the committee category.
+// Members without an appointment (empty or "None") are not checked.
+func (p AppointedByPolicy) validate(cm *CommitteeMember, category string) error {
+	allowed, ok := p[category]
+	if !ok || cm.AppointedBy == "" || cm.AppointedBy == appointedByNone {
+		return nil
+	}
+	for _, appointedBy := range allowed {
+		if cm.AppointedBy == appointedBy {
+			return nil
+		}
+	}
+	return errs.NewValidation(fmt.Sprintf("appointed_by %q is not allowed for %s committees", cm.AppointedBy, category))
+}
+
+// MemberSensitiveFields lists the member fields, referenced by their JSON name,
+// omitted from the member returned to unprivileged callers
+type MemberSensitiveFields []string
+
+// MemberSensitiveSection lists the member fields only returned to the writers and auditors of the committee
+// and to the member themselves, on top of the configured sensitive fields
+var MemberSensitiveSection = MemberSensitiveFields{"email"}
+
+// memberSensitiveFieldRedactors clears every member field that can be configured as sensitive
+var memberSensitiveFieldRedactors = map[string]func(cm *CommitteeMember){
+	"email":            func(cm *CommitteeMember) { cm.Email = "" },
+	"username":         func(cm *CommitteeMember) { cm.Username = "" },
+	"first_name":       func(cm *CommitteeMember) { cm.FirstName = "" },
+	"last_name":        func(cm *CommitteeMember) { cm.LastName = "" },
+	"job_title":        func(cm *CommitteeMember) { cm.JobTitle = "" },
+	"linkedin_profile": func(cm *CommitteeMember) { cm.LinkedInProfile = "" },
+	"appointed_by":     func(cm *CommitteeMember) { cm.AppointedBy = "" },
+	"organization": func(cm *CommitteeMember) {
+		cm.Organization = CommitteeMemberOrganization{}
+		cm.Organizations = nil
+	},
+}
+
+// ParseMemberSensitiveFields parses a comma separated list of member fields, such as "email,job_title".
+// An empty value returns no sensitive fields, so members are returned in full.
+func ParseMemberSensitiveFields(value string) (MemberSensitiveFields, error) {
+	var fields MemberSensitiveFields
+	for _, field := range strings.Split(value, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		if _, ok := memberSensitiveFieldRedactors[field]; !ok {
+			return nil, errs.NewValidation(fmt.Sprintf("unsupported member sensitive field %q, expected one of %s", field, strings.Join(supportedMemberSensitiveFields(), ", ")))
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// supportedMemberSensitiveFields returns the sorted list of fields that can be configured as sensitive
+func supportedMemberSensitiveFields() []string {
+	fields := make([]string, 0, len(memberSensitiveFieldRedactors))
+	for field := range memberSensitiveFieldRedactors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// Redact returns a copy of the member without the sensitive fields, the member itself is left untouched.
+// Without sensitive fields the member is returned as is.
+func (f MemberSensitiveFields) Redact(cm *CommitteeMember) *CommitteeMember {
+	if cm == nil || len(f) == 0 {
+		return cm
+	}
+
+	redacted := *cm
+	for _, field := range f {
+		if redact, ok := memberSensitiveFieldRedactors[field]; ok {
+			redact(&redacted)
+		}
+	}
+	return &redacted
+}
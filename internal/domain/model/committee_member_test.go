@@ -8,6 +8,7 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	errs "github.com/linuxfoundation/lfx-v2-committee-service/pkg/errors"
 )
@@ -69,11 +70,33 @@ func TestCommitteeMember_Validate(t *testing.T) {
 			expectError:   true,
 			expectedError: "email is required",
 		},
+		{
+			name: "negative voting weight",
+			member: &CommitteeMember{
+				CommitteeMemberBase: CommitteeMemberBase{
+					Email:  "test@example.com",
+					Voting: CommitteeMemberVotingInfo{Status: "Voting Rep", Weight: float64Ptr(-1)},
+				},
+			},
+			committee:     nonGacCommittee,
+			expectError:   true,
+			expectedError: "voting weight must be non-negative",
+		},
+		{
+			name: "zero voting weight",
+			member: &CommitteeMember{
+				CommitteeMemberBase: CommitteeMemberBase{
+					Email:  "test@example.com",
+					Voting: CommitteeMemberVotingInfo{Status: "Voting Rep", Weight: float64Ptr(0)},
+				},
+			},
+			committee: nonGacCommittee,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := tt.member.Validate(tt.committee)
+			err := tt.member.Validate(tt.committee, MemberPolicy{})
 
 			if tt.expectError {
 				if err == nil {
@@ -97,6 +120,439 @@ func TestCommitteeMember_Validate(t *testing.T) {
 	}
 }
 
+func TestCommitteeMemberVotingInfo_EffectiveWeight(t *testing.T) {
+	tests := []struct {
+		name     string
+		voting   CommitteeMemberVotingInfo
+		expected float64
+	}{
+		{
+			name:     "weight not set defaults to 1",
+			voting:   CommitteeMemberVotingInfo{Status: "Voting Rep"},
+			expected: DefaultVotingWeight,
+		},
+		{
+			name:     "custom weight",
+			voting:   CommitteeMemberVotingInfo{Status: "Voting Rep", Weight: float64Ptr(2.5)},
+			expected: 2.5,
+		},
+		{
+			name:     "zero weight is kept",
+			voting:   CommitteeMemberVotingInfo{Status: "Observer", Weight: float64Ptr(0)},
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.voting.EffectiveWeight(); got != tt.expected {
+				t.Errorf("Expected weight %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func float64Ptr(f float64) *float64 {
+	return &f
+}
+
+func TestCommitteeMember_Validate_Organizations(t *testing.T) {
+	committee := &Committee{CommitteeBase: CommitteeBase{Category: "Other"}}
+
+	tests := []struct {
+		name                 string
+		organization         CommitteeMemberOrganization
+		organizations        []CommitteeMemberOrganization
+		expectError          bool
+		expectedOrganization CommitteeMemberOrganization
+	}{
+		{
+			name: "multiple organizations with one primary",
+			organizations: []CommitteeMemberOrganization{
+				{Name: "Secondary Org"},
+				{ID: "org-1", Name: "Primary Org", Website: "https://primary.example.com", Primary: true},
+			},
+			expectedOrganization: CommitteeMemberOrganization{ID: "org-1", Name: "Primary Org", Website: "https://primary.example.com"},
+		},
+		{
+			name: "multiple organizations without a primary",
+			organizations: []CommitteeMemberOrganization{
+				{Name: "First Org"},
+				{Name: "Second Org"},
+			},
+			expectError: true,
+		},
+		{
+			name: "multiple organizations with two primaries",
+			organizations: []CommitteeMemberOrganization{
+				{Name: "First Org", Primary: true},
+				{Name: "Second Org", Primary: true},
+			},
+			expectError: true,
+		},
+		{
+			name:                 "single organization is kept",
+			organization:         CommitteeMemberOrganization{Name: "Only Org"},
+			expectedOrganization: CommitteeMemberOrganization{Name: "Only Org"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			member := &CommitteeMember{
+				CommitteeMemberBase: CommitteeMemberBase{
+					Email:         "test@example.com",
+					Organization:  tt.organization,
+					Organizations: tt.organizations,
+				},
+			}
+
+			err := member.Validate(committee, MemberPolicy{})
+
+			if tt.expectError {
+				var validationErr errs.Validation
+				if !errors.As(err, &validationErr) {
+					t.Errorf("expected validation error, got %T: %v", err, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+			if !reflect.DeepEqual(member.Organization, tt.expectedOrganization) {
+				t.Errorf("expected organization %+v, got %+v", tt.expectedOrganization, member.Organization)
+			}
+		})
+	}
+}
+
+func TestCommitteeMember_Validate_RequiredFields(t *testing.T) {
+	requiredFields := MemberRequiredFields{
+		"Board": {"job_title", "organization"},
+	}
+
+	boardCommittee := &Committee{CommitteeBase: CommitteeBase{Category: "Board"}}
+	technicalCommittee := &Committee{CommitteeBase: CommitteeBase{Category: "Technical Steering Committee"}}
+
+	tests := []struct {
+		name          string
+		member        *CommitteeMember
+		committee     *Committee
+		expectedError string
+	}{
+		{
+			name: "board member with job title and organization",
+			member: &CommitteeMember{
+				CommitteeMemberBase: CommitteeMemberBase{
+					Email:        "test@example.com",
+					JobTitle:     "CTO",
+					Organization: CommitteeMemberOrganization{Name: "Test Org"},
+				},
+			},
+			committee: boardCommittee,
+		},
+		{
+			name: "board member without job title",
+			member: &CommitteeMember{
+				CommitteeMemberBase: CommitteeMemberBase{
+					Email:        "test@example.com",
+					JobTitle:     "  ",
+					Organization: CommitteeMemberOrganization{Name: "Test Org"},
+				},
+			},
+			committee:     boardCommittee,
+			expectedError: "job_title is required for Board committees",
+		},
+		{
+			name: "board member without organization",
+			member: &CommitteeMember{
+				CommitteeMemberBase: CommitteeMemberBase{
+					Email:    "test@example.com",
+					JobTitle: "CTO",
+				},
+			},
+			committee:     boardCommittee,
+			expectedError: "organization is required for Board committees",
+		},
+		{
+			name: "technical committee has no extra requirements",
+			member: &CommitteeMember{
+				CommitteeMemberBase: CommitteeMemberBase{
+					Email: "test@example.com",
+				},
+			},
+			committee: technicalCommittee,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.member.Validate(tt.committee, MemberPolicy{RequiredFields: requiredFields})
+
+			if tt.expectedError == "" {
+				if err != nil {
+					t.Errorf("expected no error but got: %v", err)
+				}
+				return
+			}
+
+			var validationErr errs.Validation
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("expected validation error, got %T: %v", err, err)
+			}
+			if err.Error() != tt.expectedError {
+				t.Errorf("expected error %q, got %q", tt.expectedError, err.Error())
+			}
+		})
+	}
+}
+
+func TestParseMemberRequiredFields(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		expected    MemberRequiredFields
+		expectError bool
+	}{
+		{
+			name:     "empty value",
+			value:    "",
+			expected: MemberRequiredFields{},
+		},
+		{
+			name:  "multiple categories",
+			value: "Board=job_title, organization ; Technical Steering Committee=job_title",
+			expected: MemberRequiredFields{
+				"Board":                        {"job_title", "organization"},
+				"Technical Steering Committee": {"job_title"},
+			},
+		},
+		{
+			name:        "missing category",
+			value:       "=job_title",
+			expectError: true,
+		},
+		{
+			name:        "unsupported field",
+			value:       "Board=shoe_size",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := ParseMemberRequiredFields(tt.value)
+
+			if tt.expectError {
+				var validationErr errs.Validation
+				if !errors.As(err, &validationErr) {
+					t.Fatalf("expected validation error, got %T: %v", err, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+			if !reflect.DeepEqual(tt.expected, policy) {
+				t.Errorf("expected policy %v, got %v", tt.expected, policy)
+			}
+		})
+	}
+}
+
+func TestParseMemberSensitiveFields(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		expected    MemberSensitiveFields
+		expectError bool
+	}{
+		{
+			name:     "empty value",
+			value:    "",
+			expected: nil,
+		},
+		{
+			name:     "multiple fields",
+			value:    "email, job_title ,",
+			expected: MemberSensitiveFields{"email", "job_title"},
+		},
+		{
+			name:        "unsupported field",
+			value:       "email,shoe_size",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields, err := ParseMemberSensitiveFields(tt.value)
+
+			if tt.expectError {
+				var validationErr errs.Validation
+				if !errors.As(err, &validationErr) {
+					t.Fatalf("expected validation error, got %T: %v", err, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+			if !reflect.DeepEqual(tt.expected, fields) {
+				t.Errorf("expected fields %v, got %v", tt.expected, fields)
+			}
+		})
+	}
+}
+
+func TestMemberSensitiveFields_Redact(t *testing.T) {
+	member := &CommitteeMember{
+		CommitteeMemberBase: CommitteeMemberBase{
+			UID:           "member-1",
+			Email:         "jane@example.com",
+			FirstName:     "Jane",
+			JobTitle:      "Engineer",
+			Organization:  CommitteeMemberOrganization{Name: "Example"},
+			Organizations: []CommitteeMemberOrganization{{Name: "Example", Primary: true}},
+		},
+	}
+
+	t.Run("no sensitive fields returns the member as is", func(t *testing.T) {
+		if got := MemberSensitiveFields(nil).Redact(member); got != member {
+			t.Errorf("expected the same member, got %+v", got)
+		}
+	})
+
+	t.Run("sensitive fields are cleared on a copy", func(t *testing.T) {
+		got := MemberSensitiveFields{"email", "job_title", "organization"}.Redact(member)
+
+		expected := CommitteeMemberBase{UID: "member-1", FirstName: "Jane"}
+		if !reflect.DeepEqual(expected, got.CommitteeMemberBase) {
+			t.Errorf("expected member %+v, got %+v", expected, got.CommitteeMemberBase)
+		}
+		if member.Email != "jane@example.com" || member.JobTitle != "Engineer" || member.Organization.Name != "Example" {
+			t.Errorf("expected the original member to be untouched, got %+v", member)
+		}
+	})
+}
+
+func TestCommitteeMember_Validate_AppointedBy(t *testing.T) {
+	appointedBy := AppointedByPolicy{
+		"Board": {"Vote of Governing Board", "Membership Entitlement"},
+	}
+
+	boardCommittee := &Committee{CommitteeBase: CommitteeBase{Category: "Board"}}
+	technicalCommittee := &Committee{CommitteeBase: CommitteeBase{Category: "Technical Steering Committee"}}
+
+	tests := []struct {
+		name          string
+		appointedBy   string
+		committee     *Committee
+		expectedError string
+	}{
+		{
+			name:        "allowed appointment for the category",
+			appointedBy: "Vote of Governing Board",
+			committee:   boardCommittee,
+		},
+		{
+			name:          "appointment not allowed for the category",
+			appointedBy:   "Vote of TSC Committee",
+			committee:     boardCommittee,
+			expectedError: `appointed_by "Vote of TSC Committee" is not allowed for Board committees`,
+		},
+		{
+			name:        "member without appointment",
+			appointedBy: "None",
+			committee:   boardCommittee,
+		},
+		{
+			name:        "category without allowlist accepts any appointment",
+			appointedBy: "Vote of Governing Board",
+			committee:   technicalCommittee,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			member := &CommitteeMember{
+				CommitteeMemberBase: CommitteeMemberBase{
+					Email:       "test@example.com",
+					AppointedBy: tt.appointedBy,
+				},
+			}
+			err := member.Validate(tt.committee, MemberPolicy{AppointedBy: appointedBy})
+
+			if tt.expectedError == "" {
+				if err != nil {
+					t.Errorf("expected no error but got: %v", err)
+				}
+				return
+			}
+
+			var validationErr errs.Validation
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("expected validation error, got %T: %v", err, err)
+			}
+			if err.Error() != tt.expectedError {
+				t.Errorf("expected error %q, got %q", tt.expectedError, err.Error())
+			}
+		})
+	}
+}
+
+func TestParseAppointedByPolicy(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		expected    AppointedByPolicy
+		expectError bool
+	}{
+		{
+			name:     "empty value",
+			value:    "",
+			expected: AppointedByPolicy{},
+		},
+		{
+			name:  "multiple categories",
+			value: "Board=Vote of Governing Board, Membership Entitlement;Technical Steering Committee=Vote of TSC Committee",
+			expected: AppointedByPolicy{
+				"Board":                        {"Vote of Governing Board", "Membership Entitlement"},
+				"Technical Steering Committee": {"Vote of TSC Committee"},
+			},
+		},
+		{
+			name:        "unsupported appointed_by value",
+			value:       "Board=Coin Toss",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := ParseAppointedByPolicy(tt.value)
+
+			if tt.expectError {
+				var validationErr errs.Validation
+				if !errors.As(err, &validationErr) {
+					t.Fatalf("expected validation error, got %T: %v", err, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+			if !reflect.DeepEqual(tt.expected, policy) {
+				t.Errorf("expected policy %v, got %v", tt.expected, policy)
+			}
+		})
+	}
+}
+
 func TestCommitteeMember_Tags(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -368,3 +824,377 @@ func TestCommitteeMember_BuildIndexKey_Uniqueness(t *testing.T) {
 		t.Errorf("Expected different keys for different committee/email combinations, but got same key: %s", key2)
 	}
 }
+
+func TestCommitteeMember_BuildScopedIndexKey(t *testing.T) {
+	ctx := context.Background()
+
+	member1 := &CommitteeMember{
+		CommitteeMemberBase: CommitteeMemberBase{
+			CommitteeUID: "committee-123",
+			ProjectUID:   "project-1",
+			Email:        "test@example.com",
+		},
+	}
+
+	member2 := &CommitteeMember{
+		CommitteeMemberBase: CommitteeMemberBase{
+			CommitteeUID: "committee-456",
+			ProjectUID:   "project-1",
+			Email:        "TEST@example.com",
+		},
+	}
+
+	// Committee scope is the default index key
+	if member1.BuildScopedIndexKey(ctx, MemberUniquenessScopeCommittee) != member1.BuildIndexKey(ctx) {
+		t.Errorf("Expected committee scoped key to match BuildIndexKey")
+	}
+
+	if member1.BuildScopedIndexKey(ctx, MemberUniquenessScopeCommittee) == member2.BuildScopedIndexKey(ctx, MemberUniquenessScopeCommittee) {
+		t.Errorf("Expected different committee scoped keys for different committees")
+	}
+
+	// Project scope ignores the committee
+	if member1.BuildScopedIndexKey(ctx, MemberUniquenessScopeProject) != member2.BuildScopedIndexKey(ctx, MemberUniquenessScopeProject) {
+		t.Errorf("Expected same project scoped key for the same project and email")
+	}
+
+	if member1.BuildScopedIndexKey(ctx, MemberUniquenessScopeProject) == member1.BuildIndexKey(ctx) {
+		t.Errorf("Expected project scoped key to differ from committee scoped key")
+	}
+}
+
+func TestCommitteeMember_AsOf(t *testing.T) {
+	member := &CommitteeMember{
+		CommitteeMemberBase: CommitteeMemberBase{
+			UID: "member-1",
+			Role: CommitteeMemberRole{
+				Name:      "Chair",
+				StartDate: "2023-01-01",
+				EndDate:   "2023-12-31",
+			},
+			Voting: CommitteeMemberVotingInfo{
+				Status:    "Voting Rep",
+				StartDate: "2023-06-01",
+				EndDate:   "2024-06-30",
+			},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		member          *CommitteeMember
+		date            time.Time
+		expectActive    bool
+		expectRole      string
+		expectVoting    string
+		expectErrorType interface{}
+	}{
+		{
+			name:         "date within role and voting windows",
+			member:       member,
+			date:         time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC),
+			expectActive: true,
+			expectRole:   "Chair",
+			expectVoting: "Voting Rep",
+		},
+		{
+			name:         "date within role window only",
+			member:       member,
+			date:         time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+			expectActive: true,
+			expectRole:   "Chair",
+			expectVoting: "None",
+		},
+		{
+			name:         "date within voting window only",
+			member:       member,
+			date:         time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			expectActive: true,
+			expectRole:   "None",
+			expectVoting: "Voting Rep",
+		},
+		{
+			name:         "end date is inclusive",
+			member:       member,
+			date:         time.Date(2024, 6, 30, 23, 59, 0, 0, time.UTC),
+			expectActive: true,
+			expectRole:   "None",
+			expectVoting: "Voting Rep",
+		},
+		{
+			name:         "date before tenure",
+			member:       member,
+			date:         time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC),
+			expectActive: false,
+		},
+		{
+			name:         "date after tenure",
+			member:       member,
+			date:         time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC),
+			expectActive: false,
+		},
+		{
+			name: "open windows always cover the date",
+			member: &CommitteeMember{CommitteeMemberBase: CommitteeMemberBase{
+				Role:   CommitteeMemberRole{Name: "Lead"},
+				Voting: CommitteeMemberVotingInfo{Status: "Observer"},
+			}},
+			date:         time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			expectActive: true,
+			expectRole:   "Lead",
+			expectVoting: "Observer",
+		},
+		{
+			name: "invalid date",
+			member: &CommitteeMember{CommitteeMemberBase: CommitteeMemberBase{
+				Role: CommitteeMemberRole{Name: "Lead", StartDate: "01/01/2023"},
+			}},
+			date:            time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC),
+			expectErrorType: errs.Validation{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			snapshot, active, err := tt.member.AsOf(tt.date)
+
+			if tt.expectErrorType != nil {
+				if err == nil {
+					t.Fatalf("Expected error but got none")
+				}
+				if reflect.TypeOf(err) != reflect.TypeOf(tt.expectErrorType) {
+					t.Errorf("Expected error type %T, got %T", tt.expectErrorType, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if active != tt.expectActive {
+				t.Fatalf("Expected active %v, got %v", tt.expectActive, active)
+			}
+
+			if !tt.expectActive {
+				if snapshot != nil {
+					t.Errorf("Expected no snapshot for inactive member")
+				}
+				return
+			}
+
+			if snapshot.Role.Name != tt.expectRole {
+				t.Errorf("Expected role %q, got %q", tt.expectRole, snapshot.Role.Name)
+			}
+			if snapshot.Voting.Status != tt.expectVoting {
+				t.Errorf("Expected voting status %q, got %q", tt.expectVoting, snapshot.Voting.Status)
+			}
+		})
+	}
+
+	// The original member is left untouched
+	if member.Role.Name != "Chair" || member.Voting.Status != "Voting Rep" {
+		t.Errorf("Expected original member to be unchanged")
+	}
+}
+
+func TestCommitteeMember_ApplyDefaults(t *testing.T) {
+	tests := []struct {
+		name     string
+		member   CommitteeMember
+		expected CommitteeMemberBase
+	}{
+		{
+			name:   "omitted fields get the schema defaults",
+			member: CommitteeMember{},
+			expected: CommitteeMemberBase{
+				Role:   CommitteeMemberRole{Name: "None"},
+				Voting: CommitteeMemberVotingInfo{Status: "None"},
+				Status: "Active",
+			},
+		},
+		{
+			name: "explicit values are preserved",
+			member: CommitteeMember{
+				CommitteeMemberBase: CommitteeMemberBase{
+					Role:   CommitteeMemberRole{Name: "Chair"},
+					Voting: CommitteeMemberVotingInfo{Status: "Voting Rep"},
+					Status: "Inactive",
+				},
+			},
+			expected: CommitteeMemberBase{
+				Role:   CommitteeMemberRole{Name: "Chair"},
+				Voting: CommitteeMemberVotingInfo{Status: "Voting Rep"},
+				Status: "Inactive",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			member := tt.member
+			member.ApplyDefaults()
+			if !reflect.DeepEqual(member.CommitteeMemberBase, tt.expected) {
+				t.Errorf("expected member %+v, got %+v", tt.expected, member.CommitteeMemberBase)
+			}
+		})
+	}
+}
+
+func TestCommitteeMemberPatch_Apply(t *testing.T) {
+	stringPtr := func(s string) *string { return &s }
+	weight := 2.0
+
+	existing := func() *CommitteeMember {
+		return &CommitteeMember{
+			CommitteeMemberBase: CommitteeMemberBase{
+				UID:          "member-1",
+				Email:        "jane@example.com",
+				FirstName:    "Jane",
+				LastName:     "Doe",
+				JobTitle:     "CTO",
+				AppointedBy:  "Community",
+				Status:       "Active",
+				Role:         CommitteeMemberRole{Name: "Chair", StartDate: "2024-01-01", EndDate: "2025-01-01"},
+				Voting:       CommitteeMemberVotingInfo{Status: "Voting Rep", StartDate: "2024-01-01"},
+				Organization: CommitteeMemberOrganization{ID: "org-1", Name: "Acme", Website: "acme.com"},
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		patch  *CommitteeMemberPatch
+		expect func(member *CommitteeMember)
+	}{
+		{
+			name:   "nil patch leaves the member unchanged",
+			patch:  nil,
+			expect: func(member *CommitteeMember) {},
+		},
+		{
+			name:   "omitted job title is preserved",
+			patch:  &CommitteeMemberPatch{FirstName: stringPtr("Janet")},
+			expect: func(member *CommitteeMember) { member.FirstName = "Janet" },
+		},
+		{
+			name:   "explicitly empty job title is cleared",
+			patch:  &CommitteeMemberPatch{JobTitle: stringPtr("")},
+			expect: func(member *CommitteeMember) { member.JobTitle = "" },
+		},
+		{
+			name:   "role is merged field-wise",
+			patch:  &CommitteeMemberPatch{Role: &CommitteeMemberRolePatch{Name: stringPtr("Vice Chair"), EndDate: stringPtr("")}},
+			expect: func(member *CommitteeMember) { member.Role.Name = "Vice Chair"; member.Role.EndDate = "" },
+		},
+		{
+			name:   "voting is merged field-wise",
+			patch:  &CommitteeMemberPatch{Voting: &CommitteeMemberVotingPatch{Weight: &weight}},
+			expect: func(member *CommitteeMember) { member.Voting.Weight = &weight },
+		},
+		{
+			name:   "organization is merged field-wise",
+			patch:  &CommitteeMemberPatch{Organization: &CommitteeMemberOrganizationPatch{Website: stringPtr("acme.org")}},
+			expect: func(member *CommitteeMember) { member.Organization.Website = "acme.org" },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			member := existing()
+			patched := tt.patch.Apply(member)
+
+			expected := existing()
+			tt.expect(expected)
+			if !reflect.DeepEqual(expected, patched) {
+				t.Errorf("Apply() = %+v, want %+v", patched, expected)
+			}
+			if !reflect.DeepEqual(existing(), member) {
+				t.Errorf("Apply() modified the member: %+v", member)
+			}
+		})
+	}
+
+	if (&CommitteeMemberPatch{}).Apply(nil) != nil {
+		t.Error("Apply(nil) should return nil")
+	}
+}
+
+func TestCommitteeMember_ValidateAlternateFor(t *testing.T) {
+	primary := func() *CommitteeMember {
+		return &CommitteeMember{CommitteeMemberBase: CommitteeMemberBase{UID: "primary", CommitteeUID: "committee-1"}}
+	}
+
+	tests := []struct {
+		name            string
+		alternateForUID *string
+		primary         *CommitteeMember
+		wantErr         bool
+	}{
+		{
+			name:    "member without alternate link",
+			primary: nil,
+		},
+		{
+			name:            "alternate of a member of the same committee",
+			alternateForUID: stringPtr("primary"),
+			primary:         primary(),
+		},
+		{
+			name:            "alternate of a nonexistent member",
+			alternateForUID: stringPtr("primary"),
+			primary:         nil,
+			wantErr:         true,
+		},
+		{
+			name:            "alternate of a member of another committee",
+			alternateForUID: stringPtr("primary"),
+			primary: func() *CommitteeMember {
+				member := primary()
+				member.CommitteeUID = "committee-2"
+				return member
+			}(),
+			wantErr: true,
+		},
+		{
+			name:            "alternate of an alternate",
+			alternateForUID: stringPtr("primary"),
+			primary: func() *CommitteeMember {
+				member := primary()
+				member.AlternateForUID = stringPtr("another")
+				return member
+			}(),
+			wantErr: true,
+		},
+		{
+			name:            "alternate of itself",
+			alternateForUID: stringPtr("alternate"),
+			primary: func() *CommitteeMember {
+				member := primary()
+				member.UID = "alternate"
+				return member
+			}(),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alternate := &CommitteeMember{CommitteeMemberBase: CommitteeMemberBase{
+				UID:             "alternate",
+				CommitteeUID:    "committee-1",
+				AlternateForUID: tt.alternateForUID,
+			}}
+
+			err := alternate.ValidateAlternateFor(tt.primary)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateAlternateFor() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if _, ok := err.(errs.Validation); !ok {
+					t.Errorf("ValidateAlternateFor() error = %T, want errs.Validation", err)
+				}
+			}
+		})
+	}
+}
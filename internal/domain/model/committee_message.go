@@ -5,6 +5,8 @@ package model
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"log/slog"
@@ -34,6 +36,13 @@ type CommitteeMemberMessageData struct {
 	OldMember *CommitteeMember // Only used for ActionUpdated
 }
 
+// Indexed object types, telling apart the dedup IDs of the messages about records sharing a UID
+const (
+	IndexedObjectCommittee         = "committee"
+	IndexedObjectCommitteeSettings = "committee_settings"
+	IndexedObjectCommitteeMember   = "committee_member"
+)
+
 // CommitteeIndexerMessage is a NATS message schema for sending messages related to committees CRUD operations.
 type CommitteeIndexerMessage struct {
 	Action  MessageAction     `json:"action"`
@@ -41,6 +50,85 @@ type CommitteeIndexerMessage struct {
 	Data    any               `json:"data"`
 	// Tags is a list of tags to be set on the indexed resource for search.
 	Tags []string `json:"tags"`
+	// DedupID is identical for every copy of the message, so the consumers can drop the duplicates
+	DedupID string `json:"dedup_id,omitempty"`
+}
+
+// TagLimits bounds the tags carried by indexer messages. A zero value disables the corresponding limit.
+type TagLimits struct {
+	MaxTags      int
+	MaxTagLength int
+}
+
+// Apply returns the tags within the limits, truncating the oversized tags and dropping the excess ones
+func (l TagLimits) Apply(ctx context.Context, tags []string) []string {
+	bounded := tags
+	if l.MaxTags > 0 && len(bounded) > l.MaxTags {
+		slog.WarnContext(ctx, "dropping indexer message tags over the limit",
+			"tag_count", len(bounded),
+			"max_tags", l.MaxTags,
+		)
+		bounded = bounded[:l.MaxTags]
+	}
+	if l.MaxTagLength <= 0 {
+		return bounded
+	}
+
+	truncated := 0
+	result := make([]string, 0, len(bounded))
+	for _, tag := range bounded {
+		if runes := []rune(tag); len(runes) > l.MaxTagLength {
+			tag = string(runes[:l.MaxTagLength])
+			truncated++
+		}
+		result = append(result, tag)
+	}
+	if truncated > 0 {
+		slog.WarnContext(ctx, "truncating oversized indexer message tags",
+			"truncated_count", truncated,
+			"max_tag_length", l.MaxTagLength,
+		)
+	}
+	return result
+}
+
+// MessageDedupID returns the deterministic dedup ID of the message about the object at the given revision
+func MessageDedupID(objectType, uid string, revision uint64, action MessageAction) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%s|%d|%s", objectType, uid, revision, action)))
+	return hex.EncodeToString(sum[:16])
+}
+
+// ChangeRevision returns the revision of a record change used in the dedup IDs.
+// The storage does not return the revision of a write, so the time of the change stands for it:
+// it is the same for every copy of a message and moves with every change.
+func ChangeRevision(updatedAt time.Time) uint64 {
+	return uint64(updatedAt.UnixNano())
+}
+
+// SetDedupID stamps the message with the dedup ID of the object at the given revision
+func (c *CommitteeIndexerMessage) SetDedupID(objectType, uid string, revision uint64) {
+	c.DedupID = MessageDedupID(objectType, uid, revision, c.Action)
+}
+
+// stampDedupID sets the dedup ID from the record the message is about, when it is a known one.
+// Deletion messages only carry the UID, so their dedup ID is set by the caller.
+func (c *CommitteeIndexerMessage) stampDedupID(input any) {
+	switch record := input.(type) {
+	case CommitteeBase:
+		c.SetDedupID(IndexedObjectCommittee, record.UID, ChangeRevision(record.UpdatedAt))
+	case *CommitteeBase:
+		if record != nil {
+			c.SetDedupID(IndexedObjectCommittee, record.UID, ChangeRevision(record.UpdatedAt))
+		}
+	case *CommitteeSettings:
+		if record != nil {
+			c.SetDedupID(IndexedObjectCommitteeSettings, record.UID, ChangeRevision(record.UpdatedAt))
+		}
+	case *CommitteeMember:
+		if record != nil {
+			c.SetDedupID(IndexedObjectCommitteeMember, record.UID, ChangeRevision(record.UpdatedAt))
+		}
+	}
 }
 
 func (c *CommitteeIndexerMessage) Build(ctx context.Context, input any) (*CommitteeIndexerMessage, error) {
@@ -89,6 +177,7 @@ func (c *CommitteeIndexerMessage) Build(ctx context.Context, input any) (*Commit
 	}
 
 	c.Data = payload
+	c.stampDedupID(input)
 
 	return c, nil
 
@@ -137,6 +226,7 @@ type ResourceType string
 
 // ResourceType constants for the resource type of a committee event.
 const (
+	ResourceCommittee       ResourceType = "committee"
 	ResourceCommitteeMember ResourceType = "committee_member"
 )
 
@@ -147,6 +237,8 @@ func (e *CommitteeEvent) Build(ctx context.Context, resource ResourceType, actio
 
 	// Build events depending on the resource type
 	switch resource {
+	case ResourceCommittee:
+		return e.buildCommittees(ctx, resource, action, input)
 	case ResourceCommitteeMember:
 		return e.buildCommitteeMembers(ctx, resource, action, input)
 	default:
@@ -166,6 +258,36 @@ func (e *CommitteeEvent) buildEventType(resource ResourceType, action MessageAct
 	e.EventType = fmt.Sprintf("%s.%s", resource, action)
 }
 
+func (e *CommitteeEvent) buildCommittees(ctx context.Context, resource ResourceType, action MessageAction, input any) (*CommitteeEvent, error) {
+	switch action {
+	case ActionCreated:
+		e.Subject = constants.CommitteeCreatedSubject
+	case ActionUpdated:
+		e.Subject = constants.CommitteeUpdatedSubject
+	case ActionDeleted:
+		e.Subject = constants.CommitteeDeletedSubject
+	default:
+		return nil, fmt.Errorf("unsupported action: %s", action)
+	}
+
+	e.buildEventType(resource, action)
+
+	// Every action carries the committee base, the deleted one for deletions
+	committee, ok := input.(*CommitteeBase)
+	if !ok || committee == nil {
+		slog.ErrorContext(ctx, "invalid input type for CommitteeEvent",
+			"resource", resource,
+			"action", action,
+			"expected", "*CommitteeBase",
+			"got", fmt.Sprintf("%T", input),
+		)
+		return nil, fmt.Errorf("invalid input type, got %T", input)
+	}
+	e.Data = committee
+
+	return e, nil
+}
+
 func (e *CommitteeEvent) buildCommitteeMembers(ctx context.Context, resource ResourceType, action MessageAction, input any) (*CommitteeEvent, error) {
 	switch action {
 	case ActionCreated:
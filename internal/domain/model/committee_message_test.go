@@ -6,6 +6,7 @@ package model
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/linuxfoundation/lfx-v2-committee-service/pkg/constants"
 	"github.com/stretchr/testify/assert"
@@ -343,3 +344,90 @@ func TestCommitteeIndexerMessage_Build_DeleteAction_RawUID(t *testing.T) {
 		})
 	}
 }
+
+func TestMessageDedupID(t *testing.T) {
+	base := MessageDedupID(IndexedObjectCommittee, "committee-1", 7, ActionUpdated)
+
+	assert.Equal(t, base, MessageDedupID(IndexedObjectCommittee, "committee-1", 7, ActionUpdated), "stable for identical content")
+	assert.NotEqual(t, base, MessageDedupID(IndexedObjectCommittee, "committee-1", 8, ActionUpdated), "changes with the revision")
+	assert.NotEqual(t, base, MessageDedupID(IndexedObjectCommittee, "committee-1", 7, ActionDeleted), "changes with the action")
+	assert.NotEqual(t, base, MessageDedupID(IndexedObjectCommittee, "committee-2", 7, ActionUpdated), "changes with the UID")
+	assert.NotEqual(t, base, MessageDedupID(IndexedObjectCommitteeSettings, "committee-1", 7, ActionUpdated), "changes with the object type")
+}
+
+func TestCommitteeIndexerMessage_Build_DedupID(t *testing.T) {
+	ctx := context.Background()
+	updatedAt := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	build := func(input any) string {
+		message := &CommitteeIndexerMessage{Action: ActionUpdated}
+		built, err := message.Build(ctx, input)
+		require.NoError(t, err)
+		return built.DedupID
+	}
+
+	first := build(CommitteeBase{UID: "committee-1", Name: "TSC", UpdatedAt: updatedAt})
+	require.NotEmpty(t, first)
+
+	// a republished copy of the same revision keeps the ID
+	assert.Equal(t, first, build(CommitteeBase{UID: "committee-1", Name: "TSC", UpdatedAt: updatedAt}))
+	assert.Equal(t, first, build(&CommitteeBase{UID: "committee-1", Name: "TSC", UpdatedAt: updatedAt}))
+
+	// a new revision gets a new ID
+	assert.NotEqual(t, first, build(CommitteeBase{UID: "committee-1", Name: "TSC", UpdatedAt: updatedAt.Add(time.Second)}))
+
+	// the settings of the committee get their own ID
+	assert.NotEqual(t, first, build(&CommitteeSettings{UID: "committee-1", UpdatedAt: updatedAt}))
+
+	member := build(&CommitteeMember{CommitteeMemberBase: CommitteeMemberBase{UID: "member-1", UpdatedAt: updatedAt}})
+	assert.Equal(t, MessageDedupID(IndexedObjectCommitteeMember, "member-1", ChangeRevision(updatedAt), ActionUpdated), member)
+
+	// deletions only carry the UID, the caller stamps them
+	deletion := &CommitteeIndexerMessage{Action: ActionDeleted}
+	built, err := deletion.Build(ctx, "committee-1")
+	require.NoError(t, err)
+	assert.Empty(t, built.DedupID)
+	built.SetDedupID(IndexedObjectCommittee, "committee-1", 7)
+	assert.Equal(t, MessageDedupID(IndexedObjectCommittee, "committee-1", 7, ActionDeleted), built.DedupID)
+}
+
+func TestTagLimits_Apply(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		limits   TagLimits
+		tags     []string
+		expected []string
+	}{
+		{
+			name:     "tags within the limits are kept",
+			limits:   TagLimits{MaxTags: 3, MaxTagLength: 10},
+			tags:     []string{"a", "b"},
+			expected: []string{"a", "b"},
+		},
+		{
+			name:     "tag list is capped",
+			limits:   TagLimits{MaxTags: 2, MaxTagLength: 10},
+			tags:     []string{"a", "b", "c", "d"},
+			expected: []string{"a", "b"},
+		},
+		{
+			name:     "oversized tags are truncated",
+			limits:   TagLimits{MaxTags: 5, MaxTagLength: 5},
+			tags:     []string{"short", "name:very long committee name", "ñandúñandú"},
+			expected: []string{"short", "name:", "ñandú"},
+		},
+		{
+			name:     "zero limits keep every tag",
+			tags:     []string{"a", "name:very long committee name"},
+			expected: []string{"a", "name:very long committee name"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.limits.Apply(ctx, tt.tags))
+		})
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package model
+
+import "time"
+
+// CommitteeRevision holds the revisions of the records that make up a full committee.
+// Base and settings are stored under separate keys, so each one moves independently.
+type CommitteeRevision struct {
+	Base     uint64
+	Settings uint64
+}
+
+// CommitteeMemberRevision is a version of a committee member record as stored at a revision,
+// along with the time it was written
+type CommitteeMemberRevision struct {
+	Revision  uint64
+	CreatedAt time.Time
+	Member    *CommitteeMember
+}
@@ -4,6 +4,7 @@
 package model
 
 import (
+	"strings"
 	"time"
 )
 
@@ -13,6 +14,7 @@ type CommitteeSettings struct {
 	BusinessEmailRequired bool      `json:"business_email_required"`
 	ShowMeetingAttendees  bool      `json:"show_meeting_attendees"`
 	MemberVisibility      string    `json:"member_visibility"`
+	AllowedEmailDomains   []string  `json:"allowed_email_domains,omitempty"`
 	LastReviewedAt        *string   `json:"last_reviewed_at,omitempty"`
 	LastReviewedBy        *string   `json:"last_reviewed_by,omitempty"`
 	Writers               []string  `json:"writers"`
@@ -20,3 +22,27 @@ type CommitteeSettings struct {
 	CreatedAt             time.Time `json:"created_at"`
 	UpdatedAt             time.Time `json:"updated_at"`
 }
+
+// AllowsEmailDomain reports whether the email belongs to one of the allowed email domains,
+// accepting subdomains. Every email is allowed when the committee does not restrict domains.
+func (s *CommitteeSettings) AllowsEmailDomain(email string) bool {
+	if s == nil || len(s.AllowedEmailDomains) == 0 {
+		return true
+	}
+
+	_, emailDomain, found := strings.Cut(strings.ToLower(strings.TrimSpace(email)), "@")
+	if !found || emailDomain == "" {
+		return false
+	}
+
+	for _, allowed := range s.AllowedEmailDomains {
+		allowed = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(allowed)), "@")
+		if allowed == "" {
+			continue
+		}
+		if emailDomain == allowed || strings.HasSuffix(emailDomain, "."+allowed) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,113 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package model
+
+import (
+	"strings"
+	"unicode"
+)
+
+// organizationLegalSuffixes are the trailing words ignored when comparing organization names
+var organizationLegalSuffixes = map[string]struct{}{
+	"ag":           {},
+	"co":           {},
+	"company":      {},
+	"corp":         {},
+	"corporation":  {},
+	"gmbh":         {},
+	"inc":          {},
+	"incorporated": {},
+	"limited":      {},
+	"llc":          {},
+	"ltd":          {},
+	"plc":          {},
+}
+
+// NormalizeOrganizationName returns the key used to group organization names written differently.
+// The name is lowercased, dots and apostrophes are dropped, any other punctuation separates words,
+// and trailing legal suffixes (e.g. "Corp", "Inc.") are ignored unless they are the only word,
+// so "IBM", "I.B.M." and "ibm corp" share the same key.
+func NormalizeOrganizationName(name string) string {
+	var builder strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			builder.WriteRune(r)
+		case r == '.' || r == '\'' || r == '’':
+			// abbreviations and possessives, e.g. "I.B.M." or "Macy's"
+		default:
+			builder.WriteRune(' ')
+		}
+	}
+
+	words := strings.Fields(builder.String())
+	for len(words) > 1 {
+		if _, isSuffix := organizationLegalSuffixes[words[len(words)-1]]; !isSuffix {
+			break
+		}
+		words = words[:len(words)-1]
+	}
+
+	return strings.Join(words, " ")
+}
+
+// OrganizationNameIndex groups organization names by their normalized key
+// to suggest the canonical spelling of a name
+type OrganizationNameIndex struct {
+	// variants counts the occurrences of each spelling per normalized key
+	variants map[string]map[string]int
+}
+
+// NewOrganizationNameIndex creates an index holding the given organization names
+func NewOrganizationNameIndex(names ...string) *OrganizationNameIndex {
+	index := &OrganizationNameIndex{variants: make(map[string]map[string]int)}
+	for _, name := range names {
+		index.Add(name)
+	}
+	return index
+}
+
+// Add records an occurrence of the organization name, blank names are ignored
+func (i *OrganizationNameIndex) Add(name string) {
+	name = strings.TrimSpace(name)
+	key := NormalizeOrganizationName(name)
+	if key == "" {
+		return
+	}
+
+	if i.variants[key] == nil {
+		i.variants[key] = make(map[string]int)
+	}
+	i.variants[key][name]++
+}
+
+// Canonical returns the most used spelling of the names sharing the key of the given name,
+// ties being broken alphabetically. It reports false when no such name was added.
+func (i *OrganizationNameIndex) Canonical(name string) (string, bool) {
+	variants, ok := i.variants[NormalizeOrganizationName(name)]
+	if !ok {
+		return "", false
+	}
+
+	var (
+		canonical string
+		maxCount  int
+	)
+	for variant, count := range variants {
+		if count > maxCount || (count == maxCount && variant < canonical) {
+			canonical, maxCount = variant, count
+		}
+	}
+	return canonical, true
+}
+
+// Suggest returns the canonical spelling of the name when it is written differently,
+// and an empty string when the name is unknown or already canonical
+func (i *OrganizationNameIndex) Suggest(name string) string {
+	canonical, ok := i.Canonical(name)
+	if !ok || canonical == strings.TrimSpace(name) {
+		return ""
+	}
+	return canonical
+}
@@ -0,0 +1,65 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeOrganizationName(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "plain name", input: "IBM", expected: "ibm"},
+		{name: "dotted abbreviation", input: "I.B.M.", expected: "ibm"},
+		{name: "legal suffix", input: "ibm corp", expected: "ibm"},
+		{name: "punctuated legal suffix", input: "IBM Corp.", expected: "ibm"},
+		{name: "several legal suffixes", input: "Acme Co., Ltd.", expected: "acme"},
+		{name: "surrounding and inner spaces", input: "  The   Linux  Foundation ", expected: "the linux foundation"},
+		{name: "punctuation separates words", input: "Red-Hat, Inc.", expected: "red hat"},
+		{name: "apostrophes are dropped", input: "Macy's", expected: "macys"},
+		{name: "suffix alone is kept", input: "Company", expected: "company"},
+		{name: "blank name", input: " ", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NormalizeOrganizationName(tt.input))
+		})
+	}
+}
+
+func TestNormalizeOrganizationName_SameKey(t *testing.T) {
+	variants := []string{"IBM", "I.B.M.", "ibm corp", "IBM Corporation", " Ibm, Inc. "}
+	for _, variant := range variants {
+		assert.Equal(t, NormalizeOrganizationName(variants[0]), NormalizeOrganizationName(variant), variant)
+	}
+	assert.NotEqual(t, NormalizeOrganizationName("IBM"), NormalizeOrganizationName("IBX"))
+}
+
+func TestOrganizationNameIndex(t *testing.T) {
+	index := NewOrganizationNameIndex("IBM", "I.B.M.", "IBM", "The Linux Foundation", "", "Acme", "ACME")
+
+	canonical, ok := index.Canonical("ibm corp")
+	assert.True(t, ok)
+	assert.Equal(t, "IBM", canonical, "the most used spelling is canonical")
+
+	canonical, ok = index.Canonical("acme inc")
+	assert.True(t, ok)
+	assert.Equal(t, "ACME", canonical, "ties are broken alphabetically")
+
+	_, ok = index.Canonical("Unknown Org")
+	assert.False(t, ok)
+
+	assert.Equal(t, "IBM", index.Suggest("I.B.M."))
+	assert.Equal(t, "The Linux Foundation", index.Suggest("the linux foundation"))
+	assert.Empty(t, index.Suggest("IBM"), "the canonical spelling needs no suggestion")
+	assert.Empty(t, index.Suggest(" IBM "), "surrounding spaces are ignored")
+	assert.Empty(t, index.Suggest("Unknown Org"))
+	assert.Empty(t, index.Suggest(""))
+}
@@ -0,0 +1,56 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package model
+
+import (
+	"strconv"
+
+	errs "github.com/linuxfoundation/lfx-v2-committee-service/pkg/errors"
+)
+
+// PageOptions represents the page requested from a paginated listing.
+// The page token is the offset of the first item of the page, empty for the first page.
+type PageOptions struct {
+	PageSize  int
+	PageToken string
+}
+
+// PageResult represents a page of items along with the metadata needed to fetch the next one
+type PageResult[T any] struct {
+	Items []T
+	// NextPageToken is the token of the next page, empty on the last page
+	NextPageToken string
+	// TotalCount is the number of items across every page
+	TotalCount int
+}
+
+// Paginate cuts the page described by the options out of the items, which must already be ordered
+func Paginate[T any](items []T, options PageOptions) (*PageResult[T], error) {
+	if options.PageSize <= 0 {
+		return nil, errs.NewValidation("page size must be a positive number")
+	}
+	offset := 0
+	if options.PageToken != "" {
+		parsed, errParse := strconv.Atoi(options.PageToken)
+		if errParse != nil || parsed < 0 {
+			return nil, errs.NewValidation("invalid page token")
+		}
+		offset = parsed
+	}
+
+	page := &PageResult[T]{
+		Items:      []T{},
+		TotalCount: len(items),
+	}
+	if offset >= len(items) {
+		return page, nil
+	}
+
+	end := min(offset+options.PageSize, len(items))
+	page.Items = items[offset:end]
+	if end < len(items) {
+		page.NextPageToken = strconv.Itoa(end)
+	}
+	return page, nil
+}
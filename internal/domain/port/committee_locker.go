@@ -0,0 +1,16 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package port
+
+import (
+	"context"
+	"time"
+)
+
+// CommitteeLocker provides advisory locks serializing operations on the same committee
+type CommitteeLocker interface {
+	// LockCommittee blocks until the lock of the committee is acquired, or the context is done,
+	// and returns the function releasing it. The lock expires after the TTL if it is never released.
+	LockCommittee(ctx context.Context, uid string, ttl time.Duration) (func(), error)
+}
@@ -17,4 +17,6 @@ type CommitteeMemberReader interface {
 	GetMemberRevision(ctx context.Context, uid string) (uint64, error)
 	// ListMembers retrieves all members for a given committee UID
 	ListMembers(ctx context.Context, committeeUID string) ([]*model.CommitteeMember, error)
+	// GetMemberHistory retrieves the stored revisions of a committee member, newest first, up to the limit
+	GetMemberHistory(ctx context.Context, uid string, limit int) ([]*model.CommitteeMemberRevision, error)
 }
@@ -20,4 +20,6 @@ type CommitteeMemberWriter interface {
 
 	// Checkers for uniqueness
 	UniqueMember(ctx context.Context, member *model.CommitteeMember) (string, error)
+	// MemberIndexKey returns the lookup key reserved by UniqueMember for the configured uniqueness scope
+	MemberIndexKey(ctx context.Context, member *model.CommitteeMember) (string, error)
 }
@@ -5,6 +5,7 @@ package port
 
 import (
 	"context"
+	"time"
 
 	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/model"
 )
@@ -20,9 +21,18 @@ type CommitteeReader interface {
 type CommitteeBaseReader interface {
 	GetBase(ctx context.Context, uid string) (*model.CommitteeBase, uint64, error)
 	GetRevision(ctx context.Context, uid string) (uint64, error)
+	// Exists reports whether the committee is present without decoding it
+	Exists(ctx context.Context, uid string) (bool, error)
+	ListBases(ctx context.Context) ([]*model.CommitteeBase, error)
+	// ListChildren returns the UIDs of the committees indexed as children of the parent committee
+	ListChildren(ctx context.Context, parentUID string) ([]string, error)
+	// GetLastIndexed returns the time the committee was last published to the search index, nil if never
+	GetLastIndexed(ctx context.Context, uid string) (*time.Time, error)
 }
 
 // CommitteeSettingsReader handles committee settings reading operations
 type CommitteeSettingsReader interface {
 	GetSettings(ctx context.Context, committeeUID string) (*model.CommitteeSettings, uint64, error)
+	// GetSettingsRevision returns the revision of the committee settings without decoding them
+	GetSettingsRevision(ctx context.Context, committeeUID string) (uint64, error)
 }
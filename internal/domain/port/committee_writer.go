@@ -5,6 +5,7 @@ package port
 
 import (
 	"context"
+	"time"
 
 	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/model"
 )
@@ -26,9 +27,17 @@ type CommitteeBaseWriter interface {
 	// Checkers for uniqueness
 	UniqueNameProject(ctx context.Context, committee *model.Committee) (string, error)
 	UniqueSSOGroupName(ctx context.Context, committee *model.Committee) (string, error)
+
+	// IndexParent records the committee as a child of its parent committee and returns the index key
+	IndexParent(ctx context.Context, committee *model.Committee) (string, error)
+
+	// RecordIndexed stores the time the committee was successfully published to the search index
+	RecordIndexed(ctx context.Context, uid string, indexedAt time.Time) error
 }
 
 // CommitteeSettingsWriter handles committee settings writing operations
 type CommitteeSettingsWriter interface {
 	UpdateSetting(ctx context.Context, settings *model.CommitteeSettings, revision uint64) error
+	// CreateSetting creates the settings of a committee that has none
+	CreateSetting(ctx context.Context, settings *model.CommitteeSettings) error
 }
@@ -0,0 +1,15 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package port
+
+import (
+	"context"
+
+	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/model"
+)
+
+// WebhookNotifier defines the behavior of a service delivering committee events to outbound webhooks
+type WebhookNotifier interface {
+	Notify(ctx context.Context, event *model.CommitteeEvent) error
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/model"
 	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/port"
+	"github.com/linuxfoundation/lfx-v2-committee-service/pkg/constants"
 	"github.com/linuxfoundation/lfx-v2-committee-service/pkg/errors"
 )
 
@@ -36,10 +37,13 @@ func NewMockRepository() *MockRepository {
 			projectSlugs:       make(map[string]string),
 			projectNames:       make(map[string]string),
 			committeeIndexKeys: make(map[string]*model.Committee),
+			nameReservations:   make(map[string]string),
 			memberIndexKeys:    make(map[string]map[string]*model.CommitteeMember),
 			committeeRevisions: make(map[string]uint64),
 			settingsRevisions:  make(map[string]uint64),
 			memberRevisions:    make(map[string]uint64),
+			memberHistory:      make(map[string][]*model.CommitteeMemberRevision),
+			lastIndexed:        make(map[string]time.Time),
 		}
 
 		// Add some sample data
@@ -191,6 +195,8 @@ func NewMockRepository() *MockRepository {
 		mock.memberIndexKeys["committee-1"][sampleMember2.BuildIndexKey(ctx)] = sampleMember2
 		mock.memberRevisions[sampleMember1.UID] = 1
 		mock.memberRevisions[sampleMember2.UID] = 1
+		mock.recordMemberRevision(sampleMember1, 1)
+		mock.recordMemberRevision(sampleMember2, 1)
 
 		globalMockRepo = mock
 	})
@@ -206,12 +212,17 @@ type MockRepository struct {
 	projectSlugs       map[string]string                            // projectUID -> slug
 	projectNames       map[string]string                            // projectUID -> name
 	committeeIndexKeys map[string]*model.Committee                  // indexKey -> committee
+	nameReservations   map[string]string                            // indexKey -> UID of the committee reserving it
 	memberIndexKeys    map[string]map[string]*model.CommitteeMember // committeeUID -> indexKey -> member
 	// Revision tracking for optimistic locking
-	committeeRevisions map[string]uint64 // committeeUID -> revision
-	settingsRevisions  map[string]uint64 // committeeUID -> settings revision
-	memberRevisions    map[string]uint64 // memberUID -> revision
-	mu                 sync.RWMutex      // Protect concurrent access to maps
+	committeeRevisions map[string]uint64                           // committeeUID -> revision
+	settingsRevisions  map[string]uint64                           // committeeUID -> settings revision
+	memberRevisions    map[string]uint64                           // memberUID -> revision
+	memberHistory      map[string][]*model.CommitteeMemberRevision // memberUID -> stored revisions, oldest first
+	lastIndexed        map[string]time.Time                        // committeeUID -> last successful index publish
+	// memberUniquenessScope is the scope in which member emails must be unique
+	memberUniquenessScope model.MemberUniquenessScope
+	mu                    sync.RWMutex // Protect concurrent access to maps
 }
 
 // ================== CommitteeBaseReader implementation ==================
@@ -242,7 +253,8 @@ func (m *MockRepository) GetRevision(ctx context.Context, uid string) (uint64, e
 	defer m.mu.RUnlock()
 
 	_, exists := m.committees[uid]
-	if !exists {
+	_, reserved := m.nameReservations[uid]
+	if !exists && !reserved {
 		return 0, errors.NewNotFound(fmt.Sprintf("committee with UID %s not found", uid))
 	}
 
@@ -250,6 +262,63 @@ func (m *MockRepository) GetRevision(ctx context.Context, uid string) (uint64, e
 	return 1, nil
 }
 
+// Exists reports whether a committee is present by UID
+func (m *MockRepository) Exists(ctx context.Context, uid string) (bool, error) {
+	slog.DebugContext(ctx, "mock repository: checking committee existence", "uid", uid)
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	_, exists := m.committees[uid]
+	return exists, nil
+}
+
+// ListBases retrieves all committee bases
+func (m *MockRepository) ListBases(ctx context.Context) ([]*model.CommitteeBase, error) {
+	slog.DebugContext(ctx, "mock repository: listing committees")
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	committees := make([]*model.CommitteeBase, 0, len(m.committees))
+	for _, committee := range m.committees {
+		// Return copies to avoid data races
+		baseCopy := committee.CommitteeBase
+		committees = append(committees, &baseCopy)
+	}
+
+	return committees, nil
+}
+
+// ListChildren retrieves the UIDs of the committees whose parent is the given committee
+func (m *MockRepository) ListChildren(ctx context.Context, parentUID string) ([]string, error) {
+	slog.DebugContext(ctx, "mock repository: listing child committees", "parent_uid", parentUID)
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var children []string
+	for _, committee := range m.committees {
+		if committee.ParentUID != nil && *committee.ParentUID == parentUID {
+			children = append(children, committee.CommitteeBase.UID)
+		}
+	}
+
+	return children, nil
+}
+
+// GetLastIndexed returns the time the committee was last published to the search index, nil if never
+func (m *MockRepository) GetLastIndexed(ctx context.Context, uid string) (*time.Time, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	indexedAt, exists := m.lastIndexed[uid]
+	if !exists {
+		return nil, nil
+	}
+	return &indexedAt, nil
+}
+
 // ================== CommitteeSettingsReader implementation ==================
 
 // GetSettings retrieves committee settings by committee UID
@@ -268,6 +337,21 @@ func (m *MockRepository) GetSettings(ctx context.Context, committeeUID string) (
 	return settings, 1, nil
 }
 
+// GetSettingsRevision retrieves the revision of the committee settings
+func (m *MockRepository) GetSettingsRevision(ctx context.Context, committeeUID string) (uint64, error) {
+	slog.DebugContext(ctx, "mock repository: getting committee settings revision", "committee_uid", committeeUID)
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if _, exists := m.committeeSettings[committeeUID]; !exists {
+		return 0, errors.NewNotFound(fmt.Sprintf("committee settings for UID %s not found", committeeUID))
+	}
+
+	// Return version 1 for mock (in real implementation this would be the actual revision)
+	return 1, nil
+}
+
 // ================== CommitteeMemberReader implementation ==================
 
 // GetMember retrieves a committee member by member UID
@@ -314,6 +398,31 @@ func (m *MockRepository) GetMemberRevision(ctx context.Context, memberUID string
 	return 0, errors.NewNotFound(fmt.Sprintf("member with UID %s not found", memberUID))
 }
 
+// GetMemberHistory retrieves the recorded revisions of a committee member, newest first
+func (m *MockRepository) GetMemberHistory(ctx context.Context, memberUID string, limit int) ([]*model.CommitteeMemberRevision, error) {
+	slog.DebugContext(ctx, "mock repository: getting member history", "member_uid", memberUID, "limit", limit)
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	history, exists := m.memberHistory[memberUID]
+	if !exists {
+		return nil, errors.NewNotFound(fmt.Sprintf("member with UID %s not found", memberUID))
+	}
+
+	revisions := make([]*model.CommitteeMemberRevision, 0, len(history))
+	for i := len(history) - 1; i >= 0; i-- {
+		if limit > 0 && len(revisions) >= limit {
+			break
+		}
+		revisionCopy := *history[i]
+		memberCopy := *history[i].Member
+		revisionCopy.Member = &memberCopy
+		revisions = append(revisions, &revisionCopy)
+	}
+	return revisions, nil
+}
+
 // ListMembers retrieves all members for a committee
 func (m *MockRepository) ListMembers(ctx context.Context, committeeUID string) ([]*model.CommitteeMember, error) {
 	slog.DebugContext(ctx, "mock repository: listing committee members", "committee_uid", committeeUID)
@@ -353,7 +462,10 @@ func (w *MockCommitteeWriter) Create(ctx context.Context, committee *model.Commi
 	committee.CommitteeBase.CreatedAt = now
 	committee.CommitteeBase.UpdatedAt = now
 
-	// Create committee settings as well
+	// Create committee settings as well, a committee never exists without settings
+	if committee.CommitteeSettings == nil {
+		committee.CommitteeSettings = &model.CommitteeSettings{}
+	}
 	committee.CommitteeSettings.UID = committee.CommitteeBase.UID
 	committee.CommitteeSettings.CreatedAt = now
 	committee.CommitteeSettings.UpdatedAt = now
@@ -397,6 +509,12 @@ func (w *MockCommitteeWriter) Delete(ctx context.Context, uid string, revision u
 	w.mock.mu.Lock()
 	defer w.mock.mu.Unlock()
 
+	// Name reservations are deleted like the lookup keys of the real storage
+	if _, reserved := w.mock.nameReservations[uid]; reserved {
+		delete(w.mock.nameReservations, uid)
+		return nil
+	}
+
 	// Check if committee exists and get it to obtain the index key
 	committee, exists := w.mock.committees[uid]
 	if !exists {
@@ -405,6 +523,7 @@ func (w *MockCommitteeWriter) Delete(ctx context.Context, uid string, revision u
 
 	// Get the index key before deleting
 	indexKey := committee.BuildIndexKey(ctx)
+	delete(w.mock.nameReservations, indexKey)
 
 	// Delete committee and its settings
 	delete(w.mock.committees, uid)
@@ -412,27 +531,33 @@ func (w *MockCommitteeWriter) Delete(ctx context.Context, uid string, revision u
 	delete(w.mock.committeeIndexKeys, indexKey)
 	delete(w.mock.committeeRevisions, uid)
 	delete(w.mock.settingsRevisions, uid)
+	delete(w.mock.lastIndexed, uid)
 
 	return nil
 }
 
-// UniqueNameProject verifies if a committee with the same name and project exists
-// Returns conflict error if found (for uniqueness checking)
+// UniqueNameProject reserves the name and project combination of the committee.
+// The check and the reservation happen under the same lock, like the create-only operation
+// of the real storage, so only one of several concurrent callers gets the reservation.
+// Returns conflict error if the combination is already taken or reserved.
 func (w *MockCommitteeWriter) UniqueNameProject(ctx context.Context, committee *model.Committee) (string, error) {
 	nameProjectKey := committee.BuildIndexKey(ctx)
-	slog.DebugContext(ctx, "mock committee writer: checking uniqueness by name project key", "name_project_key", nameProjectKey)
+	slog.DebugContext(ctx, "mock committee writer: reserving name project key", "name_project_key", nameProjectKey)
 
-	w.mock.mu.RLock()
-	defer w.mock.mu.RUnlock()
+	w.mock.mu.Lock()
+	defer w.mock.mu.Unlock()
 
 	existing, exists := w.mock.committeeIndexKeys[nameProjectKey]
 	if exists {
 		// Return conflict error to indicate non-uniqueness
 		return existing.CommitteeBase.UID, errors.NewConflict(fmt.Sprintf("committee with name project key %s already exists", nameProjectKey))
 	}
+	if reservedBy, reserved := w.mock.nameReservations[nameProjectKey]; reserved {
+		return reservedBy, errors.NewConflict(fmt.Sprintf("committee with name project key %s already exists", nameProjectKey))
+	}
 
-	// Return not found if unique (no conflict)
-	return "", errors.NewNotFound(fmt.Sprintf("committee with name project key %s not found", nameProjectKey))
+	w.mock.nameReservations[nameProjectKey] = committee.CommitteeBase.UID
+	return nameProjectKey, nil
 }
 
 // UniqueSSOGroupName verifies if a committee with the same SSO group name exists
@@ -454,6 +579,28 @@ func (w *MockCommitteeWriter) UniqueSSOGroupName(ctx context.Context, committee
 	return "", errors.NewNotFound(fmt.Sprintf("committee with SSO group name %s not found", committee.SSOGroupName))
 }
 
+// IndexParent returns the parent index key, children are resolved from the stored committees
+func (w *MockCommitteeWriter) IndexParent(ctx context.Context, committee *model.Committee) (string, error) {
+	if committee.ParentUID == nil || *committee.ParentUID == "" {
+		return "", errors.NewValidation("committee has no parent")
+	}
+
+	slog.DebugContext(ctx, "mock committee writer: indexing parent", "uid", committee.CommitteeBase.UID, "parent_uid", *committee.ParentUID)
+
+	return fmt.Sprintf(constants.KVLookupParentPrefix, *committee.ParentUID) + committee.CommitteeBase.UID, nil
+}
+
+// RecordIndexed stores the time the committee was successfully published to the search index
+func (w *MockCommitteeWriter) RecordIndexed(ctx context.Context, uid string, indexedAt time.Time) error {
+	slog.DebugContext(ctx, "mock committee writer: recording index status", "uid", uid)
+
+	w.mock.mu.Lock()
+	defer w.mock.mu.Unlock()
+
+	w.mock.lastIndexed[uid] = indexedAt
+	return nil
+}
+
 // ================== CommitteeSettingsWriter implementation ==================
 
 // UpdateSetting updates committee settings
@@ -481,6 +628,27 @@ func (w *MockCommitteeWriter) UpdateSetting(ctx context.Context, settings *model
 	return nil
 }
 
+// CreateSetting creates the settings of a committee that has none
+func (w *MockCommitteeWriter) CreateSetting(ctx context.Context, settings *model.CommitteeSettings) error {
+	slog.DebugContext(ctx, "mock committee writer: creating settings", "committee_uid", settings.UID)
+
+	w.mock.mu.Lock()
+	defer w.mock.mu.Unlock()
+
+	if existing, exists := w.mock.committeeSettings[settings.UID]; exists && existing != nil {
+		return errors.NewConflict(fmt.Sprintf("committee settings for UID %s already exist", settings.UID))
+	}
+
+	w.mock.committeeSettings[settings.UID] = settings
+	w.mock.settingsRevisions[settings.UID] = 1
+
+	if committee, exists := w.mock.committees[settings.UID]; exists {
+		committee.CommitteeSettings = settings
+	}
+
+	return nil
+}
+
 // ================== CommitteeMemberWriter implementation ==================
 
 // CreateMember creates a new committee member
@@ -504,6 +672,13 @@ func (w *MockCommitteeWriter) CreateMember(ctx context.Context, member *model.Co
 	}
 	committeeUID := member.CommitteeUID
 
+	// Member UIDs are unique across all committees
+	for _, committeeMembers := range w.mock.committeeMembers {
+		if _, exists := committeeMembers[member.UID]; exists {
+			return errors.NewConflict(fmt.Sprintf("committee member with UID %s already exists", member.UID))
+		}
+	}
+
 	// Initialize committee members map if it doesn't exist
 	if w.mock.committeeMembers[committeeUID] == nil {
 		w.mock.committeeMembers[committeeUID] = make(map[string]*model.CommitteeMember)
@@ -516,6 +691,7 @@ func (w *MockCommitteeWriter) CreateMember(ctx context.Context, member *model.Co
 	w.mock.committeeMembers[committeeUID][member.UID] = member
 	w.mock.memberIndexKeys[committeeUID][member.BuildIndexKey(ctx)] = member
 	w.mock.memberRevisions[member.UID] = 1
+	w.mock.recordMemberRevision(member, 1)
 
 	return nil
 }
@@ -547,6 +723,7 @@ func (w *MockCommitteeWriter) UpdateMember(ctx context.Context, member *model.Co
 	// Update revision
 	currentRevision := w.mock.memberRevisions[member.UID]
 	w.mock.memberRevisions[member.UID] = currentRevision + 1
+	w.mock.recordMemberRevision(member, currentRevision+1)
 
 	return member, nil
 }
@@ -580,19 +757,32 @@ func (w *MockCommitteeWriter) DeleteMember(ctx context.Context, memberUID string
 	delete(w.mock.committeeMembers[foundCommitteeUID], memberUID)
 	delete(w.mock.memberIndexKeys[foundCommitteeUID], indexKey)
 	delete(w.mock.memberRevisions, memberUID)
+	delete(w.mock.memberHistory, memberUID)
 
 	return nil
 }
 
-// UniqueMember verifies if a member is unique (based on email or other unique identifiers)
+// UniqueMember verifies if a member is unique (based on email) within the configured uniqueness scope
 func (w *MockCommitteeWriter) UniqueMember(ctx context.Context, member *model.CommitteeMember) (string, error) {
 	slog.DebugContext(ctx, "mock committee writer: checking member uniqueness", "member_uid", member.UID, "email", member.Email)
 
 	w.mock.mu.RLock()
 	defer w.mock.mu.RUnlock()
 
-	// Check across all committees for existing member with same email
-	for _, committeeMembers := range w.mock.committeeMembers {
+	inScope := func(committeeUID string) bool {
+		if w.mock.memberUniquenessScope != model.MemberUniquenessScopeProject {
+			return committeeUID == member.CommitteeUID
+		}
+		committee, exists := w.mock.committees[committeeUID]
+		current, currentExists := w.mock.committees[member.CommitteeUID]
+		return exists && currentExists && committee.ProjectUID == current.ProjectUID
+	}
+
+	// Check the committees in scope for existing member with same email
+	for committeeUID, committeeMembers := range w.mock.committeeMembers {
+		if !inScope(committeeUID) {
+			continue
+		}
 		for _, existing := range committeeMembers {
 			if existing.Email == member.Email && existing.UID != member.UID {
 				// Return conflict error to indicate non-uniqueness
@@ -604,6 +794,20 @@ func (w *MockCommitteeWriter) UniqueMember(ctx context.Context, member *model.Co
 	return "", nil
 }
 
+// MemberIndexKey returns the member lookup key for the configured uniqueness scope
+func (w *MockCommitteeWriter) MemberIndexKey(ctx context.Context, member *model.CommitteeMember) (string, error) {
+	w.mock.mu.RLock()
+	defer w.mock.mu.RUnlock()
+
+	if w.mock.memberUniquenessScope == model.MemberUniquenessScopeProject && member.ProjectUID == "" {
+		if committee, exists := w.mock.committees[member.CommitteeUID]; exists {
+			member.ProjectUID = committee.ProjectUID
+		}
+	}
+
+	return fmt.Sprintf(constants.KVLookupMemberPrefix, member.BuildScopedIndexKey(ctx, w.mock.memberUniquenessScope)), nil
+}
+
 // MockProjectRetriever implements ProjectRetriever interface
 type MockProjectRetriever struct {
 	mock *MockRepository
@@ -690,6 +894,15 @@ func (m *MockRepository) AddCommittee(committee *model.Committee) {
 	m.settingsRevisions[committee.CommitteeBase.UID] = 1
 }
 
+// RemoveCommitteeSettings drops the stored settings of a committee (useful for testing)
+func (m *MockRepository) RemoveCommitteeSettings(uid string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.committeeSettings, uid)
+	delete(m.settingsRevisions, uid)
+}
+
 // AddProjectSlug adds a project slug mapping (useful for testing)
 func (m *MockRepository) AddProjectSlug(uid, slug string) {
 	m.mu.Lock()
@@ -715,6 +928,16 @@ func (m *MockRepository) AddProject(uid, slug, name string) {
 	m.projectNames[uid] = name
 }
 
+// recordMemberRevision keeps a copy of the member as stored at the revision, the caller must hold the lock
+func (m *MockRepository) recordMemberRevision(member *model.CommitteeMember, revision uint64) {
+	memberCopy := *member
+	m.memberHistory[member.UID] = append(m.memberHistory[member.UID], &model.CommitteeMemberRevision{
+		Revision:  revision,
+		CreatedAt: time.Now(),
+		Member:    &memberCopy,
+	})
+}
+
 // ClearAll clears all mock data (useful for testing)
 func (m *MockRepository) ClearAll() {
 	m.mu.Lock()
@@ -726,10 +949,22 @@ func (m *MockRepository) ClearAll() {
 	m.projectSlugs = make(map[string]string)
 	m.projectNames = make(map[string]string)
 	m.committeeIndexKeys = make(map[string]*model.Committee)
+	m.nameReservations = make(map[string]string)
 	m.memberIndexKeys = make(map[string]map[string]*model.CommitteeMember)
 	m.committeeRevisions = make(map[string]uint64)
 	m.settingsRevisions = make(map[string]uint64)
 	m.memberRevisions = make(map[string]uint64)
+	m.memberHistory = make(map[string][]*model.CommitteeMemberRevision)
+	m.lastIndexed = make(map[string]time.Time)
+	m.memberUniquenessScope = model.MemberUniquenessScopeCommittee
+}
+
+// SetMemberUniquenessScope sets the scope in which member emails must be unique (useful for testing)
+func (m *MockRepository) SetMemberUniquenessScope(scope model.MemberUniquenessScope) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.memberUniquenessScope = scope
 }
 
 // GetCommitteeCount returns the total number of committees
@@ -755,6 +990,7 @@ func (m *MockRepository) AddCommitteeMember(committeeUID string, member *model.C
 	m.committeeMembers[committeeUID][member.UID] = member
 	m.memberIndexKeys[committeeUID][member.BuildIndexKey(context.Background())] = member
 	m.memberRevisions[member.UID] = 1
+	m.recordMemberRevision(member, 1)
 }
 
 // GetCommitteeMemberCount returns the total number of members for a committee
@@ -0,0 +1,59 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package mock
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	errs "github.com/linuxfoundation/lfx-v2-committee-service/pkg/errors"
+)
+
+// MockCommitteeLocker is an in-memory implementation of the CommitteeLocker interface.
+// The TTL is ignored, a lock is held until it is released.
+type MockCommitteeLocker struct {
+	mu    sync.Mutex
+	locks map[string]chan struct{} // committeeUID -> closed on release
+}
+
+// LockCommittee blocks until no other caller holds the lock of the committee
+func (l *MockCommitteeLocker) LockCommittee(ctx context.Context, uid string, ttl time.Duration) (func(), error) {
+	slog.DebugContext(ctx, "mock committee locker: locking committee", "committee_uid", uid)
+
+	for {
+		l.mu.Lock()
+		held, locked := l.locks[uid]
+		if !locked {
+			released := make(chan struct{})
+			l.locks[uid] = released
+			l.mu.Unlock()
+
+			var once sync.Once
+			return func() {
+				once.Do(func() {
+					l.mu.Lock()
+					defer l.mu.Unlock()
+					delete(l.locks, uid)
+					close(released)
+				})
+			}, nil
+		}
+		l.mu.Unlock()
+
+		select {
+		case <-held:
+		case <-ctx.Done():
+			return nil, errs.NewServiceUnavailable("timed out waiting for committee lock", ctx.Err())
+		}
+	}
+}
+
+// NewMockCommitteeLocker creates a new in-memory committee locker
+func NewMockCommitteeLocker() *MockCommitteeLocker {
+	return &MockCommitteeLocker{
+		locks: make(map[string]chan struct{}),
+	}
+}
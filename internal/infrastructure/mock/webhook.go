@@ -0,0 +1,59 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package mock
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/model"
+)
+
+// MockWebhookNotifier is a mock implementation of the WebhookNotifier interface
+// recording the notified events
+type MockWebhookNotifier struct {
+	mu     sync.Mutex
+	events []*model.CommitteeEvent
+	// Err, when set, is returned by every notification
+	Err error
+}
+
+// Notify records the event
+func (m *MockWebhookNotifier) Notify(ctx context.Context, event *model.CommitteeEvent) error {
+	slog.InfoContext(ctx, "mock webhook notifier: event notified",
+		"event_type", event.EventType,
+	)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.events = append(m.events, event)
+	return m.Err
+}
+
+// Events returns a copy of the notified events
+func (m *MockWebhookNotifier) Events() []*model.CommitteeEvent {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]*model.CommitteeEvent(nil), m.events...)
+}
+
+// EventTypes returns the types of the notified events
+func (m *MockWebhookNotifier) EventTypes() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	eventTypes := make([]string, 0, len(m.events))
+	for _, event := range m.events {
+		eventTypes = append(eventTypes, event.EventType)
+	}
+	return eventTypes
+}
+
+// NewMockWebhookNotifier creates a mock webhook notifier
+func NewMockWebhookNotifier() *MockWebhookNotifier {
+	return &MockWebhookNotifier{}
+}
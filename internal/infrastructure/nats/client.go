@@ -5,7 +5,12 @@ package nats
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/port"
@@ -22,6 +27,9 @@ type NATSClient struct {
 	config  Config
 	kvStore map[string]jetstream.KeyValue
 	timeout time.Duration
+
+	mu                sync.Mutex
+	reconnectHandlers []func()
 }
 
 // NATSClientInterface defines the interface for NATS operations
@@ -50,6 +58,77 @@ func (c *NATSClient) IsReady(ctx context.Context) error {
 	return nil
 }
 
+// OnReconnect registers a handler invoked every time the connection is re-established
+func (c *NATSClient) OnReconnect(handler func()) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.reconnectHandlers = append(c.reconnectHandlers, handler)
+}
+
+// reconnected invokes the registered reconnect handlers
+func (c *NATSClient) reconnected() {
+	c.mu.Lock()
+	handlers := append([]func(){}, c.reconnectHandlers...)
+	c.mu.Unlock()
+
+	for _, handler := range handlers {
+		handler()
+	}
+}
+
+// CheckConfig validates the configuration and makes sure at least one of the NATS servers
+// accepts connections, so a wrong configuration is reported before serving traffic
+func CheckConfig(ctx context.Context, config Config) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	dialer := net.Dialer{Timeout: config.Timeout}
+	var (
+		errDial     error
+		unreachable []string
+	)
+	for _, server := range config.servers() {
+		serverURL, _ := url.Parse(server)
+		address := serverURL.Host
+		if serverURL.Port() == "" {
+			address = net.JoinHostPort(serverURL.Hostname(), defaultPort(serverURL.Scheme))
+		}
+
+		conn, err := dialer.DialContext(ctx, "tcp", address)
+		if err != nil {
+			errDial = err
+			unreachable = append(unreachable, serverURL.Redacted())
+			slog.WarnContext(ctx, "NATS server is unreachable",
+				"error", err,
+				"url", serverURL.Redacted(),
+			)
+			continue
+		}
+		_ = conn.Close()
+		return nil
+	}
+
+	return errors.NewServiceUnavailable(fmt.Sprintf("no NATS server is reachable at %s", strings.Join(unreachable, ", ")), errDial)
+}
+
+// defaultPort returns the port used by the NATS client when the server URL has none
+func defaultPort(scheme string) string {
+	switch scheme {
+	case "ws":
+		return "80"
+	case "wss":
+		return "443"
+	default:
+		return "4222"
+	}
+}
+
+// keyValueBinder is the subset of the JetStream API used to bind the key-value stores
+type keyValueBinder interface {
+	KeyValue(ctx context.Context, bucket string) (jetstream.KeyValue, error)
+}
+
 // KeyValueStore creates a JetStream client and gets the key-value store for projects.
 func (c *NATSClient) KeyValueStore(ctx context.Context, bucketName string) error {
 	js, err := jetstream.New(c.conn)
@@ -60,12 +139,18 @@ func (c *NATSClient) KeyValueStore(ctx context.Context, bucketName string) error
 		)
 		return err
 	}
-	kvStore, err := js.KeyValue(ctx, bucketName)
+	return c.bindKeyValueStore(ctx, js, bucketName)
+}
+
+// bindKeyValueStore gets the configured bucket for the logical bucket name and registers it under
+// the logical name, so the storage is unaware of the bucket prefix
+func (c *NATSClient) bindKeyValueStore(ctx context.Context, js keyValueBinder, bucketName string) error {
+	configuredName := c.config.bucketName(bucketName)
+	kvStore, err := js.KeyValue(ctx, configuredName)
 	if err != nil {
 		slog.ErrorContext(ctx, "error getting NATS JetStream key-value store",
 			"error", err,
-			"nats_url", c.conn.ConnectedUrl(),
-			"bucket", bucketName,
+			"bucket", configuredName,
 		)
 		return err
 	}
@@ -77,6 +162,11 @@ func (c *NATSClient) KeyValueStore(ctx context.Context, bucketName string) error
 	return nil
 }
 
+// QueueGroup returns the queue group the subject subscriptions join
+func (c *NATSClient) QueueGroup() string {
+	return c.config.queueGroup()
+}
+
 // SubscribeWithTransportMessenger subscribes to a subject with proper TransportMessenger handling
 func (c *NATSClient) SubscribeWithTransportMessenger(ctx context.Context, subject string, queueName string, handler func(context.Context, port.TransportMessenger)) (*nats.Subscription, error) {
 	return c.conn.QueueSubscribe(subject, queueName, func(msg *nats.Msg) {
@@ -108,6 +198,11 @@ func NewClient(ctx context.Context, config Config) (*NATSClient, error) {
 		return nil, errors.NewUnexpected("NATS URL is required")
 	}
 
+	client := &NATSClient{
+		config:  config,
+		timeout: config.Timeout,
+	}
+
 	// Configure NATS connection options
 	opts := []nats.Option{
 		nats.Name(constants.ServiceName),
@@ -119,6 +214,7 @@ func NewClient(ctx context.Context, config Config) (*NATSClient, error) {
 		}),
 		nats.ReconnectHandler(func(nc *nats.Conn) {
 			slog.InfoContext(ctx, "NATS reconnected", "url", nc.ConnectedUrl())
+			client.reconnected()
 		}),
 		nats.ErrorHandler(func(_ *nats.Conn, s *nats.Subscription, err error) {
 			if s != nil {
@@ -138,11 +234,7 @@ func NewClient(ctx context.Context, config Config) (*NATSClient, error) {
 		return nil, errors.NewServiceUnavailable("failed to connect to NATS", err)
 	}
 
-	client := &NATSClient{
-		conn:    conn,
-		config:  config,
-		timeout: config.Timeout,
-	}
+	client.conn = conn
 
 	for _, bucketName := range []string{
 		constants.KVBucketNameCommittees,
@@ -154,10 +246,10 @@ func NewClient(ctx context.Context, config Config) (*NATSClient, error) {
 				"error", err,
 				"bucket", bucketName,
 			)
-			return nil, errors.NewServiceUnavailable("failed to initialize NATS key-value store", err)
+			return nil, errors.NewServiceUnavailable(fmt.Sprintf("failed to initialize NATS key-value store %s, make sure the bucket exists", client.config.bucketName(bucketName)), err)
 		}
 		slog.InfoContext(ctx, "NATS key-value store initialized",
-			"bucket", bucketName,
+			"bucket", client.config.bucketName(bucketName),
 		)
 	}
 
@@ -0,0 +1,93 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-committee-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-committee-service/pkg/errors"
+)
+
+// fakeJetStream records the buckets requested and serves in-memory key-value stores
+type fakeJetStream struct {
+	requested []string
+}
+
+func (js *fakeJetStream) KeyValue(ctx context.Context, bucket string) (jetstream.KeyValue, error) {
+	js.requested = append(js.requested, bucket)
+	return newFakeKeyValue(bucket), nil
+}
+
+func TestNATSClient_BindKeyValueStore(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("default names", func(t *testing.T) {
+		client := &NATSClient{}
+		js := &fakeJetStream{}
+
+		require.NoError(t, client.bindKeyValueStore(ctx, js, constants.KVBucketNameCommittees))
+
+		assert.Equal(t, []string{constants.KVBucketNameCommittees}, js.requested)
+		assert.Equal(t, constants.CommitteeAPIQueue, client.QueueGroup())
+	})
+
+	t.Run("configured names", func(t *testing.T) {
+		client := &NATSClient{config: Config{BucketPrefix: "staging-", QueueGroup: "staging.committee-api.queue"}}
+		js := &fakeJetStream{}
+
+		require.NoError(t, client.bindKeyValueStore(ctx, js, constants.KVBucketNameCommittees))
+		require.NoError(t, client.bindKeyValueStore(ctx, js, constants.KVBucketNameCommitteeMembers))
+
+		assert.Equal(t, []string{"staging-committees", "staging-committee-members"}, js.requested)
+		// the stores stay registered under the logical names the storage uses
+		assert.Contains(t, client.kvStore, constants.KVBucketNameCommittees)
+		assert.Contains(t, client.kvStore, constants.KVBucketNameCommitteeMembers)
+		assert.Equal(t, "staging.committee-api.queue", client.QueueGroup())
+	})
+}
+
+func TestCheckConfig(t *testing.T) {
+	ctx := context.Background()
+
+	// a listener stands in for a reachable server
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	reachable := "nats://" + listener.Addr().String()
+
+	// a closed listener gives an address nothing listens on
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	unreachable := "nats://" + closed.Addr().String()
+	require.NoError(t, closed.Close())
+
+	t.Run("reachable server", func(t *testing.T) {
+		assert.NoError(t, CheckConfig(ctx, Config{URL: reachable, Timeout: time.Second}))
+	})
+
+	t.Run("one reachable server is enough", func(t *testing.T) {
+		assert.NoError(t, CheckConfig(ctx, Config{URL: unreachable + "," + reachable, Timeout: time.Second}))
+	})
+
+	t.Run("missing NATS URL", func(t *testing.T) {
+		err := CheckConfig(ctx, Config{Timeout: time.Second})
+		require.Error(t, err)
+		assert.IsType(t, errors.Validation{}, err)
+	})
+
+	t.Run("unreachable NATS", func(t *testing.T) {
+		err := CheckConfig(ctx, Config{URL: unreachable, Timeout: time.Second})
+		require.Error(t, err)
+		assert.IsType(t, errors.ServiceUnavailable{}, err)
+		assert.Contains(t, err.Error(), closed.Addr().String())
+	})
+}
@@ -0,0 +1,114 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/port"
+	"github.com/linuxfoundation/lfx-v2-committee-service/pkg/constants"
+	errs "github.com/linuxfoundation/lfx-v2-committee-service/pkg/errors"
+
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// committeeLockRetryInterval is the time waited before trying again to acquire a held lock
+const committeeLockRetryInterval = 50 * time.Millisecond
+
+// committeeLock is the value stored under the lock key of a committee
+type committeeLock struct {
+	Owner     string    `json:"owner"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type committeeLocker struct {
+	client *NATSClient
+}
+
+// LockCommittee acquires the advisory lock of the committee by creating its lock key.
+// A lock held past its expiration is taken over, so a crashed holder never blocks the committee.
+// It waits at most one TTL for the lock before giving up with a conflict.
+func (l *committeeLocker) LockCommittee(ctx context.Context, uid string, ttl time.Duration) (func(), error) {
+
+	if uid == "" {
+		return nil, errs.NewValidation("committee UID cannot be empty")
+	}
+
+	kv := l.client.kvStore[constants.KVBucketNameCommittees]
+	key := fmt.Sprintf(constants.KVLookupCommitteeLockPrefix, uid)
+	owner := uuid.New().String()
+	deadline := time.Now().Add(ttl)
+
+	for {
+		lockBytes, errMarshal := json.Marshal(committeeLock{Owner: owner, ExpiresAt: time.Now().Add(ttl)})
+		if errMarshal != nil {
+			return nil, errs.NewUnexpected("failed to marshal committee lock", errMarshal)
+		}
+
+		revision, errCreate := kv.Create(ctx, key, lockBytes)
+		if errCreate == nil {
+			return l.release(ctx, key, uid, revision), nil
+		}
+		if !errors.Is(errCreate, jetstream.ErrKeyExists) {
+			return nil, errs.NewUnexpected("failed to acquire committee lock", errCreate)
+		}
+
+		// The lock is held, take it over when it has expired
+		entry, errGet := kv.Get(ctx, key)
+		switch {
+		case errors.Is(errGet, jetstream.ErrKeyNotFound):
+			continue
+		case errGet != nil:
+			return nil, errs.NewUnexpected("failed to get committee lock", errGet)
+		}
+
+		var held committeeLock
+		if errUnmarshal := json.Unmarshal(entry.Value(), &held); errUnmarshal != nil || time.Now().After(held.ExpiresAt) {
+			revision, errUpdate := kv.Update(ctx, key, lockBytes, entry.Revision())
+			if errUpdate == nil {
+				slog.WarnContext(ctx, "took over expired committee lock",
+					"committee_uid", uid,
+					"previous_owner", held.Owner,
+				)
+				return l.release(ctx, key, uid, revision), nil
+			}
+		}
+
+		if time.Now().After(deadline) {
+			return nil, errs.NewConflict("committee is locked by another operation", fmt.Errorf("committee UID: %s", uid))
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, errs.NewServiceUnavailable("timed out waiting for committee lock", ctx.Err())
+		case <-time.After(committeeLockRetryInterval):
+		}
+	}
+}
+
+// release returns the function deleting the lock key, only while it still holds the acquired revision
+func (l *committeeLocker) release(ctx context.Context, key, uid string, revision uint64) func() {
+	return func() {
+		errDelete := l.client.kvStore[constants.KVBucketNameCommittees].Delete(context.WithoutCancel(ctx), key, jetstream.LastRevision(revision))
+		if errDelete != nil {
+			slog.WarnContext(ctx, "failed to release committee lock",
+				"error", errDelete,
+				"committee_uid", uid,
+			)
+		}
+	}
+}
+
+// NewCommitteeLocker creates a new committee locker backed by the NATS KV store
+func NewCommitteeLocker(client *NATSClient) port.CommitteeLocker {
+	return &committeeLocker{
+		client: client,
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+)
+
+// bufferedMessage is an asynchronous message waiting for the NATS connection to be re-established
+type bufferedMessage struct {
+	subject     string
+	data        []byte
+	messageType string
+	dedupID     string
+}
+
+// messageBuffer is a bounded in-memory queue for the asynchronous messages published
+// while NATS is disconnected. When the buffer is full, new messages are dropped.
+type messageBuffer struct {
+	mu       sync.Mutex
+	messages []bufferedMessage
+	capacity int
+	dropped  uint64
+}
+
+// add queues the message, it returns false when the buffer is full and the message is dropped
+func (b *messageBuffer) add(ctx context.Context, msg bufferedMessage) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.messages) >= b.capacity {
+		b.dropped++
+		slog.WarnContext(ctx, "NATS publish buffer is full, message dropped",
+			"subject", msg.subject,
+			"message_type", msg.messageType,
+			"buffer_capacity", b.capacity,
+			"dropped_total", b.dropped,
+		)
+		return false
+	}
+
+	b.messages = append(b.messages, msg)
+	return true
+}
+
+// flush sends the buffered messages in the order they were added.
+// It stops at the first failure, keeping the unsent messages for the next flush.
+func (b *messageBuffer) flush(ctx context.Context, send func(msg bufferedMessage) error) (int, error) {
+	b.mu.Lock()
+	pending := b.messages
+	b.messages = nil
+	b.mu.Unlock()
+
+	for i, msg := range pending {
+		if err := send(msg); err != nil {
+			b.requeue(pending[i:])
+			slog.WarnContext(ctx, "failed to flush NATS publish buffer",
+				"error", err,
+				"flushed", i,
+				"remaining", len(pending)-i,
+			)
+			return i, err
+		}
+	}
+
+	if len(pending) > 0 {
+		slog.InfoContext(ctx, "NATS publish buffer flushed", "flushed", len(pending))
+	}
+
+	return len(pending), nil
+}
+
+// requeue puts the unsent messages back in front of the ones added during the flush,
+// dropping the newest ones when the capacity is exceeded
+func (b *messageBuffer) requeue(unsent []bufferedMessage) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	messages := append(append([]bufferedMessage{}, unsent...), b.messages...)
+	if len(messages) > b.capacity {
+		b.dropped += uint64(len(messages) - b.capacity)
+		messages = messages[:b.capacity]
+	}
+	b.messages = messages
+}
+
+// len returns the number of messages waiting to be flushed
+func (b *messageBuffer) len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.messages)
+}
+
+// droppedCount returns the number of messages dropped because the buffer was full
+func (b *messageBuffer) droppedCount() uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.dropped
+}
+
+func newMessageBuffer(capacity int) *messageBuffer {
+	return &messageBuffer{
+		capacity: capacity,
+	}
+}
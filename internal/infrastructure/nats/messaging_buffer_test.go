@@ -0,0 +1,123 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errs "github.com/linuxfoundation/lfx-v2-committee-service/pkg/errors"
+)
+
+func TestMessageBuffer_DropsWhenFull(t *testing.T) {
+	ctx := context.Background()
+	buffer := newMessageBuffer(2)
+
+	assert.True(t, buffer.add(ctx, bufferedMessage{subject: "a"}))
+	assert.True(t, buffer.add(ctx, bufferedMessage{subject: "b"}))
+	assert.False(t, buffer.add(ctx, bufferedMessage{subject: "c"}))
+
+	assert.Equal(t, 2, buffer.len())
+	assert.Equal(t, uint64(1), buffer.droppedCount())
+}
+
+func TestMessageBuffer_FlushKeepsUnsentMessages(t *testing.T) {
+	ctx := context.Background()
+	buffer := newMessageBuffer(3)
+	for _, subject := range []string{"a", "b", "c"} {
+		require.True(t, buffer.add(ctx, bufferedMessage{subject: subject}))
+	}
+
+	var sent []string
+	flushed, err := buffer.flush(ctx, func(msg bufferedMessage) error {
+		if msg.subject == "b" {
+			return errors.New("connection lost")
+		}
+		sent = append(sent, msg.subject)
+		return nil
+	})
+	require.Error(t, err)
+	assert.Equal(t, 1, flushed)
+	assert.Equal(t, []string{"a"}, sent)
+	assert.Equal(t, 2, buffer.len())
+
+	sent = nil
+	flushed, err = buffer.flush(ctx, func(msg bufferedMessage) error {
+		sent = append(sent, msg.subject)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, flushed)
+	assert.Equal(t, []string{"b", "c"}, sent)
+	assert.Equal(t, 0, buffer.len())
+}
+
+func TestMessagePublisher_BuffersWhileDisconnected(t *testing.T) {
+	ctx := context.Background()
+
+	// a client without connection behaves as disconnected
+	client := &NATSClient{config: Config{PublishBufferSize: 2}}
+	publisher, ok := NewMessagePublisher(client).(*messagePublisher)
+	require.True(t, ok)
+	require.NotNil(t, publisher.buffer)
+
+	require.NoError(t, publisher.Indexer(ctx, "lfx.index.committee", map[string]string{"uid": "1"}, false))
+	require.NoError(t, publisher.Access(ctx, "lfx.update_access.committee", "raw", false))
+
+	// buffer is full, the message is dropped
+	err := publisher.Event(ctx, "lfx.committee.event", "event", false)
+	require.Error(t, err)
+	assert.IsType(t, errs.ServiceUnavailable{}, err)
+	assert.Equal(t, uint64(1), publisher.buffer.droppedCount())
+
+	// synchronous messages are never buffered
+	err = publisher.Indexer(ctx, "lfx.index.committee", "sync", true)
+	require.Error(t, err)
+	assert.IsType(t, errs.ServiceUnavailable{}, err)
+	assert.Equal(t, 2, publisher.buffer.len())
+
+	// simulate the reconnect, flushing the buffered messages to a recording sender
+	var sent []bufferedMessage
+	client.reconnectHandlers = nil
+	client.OnReconnect(func() {
+		_, errFlush := publisher.buffer.flush(ctx, func(msg bufferedMessage) error {
+			sent = append(sent, msg)
+			return nil
+		})
+		assert.NoError(t, errFlush)
+	})
+	client.reconnected()
+
+	require.Len(t, sent, 2)
+	assert.Equal(t, "lfx.index.committee", sent[0].subject)
+	assert.Equal(t, "indexer", sent[0].messageType)
+	assert.JSONEq(t, `{"uid":"1"}`, string(sent[0].data))
+	assert.Equal(t, "lfx.update_access.committee", sent[1].subject)
+	assert.Equal(t, []byte("raw"), sent[1].data)
+	assert.Equal(t, 0, publisher.buffer.len())
+}
+
+func TestMessagePublisher_NoBufferWhenDisabled(t *testing.T) {
+	client := &NATSClient{}
+	publisher, ok := NewMessagePublisher(client).(*messagePublisher)
+	require.True(t, ok)
+	assert.Nil(t, publisher.buffer)
+
+	err := publisher.Indexer(context.Background(), "lfx.index.committee", "msg", false)
+	require.Error(t, err)
+	assert.IsType(t, errs.ServiceUnavailable{}, err)
+}
+
+func TestNewMessage_DedupHeader(t *testing.T) {
+	msg := newMessage("subject", []byte("data"), "dedup-1")
+	assert.Equal(t, "dedup-1", msg.Header.Get(nats.MsgIdHdr))
+
+	msg = newMessage("subject", []byte("data"), "")
+	assert.Empty(t, msg.Header.Get(nats.MsgIdHdr))
+}
@@ -9,43 +9,58 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/model"
 	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/port"
 	"github.com/linuxfoundation/lfx-v2-committee-service/pkg/errors"
+
+	"github.com/nats-io/nats.go"
 )
 
 const defaultRequestTimeout = 10 * time.Second
 
 type messagePublisher struct {
 	client *NATSClient
+	// buffer keeps the asynchronous messages published while disconnected, nil disables buffering
+	buffer *messageBuffer
+}
+
+// newMessage builds the NATS message, stamping the dedup ID as the message ID header when there is one
+func newMessage(subject string, data []byte, dedupID string) *nats.Msg {
+	msg := nats.NewMsg(subject)
+	msg.Data = data
+	if dedupID != "" {
+		msg.Header.Set(nats.MsgIdHdr, dedupID)
+	}
+	return msg
 }
 
 // publishMessage handles asynchronous NATS message publishing
-func (m *messagePublisher) publishMessage(ctx context.Context, subject string, data []byte, messageType string) error {
-	if err := m.client.conn.Publish(subject, data); err != nil {
+func (m *messagePublisher) publishMessage(ctx context.Context, msg *nats.Msg, messageType string) error {
+	if err := m.client.conn.PublishMsg(msg); err != nil {
 		slog.ErrorContext(ctx, "failed to publish message to NATS",
 			"error", err,
-			"subject", subject,
+			"subject", msg.Subject,
 			"message_type", messageType,
 		)
 		return errors.NewServiceUnavailable("failed to publish message", err)
 	}
 
 	slog.DebugContext(ctx, "asynchronous message published successfully",
-		"subject", subject,
+		"subject", msg.Subject,
 		"message_type", messageType,
-		"message_size", len(data),
+		"message_size", len(msg.Data),
 	)
 
 	return nil
 }
 
 // requestMessage handles synchronous NATS request/reply pattern
-func (m *messagePublisher) requestMessage(ctx context.Context, subject string, data []byte, messageType string) error {
-	msg, err := m.client.conn.Request(subject, data, defaultRequestTimeout)
+func (m *messagePublisher) requestMessage(ctx context.Context, request *nats.Msg, messageType string) error {
+	msg, err := m.client.conn.RequestMsg(request, defaultRequestTimeout)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to send synchronous request to NATS",
 			"error", err,
-			"subject", subject,
+			"subject", request.Subject,
 			"message_type", messageType,
 			"timeout", defaultRequestTimeout,
 		)
@@ -53,9 +68,9 @@ func (m *messagePublisher) requestMessage(ctx context.Context, subject string, d
 	}
 
 	slog.DebugContext(ctx, "synchronous message sent successfully",
-		"subject", subject,
+		"subject", request.Subject,
 		"message_type", messageType,
-		"message_size", len(data),
+		"message_size", len(request.Data),
 		"response_size", len(msg.Data),
 	)
 
@@ -64,17 +79,6 @@ func (m *messagePublisher) requestMessage(ctx context.Context, subject string, d
 
 // publish is a generic function that handles NATS message publishing
 func (m *messagePublisher) publish(ctx context.Context, subject string, message any, messageType string, sync bool) error {
-	// Check if client is ready
-	if err := m.client.IsReady(ctx); err != nil {
-		slog.ErrorContext(ctx, "NATS client is not ready for publishing",
-			"error", err,
-			"subject", subject,
-			"message_type", messageType,
-			"sync", sync,
-		)
-		return errors.NewServiceUnavailable("NATS client is not ready", err)
-	}
-
 	var data []byte
 	// If message is a string, send it as plain text without JSON encoding
 	if str, ok := message.(string); ok {
@@ -94,12 +98,46 @@ func (m *messagePublisher) publish(ctx context.Context, subject string, message
 		}
 	}
 
+	// Indexer messages carry a dedup ID, so the consumers can drop the copies of a republished message
+	var dedupID string
+	if indexerMessage, ok := message.(*model.CommitteeIndexerMessage); ok {
+		dedupID = indexerMessage.DedupID
+	}
+
+	// Check if client is ready, asynchronous messages are buffered until the connection is back
+	if err := m.client.IsReady(ctx); err != nil {
+		if !sync && m.buffer != nil && m.buffer.add(ctx, bufferedMessage{subject: subject, data: data, messageType: messageType, dedupID: dedupID}) {
+			slog.WarnContext(ctx, "NATS client is not ready, message buffered",
+				"subject", subject,
+				"message_type", messageType,
+			)
+			return nil
+		}
+		slog.ErrorContext(ctx, "NATS client is not ready for publishing",
+			"error", err,
+			"subject", subject,
+			"message_type", messageType,
+			"sync", sync,
+		)
+		return errors.NewServiceUnavailable("NATS client is not ready", err)
+	}
+
 	// Publish message based on sync flag
 	if sync {
-		return m.requestMessage(ctx, subject, data, messageType)
+		return m.requestMessage(ctx, newMessage(subject, data, dedupID), messageType)
 	}
 
-	return m.publishMessage(ctx, subject, data, messageType)
+	return m.publishMessage(ctx, newMessage(subject, data, dedupID), messageType)
+}
+
+// flushBuffer publishes the messages buffered while the connection was down
+func (m *messagePublisher) flushBuffer(ctx context.Context) {
+	if m.buffer == nil {
+		return
+	}
+	_, _ = m.buffer.flush(ctx, func(msg bufferedMessage) error {
+		return m.publishMessage(ctx, newMessage(msg.subject, msg.data, msg.dedupID), msg.messageType)
+	})
 }
 
 func (m *messagePublisher) Indexer(ctx context.Context, subject string, message any, sync bool) error {
@@ -116,7 +154,14 @@ func (m *messagePublisher) Event(ctx context.Context, subject string, event any,
 
 // NewMessagePublish creates a new message publish service
 func NewMessagePublisher(client *NATSClient) port.CommitteePublisher {
-	return &messagePublisher{
+	publisher := &messagePublisher{
 		client: client,
 	}
+	if client.config.PublishBufferSize > 0 {
+		publisher.buffer = newMessageBuffer(client.config.PublishBufferSize)
+		client.OnReconnect(func() {
+			publisher.flushBuffer(context.Background())
+		})
+	}
+	return publisher
 }
@@ -5,9 +5,13 @@ package nats
 
 import (
 	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
 	"strings"
 	"time"
 
+	"github.com/linuxfoundation/lfx-v2-committee-service/pkg/constants"
 	"github.com/linuxfoundation/lfx-v2-committee-service/pkg/errors"
 )
 
@@ -21,6 +25,67 @@ type Config struct {
 	MaxReconnect int `json:"max_reconnect"`
 	// ReconnectWait is the time to wait between reconnection attempts
 	ReconnectWait time.Duration `json:"reconnect_wait"`
+	// PublishBufferSize is the number of asynchronous messages kept while disconnected, zero disables buffering
+	PublishBufferSize int `json:"publish_buffer_size"`
+	// BucketPrefix is prepended to the JetStream key-value bucket names, so several environments
+	// can share a NATS cluster; empty keeps the default names
+	BucketPrefix string `json:"bucket_prefix"`
+	// QueueGroup is the queue group of the subject subscriptions, empty keeps the default group
+	QueueGroup string `json:"queue_group"`
+}
+
+// bucketName returns the JetStream bucket name for the logical bucket, applying the configured prefix
+func (c Config) bucketName(name string) string {
+	return c.BucketPrefix + name
+}
+
+// bucketPrefixPattern matches the characters allowed in JetStream bucket names
+var bucketPrefixPattern = regexp.MustCompile(`^[A-Za-z0-9_-]*$`)
+
+// servers returns the comma separated server URLs of the configuration
+func (c Config) servers() []string {
+	var servers []string
+	for _, server := range strings.Split(c.URL, ",") {
+		if server = strings.TrimSpace(server); server != "" {
+			servers = append(servers, server)
+		}
+	}
+	return servers
+}
+
+// Validate checks the configuration holds what is needed to connect to NATS and bind the buckets,
+// without connecting to the server
+func (c Config) Validate() error {
+	servers := c.servers()
+	if len(servers) == 0 {
+		return errors.NewValidation("NATS URL is required")
+	}
+	for _, server := range servers {
+		serverURL, err := url.Parse(server)
+		if err != nil || serverURL.Host == "" {
+			return errors.NewValidation(fmt.Sprintf("invalid NATS URL %q, expected e.g. nats://localhost:4222", server))
+		}
+		switch serverURL.Scheme {
+		case "nats", "tls", "ws", "wss":
+		default:
+			return errors.NewValidation(fmt.Sprintf("unsupported NATS URL scheme %q in %q", serverURL.Scheme, server))
+		}
+	}
+	if c.Timeout <= 0 {
+		return errors.NewValidation("NATS timeout must be a positive duration")
+	}
+	if !bucketPrefixPattern.MatchString(c.BucketPrefix) {
+		return errors.NewValidation(fmt.Sprintf("invalid NATS bucket prefix %q, only alphanumeric characters, '-' and '_' are allowed", c.BucketPrefix))
+	}
+	return nil
+}
+
+// queueGroup returns the configured queue group, defaulting to the committee API queue
+func (c Config) queueGroup() string {
+	if c.QueueGroup == "" {
+		return constants.CommitteeAPIQueue
+	}
+	return c.QueueGroup
 }
 
 // AccessCheckNATSRequest represents a NATS request for access checking
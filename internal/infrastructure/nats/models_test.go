@@ -5,6 +5,7 @@ package nats
 
 import (
 	"testing"
+	"time"
 
 	"github.com/linuxfoundation/lfx-v2-committee-service/pkg/errors"
 )
@@ -264,3 +265,60 @@ func TestErrorMessageNATSResponse_CheckError_ErrorTypes(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Config
+		wantErr bool
+	}{
+		{
+			name:   "valid configuration",
+			config: Config{URL: "nats://localhost:4222", Timeout: time.Second, BucketPrefix: "staging-"},
+		},
+		{
+			name:   "several servers",
+			config: Config{URL: "nats://nats-0:4222, tls://nats-1:4222", Timeout: time.Second},
+		},
+		{
+			name:    "missing NATS URL",
+			config:  Config{Timeout: time.Second},
+			wantErr: true,
+		},
+		{
+			name:    "NATS URL without host",
+			config:  Config{URL: "localhost", Timeout: time.Second},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported scheme",
+			config:  Config{URL: "http://localhost:4222", Timeout: time.Second},
+			wantErr: true,
+		},
+		{
+			name:    "missing timeout",
+			config:  Config{URL: "nats://localhost:4222"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid bucket prefix",
+			config:  Config{URL: "nats://localhost:4222", Timeout: time.Second, BucketPrefix: "staging."},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if !tt.wantErr {
+				if err != nil {
+					t.Errorf("Validate() unexpected error = %v", err)
+				}
+				return
+			}
+			if _, ok := err.(errors.Validation); !ok {
+				t.Errorf("Validate() error = %v, want a validation error", err)
+			}
+		})
+	}
+}
@@ -10,30 +10,61 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
+	"time"
 
 	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/model"
 	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/port"
 	"github.com/linuxfoundation/lfx-v2-committee-service/pkg/constants"
 	errs "github.com/linuxfoundation/lfx-v2-committee-service/pkg/errors"
+	"github.com/linuxfoundation/lfx-v2-committee-service/pkg/log"
 
 	"github.com/nats-io/nats.go/jetstream"
 )
 
 type storage struct {
-	client *NATSClient
+	client                *NATSClient
+	memberUniquenessScope model.MemberUniquenessScope
 }
 
+// StorageOption defines a function type for setting storage options
+type StorageOption func(*storage)
+
+// WithMemberUniquenessScope sets the scope in which member emails must be unique
+func WithMemberUniquenessScope(scope model.MemberUniquenessScope) StorageOption {
+	return func(s *storage) {
+		s.memberUniquenessScope = scope
+	}
+}
+
+// Create writes the committee base and its settings as a single unit.
+// Base and settings live in separate buckets, so when the settings write fails
+// the base record is removed again (compensating delete), ensuring a committee
+// never exists without settings.
 func (s *storage) Create(ctx context.Context, committee *model.Committee) error {
 
 	if committee == nil {
 		return errs.NewValidation("committee cannot be nil")
 	}
 
+	// Every committee must have settings, default them when not provided
+	if committee.CommitteeSettings == nil {
+		committee.CommitteeSettings = &model.CommitteeSettings{
+			CreatedAt: committee.CommitteeBase.CreatedAt,
+			UpdatedAt: committee.CommitteeBase.UpdatedAt,
+		}
+	}
+	committee.CommitteeSettings.UID = committee.CommitteeBase.UID
+
 	committeeBaseBytes, errMarshal := json.Marshal(committee.CommitteeBase)
 	if errMarshal != nil {
 		return errs.NewUnexpected("failed to marshal committee base", errMarshal)
 	}
 
+	settingsBytes, errMarshalSettings := json.Marshal(committee.CommitteeSettings)
+	if errMarshalSettings != nil {
+		return errs.NewUnexpected("failed to marshal committee settings", errMarshalSettings)
+	}
+
 	rev, errCreate := s.client.kvStore[constants.KVBucketNameCommittees].Create(ctx, committee.CommitteeBase.UID, committeeBaseBytes)
 	if errCreate != nil {
 		return errs.NewUnexpected("failed to create committee", errCreate)
@@ -44,25 +75,29 @@ func (s *storage) Create(ctx context.Context, committee *model.Committee) error
 		"revision", rev,
 	)
 
-	// Create settings if they exist
-	if committee.CommitteeSettings != nil {
-		committee.CommitteeSettings.UID = committee.CommitteeBase.UID
-		settingsBytes, errMarshalSettings := json.Marshal(committee.CommitteeSettings)
-		if errMarshalSettings != nil {
-			return errs.NewUnexpected("failed to marshal committee settings", errMarshalSettings)
-		}
-
-		rev, errCreate := s.client.kvStore[constants.KVBucketNameCommitteeSettings].Create(ctx, committee.CommitteeBase.UID, settingsBytes)
-		if errCreate != nil {
-			return errs.NewUnexpected("failed to create committee settings", errCreate)
-		}
-
-		slog.DebugContext(ctx, "created committee settings in NATS storage",
+	settingsRev, errCreateSettings := s.client.kvStore[constants.KVBucketNameCommitteeSettings].Create(ctx, committee.CommitteeBase.UID, settingsBytes)
+	if errCreateSettings != nil {
+		slog.ErrorContext(ctx, "failed to create committee settings, removing committee base",
+			"error", errCreateSettings,
 			"committee_uid", committee.CommitteeBase.UID,
-			"revision", rev,
 		)
+		// compensating delete, the committee base must not exist without settings
+		errDeleteBase := s.client.kvStore[constants.KVBucketNameCommittees].Delete(ctx, committee.CommitteeBase.UID, jetstream.LastRevision(rev))
+		if errDeleteBase != nil {
+			slog.ErrorContext(ctx, "failed to remove committee base after settings creation failure",
+				"error", errDeleteBase,
+				"committee_uid", committee.CommitteeBase.UID,
+				log.PriorityCritical(),
+			)
+		}
+		return errs.NewUnexpected("failed to create committee settings", errCreateSettings)
 	}
 
+	slog.DebugContext(ctx, "created committee settings in NATS storage",
+		"committee_uid", committee.CommitteeBase.UID,
+		"revision", settingsRev,
+	)
+
 	return nil
 }
 
@@ -92,6 +127,60 @@ func (s *storage) UniqueSSOGroupName(ctx context.Context, committee *model.Commi
 	return ssoGroupKey, nil
 }
 
+// IndexParent records the committee under its parent lookup prefix, so child committees
+// can be found without scanning every committee. Indexing an already indexed child is a no-op.
+func (s *storage) IndexParent(ctx context.Context, committee *model.Committee) (string, error) {
+
+	if committee.ParentUID == nil || *committee.ParentUID == "" {
+		return "", errs.NewValidation("committee has no parent")
+	}
+
+	parentKey := fmt.Sprintf(constants.KVLookupParentPrefix, *committee.ParentUID) + committee.CommitteeBase.UID
+	_, errIndex := s.client.kvStore[constants.KVBucketNameCommittees].Create(ctx, parentKey, []byte(committee.CommitteeBase.UID))
+	if errIndex != nil && !errors.Is(errIndex, jetstream.ErrKeyExists) {
+		return parentKey, errs.NewUnexpected("failed to create parent index key for committee", errIndex)
+	}
+	return parentKey, nil
+}
+
+// RecordIndexed stores the time the committee was successfully published to the search index.
+// It is kept apart from the committee record, so recording it doesn't change the committee revision.
+func (s *storage) RecordIndexed(ctx context.Context, uid string, indexedAt time.Time) error {
+
+	if uid == "" {
+		return errs.NewValidation("committee UID cannot be empty")
+	}
+
+	indexStatusKey := fmt.Sprintf(constants.KVLookupIndexStatusPrefix, uid)
+	_, errPut := s.client.kvStore[constants.KVBucketNameCommittees].Put(ctx, indexStatusKey, []byte(indexedAt.UTC().Format(time.RFC3339Nano)))
+	if errPut != nil {
+		return errs.NewUnexpected("failed to record committee index status", errPut)
+	}
+	return nil
+}
+
+// GetLastIndexed returns the time the committee was last published to the search index, nil if never
+func (s *storage) GetLastIndexed(ctx context.Context, uid string) (*time.Time, error) {
+
+	if uid == "" {
+		return nil, errs.NewValidation("committee UID cannot be empty")
+	}
+
+	entry, errGet := s.client.kvStore[constants.KVBucketNameCommittees].Get(ctx, fmt.Sprintf(constants.KVLookupIndexStatusPrefix, uid))
+	if errGet != nil {
+		if errors.Is(errGet, jetstream.ErrKeyNotFound) {
+			return nil, nil
+		}
+		return nil, errs.NewUnexpected("failed to get committee index status", errGet)
+	}
+
+	indexedAt, errParse := time.Parse(time.RFC3339Nano, string(entry.Value()))
+	if errParse != nil {
+		return nil, errs.NewUnexpected("failed to parse committee index status", errParse)
+	}
+	return &indexedAt, nil
+}
+
 // get retrieves a model from the NATS KV store by bucket and UID.
 // It unmarshals the data into the provided model and returns the revision.
 // If the UID is empty, it returns a validation error.
@@ -110,7 +199,7 @@ func (s *storage) get(ctx context.Context, bucket, uid string, model any, onlyRe
 	if !onlyRevision {
 		errUnmarshal := json.Unmarshal(data.Value(), &model)
 		if errUnmarshal != nil {
-			return 0, errUnmarshal
+			return 0, errs.NewUnexpected(fmt.Sprintf("corrupt record %q in bucket %s", uid, bucket), errUnmarshal)
 		}
 	}
 
@@ -118,6 +207,13 @@ func (s *storage) get(ctx context.Context, bucket, uid string, model any, onlyRe
 
 }
 
+// isCorruptRecord reports whether get failed because the stored value could not be decoded,
+// the only case where get returns an Unexpected error
+func isCorruptRecord(err error) bool {
+	var errCorrupt errs.Unexpected
+	return errors.As(err, &errCorrupt)
+}
+
 func (s *storage) GetBase(ctx context.Context, uid string) (*model.CommitteeBase, uint64, error) {
 
 	committee := &model.CommitteeBase{}
@@ -127,6 +223,9 @@ func (s *storage) GetBase(ctx context.Context, uid string) (*model.CommitteeBase
 		if errors.Is(errGet, jetstream.ErrKeyNotFound) {
 			return nil, 0, errs.NewNotFound("committee not found", fmt.Errorf("committee UID: %s", uid))
 		}
+		if isCorruptRecord(errGet) {
+			return nil, 0, errGet
+		}
 		return nil, 0, errs.NewUnexpected("failed to get committee", errGet)
 	}
 
@@ -137,6 +236,95 @@ func (s *storage) GetRevision(ctx context.Context, uid string) (uint64, error) {
 	return s.get(ctx, constants.KVBucketNameCommittees, uid, &model.CommitteeBase{}, true)
 }
 
+// Exists reports whether the committee is present, checking the key without unmarshaling the record
+func (s *storage) Exists(ctx context.Context, uid string) (bool, error) {
+	if _, errGet := s.get(ctx, constants.KVBucketNameCommittees, uid, nil, true); errGet != nil {
+		if errors.Is(errGet, jetstream.ErrKeyNotFound) {
+			return false, nil
+		}
+		return false, errs.NewUnexpected("failed to check committee existence", errGet)
+	}
+	return true, nil
+}
+
+// ListBases retrieves all committee bases, skipping the lookup keys stored in the same bucket
+func (s *storage) ListBases(ctx context.Context) ([]*model.CommitteeBase, error) {
+	slog.DebugContext(ctx, "listing committees from NATS storage")
+
+	keys, errKeys := s.client.kvStore[constants.KVBucketNameCommittees].ListKeys(ctx)
+	if errKeys != nil {
+		return nil, errs.NewUnexpected("failed to list keys from committees bucket", errKeys)
+	}
+
+	var (
+		committees   []*model.CommitteeBase
+		corruptCount int
+	)
+
+	for key := range keys.Keys() {
+		// Skip lookup keys (they start with "lookup/")
+		if strings.HasPrefix(key, "lookup/") {
+			continue
+		}
+
+		committee := &model.CommitteeBase{}
+		_, errGet := s.get(ctx, constants.KVBucketNameCommittees, key, committee, false)
+		if errGet != nil {
+			if isCorruptRecord(errGet) {
+				corruptCount++
+			}
+			slog.WarnContext(ctx, "failed to get committee while listing",
+				"key", key,
+				"error", errGet,
+			)
+			continue
+		}
+
+		committees = append(committees, committee)
+	}
+
+	if corruptCount > 0 {
+		slog.WarnContext(ctx, "skipped corrupt committee records while listing",
+			"corrupt_count", corruptCount,
+		)
+	}
+
+	slog.DebugContext(ctx, "retrieved committees from NATS storage",
+		"committee_count", len(committees),
+	)
+
+	return committees, nil
+}
+
+// ListChildren retrieves the UIDs of the committees indexed under the parent lookup prefix
+func (s *storage) ListChildren(ctx context.Context, parentUID string) ([]string, error) {
+
+	if parentUID == "" {
+		return nil, errs.NewValidation("committee UID cannot be empty")
+	}
+
+	keys, errKeys := s.client.kvStore[constants.KVBucketNameCommittees].ListKeys(ctx)
+	if errKeys != nil {
+		return nil, errs.NewUnexpected("failed to list keys from committees bucket", errKeys)
+	}
+
+	prefix := fmt.Sprintf(constants.KVLookupParentPrefix, parentUID)
+
+	var children []string
+	for key := range keys.Keys() {
+		if childUID, found := strings.CutPrefix(key, prefix); found && childUID != "" {
+			children = append(children, childUID)
+		}
+	}
+
+	slog.DebugContext(ctx, "retrieved child committees from NATS storage",
+		"parent_uid", parentUID,
+		"children_count", len(children),
+	)
+
+	return children, nil
+}
+
 func (s *storage) GetSettings(ctx context.Context, uid string) (*model.CommitteeSettings, uint64, error) {
 
 	settings := &model.CommitteeSettings{}
@@ -146,12 +334,27 @@ func (s *storage) GetSettings(ctx context.Context, uid string) (*model.Committee
 		if errors.Is(errGet, jetstream.ErrKeyNotFound) {
 			return nil, 0, errs.NewNotFound("committee settings not found", fmt.Errorf("committee UID: %s", uid))
 		}
+		if isCorruptRecord(errGet) {
+			return nil, 0, errGet
+		}
 		return nil, 0, errs.NewUnexpected("failed to get committee settings", errGet)
 	}
 
 	return settings, rev, nil
 }
 
+// GetSettingsRevision retrieves the revision of the committee settings
+func (s *storage) GetSettingsRevision(ctx context.Context, uid string) (uint64, error) {
+	rev, errGet := s.get(ctx, constants.KVBucketNameCommitteeSettings, uid, &model.CommitteeSettings{}, true)
+	if errGet != nil {
+		if errors.Is(errGet, jetstream.ErrKeyNotFound) {
+			return 0, errs.NewNotFound("committee settings not found", fmt.Errorf("committee UID: %s", uid))
+		}
+		return 0, errs.NewUnexpected("failed to get committee settings revision", errGet)
+	}
+	return rev, nil
+}
+
 func (s *storage) UpdateBase(ctx context.Context, committee *model.Committee, revision uint64) error {
 
 	// Marshal the committee base data
@@ -204,6 +407,29 @@ func (s *storage) UpdateSetting(ctx context.Context, settings *model.CommitteeSe
 	return nil
 }
 
+func (s *storage) CreateSetting(ctx context.Context, settings *model.CommitteeSettings) error {
+
+	settingsBytes, errMarshal := json.Marshal(settings)
+	if errMarshal != nil {
+		return errs.NewUnexpected("failed to marshal committee settings", errMarshal)
+	}
+
+	revision, errCreate := s.client.kvStore[constants.KVBucketNameCommitteeSettings].Create(ctx, settings.UID, settingsBytes)
+	if errCreate != nil {
+		if errors.Is(errCreate, jetstream.ErrKeyExists) {
+			return errs.NewConflict("committee settings already exist")
+		}
+		return errs.NewUnexpected("failed to create committee settings", errCreate)
+	}
+
+	slog.DebugContext(ctx, "created committee settings in NATS storage",
+		"committee_uid", settings.UID,
+		"revision", revision,
+	)
+
+	return nil
+}
+
 func (s *storage) Delete(ctx context.Context, uid string, revision uint64) error {
 
 	// Delete committee base
@@ -215,6 +441,15 @@ func (s *storage) Delete(ctx context.Context, uid string, revision uint64) error
 		return errs.NewUnexpected("failed to delete committee base", errDeleteBase)
 	}
 
+	// Delete the index status, it is only meaningful while the committee exists
+	errDeleteIndexStatus := s.client.kvStore[constants.KVBucketNameCommittees].Delete(ctx, fmt.Sprintf(constants.KVLookupIndexStatusPrefix, uid))
+	if errDeleteIndexStatus != nil && !errors.Is(errDeleteIndexStatus, jetstream.ErrKeyNotFound) {
+		slog.WarnContext(ctx, "failed to delete committee index status",
+			"error", errDeleteIndexStatus,
+			"committee_uid", uid,
+		)
+	}
+
 	// Delete committee settings if they exist
 	errDeleteSettings := s.client.kvStore[constants.KVBucketNameCommitteeSettings].Delete(ctx, uid)
 	if errDeleteSettings != nil {
@@ -240,6 +475,9 @@ func (s *storage) GetMember(ctx context.Context, memberUID string) (*model.Commi
 		if errors.Is(errGet, jetstream.ErrKeyNotFound) {
 			return nil, 0, errs.NewNotFound("committee member not found", fmt.Errorf("member UID: %s", memberUID))
 		}
+		if isCorruptRecord(errGet) {
+			return nil, 0, errGet
+		}
 		return nil, 0, errs.NewUnexpected("failed to get committee member", errGet)
 	}
 
@@ -256,7 +494,10 @@ func (s *storage) ListMembers(ctx context.Context, committeeUID string) ([]*mode
 		return nil, errs.NewUnexpected("failed to list keys from committee members bucket", errKeys)
 	}
 
-	var members []*model.CommitteeMember
+	var (
+		members      []*model.CommitteeMember
+		corruptCount int
+	)
 
 	// Iterate through all keys and filter by committee UID
 	for key := range keys.Keys() {
@@ -269,6 +510,9 @@ func (s *storage) ListMembers(ctx context.Context, committeeUID string) ([]*mode
 		member := &model.CommitteeMember{}
 		_, errGet := s.get(ctx, constants.KVBucketNameCommitteeMembers, key, member, false)
 		if errGet != nil {
+			if isCorruptRecord(errGet) {
+				corruptCount++
+			}
 			slog.WarnContext(ctx, "failed to get member while listing",
 				"key", key,
 				"error", errGet,
@@ -282,6 +526,13 @@ func (s *storage) ListMembers(ctx context.Context, committeeUID string) ([]*mode
 		}
 	}
 
+	if corruptCount > 0 {
+		slog.WarnContext(ctx, "skipped corrupt committee member records while listing",
+			"committee_uid", committeeUID,
+			"corrupt_count", corruptCount,
+		)
+	}
+
 	slog.DebugContext(ctx, "retrieved committee members from NATS storage",
 		"committee_uid", committeeUID,
 		"member_count", len(members),
@@ -290,6 +541,53 @@ func (s *storage) ListMembers(ctx context.Context, committeeUID string) ([]*mode
 	return members, nil
 }
 
+// GetMemberHistory retrieves the revisions of a committee member kept by the members bucket, newest first.
+// Deletions are skipped, and a zero or negative limit returns every revision kept.
+func (s *storage) GetMemberHistory(ctx context.Context, memberUID string, limit int) ([]*model.CommitteeMemberRevision, error) {
+
+	if memberUID == "" {
+		return nil, errs.NewValidation("member UID cannot be empty")
+	}
+
+	entries, errHistory := s.client.kvStore[constants.KVBucketNameCommitteeMembers].History(ctx, memberUID)
+	if errHistory != nil {
+		if errors.Is(errHistory, jetstream.ErrKeyNotFound) {
+			return nil, errs.NewNotFound("committee member not found", fmt.Errorf("member UID: %s", memberUID))
+		}
+		return nil, errs.NewUnexpected("failed to get committee member history", errHistory)
+	}
+
+	revisions := make([]*model.CommitteeMemberRevision, 0, len(entries))
+	for i := len(entries) - 1; i >= 0; i-- {
+		if limit > 0 && len(revisions) >= limit {
+			break
+		}
+
+		entry := entries[i]
+		if entry.Operation() != jetstream.KeyValuePut {
+			continue
+		}
+
+		member := &model.CommitteeMember{}
+		if errUnmarshal := json.Unmarshal(entry.Value(), member); errUnmarshal != nil {
+			slog.WarnContext(ctx, "skipping corrupt committee member revision",
+				"error", errUnmarshal,
+				"member_uid", memberUID,
+				"revision", entry.Revision(),
+			)
+			continue
+		}
+
+		revisions = append(revisions, &model.CommitteeMemberRevision{
+			Revision:  entry.Revision(),
+			CreatedAt: entry.Created(),
+			Member:    member,
+		})
+	}
+
+	return revisions, nil
+}
+
 // GetMemberRevision retrieves the revision number for a committee member
 func (s *storage) GetMemberRevision(ctx context.Context, memberUID string) (uint64, error) {
 	return s.get(ctx, constants.KVBucketNameCommitteeMembers, memberUID, &model.CommitteeMember{}, true)
@@ -311,6 +609,9 @@ func (s *storage) CreateMember(ctx context.Context, member *model.CommitteeMembe
 
 	rev, errCreate := s.client.kvStore[constants.KVBucketNameCommitteeMembers].Create(ctx, member.UID, memberBytes)
 	if errCreate != nil {
+		if errors.Is(errCreate, jetstream.ErrKeyExists) {
+			return errs.NewConflict("committee member with the same UID already exists")
+		}
 		return errs.NewUnexpected("failed to create committee member", errCreate)
 	}
 
@@ -387,14 +688,35 @@ func (s *storage) DeleteMember(ctx context.Context, uid string, revision uint64)
 	return nil
 }
 
-// UniqueMember verifies if a member with the same email exists in the committee
+// MemberIndexKey builds the member lookup key according to the configured uniqueness scope.
+// For the project scope, the project UID is resolved from the committee when the member doesn't carry it.
+func (s *storage) MemberIndexKey(ctx context.Context, member *model.CommitteeMember) (string, error) {
+
+	if s.memberUniquenessScope == model.MemberUniquenessScopeProject && member.ProjectUID == "" {
+		committee, _, errGet := s.GetBase(ctx, member.CommitteeUID)
+		if errGet != nil {
+			return "", errGet
+		}
+		member.ProjectUID = committee.ProjectUID
+	}
+
+	return fmt.Sprintf(constants.KVLookupMemberPrefix, member.BuildScopedIndexKey(ctx, s.memberUniquenessScope)), nil
+}
+
+// UniqueMember verifies if a member with the same email exists in the configured scope (committee or project)
 // It stores the member UID in the KV store with the index key as the value as secondary index
 // to ensure that the member is unique, avoiding concurrent operations for the same member.
 func (s *storage) UniqueMember(ctx context.Context, member *model.CommitteeMember) (string, error) {
-	uniqueKey := fmt.Sprintf(constants.KVLookupMemberPrefix, member.BuildIndexKey(ctx))
+	uniqueKey, errKey := s.MemberIndexKey(ctx, member)
+	if errKey != nil {
+		return "", errKey
+	}
 	_, errUnique := s.client.kvStore[constants.KVBucketNameCommitteeMembers].Create(ctx, uniqueKey, []byte(member.UID))
 	if errUnique != nil {
 		if errors.Is(errUnique, jetstream.ErrKeyExists) {
+			if s.memberUniquenessScope == model.MemberUniquenessScopeProject {
+				return uniqueKey, errs.NewConflict("member with the same email already exists in the project")
+			}
 			return uniqueKey, errs.NewConflict("member with the same email already exists in the committee")
 		}
 		return uniqueKey, errs.NewUnexpected("failed to create unique key for member", errUnique)
@@ -402,12 +724,36 @@ func (s *storage) UniqueMember(ctx context.Context, member *model.CommitteeMembe
 	return uniqueKey, nil
 }
 
+// IsReady checks the NATS connection and that every key-value bucket can be reached.
+// It returns a ServiceUnavailable error when NATS is down, so it can be told apart from other failures.
 func (s *storage) IsReady(ctx context.Context) error {
-	return s.client.IsReady(ctx)
+	if err := s.client.IsReady(ctx); err != nil {
+		return err
+	}
+	return s.bucketsReady(ctx)
+}
+
+// bucketsReady checks that the status of every key-value bucket can be retrieved
+func (s *storage) bucketsReady(ctx context.Context) error {
+	for bucket, kv := range s.client.kvStore {
+		if _, errStatus := kv.Status(ctx); errStatus != nil {
+			slog.ErrorContext(ctx, "NATS key-value bucket is not reachable",
+				"error", errStatus,
+				"bucket", bucket,
+			)
+			return errs.NewServiceUnavailable(fmt.Sprintf("NATS key-value bucket %s is not reachable", bucket), errStatus)
+		}
+	}
+	return nil
 }
 
-func NewStorage(client *NATSClient) port.CommitteeReaderWriter {
-	return &storage{
-		client: client,
+func NewStorage(client *NATSClient, opts ...StorageOption) port.CommitteeReaderWriter {
+	s := &storage{
+		client:                client,
+		memberUniquenessScope: model.MemberUniquenessScopeCommittee,
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
 }
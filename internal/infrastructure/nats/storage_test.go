@@ -0,0 +1,576 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/model"
+	"github.com/linuxfoundation/lfx-v2-committee-service/pkg/constants"
+	errs "github.com/linuxfoundation/lfx-v2-committee-service/pkg/errors"
+)
+
+// fakeKeyValueEntry is an in-memory implementation of jetstream.KeyValueEntry
+type fakeKeyValueEntry struct {
+	bucket   string
+	key      string
+	value    []byte
+	revision uint64
+}
+
+func (e *fakeKeyValueEntry) Bucket() string                  { return e.bucket }
+func (e *fakeKeyValueEntry) Key() string                     { return e.key }
+func (e *fakeKeyValueEntry) Value() []byte                   { return e.value }
+func (e *fakeKeyValueEntry) Revision() uint64                { return e.revision }
+func (e *fakeKeyValueEntry) Created() time.Time              { return time.Time{} }
+func (e *fakeKeyValueEntry) Delta() uint64                   { return 0 }
+func (e *fakeKeyValueEntry) Operation() jetstream.KeyValueOp { return jetstream.KeyValuePut }
+
+// fakeKeyLister is an in-memory implementation of jetstream.KeyLister
+type fakeKeyLister struct {
+	keys chan string
+}
+
+func (l *fakeKeyLister) Keys() <-chan string { return l.keys }
+func (l *fakeKeyLister) Stop() error         { return nil }
+
+// fakeKeyValue is an in-memory jetstream.KeyValue covering the operations used by the storage.
+// Methods not overridden panic through the embedded nil interface.
+type fakeKeyValue struct {
+	jetstream.KeyValue
+
+	mu        sync.Mutex
+	bucket    string
+	entries   map[string]*fakeKeyValueEntry
+	revision  uint64
+	createErr map[string]error
+	getErr    map[string]error
+	statusErr error
+}
+
+func newFakeKeyValue(bucket string) *fakeKeyValue {
+	return &fakeKeyValue{
+		bucket:    bucket,
+		entries:   make(map[string]*fakeKeyValueEntry),
+		createErr: make(map[string]error),
+		getErr:    make(map[string]error),
+	}
+}
+
+func (kv *fakeKeyValue) Get(ctx context.Context, key string) (jetstream.KeyValueEntry, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	if err, ok := kv.getErr[key]; ok {
+		return nil, err
+	}
+	entry, ok := kv.entries[key]
+	if !ok {
+		return nil, jetstream.ErrKeyNotFound
+	}
+	return entry, nil
+}
+
+func (kv *fakeKeyValue) Put(ctx context.Context, key string, value []byte) (uint64, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	kv.revision++
+	kv.entries[key] = &fakeKeyValueEntry{bucket: kv.bucket, key: key, value: value, revision: kv.revision}
+	return kv.revision, nil
+}
+
+func (kv *fakeKeyValue) Create(ctx context.Context, key string, value []byte, opts ...jetstream.KVCreateOpt) (uint64, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	if err, ok := kv.createErr[key]; ok {
+		return 0, err
+	}
+	if _, ok := kv.entries[key]; ok {
+		return 0, jetstream.ErrKeyExists
+	}
+	kv.revision++
+	kv.entries[key] = &fakeKeyValueEntry{bucket: kv.bucket, key: key, value: value, revision: kv.revision}
+	return kv.revision, nil
+}
+
+func (kv *fakeKeyValue) Update(ctx context.Context, key string, value []byte, revision uint64) (uint64, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	entry, ok := kv.entries[key]
+	if !ok {
+		return 0, jetstream.ErrKeyNotFound
+	}
+	if entry.revision != revision {
+		return 0, errors.New("wrong last sequence")
+	}
+	kv.revision++
+	kv.entries[key] = &fakeKeyValueEntry{bucket: kv.bucket, key: key, value: value, revision: kv.revision}
+	return kv.revision, nil
+}
+
+func (kv *fakeKeyValue) Delete(ctx context.Context, key string, opts ...jetstream.KVDeleteOpt) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	if _, ok := kv.entries[key]; !ok {
+		return jetstream.ErrKeyNotFound
+	}
+	delete(kv.entries, key)
+	return nil
+}
+
+func (kv *fakeKeyValue) ListKeys(ctx context.Context, opts ...jetstream.WatchOpt) (jetstream.KeyLister, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	keys := make(chan string, len(kv.entries))
+	for key := range kv.entries {
+		keys <- key
+	}
+	close(keys)
+	return &fakeKeyLister{keys: keys}, nil
+}
+
+func (kv *fakeKeyValue) Status(ctx context.Context) (jetstream.KeyValueStatus, error) {
+	return nil, kv.statusErr
+}
+
+// newTestStorage builds a storage backed by in-memory buckets
+func newTestStorage() (*storage, map[string]*fakeKeyValue) {
+	buckets := map[string]*fakeKeyValue{
+		constants.KVBucketNameCommittees:        newFakeKeyValue(constants.KVBucketNameCommittees),
+		constants.KVBucketNameCommitteeSettings: newFakeKeyValue(constants.KVBucketNameCommitteeSettings),
+		constants.KVBucketNameCommitteeMembers:  newFakeKeyValue(constants.KVBucketNameCommitteeMembers),
+	}
+
+	kvStore := make(map[string]jetstream.KeyValue, len(buckets))
+	for name, bucket := range buckets {
+		kvStore[name] = bucket
+	}
+
+	return &storage{client: &NATSClient{kvStore: kvStore}}, buckets
+}
+
+func TestStorage_Create(t *testing.T) {
+	tests := []struct {
+		name          string
+		committee     *model.Committee
+		setup         func(buckets map[string]*fakeKeyValue)
+		expectError   bool
+		expectBase    bool
+		expectSetting bool
+	}{
+		{
+			name: "base and settings are created",
+			committee: &model.Committee{
+				CommitteeBase:     model.CommitteeBase{UID: "committee-1", Name: "TSC"},
+				CommitteeSettings: &model.CommitteeSettings{BusinessEmailRequired: true},
+			},
+			expectBase:    true,
+			expectSetting: true,
+		},
+		{
+			name: "default settings are created when none provided",
+			committee: &model.Committee{
+				CommitteeBase: model.CommitteeBase{UID: "committee-2", Name: "TAC"},
+			},
+			expectBase:    true,
+			expectSetting: true,
+		},
+		{
+			name: "settings write failure leaves no orphaned base record",
+			committee: &model.Committee{
+				CommitteeBase:     model.CommitteeBase{UID: "committee-3", Name: "Board"},
+				CommitteeSettings: &model.CommitteeSettings{},
+			},
+			setup: func(buckets map[string]*fakeKeyValue) {
+				buckets[constants.KVBucketNameCommitteeSettings].createErr["committee-3"] = errors.New("settings bucket unavailable")
+			},
+			expectError: true,
+		},
+		{
+			name:        "nil committee",
+			committee:   nil,
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s, buckets := newTestStorage()
+			if tc.setup != nil {
+				tc.setup(buckets)
+			}
+
+			err := s.Create(context.Background(), tc.committee)
+			if tc.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tc.committee == nil {
+				return
+			}
+
+			_, baseExists := buckets[constants.KVBucketNameCommittees].entries[tc.committee.CommitteeBase.UID]
+			_, settingsExists := buckets[constants.KVBucketNameCommitteeSettings].entries[tc.committee.CommitteeBase.UID]
+			assert.Equal(t, tc.expectBase, baseExists)
+			assert.Equal(t, tc.expectSetting, settingsExists)
+		})
+	}
+}
+
+func TestStorage_Create_SettingsFailureReturnsUnexpected(t *testing.T) {
+	s, buckets := newTestStorage()
+	buckets[constants.KVBucketNameCommitteeSettings].createErr["committee-1"] = errors.New("boom")
+
+	err := s.Create(context.Background(), &model.Committee{
+		CommitteeBase:     model.CommitteeBase{UID: "committee-1"},
+		CommitteeSettings: &model.CommitteeSettings{},
+	})
+
+	require.Error(t, err)
+	assert.IsType(t, errs.Unexpected{}, err)
+
+	_, _, errGet := s.GetBase(context.Background(), "committee-1")
+	assert.IsType(t, errs.NotFound{}, errGet)
+}
+
+func TestStorage_UpdateBase_LeavesSettingsUntouched(t *testing.T) {
+	ctx := context.Background()
+	s, buckets := newTestStorage()
+
+	require.NoError(t, s.Create(ctx, &model.Committee{
+		CommitteeBase:     model.CommitteeBase{UID: "committee-1", Name: "TSC"},
+		CommitteeSettings: &model.CommitteeSettings{Writers: []string{"writer@example.com"}},
+	}))
+	_, baseRevision, err := s.GetBase(ctx, "committee-1")
+	require.NoError(t, err)
+
+	// A concurrent settings change lands after the base was read
+	_, settingsRevision, err := s.GetSettings(ctx, "committee-1")
+	require.NoError(t, err)
+	require.NoError(t, s.UpdateSetting(ctx, &model.CommitteeSettings{
+		UID:                   "committee-1",
+		BusinessEmailRequired: true,
+		Writers:               []string{"new-writer@example.com"},
+	}, settingsRevision))
+	settingsEntry, err := buckets[constants.KVBucketNameCommitteeSettings].Get(ctx, "committee-1")
+	require.NoError(t, err)
+
+	// The base update carries stale settings
+	err = s.UpdateBase(ctx, &model.Committee{
+		CommitteeBase:     model.CommitteeBase{UID: "committee-1", Name: "Technical Steering Committee"},
+		CommitteeSettings: &model.CommitteeSettings{UID: "committee-1", Writers: []string{"stale-writer@example.com"}},
+	}, baseRevision)
+	require.NoError(t, err)
+
+	base, _, err := s.GetBase(ctx, "committee-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Technical Steering Committee", base.Name)
+
+	afterEntry, err := buckets[constants.KVBucketNameCommitteeSettings].Get(ctx, "committee-1")
+	require.NoError(t, err)
+	assert.Equal(t, settingsEntry.Revision(), afterEntry.Revision())
+	assert.Equal(t, settingsEntry.Value(), afterEntry.Value())
+
+	settings, _, err := s.GetSettings(ctx, "committee-1")
+	require.NoError(t, err)
+	assert.True(t, settings.BusinessEmailRequired)
+	assert.Equal(t, []string{"new-writer@example.com"}, settings.Writers)
+}
+
+func TestStorage_UniqueMember_Scope(t *testing.T) {
+	tests := []struct {
+		name        string
+		scope       model.MemberUniquenessScope
+		expectError bool
+	}{
+		{
+			name:        "same email in two committees is allowed under per-committee scope",
+			scope:       model.MemberUniquenessScopeCommittee,
+			expectError: false,
+		},
+		{
+			name:        "same email in two committees is rejected under project scope",
+			scope:       model.MemberUniquenessScopeProject,
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s, _ := newTestStorage()
+			s.memberUniquenessScope = tc.scope
+			ctx := context.Background()
+
+			for _, committeeUID := range []string{"committee-a", "committee-b"} {
+				require.NoError(t, s.Create(ctx, &model.Committee{
+					CommitteeBase: model.CommitteeBase{UID: committeeUID, ProjectUID: "project-1"},
+				}))
+			}
+
+			first := &model.CommitteeMember{CommitteeMemberBase: model.CommitteeMemberBase{
+				UID:          "member-1",
+				CommitteeUID: "committee-a",
+				Email:        "jane@example.com",
+			}}
+			_, err := s.UniqueMember(ctx, first)
+			require.NoError(t, err)
+
+			second := &model.CommitteeMember{CommitteeMemberBase: model.CommitteeMemberBase{
+				UID:          "member-2",
+				CommitteeUID: "committee-b",
+				Email:        "Jane@Example.com",
+			}}
+			_, err = s.UniqueMember(ctx, second)
+			if tc.expectError {
+				require.Error(t, err)
+				assert.IsType(t, errs.Conflict{}, err)
+				assert.Contains(t, err.Error(), "project")
+			} else {
+				require.NoError(t, err)
+			}
+
+			// the same email in the same committee is always rejected
+			duplicate := &model.CommitteeMember{CommitteeMemberBase: model.CommitteeMemberBase{
+				UID:          "member-3",
+				CommitteeUID: "committee-a",
+				Email:        "jane@example.com",
+			}}
+			_, err = s.UniqueMember(ctx, duplicate)
+			require.Error(t, err)
+			assert.IsType(t, errs.Conflict{}, err)
+		})
+	}
+}
+
+func TestStorage_IndexParent_ListChildren(t *testing.T) {
+	s, buckets := newTestStorage()
+	ctx := context.Background()
+
+	parentUID := "committee-parent"
+	otherParentUID := "committee-other"
+
+	for _, child := range []struct {
+		uid    string
+		parent *string
+	}{
+		{uid: "committee-child-1", parent: &parentUID},
+		{uid: "committee-child-2", parent: &parentUID},
+		{uid: "committee-child-3", parent: &otherParentUID},
+	} {
+		key, err := s.IndexParent(ctx, &model.Committee{
+			CommitteeBase: model.CommitteeBase{UID: child.uid, ParentUID: child.parent},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "lookup/committee-parents/"+*child.parent+"/"+child.uid, key)
+	}
+
+	// indexing the same child twice is a no-op
+	_, err := s.IndexParent(ctx, &model.Committee{
+		CommitteeBase: model.CommitteeBase{UID: "committee-child-1", ParentUID: &parentUID},
+	})
+	require.NoError(t, err)
+
+	// a committee without parent cannot be indexed
+	_, err = s.IndexParent(ctx, &model.Committee{CommitteeBase: model.CommitteeBase{UID: "committee-root"}})
+	require.Error(t, err)
+	assert.IsType(t, errs.Validation{}, err)
+
+	children, err := s.ListChildren(ctx, parentUID)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"committee-child-1", "committee-child-2"}, children)
+
+	children, err = s.ListChildren(ctx, "committee-child-1")
+	require.NoError(t, err)
+	assert.Empty(t, children)
+
+	// parent index keys are not listed as committees
+	bases, err := s.ListBases(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, bases)
+	assert.Len(t, buckets[constants.KVBucketNameCommittees].entries, 3)
+}
+
+func TestStorage_IsReady(t *testing.T) {
+	t.Run("not connected client is unavailable", func(t *testing.T) {
+		s, _ := newTestStorage()
+
+		err := s.IsReady(context.Background())
+		require.Error(t, err)
+		assert.IsType(t, errs.ServiceUnavailable{}, err)
+	})
+
+	t.Run("healthy buckets", func(t *testing.T) {
+		s, _ := newTestStorage()
+
+		assert.NoError(t, s.bucketsReady(context.Background()))
+	})
+
+	t.Run("unreachable bucket is unavailable", func(t *testing.T) {
+		s, buckets := newTestStorage()
+		buckets[constants.KVBucketNameCommitteeSettings].statusErr = errors.New("nats: timeout")
+
+		err := s.bucketsReady(context.Background())
+		require.Error(t, err)
+		assert.IsType(t, errs.ServiceUnavailable{}, err)
+		assert.Contains(t, err.Error(), constants.KVBucketNameCommitteeSettings)
+	})
+}
+
+func TestStorage_CorruptRecords(t *testing.T) {
+	ctx := context.Background()
+	corrupt := []byte(`{"uid": "broken"`)
+
+	t.Run("get wraps the decode failure with the key", func(t *testing.T) {
+		s, buckets := newTestStorage()
+		_, err := buckets[constants.KVBucketNameCommittees].Put(ctx, "committee-corrupt", corrupt)
+		require.NoError(t, err)
+		_, err = buckets[constants.KVBucketNameCommitteeMembers].Put(ctx, "member-corrupt", corrupt)
+		require.NoError(t, err)
+
+		_, _, errBase := s.GetBase(ctx, "committee-corrupt")
+		require.Error(t, errBase)
+		assert.IsType(t, errs.Unexpected{}, errBase)
+		assert.Contains(t, errBase.Error(), "committee-corrupt")
+
+		_, _, errMember := s.GetMember(ctx, "member-corrupt")
+		require.Error(t, errMember)
+		assert.IsType(t, errs.Unexpected{}, errMember)
+		assert.Contains(t, errMember.Error(), "member-corrupt")
+	})
+
+	t.Run("list committees skips corrupt records", func(t *testing.T) {
+		s, buckets := newTestStorage()
+		require.NoError(t, s.Create(ctx, &model.Committee{
+			CommitteeBase: model.CommitteeBase{UID: "committee-1", Name: "TSC"},
+		}))
+		_, err := buckets[constants.KVBucketNameCommittees].Put(ctx, "committee-corrupt", corrupt)
+		require.NoError(t, err)
+
+		bases, err := s.ListBases(ctx)
+		require.NoError(t, err)
+		require.Len(t, bases, 1)
+		assert.Equal(t, "committee-1", bases[0].UID)
+	})
+
+	t.Run("list members skips corrupt records", func(t *testing.T) {
+		s, buckets := newTestStorage()
+		require.NoError(t, s.CreateMember(ctx, &model.CommitteeMember{
+			CommitteeMemberBase: model.CommitteeMemberBase{
+				UID:          "member-1",
+				CommitteeUID: "committee-1",
+				Email:        "member@example.com",
+			},
+		}))
+		_, err := buckets[constants.KVBucketNameCommitteeMembers].Put(ctx, "member-corrupt", corrupt)
+		require.NoError(t, err)
+
+		members, err := s.ListMembers(ctx, "committee-1")
+		require.NoError(t, err)
+		require.Len(t, members, 1)
+		assert.Equal(t, "member-1", members[0].UID)
+	})
+}
+
+func TestStorage_GetSettingsRevision(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("existing committee returns the settings revision", func(t *testing.T) {
+		s, _ := newTestStorage()
+		require.NoError(t, s.Create(ctx, &model.Committee{
+			CommitteeBase:     model.CommitteeBase{UID: "committee-1", Name: "TSC"},
+			CommitteeSettings: &model.CommitteeSettings{Writers: []string{"writer@example.com"}},
+		}))
+
+		_, settingsRevision, err := s.GetSettings(ctx, "committee-1")
+		require.NoError(t, err)
+
+		revision, err := s.GetSettingsRevision(ctx, "committee-1")
+		require.NoError(t, err)
+		assert.Equal(t, settingsRevision, revision)
+
+		require.NoError(t, s.UpdateSetting(ctx, &model.CommitteeSettings{UID: "committee-1"}, revision))
+		updatedRevision, err := s.GetSettingsRevision(ctx, "committee-1")
+		require.NoError(t, err)
+		assert.Greater(t, updatedRevision, revision)
+	})
+
+	t.Run("nonexistent committee returns not found", func(t *testing.T) {
+		s, _ := newTestStorage()
+
+		revision, err := s.GetSettingsRevision(ctx, "committee-missing")
+		require.Error(t, err)
+		assert.IsType(t, errs.NotFound{}, err)
+		assert.Zero(t, revision)
+	})
+}
+
+func TestStorage_Exists(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestStorage()
+	require.NoError(t, s.Create(ctx, &model.Committee{
+		CommitteeBase:     model.CommitteeBase{UID: "committee-1", Name: "TSC"},
+		CommitteeSettings: &model.CommitteeSettings{},
+	}))
+
+	exists, err := s.Exists(ctx, "committee-1")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	exists, err = s.Exists(ctx, "committee-missing")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestStorage_UniqueNameProject_Concurrent(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestStorage()
+
+	const attempts = 10
+	var (
+		wg        sync.WaitGroup
+		succeeded atomic.Int32
+		conflicts atomic.Int32
+	)
+	for i := 0; i < attempts; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := s.UniqueNameProject(ctx, &model.Committee{
+				CommitteeBase: model.CommitteeBase{
+					UID:        fmt.Sprintf("committee-%d", i),
+					ProjectUID: "project-1",
+					Name:       "Concurrent Committee",
+				},
+			})
+			var conflict errs.Conflict
+			switch {
+			case err == nil:
+				succeeded.Add(1)
+			case errors.As(err, &conflict):
+				conflicts.Add(1)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), succeeded.Load())
+	assert.Equal(t, int32(attempts-1), conflicts.Load())
+}
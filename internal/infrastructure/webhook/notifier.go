@@ -0,0 +1,183 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/model"
+	"github.com/linuxfoundation/lfx-v2-committee-service/pkg/errors"
+)
+
+const (
+	// SignatureHeader carries the HMAC-SHA256 signature of the request body, in the form sha256=<hex>
+	SignatureHeader = "X-LFX-Signature"
+	// EventTypeHeader carries the type of the delivered event, e.g. committee.created
+	EventTypeHeader = "X-LFX-Event"
+
+	defaultMaxAttempts = 3
+	defaultRetryDelay  = 500 * time.Millisecond
+	defaultTimeout     = 5 * time.Second
+)
+
+// Config represents the outbound webhook configuration
+type Config struct {
+	// URLs are the endpoints every event is delivered to
+	URLs []string `json:"urls"`
+	// Secret is the key used to sign the request bodies
+	Secret string `json:"-"`
+	// MaxAttempts is the number of delivery attempts per URL, zero keeps the default
+	MaxAttempts int `json:"max_attempts"`
+	// RetryDelay is the wait before the first retry, doubled on every following one; zero keeps the default
+	RetryDelay time.Duration `json:"retry_delay"`
+	// Timeout is the timeout of each delivery attempt, zero keeps the default
+	Timeout time.Duration `json:"timeout"`
+}
+
+// Notifier delivers committee events to the configured webhook URLs over HTTP
+type Notifier struct {
+	config Config
+	client *http.Client
+	sleep  func(ctx context.Context, d time.Duration) error
+}
+
+// NewNotifier creates a new webhook notifier with the given configuration
+func NewNotifier(config Config) (*Notifier, error) {
+	if len(config.URLs) == 0 {
+		return nil, errors.NewUnexpected("at least one webhook URL is required")
+	}
+	if config.Secret == "" {
+		return nil, errors.NewUnexpected("webhook secret is required")
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = defaultMaxAttempts
+	}
+	if config.RetryDelay <= 0 {
+		config.RetryDelay = defaultRetryDelay
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = defaultTimeout
+	}
+
+	return &Notifier{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+		sleep:  sleepContext,
+	}, nil
+}
+
+// Sign returns the signature of the body with the secret, in the form sha256=<hex>
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Notify delivers the event to every configured URL, retrying the failed deliveries
+func (n *Notifier) Notify(ctx context.Context, event *model.CommitteeEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return errors.NewUnexpected("failed to marshal webhook event", err)
+	}
+
+	var errDeliveries []error
+	for _, url := range n.config.URLs {
+		if errDeliver := n.deliver(ctx, url, event.EventType, body); errDeliver != nil {
+			slog.ErrorContext(ctx, "failed to deliver webhook event",
+				"error", errDeliver,
+				"url", url,
+				"event_type", event.EventType,
+			)
+			errDeliveries = append(errDeliveries, errDeliver)
+		}
+	}
+
+	return stderrors.Join(errDeliveries...)
+}
+
+// deliver posts the body to the URL until it is accepted, a non retryable status is returned
+// or the attempts are exhausted
+func (n *Notifier) deliver(ctx context.Context, url, eventType string, body []byte) error {
+	signature := Sign(n.config.Secret, body)
+	delay := n.config.RetryDelay
+
+	var errAttempt error
+	for attempt := 1; attempt <= n.config.MaxAttempts; attempt++ {
+		if attempt > 1 {
+			if errSleep := n.sleep(ctx, delay); errSleep != nil {
+				return errSleep
+			}
+			delay *= 2
+		}
+
+		var retryable bool
+		retryable, errAttempt = n.post(ctx, url, eventType, signature, body)
+		if errAttempt == nil {
+			return nil
+		}
+		if !retryable {
+			return errAttempt
+		}
+
+		slog.WarnContext(ctx, "webhook delivery attempt failed",
+			"error", errAttempt,
+			"url", url,
+			"attempt", attempt,
+			"max_attempts", n.config.MaxAttempts,
+		)
+	}
+
+	return errAttempt
+}
+
+// post sends a single delivery attempt, reporting whether a failure is worth retrying
+func (n *Notifier) post(ctx context.Context, url, eventType, signature string, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return false, errors.NewUnexpected("failed to build webhook request", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventTypeHeader, eventType)
+	req.Header.Set(SignatureHeader, signature)
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return true, errors.NewServiceUnavailable("webhook request failed", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return true, errors.NewServiceUnavailable(fmt.Sprintf("webhook endpoint returned status %d", resp.StatusCode))
+	default:
+		return false, errors.NewUnexpected(fmt.Sprintf("webhook endpoint rejected the event with status %d", resp.StatusCode))
+	}
+}
+
+// sleepContext waits for the duration unless the context is done first
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/model"
+)
+
+func newTestNotifier(t *testing.T, urls ...string) *Notifier {
+	t.Helper()
+
+	notifier, err := NewNotifier(Config{
+		URLs:        urls,
+		Secret:      "test-secret",
+		MaxAttempts: 3,
+	})
+	require.NoError(t, err)
+	notifier.sleep = func(context.Context, time.Duration) error { return nil }
+	return notifier
+}
+
+func testEvent() *model.CommitteeEvent {
+	return &model.CommitteeEvent{
+		EventType: "committee.created",
+		Subject:   "lfx.committee-api.committee.created",
+		Version:   "1",
+		Data:      map[string]string{"uid": "committee-123"},
+	}
+}
+
+func TestNewNotifier(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      Config
+		expectError bool
+	}{
+		{
+			name:   "valid configuration applies the defaults",
+			config: Config{URLs: []string{"http://example.com/hook"}, Secret: "secret"},
+		},
+		{
+			name:        "missing URLs",
+			config:      Config{Secret: "secret"},
+			expectError: true,
+		},
+		{
+			name:        "missing secret",
+			config:      Config{URLs: []string{"http://example.com/hook"}},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notifier, err := NewNotifier(tt.config)
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, notifier)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, defaultMaxAttempts, notifier.config.MaxAttempts)
+			assert.Equal(t, defaultRetryDelay, notifier.config.RetryDelay)
+			assert.Equal(t, defaultTimeout, notifier.config.Timeout)
+		})
+	}
+}
+
+func TestNotifier_Notify_SignsRequest(t *testing.T) {
+	var (
+		receivedBody      []byte
+		receivedSignature string
+		receivedEventType string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedBody, _ = io.ReadAll(r.Body)
+		receivedSignature = r.Header.Get(SignatureHeader)
+		receivedEventType = r.Header.Get(EventTypeHeader)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	notifier := newTestNotifier(t, server.URL)
+	err := notifier.Notify(context.Background(), testEvent())
+	require.NoError(t, err)
+
+	assert.Equal(t, Sign("test-secret", receivedBody), receivedSignature)
+	assert.NotEqual(t, Sign("other-secret", receivedBody), receivedSignature)
+	assert.Equal(t, "committee.created", receivedEventType)
+
+	var event model.CommitteeEvent
+	require.NoError(t, json.Unmarshal(receivedBody, &event))
+	assert.Equal(t, "lfx.committee-api.committee.created", event.Subject)
+}
+
+func TestNotifier_Notify_Retries(t *testing.T) {
+	tests := []struct {
+		name             string
+		statuses         []int
+		expectError      bool
+		expectedAttempts int32
+	}{
+		{
+			name:             "server errors are retried until accepted",
+			statuses:         []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusOK},
+			expectedAttempts: 3,
+		},
+		{
+			name:             "rate limiting is retried",
+			statuses:         []int{http.StatusTooManyRequests, http.StatusAccepted},
+			expectedAttempts: 2,
+		},
+		{
+			name:             "gives up once the attempts are exhausted",
+			statuses:         []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK},
+			expectError:      true,
+			expectedAttempts: 3,
+		},
+		{
+			name:             "client errors are not retried",
+			statuses:         []int{http.StatusBadRequest, http.StatusOK},
+			expectError:      true,
+			expectedAttempts: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempt := attempts.Add(1)
+				w.WriteHeader(tt.statuses[attempt-1])
+			}))
+			defer server.Close()
+
+			notifier := newTestNotifier(t, server.URL)
+			err := notifier.Notify(context.Background(), testEvent())
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedAttempts, attempts.Load())
+		})
+	}
+}
+
+func TestNotifier_Notify_EveryURL(t *testing.T) {
+	var delivered atomic.Int32
+	okServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		delivered.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer okServer.Close()
+	failingServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer failingServer.Close()
+
+	notifier := newTestNotifier(t, failingServer.URL, okServer.URL)
+	err := notifier.Notify(context.Background(), testEvent())
+
+	// a failing URL does not prevent the delivery to the others
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), delivered.Load())
+}
@@ -8,6 +8,9 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"net/url"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -74,11 +77,20 @@ func (uc *committeeWriterOrchestrator) CreateMember(ctx context.Context, member
 		"sync", sync,
 	)
 
+	// Keep a pre-assigned UID (e.g. members migrated from another system), otherwise generate one
+	if member.UID == "" {
+		member.UID = uuid.New().String()
+	} else if errUID := uc.validatePreAssignedMemberUID(ctx, member.UID); errUID != nil {
+		return nil, errUID
+	}
+
 	now := time.Now()
-	member.UID = uuid.New().String()
 	member.CreatedAt = now
 	member.UpdatedAt = now
 
+	// Fill the role, voting status and status the caller omitted with the schema defaults
+	member.ApplyDefaults()
+
 	// Track resources for rollback purposes
 	var (
 		keys             []string
@@ -101,6 +113,7 @@ func (uc *committeeWriterOrchestrator) CreateMember(ctx context.Context, member
 	}
 	member.CommitteeName = committee.Name
 	member.CommitteeCategory = committee.Category
+	member.ProjectUID = committee.ProjectUID
 
 	slog.DebugContext(ctx, "committee found",
 		"committee_uid", committee.UID,
@@ -134,7 +147,7 @@ func (uc *committeeWriterOrchestrator) CreateMember(ctx context.Context, member
 
 	// Step 2: Validate member against committee requirements (domain validation)
 	fullCommittee := &model.Committee{CommitteeBase: *committee, CommitteeSettings: settings}
-	if errValidation := member.Validate(fullCommittee); errValidation != nil {
+	if errValidation := member.Validate(fullCommittee, uc.memberPolicy); errValidation != nil {
 		slog.ErrorContext(ctx, "committee member validation failed",
 			"error", errValidation,
 			"member_uid", member.UID,
@@ -146,9 +159,9 @@ func (uc *committeeWriterOrchestrator) CreateMember(ctx context.Context, member
 		return nil, errValidation
 	}
 
-	// Step 3: Validate business email domain if required
-	if settings.BusinessEmailRequired {
-		if errEmailValidation := uc.validateCorporateEmailDomain(ctx, member.Email); errEmailValidation != nil {
+	// Step 3: Validate business email domain if required or restricted by the committee
+	if settings.BusinessEmailRequired || len(settings.AllowedEmailDomains) > 0 {
+		if errEmailValidation := uc.validateCorporateEmailDomain(ctx, member.Email, settings); errEmailValidation != nil {
 			slog.WarnContext(ctx, "corporate email domain validation failed",
 				"error", errEmailValidation,
 				"email", redaction.RedactEmail(member.Email),
@@ -156,6 +169,14 @@ func (uc *committeeWriterOrchestrator) CreateMember(ctx context.Context, member
 			)
 			return nil, errEmailValidation
 		}
+		if errDomain := uc.validateEmailOrganizationDomain(ctx, member.Email, member.Organization); errDomain != nil {
+			slog.WarnContext(ctx, "email organization domain validation failed",
+				"error", errDomain,
+				"email", redaction.RedactEmail(member.Email),
+				"committee_uid", member.CommitteeUID,
+			)
+			return nil, errDomain
+		}
 	}
 
 	// Step 4: Lookup username by email if username is not provided
@@ -196,6 +217,16 @@ func (uc *committeeWriterOrchestrator) CreateMember(ctx context.Context, member
 		)
 		return nil, errOrganization
 	}
+	uc.suggestOrganizationName(ctx, member.CommitteeUID, member.Organization.Name)
+
+	// Step 6.1: Validate the primary member an alternate substitutes for
+	if errAlternate := uc.validateAlternateFor(ctx, member); errAlternate != nil {
+		slog.WarnContext(ctx, "alternate member link validation failed",
+			"error", errAlternate,
+			"committee_uid", member.CommitteeUID,
+		)
+		return nil, errAlternate
+	}
 
 	// Step 7: Check if member already exists in committee
 	key, errMemberExists := uc.committeeWriter.UniqueMember(ctx, member)
@@ -257,6 +288,265 @@ func (uc *committeeWriterOrchestrator) CreateMember(ctx context.Context, member
 	return member, nil
 }
 
+// validatePreAssignedMemberUID checks that a client supplied member UID is a UUID not used by another member
+func (uc *committeeWriterOrchestrator) validatePreAssignedMemberUID(ctx context.Context, memberUID string) error {
+
+	if _, errParse := uuid.Parse(memberUID); errParse != nil {
+		slog.WarnContext(ctx, "invalid pre-assigned member UID",
+			"error", errParse,
+			"member_uid", memberUID,
+		)
+		return errs.NewValidation("member UID must be a valid UUID", errParse)
+	}
+
+	_, _, errGet := uc.committeeReader.GetMember(ctx, memberUID)
+	if errGet == nil {
+		slog.WarnContext(ctx, "pre-assigned member UID already in use",
+			"member_uid", memberUID,
+		)
+		return errs.NewConflict("committee member with the same UID already exists")
+	}
+
+	var notFoundErr errs.NotFound
+	if !errors.As(errGet, &notFoundErr) {
+		slog.ErrorContext(ctx, "failed to check pre-assigned member UID",
+			"error", errGet,
+			"member_uid", memberUID,
+		)
+		return errGet
+	}
+
+	return nil
+}
+
+// ValidateMembers validates a batch of members for the committee without creating anything.
+// It runs the same checks as CreateMember in dry mode, so no lookup key is reserved; members
+// already in the committee and duplicates within the batch are reported as conflicts.
+func (uc *committeeWriterOrchestrator) ValidateMembers(ctx context.Context, committeeUID string, members []*model.CommitteeMember) ([]*model.CommitteeMemberValidationResult, error) {
+	slog.DebugContext(ctx, "validating committee members",
+		"committee_uid", committeeUID,
+		"member_count", len(members),
+	)
+
+	// Step 1: Validate that the committee exists
+	committee, _, errCommittee := uc.committeeReader.GetBase(ctx, committeeUID)
+	if errCommittee != nil {
+		slog.ErrorContext(ctx, "committee not found",
+			"error", errCommittee,
+			"committee_uid", committeeUID,
+		)
+		return nil, errCommittee
+	}
+
+	settings, _, errSettings := uc.committeeReader.GetSettings(ctx, committeeUID)
+	if errSettings != nil {
+		var notFoundErr errs.NotFound
+		if !errors.As(errSettings, &notFoundErr) {
+			slog.ErrorContext(ctx, "failed to retrieve committee settings",
+				"error", errSettings,
+				"committee_uid", committeeUID,
+			)
+			return nil, errSettings
+		}
+	}
+	// Use empty settings if not found
+	if settings == nil {
+		settings = &model.CommitteeSettings{}
+	}
+	fullCommittee := &model.Committee{CommitteeBase: *committee, CommitteeSettings: settings}
+
+	// Step 2: Collect the lookup keys already taken by the committee members
+	existing, errList := uc.committeeReader.ListMembers(ctx, committeeUID)
+	if errList != nil {
+		slog.ErrorContext(ctx, "failed to list committee members",
+			"error", errList,
+			"committee_uid", committeeUID,
+		)
+		return nil, errList
+	}
+	takenKeys := make(map[string]struct{}, len(existing)+len(members))
+	organizations := model.NewOrganizationNameIndex()
+	for _, member := range existing {
+		key, errKey := uc.committeeWriter.MemberIndexKey(ctx, member)
+		if errKey != nil {
+			return nil, errKey
+		}
+		takenKeys[key] = struct{}{}
+		organizations.Add(member.Organization.Name)
+	}
+
+	// Step 3: Validate each member, at most batchConcurrency at a time
+	results := make([]*model.CommitteeMemberValidationResult, len(members))
+	keys := make([]string, len(members))
+	validations := make([]func() error, 0, len(members))
+	for index, member := range members {
+		results[index] = &model.CommitteeMemberValidationResult{Index: index}
+		if member != nil {
+			results[index].Email = member.Email
+			results[index].OrganizationSuggestion = organizations.Suggest(member.Organization.Name)
+		}
+		validations = append(validations, func() error {
+			// failures are reported per member, so they must not cancel the rest of the batch
+			keys[index], results[index].Err = uc.validateMemberDryRun(ctx, fullCommittee, member)
+			return nil
+		})
+	}
+	if errRun := concurrent.NewWorkerPool(uc.batchWorkerCount()).Run(ctx, validations...); errRun != nil {
+		return nil, errs.NewUnexpected("failed to validate committee members", errRun)
+	}
+
+	// Step 4: Reject emails already in the committee or repeated in the batch, in the order they were sent
+	for index, result := range results {
+		if result.Err == nil {
+			if _, taken := takenKeys[keys[index]]; taken {
+				result.Err = errs.NewConflict("member with the same email already exists in the committee")
+			}
+			takenKeys[keys[index]] = struct{}{}
+		}
+		result.Valid = result.Err == nil
+	}
+
+	return results, nil
+}
+
+// ReassignOrganization moves every member of the committee whose organization is fromOrg to toOrg,
+// clearing the organization when toOrg is empty. Organizations are compared trimmed and case insensitive.
+// Each member goes through UpdateMember, so it is validated and published like any other update,
+// and failures are reported per member without stopping the rest of the batch.
+func (uc *committeeWriterOrchestrator) ReassignOrganization(ctx context.Context, committeeUID, fromOrg, toOrg string, sync bool) ([]*model.CommitteeMemberReassignResult, error) {
+	fromOrg, toOrg = strings.TrimSpace(fromOrg), strings.TrimSpace(toOrg)
+
+	slog.DebugContext(ctx, "executing reassign committee members organization use case",
+		"committee_uid", committeeUID,
+		"from_organization", fromOrg,
+		"to_organization", toOrg,
+		"sync", sync,
+	)
+
+	// Step 1: Validate the organizations
+	if fromOrg == "" {
+		return nil, errs.NewValidation("the organization to reassign the members from is required")
+	}
+	if strings.EqualFold(fromOrg, toOrg) {
+		return nil, errs.NewValidation("the members are already assigned to the organization")
+	}
+
+	// Step 2: Validate that the committee exists
+	if _, _, errCommittee := uc.committeeReader.GetBase(ctx, committeeUID); errCommittee != nil {
+		slog.ErrorContext(ctx, "committee not found",
+			"error", errCommittee,
+			"committee_uid", committeeUID,
+		)
+		return nil, errCommittee
+	}
+
+	// Step 3: Collect the members of the departing organization
+	members, errList := uc.committeeReader.ListMembers(ctx, committeeUID)
+	if errList != nil {
+		slog.ErrorContext(ctx, "failed to list committee members",
+			"error", errList,
+			"committee_uid", committeeUID,
+		)
+		return nil, errList
+	}
+	var matching []*model.CommitteeMember
+	for _, member := range members {
+		if strings.EqualFold(strings.TrimSpace(member.Organization.Name), fromOrg) {
+			matching = append(matching, member)
+		}
+	}
+
+	// Step 4: Update each member, at most batchConcurrency at a time
+	results := make([]*model.CommitteeMemberReassignResult, len(matching))
+	updates := make([]func() error, 0, len(matching))
+	for index, member := range matching {
+		results[index] = &model.CommitteeMemberReassignResult{MemberUID: member.UID, Email: member.Email}
+		updates = append(updates, func() error {
+			// failures are reported per member, so they must not cancel the rest of the batch
+			results[index].Member, results[index].Err = uc.reassignMemberOrganization(ctx, member.UID, fromOrg, toOrg, sync)
+			return nil
+		})
+	}
+	if errRun := concurrent.NewWorkerPool(uc.batchWorkerCount()).Run(ctx, updates...); errRun != nil {
+		return nil, errs.NewUnexpected("failed to reassign committee members organization", errRun)
+	}
+
+	slog.DebugContext(ctx, "committee members organization reassigned",
+		"committee_uid", committeeUID,
+		"member_count", len(members),
+		"reassigned_count", len(matching),
+	)
+
+	return results, nil
+}
+
+// reassignMemberOrganization replaces the fromOrg organization of a single member with toOrg,
+// reading the member again so the update is made against its latest revision
+func (uc *committeeWriterOrchestrator) reassignMemberOrganization(ctx context.Context, memberUID, fromOrg, toOrg string, sync bool) (*model.CommitteeMember, error) {
+	member, revision, errGet := uc.committeeReader.GetMember(ctx, memberUID)
+	if errGet != nil {
+		return nil, errGet
+	}
+
+	// The ID and website belong to the departing organization, only the name is carried over
+	reassigned := *member
+	reassigned.Organization = model.CommitteeMemberOrganization{Name: toOrg, Primary: member.Organization.Primary}
+	reassigned.Organizations = nil
+	for _, organization := range member.Organizations {
+		if !strings.EqualFold(strings.TrimSpace(organization.Name), fromOrg) {
+			reassigned.Organizations = append(reassigned.Organizations, organization)
+			continue
+		}
+		if toOrg != "" {
+			reassigned.Organizations = append(reassigned.Organizations, model.CommitteeMemberOrganization{Name: toOrg, Primary: organization.Primary})
+		}
+	}
+	if toOrg == "" {
+		reassigned.Organization = model.CommitteeMemberOrganization{}
+	}
+
+	return uc.UpdateMember(ctx, &reassigned, revision, sync)
+}
+
+// validateMemberDryRun runs the CreateMember checks for a single member without mutating it or reserving keys.
+// It returns the lookup key the member would reserve, so the caller can detect duplicates.
+func (uc *committeeWriterOrchestrator) validateMemberDryRun(ctx context.Context, committee *model.Committee, member *model.CommitteeMember) (string, error) {
+	if member == nil {
+		return "", errs.NewValidation("committee member cannot be nil")
+	}
+
+	// work on a copy, the caller's members are left untouched
+	candidate := *member
+	candidate.CommitteeUID = committee.CommitteeBase.UID
+	candidate.CommitteeName = committee.CommitteeBase.Name
+	candidate.CommitteeCategory = committee.CommitteeBase.Category
+	candidate.ProjectUID = committee.CommitteeBase.ProjectUID
+	candidate.ApplyDefaults()
+
+	if errValidation := candidate.Validate(committee, uc.memberPolicy); errValidation != nil {
+		return "", errValidation
+	}
+
+	if committee.CommitteeSettings.BusinessEmailRequired || len(committee.CommitteeSettings.AllowedEmailDomains) > 0 {
+		if errEmailValidation := uc.validateCorporateEmailDomain(ctx, candidate.Email, committee.CommitteeSettings); errEmailValidation != nil {
+			return "", errEmailValidation
+		}
+		if errDomain := uc.validateEmailOrganizationDomain(ctx, candidate.Email, candidate.Organization); errDomain != nil {
+			return "", errDomain
+		}
+	}
+
+	if errUsername := uc.validateUsernameExists(ctx, candidate.Username); errUsername != nil {
+		return "", errUsername
+	}
+
+	if errOrganization := uc.validateOrganizationExists(ctx, candidate.Organization.Name); errOrganization != nil {
+		return "", errOrganization
+	}
+
+	return uc.committeeWriter.MemberIndexKey(ctx, &candidate)
+}
+
 // UpdateMember updates an existing committee member
 func (uc *committeeWriterOrchestrator) UpdateMember(ctx context.Context, member *model.CommitteeMember, revision uint64, sync bool) (*model.CommitteeMember, error) {
 	slog.DebugContext(ctx, "executing update committee member use case",
@@ -343,6 +633,7 @@ func (uc *committeeWriterOrchestrator) UpdateMember(ctx context.Context, member
 	}
 	member.CommitteeName = committee.Name
 	member.CommitteeCategory = committee.Category
+	member.ProjectUID = committee.ProjectUID
 
 	slog.DebugContext(ctx, "committee found for member update",
 		"committee_uid", committee.UID,
@@ -355,7 +646,7 @@ func (uc *committeeWriterOrchestrator) UpdateMember(ctx context.Context, member
 	// We use empty settings for basic validation since we only need settings for email validation
 	basicSettings := &model.CommitteeSettings{}
 	fullCommittee := &model.Committee{CommitteeBase: *committee, CommitteeSettings: basicSettings}
-	if errValidation := member.Validate(fullCommittee); errValidation != nil {
+	if errValidation := member.Validate(fullCommittee, uc.memberPolicy); errValidation != nil {
 		slog.ErrorContext(ctx, "committee member validation failed during update",
 			"error", errValidation,
 			"member_uid", member.UID,
@@ -368,14 +659,17 @@ func (uc *committeeWriterOrchestrator) UpdateMember(ctx context.Context, member
 	}
 
 	// Step 4: Handle email changes - validate corporate domain and manage lookup keys
+	// A website change re-validates the domain as well when the email organization domain policy is enabled
 	emailChanged := existing.Email != member.Email
-	if emailChanged {
-		slog.DebugContext(ctx, "email change detected",
+	websiteChanged := uc.emailDomainMatch && existing.Organization.Website != member.Organization.Website
+	if emailChanged || websiteChanged {
+		slog.DebugContext(ctx, "email or organization website change detected",
 			"old_email", redaction.RedactEmail(existing.Email),
 			"new_email", redaction.RedactEmail(member.Email),
+			"website_changed", websiteChanged,
 		)
 
-		// Get committee settings to check business email requirements (only when email changes)
+		// Get committee settings to check business email requirements (only when the email or website changes)
 		var settings *model.CommitteeSettings
 		settings, _, errSettings := uc.committeeReader.GetSettings(ctx, member.CommitteeUID)
 		if errSettings != nil {
@@ -398,9 +692,9 @@ func (uc *committeeWriterOrchestrator) UpdateMember(ctx context.Context, member
 			"business_email_required", settings.BusinessEmailRequired,
 		)
 
-		// Validate business email domain if required
-		if settings.BusinessEmailRequired {
-			if errEmailValidation := uc.validateCorporateEmailDomain(ctx, member.Email); errEmailValidation != nil {
+		// Validate business email domain if required or restricted by the committee
+		if settings.BusinessEmailRequired || len(settings.AllowedEmailDomains) > 0 {
+			if errEmailValidation := uc.validateCorporateEmailDomain(ctx, member.Email, settings); errEmailValidation != nil {
 				slog.WarnContext(ctx, "corporate email domain validation failed during update",
 					"error", errEmailValidation,
 					"email", redaction.RedactEmail(member.Email),
@@ -408,12 +702,51 @@ func (uc *committeeWriterOrchestrator) UpdateMember(ctx context.Context, member
 				)
 				return nil, errEmailValidation
 			}
+			if errDomain := uc.validateEmailOrganizationDomain(ctx, member.Email, member.Organization); errDomain != nil {
+				slog.WarnContext(ctx, "email organization domain validation failed during update",
+					"error", errDomain,
+					"email", redaction.RedactEmail(member.Email),
+					"committee_uid", member.CommitteeUID,
+				)
+				return nil, errDomain
+			}
 		}
+	}
 
-		// Check if new email already exists in committee (uniqueness check)
+	// Step 4.1: Reconcile the member lookup key with the updated data
+	// The key is recomputed on every update, so any change in its composition (email, committee or project)
+	// reserves the new key and marks the previous one for cleanup, keeping the secondary index in sync.
+	expectedLookupKey, errExpectedKey := uc.committeeWriter.MemberIndexKey(ctx, member)
+	if errExpectedKey != nil {
+		slog.ErrorContext(ctx, "failed to build member lookup key",
+			"error", errExpectedKey,
+			"member_uid", member.UID,
+		)
+		return nil, errExpectedKey
+	}
+	previous := *existing
+	oldLookupKey, errOldKey := uc.committeeWriter.MemberIndexKey(ctx, &previous)
+	if errOldKey != nil {
+		slog.WarnContext(ctx, "failed to build old member lookup key, it will not be cleaned up",
+			"error", errOldKey,
+			"member_uid", existing.UID,
+		)
+	}
+	lookupKeyChanged := oldLookupKey != expectedLookupKey
+	if errOldKey != nil {
+		lookupKeyChanged = emailChanged
+	}
+	if lookupKeyChanged {
+		slog.DebugContext(ctx, "member lookup key changed",
+			"member_uid", member.UID,
+			"old_key", oldLookupKey,
+			"new_key", expectedLookupKey,
+		)
+
+		// Check if the member already exists in the uniqueness scope
 		newLookupKey, errMemberExists := uc.committeeWriter.UniqueMember(ctx, member)
 		if errMemberExists != nil {
-			slog.WarnContext(ctx, "member with new email already exists in committee",
+			slog.WarnContext(ctx, "member with the same email already exists in the uniqueness scope",
 				"error", errMemberExists,
 				"committee_uid", member.CommitteeUID,
 				"new_email", redaction.RedactEmail(member.Email),
@@ -423,8 +756,9 @@ func (uc *committeeWriterOrchestrator) UpdateMember(ctx context.Context, member
 		newKeys = append(newKeys, newLookupKey)
 
 		// Mark old lookup key for cleanup
-		oldLookupKey := fmt.Sprintf(constants.KVLookupMemberPrefix, existing.BuildIndexKey(ctx))
-		staleKeys = append(staleKeys, oldLookupKey)
+		if errOldKey == nil {
+			staleKeys = append(staleKeys, oldLookupKey)
+		}
 	}
 
 	// Lookup username by email if username is not provided
@@ -484,12 +818,59 @@ func (uc *committeeWriterOrchestrator) UpdateMember(ctx context.Context, member
 		}
 	}
 
+	// Step 6.1: Validate the primary member an alternate substitutes for
+	if errAlternate := uc.validateAlternateFor(ctx, member); errAlternate != nil {
+		slog.WarnContext(ctx, "alternate member link validation failed during update",
+			"error", errAlternate,
+			"committee_uid", member.CommitteeUID,
+			"member_uid", member.UID,
+		)
+		rollbackRequired = true
+		return nil, errAlternate
+	}
+
 	// Step 7: Merge existing data with updated fields
 	// Preserve immutable fields
 	member.UID = existing.UID
 	member.CreatedAt = existing.CreatedAt
 	member.UpdatedAt = time.Now()
 
+	// Step 7.1: Record the role change, the history is read-only so the existing one is kept
+	member.RoleHistory = existing.RoleHistory
+	if existing.Role.Name != member.Role.Name {
+		actor, _ := ctx.Value(constants.PrincipalContextID).(string)
+		member.RoleHistory = append(slices.Clone(existing.RoleHistory), model.CommitteeMemberRoleChange{
+			OldRole:   existing.Role.Name,
+			NewRole:   member.Role.Name,
+			ChangedAt: member.UpdatedAt,
+			Actor:     actor,
+		})
+		slog.DebugContext(ctx, "member role change recorded",
+			"member_uid", member.UID,
+			"old_role", existing.Role.Name,
+			"new_role", member.Role.Name,
+			"actor", redaction.Redact(actor),
+		)
+	}
+
+	// Step 7.2: Record the status change for the membership lifecycle audit, the history is read-only as well
+	member.StatusHistory = existing.StatusHistory
+	if existing.Status != member.Status {
+		actor, _ := ctx.Value(constants.PrincipalContextID).(string)
+		member.StatusHistory = append(slices.Clone(existing.StatusHistory), model.CommitteeMemberStatusChange{
+			OldStatus: existing.Status,
+			NewStatus: member.Status,
+			ChangedAt: member.UpdatedAt,
+			Actor:     actor,
+		})
+		slog.DebugContext(ctx, "member status change recorded",
+			"member_uid", member.UID,
+			"old_status", existing.Status,
+			"new_status", member.Status,
+			"actor", redaction.Redact(actor),
+		)
+	}
+
 	slog.DebugContext(ctx, "merging existing member data with updates",
 		"member_uid", member.UID,
 		"email_changed", emailChanged,
@@ -556,6 +937,98 @@ func (uc *committeeWriterOrchestrator) UpdateMember(ctx context.Context, member
 	return member, nil
 }
 
+// PatchMember applies the patch to the stored committee member and updates it like UpdateMember,
+// so the fields omitted from the patch keep their current value
+func (uc *committeeWriterOrchestrator) PatchMember(ctx context.Context, committeeUID, memberUID string, patch *model.CommitteeMemberPatch, revision uint64, sync bool) (*model.CommitteeMember, error) {
+	slog.DebugContext(ctx, "executing patch committee member use case",
+		"committee_uid", committeeUID,
+		"member_uid", memberUID,
+		"revision", revision,
+		"sync", sync,
+	)
+
+	if patch == nil {
+		return nil, errs.NewValidation("committee member patch cannot be nil")
+	}
+
+	// Step 1: Retrieve the member the patch is applied to
+	existing, _, errGet := uc.committeeReader.GetMember(ctx, memberUID)
+	if errGet != nil {
+		slog.ErrorContext(ctx, "failed to retrieve committee member for patch",
+			"error", errGet,
+			"member_uid", memberUID,
+		)
+		return nil, errGet
+	}
+
+	// Step 2: Merge the patch into the stored member, the update checks the committee and the revision
+	patched := patch.Apply(existing)
+	patched.UID = memberUID
+	patched.CommitteeUID = committeeUID
+
+	return uc.UpdateMember(ctx, patched, revision, sync)
+}
+
+// SelfUpdateMember updates the contact fields of a committee member on behalf of the member itself.
+// Only email, first name, last name and job title are editable; governance fields (role, voting,
+// appointment and status) cannot be changed through this path.
+func (uc *committeeWriterOrchestrator) SelfUpdateMember(ctx context.Context, member *model.CommitteeMember, revision uint64, sync bool) (*model.CommitteeMember, error) {
+	slog.DebugContext(ctx, "executing self update committee member use case",
+		"member_uid", member.UID,
+		"committee_uid", member.CommitteeUID,
+		"revision", revision,
+	)
+
+	// Step 1: Resolve the caller identity from the authenticated principal
+	principal, ok := ctx.Value(constants.PrincipalContextID).(string)
+	if !ok || principal == "" {
+		slog.WarnContext(ctx, "self update attempted without an authenticated principal",
+			"member_uid", member.UID,
+		)
+		return nil, errs.NewValidation("authenticated principal is required to update own membership")
+	}
+
+	// Step 2: Retrieve the existing member and make sure the caller owns it
+	existing, _, errGet := uc.committeeReader.GetMember(ctx, member.UID)
+	if errGet != nil {
+		slog.ErrorContext(ctx, "failed to retrieve existing committee member for self update",
+			"error", errGet,
+			"member_uid", member.UID,
+		)
+		return nil, errGet
+	}
+
+	if existing.Username == "" || existing.Username != principal {
+		slog.WarnContext(ctx, "caller is not the committee member being updated",
+			"member_uid", member.UID,
+			"principal", redaction.Redact(principal),
+			"member_username", redaction.Redact(existing.Username),
+		)
+		return nil, errs.NewValidation("committee members can only update their own membership")
+	}
+
+	// Step 3: Reject any attempt to change governance fields
+	if member.Role != existing.Role ||
+		member.Voting != existing.Voting ||
+		member.AppointedBy != existing.AppointedBy ||
+		member.Status != existing.Status {
+		slog.WarnContext(ctx, "self update attempted to change governance fields",
+			"member_uid", member.UID,
+			"committee_uid", existing.CommitteeUID,
+		)
+		return nil, errs.NewValidation("role, voting, appointed_by and status cannot be changed by the member")
+	}
+
+	// Step 4: Apply only the whitelisted contact fields on top of the existing data
+	updated := *existing
+	updated.Email = member.Email
+	updated.FirstName = member.FirstName
+	updated.LastName = member.LastName
+	updated.JobTitle = member.JobTitle
+
+	return uc.UpdateMember(ctx, &updated, revision, sync)
+}
+
 // DeleteMember removes a committee member
 func (uc *committeeWriterOrchestrator) DeleteMember(ctx context.Context, uid string, revision uint64, sync bool) error {
 	slog.DebugContext(ctx, "executing delete committee member use case",
@@ -593,9 +1066,16 @@ func (uc *committeeWriterOrchestrator) DeleteMember(ctx context.Context, uid str
 	// Step 2: Build list of secondary indices to delete
 	var indicesToDelete []string
 
-	// Build member lookup index key (committee_uid + email hash)
-	memberIndexKey := fmt.Sprintf(constants.KVLookupMemberPrefix, existing.BuildIndexKey(ctx))
-	indicesToDelete = append(indicesToDelete, memberIndexKey)
+	// Build member lookup index key (scope + email hash)
+	memberIndexKey, errKey := uc.committeeWriter.MemberIndexKey(ctx, existing)
+	if errKey != nil {
+		slog.WarnContext(ctx, "failed to build member lookup key, it will not be cleaned up",
+			"error", errKey,
+			"member_uid", uid,
+		)
+	} else {
+		indicesToDelete = append(indicesToDelete, memberIndexKey)
+	}
 
 	slog.DebugContext(ctx, "secondary indices identified for member deletion",
 		"member_uid", uid,
@@ -622,7 +1102,8 @@ func (uc *committeeWriterOrchestrator) DeleteMember(ctx context.Context, uid str
 	// We don't abort here - secondary indices have a minor impact during deletion
 	uc.deleteMemberKeys(ctx, indicesToDelete, false)
 
-	// Step 5: Publish indexer message for member deletion
+	// Step 5: Publish the member deletion messages
+	// Besides the indexer deletion, the access relation granted to the member is removed from FGA
 	deleteEventData := &model.CommitteeMemberMessageData{
 		Member: existing,
 	}
@@ -642,9 +1123,17 @@ func (uc *committeeWriterOrchestrator) DeleteMember(ctx context.Context, uid str
 	return nil
 }
 
-// validateCorporateEmailDomain validates if the email domain is a corporate domain
+// validateCorporateEmailDomain validates if the email domain is a corporate domain.
+// When the committee defines allowed email domains, the email must belong to one of them instead.
 // TODO: Implement actual corporate email domain validation logic
-func (uc *committeeWriterOrchestrator) validateCorporateEmailDomain(ctx context.Context, email string) error {
+func (uc *committeeWriterOrchestrator) validateCorporateEmailDomain(ctx context.Context, email string, settings *model.CommitteeSettings) error {
+	if settings != nil && len(settings.AllowedEmailDomains) > 0 {
+		if !settings.AllowsEmailDomain(email) {
+			return errs.NewValidation("email domain is not allowed for this committee")
+		}
+		return nil
+	}
+
 	slog.DebugContext(ctx, "validating corporate email domain (placeholder)",
 		"email", redaction.RedactEmail(email),
 	)
@@ -656,6 +1145,50 @@ func (uc *committeeWriterOrchestrator) validateCorporateEmailDomain(ctx context.
 	return nil
 }
 
+// validateEmailOrganizationDomain validates that the email domain matches the organization website host,
+// accepting subdomains of the website host. It is a no-op unless the policy is enabled or when the
+// organization has no website to compare against.
+func (uc *committeeWriterOrchestrator) validateEmailOrganizationDomain(ctx context.Context, email string, organization model.CommitteeMemberOrganization) error {
+	if !uc.emailDomainMatch {
+		return nil
+	}
+
+	websiteHost := organizationWebsiteHost(organization.Website)
+	if websiteHost == "" {
+		slog.DebugContext(ctx, "organization has no website, skipping email domain match",
+			"organization", redaction.Redact(organization.Name),
+		)
+		return nil
+	}
+
+	_, emailDomain, found := strings.Cut(strings.ToLower(strings.TrimSpace(email)), "@")
+	if !found || emailDomain == "" {
+		return errs.NewValidation("email address is missing a domain")
+	}
+
+	if emailDomain != websiteHost && !strings.HasSuffix(emailDomain, "."+websiteHost) {
+		return errs.NewValidation(fmt.Sprintf("email domain %s does not match the organization domain %s", emailDomain, websiteHost))
+	}
+
+	return nil
+}
+
+// organizationWebsiteHost extracts the lower-cased host of an organization website, without the www prefix
+func organizationWebsiteHost(website string) string {
+	website = strings.ToLower(strings.TrimSpace(website))
+	if website == "" {
+		return ""
+	}
+	if !strings.Contains(website, "://") {
+		website = "https://" + website
+	}
+	parsed, err := url.Parse(website)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimPrefix(parsed.Hostname(), "www.")
+}
+
 // validateUsernameExists validates if the username exists in external systems
 // TODO: Implement actual external service integration
 func (uc *committeeWriterOrchestrator) validateUsernameExists(ctx context.Context, username string) error {
@@ -686,6 +1219,57 @@ func (uc *committeeWriterOrchestrator) validateOrganizationExists(ctx context.Co
 	return nil
 }
 
+// validateAlternateFor checks the alternate link of the member references another member
+// of the same committee, a missing member being reported as a validation error
+func (uc *committeeWriterOrchestrator) validateAlternateFor(ctx context.Context, member *model.CommitteeMember) error {
+	if member.AlternateForUID == nil {
+		return nil
+	}
+
+	primary, _, errGet := uc.committeeReader.GetMember(ctx, *member.AlternateForUID)
+	if errGet != nil {
+		var notFoundErr errs.NotFound
+		if !errors.As(errGet, &notFoundErr) {
+			return errGet
+		}
+		primary = nil
+	}
+
+	return member.ValidateAlternateFor(primary)
+}
+
+// suggestOrganizationName returns the spelling the committee members already use for the organization
+// when it is written differently, e.g. "I.B.M." for "IBM", and an empty string otherwise
+func (uc *committeeWriterOrchestrator) suggestOrganizationName(ctx context.Context, committeeUID, organizationName string) string {
+	if strings.TrimSpace(organizationName) == "" {
+		return ""
+	}
+
+	members, errList := uc.committeeReader.ListMembers(ctx, committeeUID)
+	if errList != nil {
+		slog.WarnContext(ctx, "failed to list committee members for the organization name suggestion",
+			"error", errList,
+			"committee_uid", committeeUID,
+		)
+		return ""
+	}
+
+	organizations := model.NewOrganizationNameIndex()
+	for _, member := range members {
+		organizations.Add(member.Organization.Name)
+	}
+
+	suggestion := organizations.Suggest(organizationName)
+	if suggestion != "" {
+		slog.InfoContext(ctx, "organization name written differently from the other committee members",
+			"committee_uid", committeeUID,
+			"organization", organizationName,
+			"suggested_organization", suggestion,
+		)
+	}
+	return suggestion
+}
+
 // addOrganizationUserEngagement adds user engagement to organization
 // TODO: Implement actual external API integration
 func (uc *committeeWriterOrchestrator) addOrganizationUserEngagement(ctx context.Context, organizationName, username string) error {
@@ -765,7 +1349,7 @@ func (uc *committeeWriterOrchestrator) publishMemberMessages(ctx context.Context
 	switch action {
 	case model.ActionCreated, model.ActionUpdated:
 		// Add tags for create/update operations (when we have the full member data)
-		indexerMessage.Tags = data.Member.Tags()
+		indexerMessage.Tags = uc.indexerTags(ctx, data.Member.Tags())
 		indexerMessage.Data = data.Member
 	case model.ActionDeleted:
 		// Indexer message only expects the UID for deleted operations
@@ -780,6 +1364,9 @@ func (uc *committeeWriterOrchestrator) publishMemberMessages(ctx context.Context
 		)
 		return errs.NewUnexpected("failed to build member indexer message", errBuildIndexerMessage)
 	}
+	if action == model.ActionDeleted {
+		indexerMessageBuild.SetDedupID(model.IndexedObjectCommitteeMember, data.Member.UID, model.ChangeRevision(data.Member.UpdatedAt))
+	}
 
 	// Build event message for the member
 	var eventInput any
@@ -806,6 +1393,9 @@ func (uc *committeeWriterOrchestrator) publishMemberMessages(ctx context.Context
 		return errs.NewUnexpected("failed to build member event message", errBuildEventMessage)
 	}
 
+	// The outbound webhooks are notified independently of the NATS messages
+	uc.notifyWebhooks(ctx, eventMessageBuild)
+
 	// Build access control message for the member
 	accessControlMessage := uc.buildMemberAccessControlMessage(ctx, data.Member)
 
@@ -6,6 +6,9 @@ package service
 import (
 	"context"
 	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -14,7 +17,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/model"
+	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/port"
 	"github.com/linuxfoundation/lfx-v2-committee-service/internal/infrastructure/mock"
+	"github.com/linuxfoundation/lfx-v2-committee-service/pkg/constants"
 	errs "github.com/linuxfoundation/lfx-v2-committee-service/pkg/errors"
 )
 
@@ -61,18 +66,37 @@ func (w *TestMockCommitteeMemberWriter) UniqueSSOGroupName(ctx context.Context,
 	return mockWriter.UniqueSSOGroupName(ctx, committee)
 }
 
+func (w *TestMockCommitteeMemberWriter) IndexParent(ctx context.Context, committee *model.Committee) (string, error) {
+	mockWriter := mock.NewMockCommitteeWriter(w.MockRepository)
+	return mockWriter.IndexParent(ctx, committee)
+}
+
+func (w *TestMockCommitteeMemberWriter) RecordIndexed(ctx context.Context, uid string, indexedAt time.Time) error {
+	mockWriter := mock.NewMockCommitteeWriter(w.MockRepository)
+	return mockWriter.RecordIndexed(ctx, uid, indexedAt)
+}
+
 // Implement CommitteeSettingsWriter interface
 func (w *TestMockCommitteeMemberWriter) UpdateSetting(ctx context.Context, settings *model.CommitteeSettings, revision uint64) error {
 	mockWriter := mock.NewMockCommitteeWriter(w.MockRepository)
 	return mockWriter.UpdateSetting(ctx, settings, revision)
 }
 
+func (w *TestMockCommitteeMemberWriter) CreateSetting(ctx context.Context, settings *model.CommitteeSettings) error {
+	mockWriter := mock.NewMockCommitteeWriter(w.MockRepository)
+	return mockWriter.CreateSetting(ctx, settings)
+}
+
 // Implement CommitteeMemberWriter interface
 func (w *TestMockCommitteeMemberWriter) CreateMember(ctx context.Context, member *model.CommitteeMember) error {
 	if member == nil {
 		return errs.NewValidation("member cannot be nil")
 	}
 
+	if _, exists := w.members[member.UID]; exists {
+		return errs.NewConflict("committee member with the same UID already exists")
+	}
+
 	// Store the member
 	w.members[member.UID] = member
 	return nil
@@ -127,6 +151,10 @@ func (w *TestMockCommitteeMemberWriter) UniqueMember(ctx context.Context, member
 	return key, nil
 }
 
+func (w *TestMockCommitteeMemberWriter) MemberIndexKey(ctx context.Context, member *model.CommitteeMember) (string, error) {
+	return fmt.Sprintf(constants.KVLookupMemberPrefix, member.BuildIndexKey(ctx)), nil
+}
+
 func (w *TestMockCommitteeMemberWriter) GetMemberRevision(ctx context.Context, uid string) (uint64, error) {
 	// Check if member exists in our local storage
 	if _, exists := w.members[uid]; exists {
@@ -177,10 +205,30 @@ func (r *TestMockCommitteeReader) GetRevision(ctx context.Context, uid string) (
 	return 0, errs.NewNotFound("not implemented for this test")
 }
 
+func (r *TestMockCommitteeReader) Exists(ctx context.Context, uid string) (bool, error) {
+	return false, nil
+}
+
+func (r *TestMockCommitteeReader) ListBases(ctx context.Context) ([]*model.CommitteeBase, error) {
+	return nil, errs.NewNotFound("not implemented for this test")
+}
+
+func (r *TestMockCommitteeReader) ListChildren(ctx context.Context, parentUID string) ([]string, error) {
+	return nil, errs.NewNotFound("not implemented for this test")
+}
+
+func (r *TestMockCommitteeReader) GetLastIndexed(ctx context.Context, uid string) (*time.Time, error) {
+	return nil, errs.NewNotFound("not implemented for this test")
+}
+
 func (r *TestMockCommitteeReader) GetSettings(ctx context.Context, committeeUID string) (*model.CommitteeSettings, uint64, error) {
 	return nil, 0, errs.NewNotFound("not implemented for this test")
 }
 
+func (r *TestMockCommitteeReader) GetSettingsRevision(ctx context.Context, committeeUID string) (uint64, error) {
+	return 0, errs.NewNotFound("not implemented for this test")
+}
+
 func (r *TestMockCommitteeReader) GetMember(ctx context.Context, uid string) (*model.CommitteeMember, uint64, error) {
 	return nil, 0, errs.NewNotFound("not implemented for this test")
 }
@@ -196,6 +244,10 @@ func (r *TestMockCommitteeReader) ListMembers(ctx context.Context, committeeUID
 	return []*model.CommitteeMember{}, errs.NewNotFound("not implemented for this test")
 }
 
+func (r *TestMockCommitteeReader) GetMemberHistory(ctx context.Context, uid string, limit int) ([]*model.CommitteeMemberRevision, error) {
+	return nil, errs.NewNotFound("not implemented for this test")
+}
+
 func TestCommitteeWriterOrchestrator_CreateMember(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -383,6 +435,283 @@ func TestCommitteeWriterOrchestrator_CreateMember_BusinessEmailValidation(t *tes
 	require.NotNil(t, result)
 }
 
+func TestCommitteeWriterOrchestrator_CreateMember_AllowedEmailDomains(t *testing.T) {
+	tests := []struct {
+		name                  string
+		allowedDomains        []string
+		businessEmailRequired bool
+		email                 string
+		expectError           bool
+	}{
+		{
+			name:           "email in an allowed domain is accepted",
+			allowedDomains: []string{"example.com", "linuxfoundation.org"},
+			email:          "jane@linuxfoundation.org",
+		},
+		{
+			name:           "email in a subdomain of an allowed domain is accepted",
+			allowedDomains: []string{"example.com"},
+			email:          "jane@eng.example.com",
+		},
+		{
+			name:           "email outside the allowed domains is rejected",
+			allowedDomains: []string{"example.com"},
+			email:          "jane@gmail.com",
+			expectError:    true,
+		},
+		{
+			name:                  "allowed domains override the business email requirement",
+			allowedDomains:        []string{"example.com"},
+			businessEmailRequired: true,
+			email:                 "jane@gmail.com",
+			expectError:           true,
+		},
+		{
+			name:                  "empty list falls back to the default behavior",
+			businessEmailRequired: true,
+			email:                 "jane@gmail.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orchestrator, mockRepo, _ := setupMemberWriterTest()
+			mockRepo.ClearAll()
+
+			mockRepo.AddCommittee(&model.Committee{
+				CommitteeBase: model.CommitteeBase{
+					UID:      "committee-allowed-domains",
+					Name:     "Allowed Domains Committee",
+					Category: "Technical",
+				},
+				CommitteeSettings: &model.CommitteeSettings{
+					UID:                   "committee-allowed-domains",
+					BusinessEmailRequired: tt.businessEmailRequired,
+					AllowedEmailDomains:   tt.allowedDomains,
+				},
+			})
+
+			member := &model.CommitteeMember{
+				CommitteeMemberBase: model.CommitteeMemberBase{
+					CommitteeUID: "committee-allowed-domains",
+					Email:        tt.email,
+					Username:     "jane",
+					Organization: model.CommitteeMemberOrganization{
+						Name: "Example",
+					},
+				},
+			}
+
+			result, err := orchestrator.CreateMember(context.Background(), member, false)
+			if tt.expectError {
+				require.Error(t, err)
+				assert.IsType(t, errs.Validation{}, err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, result)
+		})
+	}
+}
+
+func TestCommitteeWriterOrchestrator_CreateMember_EmailOrganizationDomain(t *testing.T) {
+	tests := []struct {
+		name        string
+		enabled     bool
+		email       string
+		website     string
+		expectError bool
+	}{
+		{
+			name:    "matching domain is allowed",
+			enabled: true,
+			email:   "jane@example.com",
+			website: "https://www.example.com/",
+		},
+		{
+			name:    "subdomain of the organization domain is allowed",
+			enabled: true,
+			email:   "jane@eng.example.com",
+			website: "example.com",
+		},
+		{
+			name:        "mismatched domain is rejected when enabled",
+			enabled:     true,
+			email:       "jane@gmail.com",
+			website:     "https://example.com",
+			expectError: true,
+		},
+		{
+			name:    "organization without website is allowed",
+			enabled: true,
+			email:   "jane@gmail.com",
+		},
+		{
+			name:    "any domain is allowed when disabled",
+			enabled: false,
+			email:   "jane@gmail.com",
+			website: "https://example.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orchestrator, mockRepo, _ := setupMemberWriterTest()
+			mockRepo.ClearAll()
+			orchestrator.emailDomainMatch = tt.enabled
+
+			mockRepo.AddCommittee(&model.Committee{
+				CommitteeBase: model.CommitteeBase{
+					UID:      "committee-domain",
+					Name:     "Domain Committee",
+					Category: "Technical",
+				},
+				CommitteeSettings: &model.CommitteeSettings{
+					UID:                   "committee-domain",
+					BusinessEmailRequired: true,
+				},
+			})
+
+			member := &model.CommitteeMember{
+				CommitteeMemberBase: model.CommitteeMemberBase{
+					CommitteeUID: "committee-domain",
+					Email:        tt.email,
+					Username:     "jane",
+					Organization: model.CommitteeMemberOrganization{
+						Name:    "Example",
+						Website: tt.website,
+					},
+				},
+			}
+
+			result, err := orchestrator.CreateMember(context.Background(), member, false)
+			if tt.expectError {
+				require.Error(t, err)
+				assert.IsType(t, errs.Validation{}, err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, result)
+		})
+	}
+}
+
+func TestCommitteeWriterOrchestrator_CreateMember_Defaults(t *testing.T) {
+	orchestrator, mockRepo, memberWriter := setupMemberWriterTest()
+	mockRepo.ClearAll()
+	mockRepo.AddCommittee(&model.Committee{
+		CommitteeBase:     model.CommitteeBase{UID: "committee-defaults", Name: "Defaults Committee", Category: "Technical"},
+		CommitteeSettings: &model.CommitteeSettings{UID: "committee-defaults"},
+	})
+
+	ctx := context.Background()
+
+	omitted, err := orchestrator.CreateMember(ctx, &model.CommitteeMember{
+		CommitteeMemberBase: model.CommitteeMemberBase{
+			CommitteeUID: "committee-defaults",
+			Email:        "omitted@example.com",
+			Username:     "omitted",
+		},
+	}, false)
+	require.NoError(t, err)
+	stored := memberWriter.members[omitted.UID]
+	require.NotNil(t, stored)
+	assert.Equal(t, "None", stored.Role.Name)
+	assert.Equal(t, "None", stored.Voting.Status)
+	assert.Equal(t, "Active", stored.Status)
+
+	explicit, err := orchestrator.CreateMember(ctx, &model.CommitteeMember{
+		CommitteeMemberBase: model.CommitteeMemberBase{
+			CommitteeUID: "committee-defaults",
+			Email:        "explicit@example.com",
+			Username:     "explicit",
+			Role:         model.CommitteeMemberRole{Name: "Chair"},
+			Voting:       model.CommitteeMemberVotingInfo{Status: "Voting Rep"},
+			Status:       "Inactive",
+		},
+	}, false)
+	require.NoError(t, err)
+	stored = memberWriter.members[explicit.UID]
+	require.NotNil(t, stored)
+	assert.Equal(t, "Chair", stored.Role.Name)
+	assert.Equal(t, "Voting Rep", stored.Voting.Status)
+	assert.Equal(t, "Inactive", stored.Status)
+}
+
+func TestCommitteeWriterOrchestrator_CreateMember_AlternateFor(t *testing.T) {
+	tests := []struct {
+		name            string
+		alternateForUID string
+		expectedErr     error
+	}{
+		{
+			name:            "alternate of a member of the same committee",
+			alternateForUID: "primary-member",
+		},
+		{
+			name:            "alternate of a nonexistent member",
+			alternateForUID: "missing-member",
+			expectedErr:     errs.Validation{},
+		},
+		{
+			name:            "alternate of a member of another committee",
+			alternateForUID: "other-committee-member",
+			expectedErr:     errs.Validation{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orchestrator, mockRepo, memberWriter := setupMemberWriterTest()
+			mockRepo.ClearAll()
+			for _, committeeUID := range []string{"committee-alternates", "committee-other"} {
+				mockRepo.AddCommittee(&model.Committee{
+					CommitteeBase:     model.CommitteeBase{UID: committeeUID, Name: committeeUID, Category: "Technical"},
+					CommitteeSettings: &model.CommitteeSettings{UID: committeeUID},
+				})
+			}
+			mockRepo.AddCommitteeMember("committee-alternates", &model.CommitteeMember{
+				CommitteeMemberBase: model.CommitteeMemberBase{
+					UID:          "primary-member",
+					CommitteeUID: "committee-alternates",
+					Email:        "primary@example.com",
+					Voting:       model.CommitteeMemberVotingInfo{Status: "Voting Rep"},
+				},
+			})
+			mockRepo.AddCommitteeMember("committee-other", &model.CommitteeMember{
+				CommitteeMemberBase: model.CommitteeMemberBase{
+					UID:          "other-committee-member",
+					CommitteeUID: "committee-other",
+					Email:        "other@example.com",
+				},
+			})
+
+			alternateForUID := tt.alternateForUID
+			created, err := orchestrator.CreateMember(context.Background(), &model.CommitteeMember{
+				CommitteeMemberBase: model.CommitteeMemberBase{
+					CommitteeUID:    "committee-alternates",
+					Email:           "alternate@example.com",
+					Username:        "alternate",
+					Voting:          model.CommitteeMemberVotingInfo{Status: "Alternate Voting Rep"},
+					AlternateForUID: &alternateForUID,
+				},
+			}, false)
+
+			if tt.expectedErr != nil {
+				require.Error(t, err)
+				assert.IsType(t, tt.expectedErr, err)
+				assert.Empty(t, memberWriter.members)
+				return
+			}
+			require.NoError(t, err)
+			stored := memberWriter.members[created.UID]
+			require.NotNil(t, stored)
+			require.NotNil(t, stored.AlternateForUID)
+			assert.Equal(t, "primary-member", *stored.AlternateForUID)
+		})
+	}
+}
+
 func TestCommitteeWriterOrchestrator_DeleteMember(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -527,7 +856,7 @@ func TestCommitteeWriterOrchestrator_validateCorporateEmailDomain(t *testing.T)
 	orchestrator, _, _ := setupMemberWriterTest()
 
 	ctx := context.Background()
-	err := orchestrator.validateCorporateEmailDomain(ctx, "test@example.com")
+	err := orchestrator.validateCorporateEmailDomain(ctx, "test@example.com", &model.CommitteeSettings{})
 
 	// Currently a placeholder that returns nil
 	assert.NoError(t, err)
@@ -668,6 +997,93 @@ func TestCommitteeWriterOrchestrator_CreateMember_RollbackOnError(t *testing.T)
 	assert.Nil(t, result)
 }
 
+func TestCommitteeWriterOrchestrator_CreateMember_PreAssignedUID(t *testing.T) {
+	suppliedUID := uuid.New().String()
+	existingUID := uuid.New().String()
+
+	tests := []struct {
+		name        string
+		memberUID   string
+		expectError bool
+		errorType   error
+		validateUID func(*testing.T, string)
+	}{
+		{
+			name:      "supplied UID is preserved",
+			memberUID: suppliedUID,
+			validateUID: func(t *testing.T, uid string) {
+				assert.Equal(t, suppliedUID, uid)
+			},
+		},
+		{
+			name:      "missing UID is generated",
+			memberUID: "",
+			validateUID: func(t *testing.T, uid string) {
+				_, err := uuid.Parse(uid)
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:        "duplicate supplied UID",
+			memberUID:   existingUID,
+			expectError: true,
+			errorType:   errs.Conflict{},
+		},
+		{
+			name:        "supplied UID is not a UUID",
+			memberUID:   "member-123",
+			expectError: true,
+			errorType:   errs.Validation{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orchestrator, mockRepo, _ := setupMemberWriterTest()
+			mockRepo.ClearAll()
+			mockRepo.AddCommittee(&model.Committee{
+				CommitteeBase: model.CommitteeBase{
+					UID:      "committee-123",
+					Name:     "Test Committee",
+					Category: "Technical",
+				},
+			})
+			mockRepo.AddCommitteeMember("committee-123", &model.CommitteeMember{
+				CommitteeMemberBase: model.CommitteeMemberBase{
+					UID:          existingUID,
+					CommitteeUID: "committee-123",
+					Email:        "existing@example.com",
+				},
+			})
+
+			member := &model.CommitteeMember{
+				CommitteeMemberBase: model.CommitteeMemberBase{
+					UID:          tt.memberUID,
+					CommitteeUID: "committee-123",
+					Email:        "migrated@example.com",
+					Username:     "migrateduser",
+					Organization: model.CommitteeMemberOrganization{
+						Name: "Test Org",
+					},
+				},
+			}
+
+			result, err := orchestrator.CreateMember(context.Background(), member, false)
+
+			if tt.expectError {
+				require.Error(t, err)
+				assert.IsType(t, tt.errorType, err)
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			tt.validateUID(t, result.UID)
+		})
+	}
+}
+
 func TestCommitteeWriterOrchestrator_CreateMember_SettingsNotFound(t *testing.T) {
 	orchestrator, mockRepo, _ := setupMemberWriterTest()
 
@@ -701,6 +1117,36 @@ func TestCommitteeWriterOrchestrator_CreateMember_SettingsNotFound(t *testing.T)
 	require.NotNil(t, result)
 }
 
+func TestCommitteeWriterOrchestrator_MemberWebhookNotifications(t *testing.T) {
+	orchestrator, mockRepo, memberWriter := setupMemberWriterTest()
+	mockRepo.ClearAll()
+	notifier := mock.NewMockWebhookNotifier()
+	orchestrator.webhookNotifier = notifier
+
+	member := &model.CommitteeMember{
+		CommitteeMemberBase: model.CommitteeMemberBase{
+			UID:          "member-webhook",
+			CommitteeUID: "committee-123",
+			Email:        "webhook@example.com",
+			Username:     "webhookuser",
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		},
+	}
+	memberWriter.members[member.UID] = member
+	mockRepo.AddCommitteeMember("committee-123", member)
+
+	err := orchestrator.DeleteMember(context.Background(), member.UID, 1, false)
+	require.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		return len(notifier.EventTypes()) == 1
+	}, time.Second, 10*time.Millisecond)
+	event := notifier.Events()[0]
+	assert.Equal(t, "committee_member.deleted", event.EventType)
+	assert.Equal(t, constants.CommitteeMemberDeletedSubject, event.Subject)
+}
+
 func TestCommitteeWriterOrchestrator_DeleteMember_CompleteFlow(t *testing.T) {
 	orchestrator, mockRepo, memberWriter := setupMemberWriterTest()
 
@@ -744,6 +1190,56 @@ func TestCommitteeWriterOrchestrator_DeleteMember_CompleteFlow(t *testing.T) {
 	// in integration tests with real NATS storage
 }
 
+func TestCommitteeWriterOrchestrator_DeleteMember_RemovesAccessRelation(t *testing.T) {
+	tests := []struct {
+		name           string
+		username       string
+		expectedAccess []string
+	}{
+		{
+			name:           "member with access has the relation removed",
+			username:       "janedoe",
+			expectedAccess: []string{constants.RemoveMemberCommitteeSubject},
+		},
+		{
+			name:     "member without username had no relation to remove",
+			username: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orchestrator, mockRepo, memberWriter := setupMemberWriterTest()
+			mockRepo.ClearAll()
+			publisher := newRecordingCommitteePublisher()
+			orchestrator.committeePublisher = publisher
+
+			member := &model.CommitteeMember{
+				CommitteeMemberBase: model.CommitteeMemberBase{
+					UID:          "member-access",
+					CommitteeUID: "committee-access",
+					Email:        "jane@example.com",
+					Username:     tt.username,
+				},
+			}
+			memberWriter.members[member.UID] = member
+			mockRepo.AddCommitteeMember(member.CommitteeUID, member)
+
+			require.NoError(t, orchestrator.DeleteMember(context.Background(), member.UID, 1, false))
+
+			assert.Equal(t, tt.expectedAccess, publisher.access)
+			assert.Equal(t, []string{constants.IndexCommitteeMemberSubject}, publisher.indexer)
+			if len(tt.expectedAccess) > 0 {
+				require.Len(t, publisher.messages[constants.RemoveMemberCommitteeSubject], 1)
+				assert.Equal(t, &committeeMemberStub{
+					Username:     tt.username,
+					CommitteeUID: "committee-access",
+				}, publisher.messages[constants.RemoveMemberCommitteeSubject][0])
+			}
+		})
+	}
+}
+
 func TestCommitteeWriterOrchestrator_DeleteMember_MessagePublishingFailure(t *testing.T) {
 	orchestrator, mockRepo, memberWriter := setupMemberWriterTest()
 
@@ -844,6 +1340,103 @@ func TestCommitteeWriterOrchestrator_UpdateMember_Success(t *testing.T) {
 	assert.True(t, result.UpdatedAt.After(existingMember.UpdatedAt)) // UpdatedAt should be newer
 }
 
+func TestCommitteeWriterOrchestrator_PatchMember(t *testing.T) {
+	tests := []struct {
+		name             string
+		committeeUID     string
+		patch            *model.CommitteeMemberPatch
+		expectedJobTitle string
+		expectedFirst    string
+		expectedRole     model.CommitteeMemberRole
+		expectedErr      error
+	}{
+		{
+			name:             "omitted job title is preserved",
+			committeeUID:     "committee-123",
+			patch:            &model.CommitteeMemberPatch{FirstName: stringPtr("Jane")},
+			expectedJobTitle: "Engineer",
+			expectedFirst:    "Jane",
+			expectedRole:     model.CommitteeMemberRole{Name: "Chair", StartDate: "2024-01-01"},
+		},
+		{
+			name:             "explicitly empty job title is cleared",
+			committeeUID:     "committee-123",
+			patch:            &model.CommitteeMemberPatch{JobTitle: stringPtr("")},
+			expectedJobTitle: "",
+			expectedFirst:    "John",
+			expectedRole:     model.CommitteeMemberRole{Name: "Chair", StartDate: "2024-01-01"},
+		},
+		{
+			name:         "role is merged field-wise",
+			committeeUID: "committee-123",
+			patch: &model.CommitteeMemberPatch{
+				Role: &model.CommitteeMemberRolePatch{EndDate: stringPtr("2025-01-01")},
+			},
+			expectedJobTitle: "Engineer",
+			expectedFirst:    "John",
+			expectedRole:     model.CommitteeMemberRole{Name: "Chair", StartDate: "2024-01-01", EndDate: "2025-01-01"},
+		},
+		{
+			name:         "member of another committee",
+			committeeUID: "committee-other",
+			patch:        &model.CommitteeMemberPatch{JobTitle: stringPtr("")},
+			expectedErr:  errs.Validation{},
+		},
+		{
+			name:         "nil patch",
+			committeeUID: "committee-123",
+			expectedErr:  errs.Validation{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orchestrator, mockRepo, memberWriter := setupMemberWriterTest()
+
+			mockRepo.AddCommittee(&model.Committee{
+				CommitteeBase: model.CommitteeBase{
+					UID:      "committee-123",
+					Name:     "Test Committee",
+					Category: "Technical",
+				},
+				CommitteeSettings: &model.CommitteeSettings{},
+			})
+
+			existingMember := &model.CommitteeMember{
+				CommitteeMemberBase: model.CommitteeMemberBase{
+					UID:          "member-123",
+					CommitteeUID: "committee-123",
+					Email:        "john@example.com",
+					Username:     "johndoe",
+					FirstName:    "John",
+					LastName:     "Doe",
+					JobTitle:     "Engineer",
+					Role:         model.CommitteeMemberRole{Name: "Chair", StartDate: "2024-01-01"},
+				},
+			}
+			mockRepo.AddCommitteeMember("committee-123", existingMember)
+			memberWriter.members["member-123"] = existingMember
+			memberWriter.customRevisions["member-123"] = 1
+
+			result, err := orchestrator.PatchMember(context.Background(), tt.committeeUID, "member-123", tt.patch, 1, false)
+			if tt.expectedErr != nil {
+				require.Error(t, err)
+				assert.IsType(t, tt.expectedErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, tt.expectedJobTitle, result.JobTitle)
+			assert.Equal(t, tt.expectedFirst, result.FirstName)
+			assert.Equal(t, "john@example.com", result.Email)
+			assert.Equal(t, tt.expectedRole.Name, result.Role.Name)
+			assert.Equal(t, tt.expectedRole.StartDate, result.Role.StartDate)
+			assert.Equal(t, tt.expectedRole.EndDate, result.Role.EndDate)
+		})
+	}
+}
+
 func TestCommitteeWriterOrchestrator_UpdateMember_RevisionMismatch(t *testing.T) {
 	orchestrator, mockRepo, memberWriter := setupMemberWriterTest()
 
@@ -1051,3 +1644,794 @@ func TestCommitteeWriterOrchestrator_UpdateMember_EmailAlreadyExists(t *testing.
 	assert.Contains(t, err.Error(), "already exists")
 	assert.Nil(t, result)
 }
+
+func TestCommitteeWriterOrchestrator_SelfUpdateMember(t *testing.T) {
+	newExistingMember := func() *model.CommitteeMember {
+		return &model.CommitteeMember{
+			CommitteeMemberBase: model.CommitteeMemberBase{
+				UID:          "member-self",
+				CommitteeUID: "committee-self",
+				Email:        "self@example.com",
+				Username:     "selfuser",
+				FirstName:    "Self",
+				LastName:     "User",
+				JobTitle:     "Engineer",
+				Role:         model.CommitteeMemberRole{Name: "None"},
+				Voting:       model.CommitteeMemberVotingInfo{Status: "Voting Rep"},
+				AppointedBy:  "Community",
+				Status:       "Active",
+				CreatedAt:    time.Now().Add(-time.Hour),
+				UpdatedAt:    time.Now().Add(-time.Hour),
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		principal   string
+		modify      func(m *model.CommitteeMember)
+		expectError bool
+		validate    func(t *testing.T, result *model.CommitteeMember)
+	}{
+		{
+			name:      "member can change job title",
+			principal: "selfuser",
+			modify: func(m *model.CommitteeMember) {
+				m.JobTitle = "Principal Engineer"
+			},
+			validate: func(t *testing.T, result *model.CommitteeMember) {
+				assert.Equal(t, "Principal Engineer", result.JobTitle)
+				assert.Equal(t, "None", result.Role.Name)
+				assert.Equal(t, "Voting Rep", result.Voting.Status)
+			},
+		},
+		{
+			name:      "member cannot change role",
+			principal: "selfuser",
+			modify: func(m *model.CommitteeMember) {
+				m.Role.Name = "Chair"
+			},
+			expectError: true,
+		},
+		{
+			name:      "member cannot change voting status",
+			principal: "selfuser",
+			modify: func(m *model.CommitteeMember) {
+				m.Voting.Status = "Observer"
+			},
+			expectError: true,
+		},
+		{
+			name:      "caller cannot update another member",
+			principal: "someoneelse",
+			modify: func(m *model.CommitteeMember) {
+				m.JobTitle = "Principal Engineer"
+			},
+			expectError: true,
+		},
+		{
+			name:      "caller without principal is rejected",
+			principal: "",
+			modify: func(m *model.CommitteeMember) {
+				m.JobTitle = "Principal Engineer"
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			orchestrator, mockRepo, memberWriter := setupMemberWriterTest()
+			mockRepo.ClearAll()
+
+			mockRepo.AddCommittee(&model.Committee{
+				CommitteeBase: model.CommitteeBase{
+					UID:      "committee-self",
+					Name:     "Self Committee",
+					Category: "Technical",
+				},
+				CommitteeSettings: &model.CommitteeSettings{},
+			})
+
+			existing := newExistingMember()
+			mockRepo.AddCommitteeMember("committee-self", existing)
+			memberWriter.members[existing.UID] = existing
+
+			payload := newExistingMember()
+			tc.modify(payload)
+
+			ctx := context.Background()
+			if tc.principal != "" {
+				ctx = context.WithValue(ctx, constants.PrincipalContextID, tc.principal)
+			}
+
+			result, err := orchestrator.SelfUpdateMember(ctx, payload, 1, false)
+			if tc.expectError {
+				require.Error(t, err)
+				assert.IsType(t, errs.Validation{}, err)
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			if tc.validate != nil {
+				tc.validate(t, result)
+			}
+		})
+	}
+}
+
+// memberLookupRevisionReader resolves the revision of member lookup keys, which the mock repository does not store
+type memberLookupRevisionReader struct {
+	port.CommitteeReader
+}
+
+func (r *memberLookupRevisionReader) GetMemberRevision(ctx context.Context, uid string) (uint64, error) {
+	if strings.HasPrefix(uid, "lookup/") {
+		return 1, nil
+	}
+	return r.CommitteeReader.GetMemberRevision(ctx, uid)
+}
+
+// memberKeyRecordingWriter records the member lookup keys reserved and deleted by the orchestrator
+type memberKeyRecordingWriter struct {
+	*TestMockCommitteeWriter
+	mu           sync.Mutex
+	reservedKeys []string
+	deletedKeys  []string
+}
+
+func (w *memberKeyRecordingWriter) UniqueMember(ctx context.Context, member *model.CommitteeMember) (string, error) {
+	key, err := w.TestMockCommitteeWriter.MemberIndexKey(ctx, member)
+	if err != nil {
+		return "", err
+	}
+	w.mu.Lock()
+	w.reservedKeys = append(w.reservedKeys, key)
+	w.mu.Unlock()
+	if _, errUnique := w.TestMockCommitteeWriter.UniqueMember(ctx, member); errUnique != nil {
+		return "", errUnique
+	}
+	return key, nil
+}
+
+func (w *memberKeyRecordingWriter) DeleteMember(ctx context.Context, uid string, revision uint64) error {
+	if strings.HasPrefix(uid, "lookup/") {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		w.deletedKeys = append(w.deletedKeys, uid)
+		return nil
+	}
+	return w.TestMockCommitteeWriter.DeleteMember(ctx, uid, revision)
+}
+
+func (w *memberKeyRecordingWriter) recorded() ([]string, []string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([]string{}, w.reservedKeys...), append([]string{}, w.deletedKeys...)
+}
+
+func TestCommitteeWriterOrchestrator_UpdateMember_LookupKeyReconciliation(t *testing.T) {
+	ctx := context.Background()
+
+	lookupKey := func(member model.CommitteeMember, scope model.MemberUniquenessScope) string {
+		return fmt.Sprintf(constants.KVLookupMemberPrefix, member.BuildScopedIndexKey(ctx, scope))
+	}
+
+	tests := []struct {
+		name              string
+		scope             model.MemberUniquenessScope
+		existingEmail     string
+		existingProject   string
+		updatedEmail      string
+		expectKeyReplaced bool
+	}{
+		{
+			name:              "committee moved to another project re-keys the member in project scope",
+			scope:             model.MemberUniquenessScopeProject,
+			existingEmail:     "jane@example.com",
+			existingProject:   "project-old",
+			updatedEmail:      "jane@example.com",
+			expectKeyReplaced: true,
+		},
+		{
+			name:              "email change re-keys the member",
+			scope:             model.MemberUniquenessScopeCommittee,
+			existingEmail:     "jane@example.com",
+			updatedEmail:      "jane.doe@example.com",
+			expectKeyReplaced: true,
+		},
+		{
+			name:            "unchanged key composition leaves the index untouched",
+			scope:           model.MemberUniquenessScopeProject,
+			existingEmail:   "jane@example.com",
+			existingProject: "project-1",
+			updatedEmail:    "jane@example.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockRepository()
+			mockRepo.ClearAll()
+			mockRepo.SetMemberUniquenessScope(tt.scope)
+			mockRepo.AddCommittee(&model.Committee{
+				CommitteeBase: model.CommitteeBase{
+					UID:        "committee-1",
+					ProjectUID: "project-1",
+					Name:       "Test Committee",
+					Category:   "Board",
+				},
+				CommitteeSettings: &model.CommitteeSettings{},
+			})
+
+			existing := &model.CommitteeMember{
+				CommitteeMemberBase: model.CommitteeMemberBase{
+					UID:          "member-1",
+					CommitteeUID: "committee-1",
+					ProjectUID:   tt.existingProject,
+					Email:        tt.existingEmail,
+					Username:     "janedoe",
+				},
+			}
+			oldKey := lookupKey(*existing, tt.scope)
+			mockRepo.AddCommitteeMember("committee-1", existing)
+
+			committeeWriter := &memberKeyRecordingWriter{TestMockCommitteeWriter: NewTestMockCommitteeWriter(mockRepo)}
+			orchestrator := NewCommitteeWriterOrchestrator(
+				WithCommitteeRetriever(&memberLookupRevisionReader{CommitteeReader: mock.NewMockCommitteeReader(mockRepo)}),
+				WithCommitteeWriter(committeeWriter),
+				WithProjectRetriever(mock.NewMockProjectRetriever(mockRepo)),
+				WithCommitteePublisher(mock.NewMockCommitteePublisher()),
+			)
+
+			updated := &model.CommitteeMember{
+				CommitteeMemberBase: model.CommitteeMemberBase{
+					UID:          "member-1",
+					CommitteeUID: "committee-1",
+					Email:        tt.updatedEmail,
+					Username:     "janedoe",
+					JobTitle:     "Engineer",
+				},
+			}
+			result, err := orchestrator.UpdateMember(ctx, updated, 1, false)
+			require.NoError(t, err)
+			require.NotNil(t, result)
+
+			expectedKey := lookupKey(*result, tt.scope)
+			if !tt.expectKeyReplaced {
+				assert.Equal(t, oldKey, expectedKey)
+				reserved, deleted := committeeWriter.recorded()
+				assert.Empty(t, reserved)
+				assert.Empty(t, deleted)
+				return
+			}
+
+			assert.NotEqual(t, oldKey, expectedKey)
+			assert.Eventually(t, func() bool {
+				_, deleted := committeeWriter.recorded()
+				return len(deleted) == 1
+			}, time.Second, 10*time.Millisecond)
+
+			reserved, deleted := committeeWriter.recorded()
+			assert.Equal(t, []string{expectedKey}, reserved)
+			assert.Equal(t, []string{oldKey}, deleted)
+		})
+	}
+}
+
+func TestCommitteeWriterOrchestrator_ValidateMembers(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reports invalid members without persisting anything", func(t *testing.T) {
+		orchestrator, mockRepo, memberWriter := setupMemberWriterTest()
+		mockRepo.ClearAll()
+
+		mockRepo.AddCommittee(&model.Committee{
+			CommitteeBase: model.CommitteeBase{
+				UID:        "committee-preflight",
+				ProjectUID: "project-1",
+				Name:       "Preflight Committee",
+				Category:   "Technical Steering Committee",
+			},
+			CommitteeSettings: &model.CommitteeSettings{UID: "committee-preflight"},
+		})
+		mockRepo.AddCommitteeMember("committee-preflight", &model.CommitteeMember{
+			CommitteeMemberBase: model.CommitteeMemberBase{
+				UID:          "member-existing",
+				CommitteeUID: "committee-preflight",
+				Email:        "alice@example.com",
+			},
+		})
+
+		newMember := func(email string) *model.CommitteeMember {
+			return &model.CommitteeMember{
+				CommitteeMemberBase: model.CommitteeMemberBase{
+					Email:        email,
+					Organization: model.CommitteeMemberOrganization{Name: "Test Org"},
+				},
+			}
+		}
+		members := []*model.CommitteeMember{
+			newMember("bob@example.com"),
+			newMember(""),
+			newMember("Alice@Example.com"),
+			newMember("bob@example.com"),
+			nil,
+		}
+
+		results, err := orchestrator.ValidateMembers(ctx, "committee-preflight", members)
+		require.NoError(t, err)
+		require.Len(t, results, len(members))
+
+		for index, result := range results {
+			assert.Equal(t, index, result.Index)
+		}
+
+		assert.True(t, results[0].Valid)
+		assert.NoError(t, results[0].Err)
+		assert.Equal(t, "bob@example.com", results[0].Email)
+
+		assert.False(t, results[1].Valid)
+		assert.IsType(t, errs.Validation{}, results[1].Err)
+
+		// the email is already taken by a committee member
+		assert.False(t, results[2].Valid)
+		assert.IsType(t, errs.Conflict{}, results[2].Err)
+
+		// the email is repeated within the batch
+		assert.False(t, results[3].Valid)
+		assert.IsType(t, errs.Conflict{}, results[3].Err)
+
+		assert.False(t, results[4].Valid)
+		assert.IsType(t, errs.Validation{}, results[4].Err)
+
+		// nothing was written or reserved, and the members were not modified
+		assert.Empty(t, memberWriter.members)
+		assert.Empty(t, memberWriter.keys)
+		assert.Equal(t, 1, mockRepo.GetCommitteeMemberCount("committee-preflight"))
+		assert.Empty(t, members[0].UID)
+		assert.Empty(t, members[0].CommitteeUID)
+		assert.Empty(t, members[0].CommitteeName)
+	})
+
+	t.Run("suggests the organization spelling used by the committee members", func(t *testing.T) {
+		orchestrator, mockRepo, _ := setupMemberWriterTest()
+		mockRepo.ClearAll()
+
+		mockRepo.AddCommittee(&model.Committee{
+			CommitteeBase: model.CommitteeBase{
+				UID:        "committee-orgs",
+				ProjectUID: "project-1",
+				Name:       "Organizations Committee",
+				Category:   "Technical Steering Committee",
+			},
+			CommitteeSettings: &model.CommitteeSettings{UID: "committee-orgs"},
+		})
+		mockRepo.AddCommitteeMember("committee-orgs", &model.CommitteeMember{
+			CommitteeMemberBase: model.CommitteeMemberBase{
+				UID:          "member-ibm",
+				CommitteeUID: "committee-orgs",
+				Email:        "alice@example.com",
+				Organization: model.CommitteeMemberOrganization{Name: "IBM"},
+			},
+		})
+
+		newMember := func(email, organization string) *model.CommitteeMember {
+			return &model.CommitteeMember{
+				CommitteeMemberBase: model.CommitteeMemberBase{
+					Email:        email,
+					Organization: model.CommitteeMemberOrganization{Name: organization},
+				},
+			}
+		}
+		results, err := orchestrator.ValidateMembers(ctx, "committee-orgs", []*model.CommitteeMember{
+			newMember("bob@example.com", "I.B.M."),
+			newMember("carol@example.com", "ibm corp"),
+			newMember("dave@example.com", "IBM"),
+			newMember("erin@example.com", "Other Org"),
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 4)
+
+		assert.Equal(t, "IBM", results[0].OrganizationSuggestion)
+		assert.Equal(t, "IBM", results[1].OrganizationSuggestion)
+		assert.Empty(t, results[2].OrganizationSuggestion)
+		assert.Empty(t, results[3].OrganizationSuggestion)
+		for _, result := range results {
+			assert.True(t, result.Valid, "a suggestion does not invalidate the member")
+		}
+
+		assert.Equal(t, "IBM", orchestrator.suggestOrganizationName(ctx, "committee-orgs", "i.b.m"))
+		assert.Empty(t, orchestrator.suggestOrganizationName(ctx, "committee-orgs", ""))
+	})
+
+	t.Run("missing committee fails the whole batch", func(t *testing.T) {
+		orchestrator, mockRepo, _ := setupMemberWriterTest()
+		mockRepo.ClearAll()
+
+		results, err := orchestrator.ValidateMembers(ctx, "committee-missing", []*model.CommitteeMember{{}})
+		require.Error(t, err)
+		assert.IsType(t, errs.NotFound{}, err)
+		assert.Nil(t, results)
+	})
+}
+
+// concurrencyTrackingMemberWriter records the highest number of MemberIndexKey calls in flight
+type concurrencyTrackingMemberWriter struct {
+	*TestMockCommitteeMemberWriter
+	inFlight    atomic.Int32
+	maxInFlight atomic.Int32
+}
+
+func (w *concurrencyTrackingMemberWriter) MemberIndexKey(ctx context.Context, member *model.CommitteeMember) (string, error) {
+	current := w.inFlight.Add(1)
+	defer w.inFlight.Add(-1)
+	for {
+		highest := w.maxInFlight.Load()
+		if current <= highest || w.maxInFlight.CompareAndSwap(highest, current) {
+			break
+		}
+	}
+	time.Sleep(5 * time.Millisecond)
+	return w.TestMockCommitteeMemberWriter.MemberIndexKey(ctx, member)
+}
+
+func TestCommitteeWriterOrchestrator_ValidateMembers_Concurrency(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := mock.NewMockRepository()
+	mockRepo.ClearAll()
+	mockRepo.AddCommittee(&model.Committee{
+		CommitteeBase: model.CommitteeBase{
+			UID:      "committee-batch",
+			Name:     "Batch Committee",
+			Category: "Technical Steering Committee",
+		},
+	})
+
+	members := make([]*model.CommitteeMember, 0, 20)
+	for i := 0; i < 20; i++ {
+		members = append(members, &model.CommitteeMember{
+			CommitteeMemberBase: model.CommitteeMemberBase{
+				Email:        fmt.Sprintf("member-%02d@example.com", i),
+				Organization: model.CommitteeMemberOrganization{Name: "Test Org"},
+			},
+		})
+	}
+	// the last member repeats the first email, only the later one is a duplicate
+	members = append(members, &model.CommitteeMember{
+		CommitteeMemberBase: model.CommitteeMemberBase{Email: "member-00@example.com"},
+	})
+
+	memberWriter := &concurrencyTrackingMemberWriter{TestMockCommitteeMemberWriter: NewTestMockCommitteeMemberWriter(mockRepo)}
+	orchestrator := NewCommitteeWriterOrchestrator(
+		WithCommitteeRetriever(mock.NewMockCommitteeReader(mockRepo)),
+		WithCommitteeWriter(memberWriter),
+		WithCommitteePublisher(mock.NewMockCommitteePublisher()),
+		WithBatchConcurrency(3),
+	)
+
+	results, err := orchestrator.ValidateMembers(ctx, "committee-batch", members)
+	require.NoError(t, err)
+	require.Len(t, results, len(members))
+
+	for index, result := range results {
+		assert.Equal(t, index, result.Index)
+		assert.Equal(t, members[index].Email, result.Email)
+	}
+	for _, result := range results[:20] {
+		assert.True(t, result.Valid, "member %d should be valid", result.Index)
+	}
+	assert.False(t, results[20].Valid)
+	assert.IsType(t, errs.Conflict{}, results[20].Err)
+
+	assert.LessOrEqual(t, memberWriter.maxInFlight.Load(), int32(3))
+	assert.Greater(t, memberWriter.maxInFlight.Load(), int32(1))
+}
+
+func TestCommitteeWriterOrchestrator_UpdateMember_RoleHistory(t *testing.T) {
+	previousChange := model.CommitteeMemberRoleChange{
+		OldRole:   "None",
+		NewRole:   "Developer Seat",
+		ChangedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Actor:     "admin-1",
+	}
+
+	tests := []struct {
+		name            string
+		newRole         string
+		principal       string
+		expectedHistory []model.CommitteeMemberRoleChange
+		expectAppended  bool
+	}{
+		{
+			name:           "role change appends an entry with the actor from context",
+			newRole:        "Chair",
+			principal:      "admin-2",
+			expectAppended: true,
+		},
+		{
+			name:            "unchanged role keeps the history",
+			newRole:         "Developer Seat",
+			principal:       "admin-2",
+			expectedHistory: []model.CommitteeMemberRoleChange{previousChange},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orchestrator, mockRepo, memberWriter := setupMemberWriterTest()
+			mockRepo.ClearAll()
+			mockRepo.AddCommittee(&model.Committee{
+				CommitteeBase: model.CommitteeBase{
+					UID:      "committee-roles",
+					Name:     "Roles Committee",
+					Category: "Technical Steering Committee",
+				},
+			})
+
+			existingMember := &model.CommitteeMember{
+				CommitteeMemberBase: model.CommitteeMemberBase{
+					UID:          "member-roles",
+					CommitteeUID: "committee-roles",
+					Email:        "member@example.com",
+					Role:         model.CommitteeMemberRole{Name: "Developer Seat"},
+					RoleHistory:  []model.CommitteeMemberRoleChange{previousChange},
+					CreatedAt:    time.Now().Add(-time.Hour),
+				},
+			}
+			mockRepo.AddCommitteeMember("committee-roles", existingMember)
+			memberWriter.members["member-roles"] = existingMember
+
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, tt.principal)
+			result, err := orchestrator.UpdateMember(ctx, &model.CommitteeMember{
+				CommitteeMemberBase: model.CommitteeMemberBase{
+					UID:          "member-roles",
+					CommitteeUID: "committee-roles",
+					Email:        "member@example.com",
+					Role:         model.CommitteeMemberRole{Name: tt.newRole},
+					// the history is read-only, a client supplied one is ignored
+					RoleHistory: []model.CommitteeMemberRoleChange{{OldRole: "forged", NewRole: "forged"}},
+				},
+			}, 1, false)
+			require.NoError(t, err)
+			require.NotNil(t, result)
+
+			if !tt.expectAppended {
+				assert.Equal(t, tt.expectedHistory, result.RoleHistory)
+				return
+			}
+
+			require.Len(t, result.RoleHistory, 2)
+			assert.Equal(t, previousChange, result.RoleHistory[0])
+			change := result.RoleHistory[1]
+			assert.Equal(t, "Developer Seat", change.OldRole)
+			assert.Equal(t, tt.newRole, change.NewRole)
+			assert.Equal(t, tt.principal, change.Actor)
+			assert.Equal(t, result.UpdatedAt, change.ChangedAt)
+
+			// the stored member history was not modified in place
+			assert.Len(t, existingMember.RoleHistory, 1)
+		})
+	}
+}
+
+func TestCommitteeWriterOrchestrator_UpdateMember_StatusHistory(t *testing.T) {
+	tests := []struct {
+		name           string
+		newStatus      string
+		expectAppended bool
+	}{
+		{
+			name:           "status transition appends an entry with the actor from context",
+			newStatus:      "Inactive",
+			expectAppended: true,
+		},
+		{
+			name:      "unchanged status keeps the history",
+			newStatus: "Active",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orchestrator, mockRepo, memberWriter := setupMemberWriterTest()
+			mockRepo.ClearAll()
+			mockRepo.AddCommittee(&model.Committee{
+				CommitteeBase: model.CommitteeBase{
+					UID:      "committee-status",
+					Name:     "Status Committee",
+					Category: "Technical Steering Committee",
+				},
+			})
+
+			existingMember := &model.CommitteeMember{
+				CommitteeMemberBase: model.CommitteeMemberBase{
+					UID:          "member-status",
+					CommitteeUID: "committee-status",
+					Email:        "member@example.com",
+					Status:       "Active",
+					CreatedAt:    time.Now().Add(-time.Hour),
+				},
+			}
+			mockRepo.AddCommitteeMember("committee-status", existingMember)
+			memberWriter.members["member-status"] = existingMember
+
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "admin-1")
+			result, err := orchestrator.UpdateMember(ctx, &model.CommitteeMember{
+				CommitteeMemberBase: model.CommitteeMemberBase{
+					UID:          "member-status",
+					CommitteeUID: "committee-status",
+					Email:        "member@example.com",
+					Status:       tt.newStatus,
+					// the history is read-only, a client supplied one is ignored
+					StatusHistory: []model.CommitteeMemberStatusChange{{OldStatus: "forged", NewStatus: "forged"}},
+				},
+			}, 1, false)
+			require.NoError(t, err)
+			require.NotNil(t, result)
+
+			if !tt.expectAppended {
+				assert.Empty(t, result.StatusHistory)
+				return
+			}
+
+			require.Len(t, result.StatusHistory, 1)
+			change := result.StatusHistory[0]
+			assert.Equal(t, "Active", change.OldStatus)
+			assert.Equal(t, "Inactive", change.NewStatus)
+			assert.Equal(t, "admin-1", change.Actor)
+			assert.Equal(t, result.UpdatedAt, change.ChangedAt)
+		})
+	}
+}
+
+func TestCommitteeWriterOrchestrator_CreateMember_DefaultStatus(t *testing.T) {
+	orchestrator, mockRepo, _ := setupMemberWriterTest()
+	mockRepo.ClearAll()
+	mockRepo.AddCommittee(&model.Committee{
+		CommitteeBase: model.CommitteeBase{
+			UID:      "committee-default-status",
+			Name:     "Default Status Committee",
+			Category: "Technical Steering Committee",
+		},
+	})
+
+	result, err := orchestrator.CreateMember(context.Background(), &model.CommitteeMember{
+		CommitteeMemberBase: model.CommitteeMemberBase{
+			CommitteeUID: "committee-default-status",
+			Email:        "member@example.com",
+			Username:     "member",
+		},
+	}, false)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, model.MemberStatusActive, result.Status)
+	assert.Empty(t, result.StatusHistory)
+}
+
+func TestCommitteeWriterOrchestrator_ReassignOrganization(t *testing.T) {
+	newMember := func(uid, email, organization string) *model.CommitteeMember {
+		return &model.CommitteeMember{
+			CommitteeMemberBase: model.CommitteeMemberBase{
+				UID:          uid,
+				CommitteeUID: "committee-123",
+				Email:        email,
+				FirstName:    "Test",
+				LastName:     "User",
+				Organization: model.CommitteeMemberOrganization{
+					ID:      "org-" + uid,
+					Name:    organization,
+					Website: "https://departing.example.com",
+				},
+			},
+		}
+	}
+
+	setup := func() (*committeeWriterOrchestrator, *TestMockCommitteeMemberWriter) {
+		orchestrator, mockRepo, memberWriter := setupMemberWriterTest()
+		mockRepo.ClearAll()
+		// the test member writer is not safe for concurrent writes
+		orchestrator.batchConcurrency = 1
+		mockRepo.AddCommittee(&model.Committee{
+			CommitteeBase: model.CommitteeBase{
+				UID:      "committee-123",
+				Name:     "Test Committee",
+				Category: "Technical",
+			},
+			CommitteeSettings: &model.CommitteeSettings{},
+		})
+		for _, member := range []*model.CommitteeMember{
+			newMember("member-1", "one@example.com", "Departing Corp"),
+			newMember("member-2", "two@example.com", " departing corp "),
+			newMember("member-3", "three@example.com", "Other Org"),
+		} {
+			mockRepo.AddCommitteeMember("committee-123", member)
+			memberWriter.members[member.UID] = member
+		}
+		return orchestrator, memberWriter
+	}
+
+	t.Run("members of the organization are reassigned", func(t *testing.T) {
+		orchestrator, memberWriter := setup()
+
+		results, err := orchestrator.ReassignOrganization(context.Background(), "committee-123", "Departing Corp", "New Org", false)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+
+		reassigned := map[string]*model.CommitteeMemberReassignResult{}
+		for _, result := range results {
+			require.NoError(t, result.Err)
+			require.NotNil(t, result.Member)
+			assert.Equal(t, model.CommitteeMemberOrganization{Name: "New Org"}, result.Member.Organization)
+			reassigned[result.MemberUID] = result
+		}
+		assert.Contains(t, reassigned, "member-1")
+		assert.Contains(t, reassigned, "member-2")
+		assert.Equal(t, "New Org", memberWriter.members["member-1"].Organization.Name)
+		assert.Equal(t, "New Org", memberWriter.members["member-2"].Organization.Name)
+	})
+
+	t.Run("members of another organization are left untouched", func(t *testing.T) {
+		orchestrator, memberWriter := setup()
+
+		results, err := orchestrator.ReassignOrganization(context.Background(), "committee-123", "Departing Corp", "New Org", false)
+		require.NoError(t, err)
+
+		for _, result := range results {
+			assert.NotEqual(t, "member-3", result.MemberUID)
+		}
+		assert.Equal(t, "Other Org", memberWriter.members["member-3"].Organization.Name)
+		assert.Equal(t, "org-member-3", memberWriter.members["member-3"].Organization.ID)
+	})
+
+	t.Run("empty target organization clears it", func(t *testing.T) {
+		orchestrator, memberWriter := setup()
+
+		results, err := orchestrator.ReassignOrganization(context.Background(), "committee-123", "Departing Corp", "", false)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		for _, result := range results {
+			require.NoError(t, result.Err)
+		}
+		assert.Equal(t, model.CommitteeMemberOrganization{}, memberWriter.members["member-1"].Organization)
+	})
+
+	t.Run("failures are reported per member", func(t *testing.T) {
+		orchestrator, memberWriter := setup()
+		// member-2 was modified since it was read
+		memberWriter.customRevisions["member-2"] = 5
+
+		results, err := orchestrator.ReassignOrganization(context.Background(), "committee-123", "Departing Corp", "New Org", false)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+
+		for _, result := range results {
+			switch result.MemberUID {
+			case "member-1":
+				assert.NoError(t, result.Err)
+				assert.NotNil(t, result.Member)
+			case "member-2":
+				assert.IsType(t, errs.Conflict{}, result.Err)
+				assert.Nil(t, result.Member)
+			}
+		}
+		assert.Equal(t, "New Org", memberWriter.members["member-1"].Organization.Name)
+		assert.Equal(t, " departing corp ", memberWriter.members["member-2"].Organization.Name)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		orchestrator, _ := setup()
+		ctx := context.Background()
+
+		_, err := orchestrator.ReassignOrganization(ctx, "committee-123", " ", "New Org", false)
+		assert.IsType(t, errs.Validation{}, err)
+
+		_, err = orchestrator.ReassignOrganization(ctx, "committee-123", "Departing Corp", "departing corp", false)
+		assert.IsType(t, errs.Validation{}, err)
+
+		_, err = orchestrator.ReassignOrganization(ctx, "missing-committee", "Departing Corp", "New Org", false)
+		assert.IsType(t, errs.NotFound{}, err)
+	})
+}
@@ -7,11 +7,18 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/model"
 	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/port"
+	"github.com/linuxfoundation/lfx-v2-committee-service/pkg/constants"
 	errs "github.com/linuxfoundation/lfx-v2-committee-service/pkg/errors"
 	"github.com/linuxfoundation/lfx-v2-committee-service/pkg/fields"
+	"github.com/linuxfoundation/lfx-v2-committee-service/pkg/redaction"
 )
 
 // CommitteeReader defines the interface for committee read operations
@@ -24,10 +31,25 @@ type CommitteeReader interface {
 type CommitteeDataReader interface {
 	// GetBase retrieves committee base information by UID and returns the revision
 	GetBase(ctx context.Context, uid string) (*model.CommitteeBase, uint64, error)
+	// Exists reports whether the committee exists, without fetching it
+	Exists(ctx context.Context, uid string) (bool, error)
 	// GetSettings retrieves committee settings by UID and returns the revision
 	GetSettings(ctx context.Context, uid string) (*model.CommitteeSettings, uint64, error)
+	// GetSettingsOrDefault retrieves committee settings by UID like GetSettings, returning empty settings
+	// with a zero revision when the committee has none
+	GetSettingsOrDefault(ctx context.Context, uid string) (*model.CommitteeSettings, uint64, error)
+	// GetFull retrieves committee base and settings by UID and returns the revision of each record
+	GetFull(ctx context.Context, uid string) (*model.Committee, model.CommitteeRevision, error)
+	// GetWithMembers retrieves committee base and settings by UID along with a page of its members
+	GetWithMembers(ctx context.Context, uid string, memberPage model.PageOptions) (*model.Committee, *model.PageResult[*model.CommitteeMember], error)
+	// GetBaseWithChildCount retrieves committee base information by UID along with the number of direct child committees
+	GetBaseWithChildCount(ctx context.Context, uid string) (*model.CommitteeBase, int, error)
 	// GetBaseAttributeValue retrieves an attribute value by UID and returns the revision
 	GetBaseAttributeValue(ctx context.Context, uid string, attributeName string) (any, error)
+	// ListCommittees retrieves the committee bases matching the filter
+	ListCommittees(ctx context.Context, filter model.CommitteeFilter) ([]*model.CommitteeBase, error)
+	// ListChildren retrieves a page of the direct child committees and the token of the next page, empty on the last one
+	ListChildren(ctx context.Context, uid string, pageSize int, pageToken string) ([]*model.CommitteeBase, string, error)
 }
 
 // CommitteeMemberDataReader defines the interface for committee member read operations
@@ -36,8 +58,23 @@ type CommitteeMemberDataReader interface {
 	GetMember(ctx context.Context, committeeUID, memberUID string) (*model.CommitteeMember, uint64, error)
 	// ListMembers retrieves all members for a given committee UID
 	ListMembers(ctx context.Context, committeeUID string) ([]*model.CommitteeMember, error)
+	// GetMembersAsOf retrieves the members of a committee with the roles and voting status effective on the given date
+	GetMembersAsOf(ctx context.Context, committeeUID string, date time.Time) ([]*model.CommitteeMember, error)
+	// ListExpiringMembers retrieves the members of a committee whose role or voting tenure ends within the window from now
+	ListExpiringMembers(ctx context.Context, committeeUID string, within time.Duration) ([]*model.CommitteeMember, error)
+	// GetMemberProjectSummary retrieves the committees of a project the person belongs to, with their role in each
+	GetMemberProjectSummary(ctx context.Context, projectUID, email string) ([]*model.CommitteeMembershipSummary, error)
+	// ListMembersWithAccess retrieves all members of a committee along with the access relations each one holds
+	ListMembersWithAccess(ctx context.Context, committeeUID string) ([]*model.CommitteeMemberAccess, error)
+	// ListMembersByOrganization retrieves the members of a committee belonging to the organization
+	ListMembersByOrganization(ctx context.Context, committeeUID, organizationName string) ([]*model.CommitteeMember, error)
+	// GetMemberHistory retrieves the prior revisions of a committee member, newest first, up to the limit
+	GetMemberHistory(ctx context.Context, committeeUID, memberUID string, limit int) ([]*model.CommitteeMemberRevision, error)
 }
 
+// defaultMemberHistoryLimit is the number of member revisions returned when no limit is given
+const defaultMemberHistoryLimit = 20
+
 // committeeReaderOrchestratorOption defines a function type for setting options
 type committeeReaderOrchestratorOption func(*committeeReaderOrchestrator)
 
@@ -48,9 +85,17 @@ func WithCommitteeReader(reader port.CommitteeReader) committeeReaderOrchestrato
 	}
 }
 
+// WithMemberSensitiveFields sets the member fields omitted for unprivileged callers
+func WithMemberSensitiveFields(fields model.MemberSensitiveFields) committeeReaderOrchestratorOption {
+	return func(r *committeeReaderOrchestrator) {
+		r.memberSensitiveFields = fields
+	}
+}
+
 // committeeReaderOrchestrator orchestrates the committee reading process
 type committeeReaderOrchestrator struct {
-	committeeReader port.CommitteeReader
+	committeeReader       port.CommitteeReader
+	memberSensitiveFields model.MemberSensitiveFields
 }
 
 // GetBase retrieves committee base information by UID
@@ -70,6 +115,16 @@ func (rc *committeeReaderOrchestrator) GetBase(ctx context.Context, uid string)
 		return nil, 0, err
 	}
 
+	// The index status is informational, failing to read it leaves the committee flagged as pending
+	lastIndexed, errLastIndexed := rc.committeeReader.GetLastIndexed(ctx, uid)
+	if errLastIndexed != nil {
+		slog.WarnContext(ctx, "failed to get committee index status",
+			"error", errLastIndexed,
+			"committee_uid", uid,
+		)
+	}
+	committeeBase.LastIndexedAt = lastIndexed
+
 	slog.DebugContext(ctx, "committee base retrieved successfully",
 		"committee_uid", uid,
 		"revision", revision,
@@ -78,6 +133,26 @@ func (rc *committeeReaderOrchestrator) GetBase(ctx context.Context, uid string)
 	return committeeBase, revision, nil
 }
 
+// Exists reports whether the committee exists, returning false rather than an error when it is absent
+func (rc *committeeReaderOrchestrator) Exists(ctx context.Context, uid string) (bool, error) {
+
+	exists, err := rc.committeeReader.Exists(ctx, uid)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to check committee existence",
+			"error", err,
+			"committee_uid", uid,
+		)
+		return false, err
+	}
+
+	slog.DebugContext(ctx, "committee existence checked",
+		"committee_uid", uid,
+		"exists", exists,
+	)
+
+	return exists, nil
+}
+
 // GetSettings retrieves committee settings by UID
 func (rc *committeeReaderOrchestrator) GetSettings(ctx context.Context, uid string) (*model.CommitteeSettings, uint64, error) {
 
@@ -103,6 +178,300 @@ func (rc *committeeReaderOrchestrator) GetSettings(ctx context.Context, uid stri
 	return committeeSettings, revision, nil
 }
 
+// GetSettingsOrDefault retrieves committee settings by UID, falling back to empty settings
+// carrying the committee UID when the committee has none.
+// It still fails with NotFound when the committee itself does not exist.
+func (rc *committeeReaderOrchestrator) GetSettingsOrDefault(ctx context.Context, uid string) (*model.CommitteeSettings, uint64, error) {
+
+	committeeSettings, revision, err := rc.committeeReader.GetSettings(ctx, uid)
+	if err == nil {
+		return committeeSettings, revision, nil
+	}
+	var notFoundErr errs.NotFound
+	if !errors.As(err, &notFoundErr) {
+		slog.ErrorContext(ctx, "failed to get committee settings",
+			"error", err,
+			"committee_uid", uid,
+		)
+		return nil, 0, err
+	}
+
+	// Only committees without settings get the default ones
+	if _, _, errBase := rc.committeeReader.GetBase(ctx, uid); errBase != nil {
+		slog.ErrorContext(ctx, "failed to get committee base for default settings",
+			"error", errBase,
+			"committee_uid", uid,
+		)
+		return nil, 0, errBase
+	}
+
+	slog.DebugContext(ctx, "committee has no settings, returning the default ones",
+		"committee_uid", uid,
+	)
+
+	return &model.CommitteeSettings{UID: uid}, 0, nil
+}
+
+// GetFull retrieves committee base and settings by UID along with the revision of each record
+func (rc *committeeReaderOrchestrator) GetFull(ctx context.Context, uid string) (*model.Committee, model.CommitteeRevision, error) {
+
+	slog.DebugContext(ctx, "executing get committee full use case",
+		"committee_uid", uid,
+	)
+
+	// Step 1: Get committee base from storage
+	committeeBase, baseRevision, err := rc.committeeReader.GetBase(ctx, uid)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get committee base",
+			"error", err,
+			"committee_uid", uid,
+		)
+		return nil, model.CommitteeRevision{}, err
+	}
+
+	// Step 2: Get committee settings from storage
+	committeeSettings, settingsRevision, err := rc.committeeReader.GetSettings(ctx, uid)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get committee settings",
+			"error", err,
+			"committee_uid", uid,
+		)
+		return nil, model.CommitteeRevision{}, err
+	}
+
+	revision := model.CommitteeRevision{
+		Base:     baseRevision,
+		Settings: settingsRevision,
+	}
+
+	slog.DebugContext(ctx, "committee full retrieved successfully",
+		"committee_uid", uid,
+		"base_revision", revision.Base,
+		"settings_revision", revision.Settings,
+	)
+
+	return &model.Committee{
+		CommitteeBase:     *committeeBase,
+		CommitteeSettings: committeeSettings,
+	}, revision, nil
+}
+
+// GetWithMembers retrieves committee base and settings by UID along with a page of its members,
+// ordered by last name, first name and UID. Committees without settings get the default ones.
+// The sensitive member fields are only kept for the writers and auditors of the committee
+// and for the members themselves.
+func (rc *committeeReaderOrchestrator) GetWithMembers(ctx context.Context, uid string, memberPage model.PageOptions) (*model.Committee, *model.PageResult[*model.CommitteeMember], error) {
+
+	slog.DebugContext(ctx, "executing get committee with members use case",
+		"committee_uid", uid,
+		"page_size", memberPage.PageSize,
+		"page_token", memberPage.PageToken,
+	)
+
+	// Step 1: Get committee base and settings from storage
+	committeeBase, _, err := rc.committeeReader.GetBase(ctx, uid)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get committee base",
+			"error", err,
+			"committee_uid", uid,
+		)
+		return nil, nil, err
+	}
+
+	committeeSettings, _, err := rc.GetSettingsOrDefault(ctx, uid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Step 2: Get the members and cut the requested page
+	members, err := rc.committeeReader.ListMembers(ctx, uid)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list committee members",
+			"error", err,
+			"committee_uid", uid,
+		)
+		return nil, nil, err
+	}
+
+	sortMembersByName(members)
+
+	page, err := model.Paginate(members, memberPage)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Step 3: Redact the sensitive fields of the page for unprivileged callers
+	page.Items = rc.redactMembers(ctx, committeeSettings, page.Items)
+
+	slog.DebugContext(ctx, "committee with members retrieved successfully",
+		"committee_uid", uid,
+		"member_count", page.TotalCount,
+		"page_count", len(page.Items),
+	)
+
+	return &model.Committee{
+		CommitteeBase:     *committeeBase,
+		CommitteeSettings: committeeSettings,
+	}, page, nil
+}
+
+// sortMembersByName orders the members by last name and first name, then by UID
+func sortMembersByName(members []*model.CommitteeMember) {
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].LastName != members[j].LastName {
+			return members[i].LastName < members[j].LastName
+		}
+		if members[i].FirstName != members[j].FirstName {
+			return members[i].FirstName < members[j].FirstName
+		}
+		return members[i].UID < members[j].UID
+	})
+}
+
+// redactMembers redacts the sensitive fields of the members unless the caller is a writer or auditor
+// of the committee, keeping them only on the caller's own membership
+func (rc *committeeReaderOrchestrator) redactMembers(ctx context.Context, settings *model.CommitteeSettings, members []*model.CommitteeMember) []*model.CommitteeMember {
+	principal, _ := ctx.Value(constants.PrincipalContextID).(string)
+	if principal != "" && (slices.Contains(settings.Writers, principal) || slices.Contains(settings.Auditors, principal)) {
+		return members
+	}
+
+	sensitiveFields := append(append(model.MemberSensitiveFields{}, model.MemberSensitiveSection...), rc.memberSensitiveFields...)
+	redacted := make([]*model.CommitteeMember, 0, len(members))
+	for _, member := range members {
+		if principal == "" || member.Username != principal {
+			member = sensitiveFields.Redact(member)
+		}
+		redacted = append(redacted, member)
+	}
+	return redacted
+}
+
+// GetBaseWithChildCount retrieves committee base information by UID along with the number
+// of committees having it as parent, counted through the parent index
+func (rc *committeeReaderOrchestrator) GetBaseWithChildCount(ctx context.Context, uid string) (*model.CommitteeBase, int, error) {
+
+	slog.DebugContext(ctx, "executing get committee base with child count use case",
+		"committee_uid", uid,
+	)
+
+	// Step 1: Get committee base from storage
+	committeeBase, _, err := rc.committeeReader.GetBase(ctx, uid)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get committee base",
+			"error", err,
+			"committee_uid", uid,
+		)
+		return nil, 0, err
+	}
+
+	// Step 2: List the direct children from the parent index
+	children, err := rc.committeeReader.ListChildren(ctx, uid)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list child committees",
+			"error", err,
+			"committee_uid", uid,
+		)
+		return nil, 0, err
+	}
+
+	slog.DebugContext(ctx, "committee base with child count retrieved successfully",
+		"committee_uid", uid,
+		"children_count", len(children),
+	)
+
+	return committeeBase, len(children), nil
+}
+
+// ListChildren retrieves the direct child committees through the parent index, ordered by name.
+// The page token is the offset of the first child of the page; parent index entries pointing to
+// committees that were deleted or moved to another parent are skipped. Unauthenticated callers
+// only see the public children.
+func (rc *committeeReaderOrchestrator) ListChildren(ctx context.Context, uid string, pageSize int, pageToken string) ([]*model.CommitteeBase, string, error) {
+
+	slog.DebugContext(ctx, "executing list committee children use case",
+		"committee_uid", uid,
+		"page_size", pageSize,
+		"page_token", pageToken,
+	)
+
+	if pageSize <= 0 {
+		return nil, "", errs.NewValidation("page size must be a positive number")
+	}
+	offset := 0
+	if pageToken != "" {
+		parsed, errParse := strconv.Atoi(pageToken)
+		if errParse != nil || parsed < 0 {
+			return nil, "", errs.NewValidation("invalid page token")
+		}
+		offset = parsed
+	}
+
+	// Step 1: Make sure the parent exists, so a missing committee is not reported as a leaf
+	if _, _, err := rc.committeeReader.GetBase(ctx, uid); err != nil {
+		slog.ErrorContext(ctx, "failed to get parent committee",
+			"error", err,
+			"committee_uid", uid,
+		)
+		return nil, "", err
+	}
+
+	// Step 2: Resolve the children from the parent index
+	childUIDs, err := rc.committeeReader.ListChildren(ctx, uid)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list child committees",
+			"error", err,
+			"committee_uid", uid,
+		)
+		return nil, "", err
+	}
+
+	principal, _ := ctx.Value(constants.PrincipalContextID).(string)
+	children := make([]*model.CommitteeBase, 0, len(childUIDs))
+	for _, childUID := range childUIDs {
+		child, _, errGet := rc.committeeReader.GetBase(ctx, childUID)
+		if errGet != nil {
+			var notFoundErr errs.NotFound
+			if errors.As(errGet, &notFoundErr) {
+				continue
+			}
+			return nil, "", errGet
+		}
+		if child.ParentUID == nil || *child.ParentUID != uid {
+			continue
+		}
+		if principal == "" && !child.Public {
+			continue
+		}
+		children = append(children, child)
+	}
+
+	// Step 3: Order the children and cut the requested page
+	sort.Slice(children, func(i, j int) bool {
+		if children[i].Name != children[j].Name {
+			return children[i].Name < children[j].Name
+		}
+		return children[i].UID < children[j].UID
+	})
+
+	if offset >= len(children) {
+		return []*model.CommitteeBase{}, "", nil
+	}
+	end := min(offset+pageSize, len(children))
+	nextPageToken := ""
+	if end < len(children) {
+		nextPageToken = strconv.Itoa(end)
+	}
+
+	slog.DebugContext(ctx, "committee children listed successfully",
+		"committee_uid", uid,
+		"children_count", len(children),
+		"page_count", end-offset,
+	)
+
+	return children[offset:end], nextPageToken, nil
+}
+
 // GetAttributeValue retrieves an attribute value by UID and returns the revision
 func (rc *committeeReaderOrchestrator) GetBaseAttributeValue(ctx context.Context, uid string, attributeName string) (any, error) {
 
@@ -119,6 +488,42 @@ func (rc *committeeReaderOrchestrator) GetBaseAttributeValue(ctx context.Context
 	return field, nil
 }
 
+// ListCommittees retrieves the committee bases matching the filter.
+// Unauthenticated callers can only see public committees, regardless of the requested filter.
+func (rc *committeeReaderOrchestrator) ListCommittees(ctx context.Context, filter model.CommitteeFilter) ([]*model.CommitteeBase, error) {
+
+	if principal, ok := ctx.Value(constants.PrincipalContextID).(string); !ok || principal == "" {
+		public := true
+		filter.Public = &public
+	}
+
+	slog.DebugContext(ctx, "executing list committees use case",
+		"public", filter.Public,
+	)
+
+	committees, err := rc.committeeReader.ListBases(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list committees",
+			"error", err,
+		)
+		return nil, err
+	}
+
+	filtered := make([]*model.CommitteeBase, 0, len(committees))
+	for _, committee := range committees {
+		if filter.Matches(committee) {
+			filtered = append(filtered, committee)
+		}
+	}
+
+	slog.DebugContext(ctx, "committees retrieved successfully",
+		"total_count", len(committees),
+		"filtered_count", len(filtered),
+	)
+
+	return filtered, nil
+}
+
 // GetMember retrieves a committee member by committee UID and member UID
 func (rc *committeeReaderOrchestrator) GetMember(ctx context.Context, committeeUID, memberUID string) (*model.CommitteeMember, uint64, error) {
 
@@ -158,6 +563,12 @@ func (rc *committeeReaderOrchestrator) GetMember(ctx context.Context, committeeU
 		return nil, 0, errs.NewValidation("committee member does not belong to the requested committee")
 	}
 
+	// Only the writers and auditors of the committee, or the member themselves, get the sensitive fields
+	if !rc.canReadMemberSensitiveFields(ctx, committeeUID, committeeMember) {
+		sensitiveFields := append(append(model.MemberSensitiveFields{}, model.MemberSensitiveSection...), rc.memberSensitiveFields...)
+		committeeMember = sensitiveFields.Redact(committeeMember)
+	}
+
 	slog.DebugContext(ctx, "committee member retrieved successfully",
 		"committee_uid", committeeUID,
 		"member_uid", memberUID,
@@ -167,6 +578,104 @@ func (rc *committeeReaderOrchestrator) GetMember(ctx context.Context, committeeU
 	return committeeMember, revision, nil
 }
 
+// GetMemberHistory retrieves the revisions of a committee member kept by the storage, newest first,
+// to audit the changes of its role, organization and other fields over time.
+// The sensitive fields of every revision are redacted unless the caller may read them on the current member.
+func (rc *committeeReaderOrchestrator) GetMemberHistory(ctx context.Context, committeeUID, memberUID string, limit int) ([]*model.CommitteeMemberRevision, error) {
+
+	slog.DebugContext(ctx, "executing get committee member history use case",
+		"committee_uid", committeeUID,
+		"member_uid", memberUID,
+		"limit", limit,
+	)
+
+	if limit <= 0 {
+		limit = defaultMemberHistoryLimit
+	}
+
+	// Step 1: Verify that the committee exists
+	_, _, err := rc.committeeReader.GetBase(ctx, committeeUID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get committee for member history",
+			"error", err,
+			"committee_uid", committeeUID,
+		)
+		return nil, err
+	}
+
+	// Step 2: Verify that the member exists and belongs to the committee
+	member, _, err := rc.committeeReader.GetMember(ctx, memberUID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get committee member for history",
+			"error", err,
+			"committee_uid", committeeUID,
+			"member_uid", memberUID,
+		)
+		return nil, err
+	}
+	if member.CommitteeUID != committeeUID {
+		slog.ErrorContext(ctx, "committee member does not belong to the requested committee",
+			"committee_uid", committeeUID,
+			"member_uid", memberUID,
+			"member_committee_uid", member.CommitteeUID,
+		)
+		return nil, errs.NewValidation("committee member does not belong to the requested committee")
+	}
+
+	// Step 3: Get the stored revisions
+	revisions, err := rc.committeeReader.GetMemberHistory(ctx, memberUID, limit)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get committee member history",
+			"error", err,
+			"committee_uid", committeeUID,
+			"member_uid", memberUID,
+		)
+		return nil, err
+	}
+
+	// Step 4: Redact the revisions for callers who can't read the sensitive fields of the member
+	if !rc.canReadMemberSensitiveFields(ctx, committeeUID, member) {
+		sensitiveFields := append(append(model.MemberSensitiveFields{}, model.MemberSensitiveSection...), rc.memberSensitiveFields...)
+		for _, revision := range revisions {
+			revision.Member = sensitiveFields.Redact(revision.Member)
+		}
+	}
+
+	slog.DebugContext(ctx, "committee member history retrieved successfully",
+		"committee_uid", committeeUID,
+		"member_uid", memberUID,
+		"revision_count", len(revisions),
+	)
+
+	return revisions, nil
+}
+
+// canReadMemberSensitiveFields reports whether the caller may read the sensitive fields of the member,
+// which is reserved to the writers and auditors of the committee and to the member themselves.
+// Failing to read the committee settings denies the access.
+func (rc *committeeReaderOrchestrator) canReadMemberSensitiveFields(ctx context.Context, committeeUID string, member *model.CommitteeMember) bool {
+	principal, _ := ctx.Value(constants.PrincipalContextID).(string)
+	if principal == "" {
+		return false
+	}
+	if member.Username != "" && member.Username == principal {
+		return true
+	}
+
+	settings, _, err := rc.committeeReader.GetSettings(ctx, committeeUID)
+	if err != nil {
+		if !errors.Is(err, errs.NotFound{}) {
+			slog.WarnContext(ctx, "failed to get committee settings to authorize the member sensitive fields",
+				"error", err,
+				"committee_uid", committeeUID,
+			)
+		}
+		return false
+	}
+
+	return slices.Contains(settings.Writers, principal) || slices.Contains(settings.Auditors, principal)
+}
+
 // ListMembers retrieves all members for a given committee UID
 func (rc *committeeReaderOrchestrator) ListMembers(ctx context.Context, committeeUID string) ([]*model.CommitteeMember, error) {
 
@@ -192,6 +701,195 @@ func (rc *committeeReaderOrchestrator) ListMembers(ctx context.Context, committe
 	return members, nil
 }
 
+// GetMembersAsOf retrieves a point-in-time snapshot of the committee members.
+// Only members whose role or voting window covers the date are returned, with the role
+// and voting status computed for that date.
+func (rc *committeeReaderOrchestrator) GetMembersAsOf(ctx context.Context, committeeUID string, date time.Time) ([]*model.CommitteeMember, error) {
+
+	slog.DebugContext(ctx, "executing get committee members as of date use case",
+		"committee_uid", committeeUID,
+		"date", date.Format(model.MemberDateLayout),
+	)
+
+	// Step 1: Verify that the committee exists
+	_, _, err := rc.committeeReader.GetBase(ctx, committeeUID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get committee for members snapshot",
+			"error", err,
+			"committee_uid", committeeUID,
+		)
+		return nil, err
+	}
+
+	// Step 2: Get all committee members from storage
+	members, err := rc.committeeReader.ListMembers(ctx, committeeUID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list committee members",
+			"error", err,
+			"committee_uid", committeeUID,
+		)
+		return nil, err
+	}
+
+	// Step 3: Keep the members whose tenure covers the date, as they were on that date
+	snapshot := make([]*model.CommitteeMember, 0, len(members))
+	for _, member := range members {
+		memberAsOf, active, errAsOf := member.AsOf(date)
+		if errAsOf != nil {
+			slog.ErrorContext(ctx, "failed to compute committee member as of date",
+				"error", errAsOf,
+				"committee_uid", committeeUID,
+				"member_uid", member.UID,
+			)
+			return nil, errs.NewUnexpected("failed to compute committee member as of date", errAsOf)
+		}
+		if active {
+			snapshot = append(snapshot, memberAsOf)
+		}
+	}
+
+	slog.DebugContext(ctx, "committee members snapshot retrieved successfully",
+		"committee_uid", committeeUID,
+		"member_count", len(members),
+		"snapshot_count", len(snapshot),
+	)
+
+	return snapshot, nil
+}
+
+// ListExpiringMembers retrieves the committee members whose role or voting end date falls between today
+// and the end of the window, so they can be renewed before their tenure lapses.
+// Members with open-ended tenure are never returned.
+func (rc *committeeReaderOrchestrator) ListExpiringMembers(ctx context.Context, committeeUID string, within time.Duration) ([]*model.CommitteeMember, error) {
+
+	slog.DebugContext(ctx, "executing list expiring committee members use case",
+		"committee_uid", committeeUID,
+		"within", within,
+	)
+
+	if within < 0 {
+		return nil, errs.NewValidation("expiry window must not be negative")
+	}
+
+	// Step 1: Verify that the committee exists
+	_, _, err := rc.committeeReader.GetBase(ctx, committeeUID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get committee for expiring members",
+			"error", err,
+			"committee_uid", committeeUID,
+		)
+		return nil, err
+	}
+
+	// Step 2: Get all committee members from storage
+	members, err := rc.committeeReader.ListMembers(ctx, committeeUID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list committee members",
+			"error", err,
+			"committee_uid", committeeUID,
+		)
+		return nil, err
+	}
+
+	// Step 3: Keep the members whose tenure ends within the window
+	now := time.Now()
+	expiring := make([]*model.CommitteeMember, 0, len(members))
+	for _, member := range members {
+		expires, errExpires := member.ExpiresBetween(now, now.Add(within))
+		if errExpires != nil {
+			slog.ErrorContext(ctx, "failed to compute committee member expiry",
+				"error", errExpires,
+				"committee_uid", committeeUID,
+				"member_uid", member.UID,
+			)
+			return nil, errs.NewUnexpected("failed to compute committee member expiry", errExpires)
+		}
+		if expires {
+			expiring = append(expiring, member)
+		}
+	}
+
+	slog.DebugContext(ctx, "expiring committee members retrieved successfully",
+		"committee_uid", committeeUID,
+		"member_count", len(members),
+		"expiring_count", len(expiring),
+	)
+
+	return expiring, nil
+}
+
+// GetMemberProjectSummary aggregates the memberships of a person across the committees of a project.
+// Emails are compared trimmed and case insensitive; the summaries are sorted by committee name.
+func (rc *committeeReaderOrchestrator) GetMemberProjectSummary(ctx context.Context, projectUID, email string) ([]*model.CommitteeMembershipSummary, error) {
+
+	normalizedEmail := strings.ToLower(strings.TrimSpace(email))
+
+	slog.DebugContext(ctx, "executing get member project summary use case",
+		"project_uid", projectUID,
+		"email", redaction.RedactEmail(normalizedEmail),
+	)
+
+	if projectUID == "" {
+		return nil, errs.NewValidation("project UID is required")
+	}
+	if normalizedEmail == "" {
+		return nil, errs.NewValidation("email is required")
+	}
+
+	// Step 1: Get the committees of the project
+	committees, err := rc.committeeReader.ListBases(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list committees",
+			"error", err,
+			"project_uid", projectUID,
+		)
+		return nil, err
+	}
+
+	// Step 2: Look for the person in the members of each project committee
+	summaries := make([]*model.CommitteeMembershipSummary, 0)
+	for _, committee := range committees {
+		if committee.ProjectUID != projectUID {
+			continue
+		}
+
+		members, errMembers := rc.committeeReader.ListMembers(ctx, committee.UID)
+		if errMembers != nil {
+			slog.ErrorContext(ctx, "failed to list committee members",
+				"error", errMembers,
+				"committee_uid", committee.UID,
+			)
+			return nil, errMembers
+		}
+
+		for _, member := range members {
+			if strings.ToLower(strings.TrimSpace(member.Email)) != normalizedEmail {
+				continue
+			}
+			summaries = append(summaries, &model.CommitteeMembershipSummary{
+				CommitteeUID:  committee.UID,
+				CommitteeName: committee.Name,
+				Role:          member.Role.Name,
+				VotingStatus:  member.Voting.Status,
+			})
+		}
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].CommitteeName != summaries[j].CommitteeName {
+			return summaries[i].CommitteeName < summaries[j].CommitteeName
+		}
+		return summaries[i].CommitteeUID < summaries[j].CommitteeUID
+	})
+
+	slog.DebugContext(ctx, "member project summary retrieved successfully",
+		"project_uid", projectUID,
+		"committee_count", len(summaries),
+	)
+
+	return summaries, nil
+}
+
 // NewCommitteeReaderOrchestrator creates a new committee reader use case using the option pattern
 func NewCommitteeReaderOrchestrator(opts ...committeeReaderOrchestratorOption) CommitteeReader {
 	rc := &committeeReaderOrchestrator{}
@@ -200,3 +898,111 @@ func NewCommitteeReaderOrchestrator(opts ...committeeReaderOrchestratorOption) C
 	}
 	return rc
 }
+
+// ListMembersWithAccess retrieves the members of a committee with their effective access relations.
+// Access is granted by username, so members without one hold no relation; every other member holds
+// the member relation, plus the writer and auditor relations when listed in the committee settings.
+// Roles such as Chair grant no relation of their own.
+func (rc *committeeReaderOrchestrator) ListMembersWithAccess(ctx context.Context, committeeUID string) ([]*model.CommitteeMemberAccess, error) {
+
+	slog.DebugContext(ctx, "executing list committee members with access use case",
+		"committee_uid", committeeUID,
+	)
+
+	// Step 1: Get the writers and auditors of the committee
+	settings, _, err := rc.GetSettingsOrDefault(ctx, committeeUID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 2: Get the members of the committee
+	members, err := rc.committeeReader.ListMembers(ctx, committeeUID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list committee members",
+			"error", err,
+			"committee_uid", committeeUID,
+		)
+		return nil, err
+	}
+
+	// Step 3: Derive the relations of each member
+	accesses := make([]*model.CommitteeMemberAccess, 0, len(members))
+	for _, member := range members {
+		relations := []string{}
+		if member.Username != "" {
+			relations = append(relations, constants.RelationMember)
+			if slices.Contains(settings.Writers, member.Username) {
+				relations = append(relations, constants.RelationWriter)
+			}
+			if slices.Contains(settings.Auditors, member.Username) {
+				relations = append(relations, constants.RelationAuditor)
+			}
+		}
+		accesses = append(accesses, &model.CommitteeMemberAccess{
+			Member:    member,
+			Relations: relations,
+		})
+	}
+
+	sort.Slice(accesses, func(i, j int) bool {
+		return accesses[i].Member.UID < accesses[j].Member.UID
+	})
+
+	slog.DebugContext(ctx, "committee members with access retrieved successfully",
+		"committee_uid", committeeUID,
+		"member_count", len(accesses),
+	)
+
+	return accesses, nil
+}
+
+// ListMembersByOrganization retrieves the members of a committee whose organization matches the given name,
+// comparing normalized names so "IBM" also matches "ibm" or "I.B.M. Corp". The members are ordered by
+// last name and first name, and their sensitive fields are only kept for the writers and auditors of the
+// committee and for the members themselves.
+func (rc *committeeReaderOrchestrator) ListMembersByOrganization(ctx context.Context, committeeUID, organizationName string) ([]*model.CommitteeMember, error) {
+
+	slog.DebugContext(ctx, "executing list committee members by organization use case",
+		"committee_uid", committeeUID,
+		"organization", redaction.Redact(organizationName),
+	)
+
+	normalizedName := model.NormalizeOrganizationName(organizationName)
+	if normalizedName == "" {
+		return nil, errs.NewValidation("organization name is required")
+	}
+
+	// Step 1: Get the committee settings, failing when the committee does not exist
+	settings, _, err := rc.GetSettingsOrDefault(ctx, committeeUID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 2: Keep the members of the organization
+	members, err := rc.committeeReader.ListMembers(ctx, committeeUID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list committee members",
+			"error", err,
+			"committee_uid", committeeUID,
+		)
+		return nil, err
+	}
+
+	matching := make([]*model.CommitteeMember, 0)
+	for _, member := range members {
+		if model.NormalizeOrganizationName(member.Organization.Name) == normalizedName {
+			matching = append(matching, member)
+		}
+	}
+	sortMembersByName(matching)
+
+	// Step 3: Redact the sensitive fields for unprivileged callers
+	matching = rc.redactMembers(ctx, settings, matching)
+
+	slog.DebugContext(ctx, "committee members by organization retrieved successfully",
+		"committee_uid", committeeUID,
+		"member_count", len(matching),
+	)
+
+	return matching, nil
+}
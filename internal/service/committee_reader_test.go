@@ -5,6 +5,8 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -14,6 +16,7 @@ import (
 
 	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/model"
 	"github.com/linuxfoundation/lfx-v2-committee-service/internal/infrastructure/mock"
+	"github.com/linuxfoundation/lfx-v2-committee-service/pkg/constants"
 	errs "github.com/linuxfoundation/lfx-v2-committee-service/pkg/errors"
 )
 
@@ -272,6 +275,91 @@ func TestCommitteeReaderOrchestratorGetSettings(t *testing.T) {
 	}
 }
 
+func TestCommitteeReaderOrchestratorGetSettingsOrDefault(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := mock.NewMockRepository()
+
+	withSettingsUID := uuid.New().String()
+	withoutSettingsUID := uuid.New().String()
+
+	setup := func() {
+		mockRepo.ClearAll()
+		mockRepo.AddCommittee(&model.Committee{
+			CommitteeBase: model.CommitteeBase{
+				UID:  withSettingsUID,
+				Name: "Committee With Settings",
+			},
+			CommitteeSettings: &model.CommitteeSettings{
+				UID:                   withSettingsUID,
+				BusinessEmailRequired: true,
+				Writers:               []string{"writer1"},
+			},
+		})
+		mockRepo.AddCommittee(&model.Committee{
+			CommitteeBase: model.CommitteeBase{
+				UID:  withoutSettingsUID,
+				Name: "Committee Without Settings",
+			},
+		})
+		mockRepo.RemoveCommitteeSettings(withoutSettingsUID)
+	}
+
+	tests := []struct {
+		name             string
+		committeeUID     string
+		expectedError    bool
+		errorType        error
+		expectedSettings *model.CommitteeSettings
+		expectedRevision uint64
+	}{
+		{
+			name:         "existing settings are returned as is",
+			committeeUID: withSettingsUID,
+			expectedSettings: &model.CommitteeSettings{
+				UID:                   withSettingsUID,
+				BusinessEmailRequired: true,
+				Writers:               []string{"writer1"},
+			},
+			expectedRevision: 1,
+		},
+		{
+			name:             "missing settings return the default with the committee UID",
+			committeeUID:     withoutSettingsUID,
+			expectedSettings: &model.CommitteeSettings{UID: withoutSettingsUID},
+			expectedRevision: 0,
+		},
+		{
+			name:          "missing committee is still not found",
+			committeeUID:  "nonexistent-committee-uid",
+			expectedError: true,
+			errorType:     errs.NotFound{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup()
+
+			reader := NewCommitteeReaderOrchestrator(
+				WithCommitteeReader(mockRepo),
+			)
+
+			settings, revision, err := reader.GetSettingsOrDefault(ctx, tt.committeeUID)
+
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.IsType(t, tt.errorType, err)
+				assert.Nil(t, settings)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedSettings, settings)
+			assert.Equal(t, tt.expectedRevision, revision)
+		})
+	}
+}
+
 func TestNewCommitteeReaderOrchestrator(t *testing.T) {
 	mockRepo := mock.NewMockRepository()
 
@@ -690,7 +778,8 @@ func TestCommitteeReaderOrchestratorGetBaseAttributeValue(t *testing.T) {
 }
 
 func TestCommitteeReaderOrchestratorGetMember(t *testing.T) {
-	ctx := context.Background()
+	// The member reads their own membership, sensitive fields included
+	ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "testuser")
 	mockRepo := mock.NewMockRepository()
 
 	// Setup test data
@@ -923,7 +1012,1283 @@ func TestCommitteeReaderOrchestratorGetMember(t *testing.T) {
 	}
 }
 
+func TestCommitteeReaderOrchestratorGetMember_SensitiveFields(t *testing.T) {
+	mockRepo := mock.NewMockRepository()
+
+	testCommitteeUID := uuid.New().String()
+	testMemberUID := uuid.New().String()
+
+	setup := func() {
+		mockRepo.ClearAll()
+		mockRepo.AddCommittee(&model.Committee{
+			CommitteeBase: model.CommitteeBase{
+				UID:  testCommitteeUID,
+				Name: "Test Committee",
+			},
+			CommitteeSettings: &model.CommitteeSettings{
+				UID:      testCommitteeUID,
+				Writers:  []string{"writer"},
+				Auditors: []string{"auditor"},
+			},
+		})
+		mockRepo.AddCommitteeMember(testCommitteeUID, &model.CommitteeMember{
+			CommitteeMemberBase: model.CommitteeMemberBase{
+				UID:          testMemberUID,
+				CommitteeUID: testCommitteeUID,
+				Username:     "member",
+				Email:        "test@example.com",
+				FirstName:    "John",
+				LastName:     "Doe",
+				JobTitle:     "Software Engineer",
+			},
+		})
+	}
+
+	tests := []struct {
+		name             string
+		sensitiveFields  model.MemberSensitiveFields
+		principal        string
+		expectedEmail    string
+		expectedJobTitle string
+	}{
+		{
+			name:             "writer gets the sensitive fields",
+			principal:        "writer",
+			expectedEmail:    "test@example.com",
+			expectedJobTitle: "Software Engineer",
+		},
+		{
+			name:             "auditor gets the sensitive fields",
+			principal:        "auditor",
+			expectedEmail:    "test@example.com",
+			expectedJobTitle: "Software Engineer",
+		},
+		{
+			name:             "member gets their own sensitive fields",
+			principal:        "member",
+			expectedEmail:    "test@example.com",
+			expectedJobTitle: "Software Engineer",
+		},
+		{
+			name:             "unprivileged caller does not get the email",
+			principal:        "viewer",
+			expectedEmail:    "",
+			expectedJobTitle: "Software Engineer",
+		},
+		{
+			name:             "unauthenticated caller does not get the email",
+			expectedEmail:    "",
+			expectedJobTitle: "Software Engineer",
+		},
+		{
+			name:             "unprivileged caller does not get the configured fields",
+			sensitiveFields:  model.MemberSensitiveFields{"job_title"},
+			principal:        "viewer",
+			expectedEmail:    "",
+			expectedJobTitle: "",
+		},
+		{
+			name:             "writer gets the configured fields",
+			sensitiveFields:  model.MemberSensitiveFields{"job_title"},
+			principal:        "writer",
+			expectedEmail:    "test@example.com",
+			expectedJobTitle: "Software Engineer",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup()
+
+			ctx := context.Background()
+			if tt.principal != "" {
+				ctx = context.WithValue(ctx, constants.PrincipalContextID, tt.principal)
+			}
+
+			reader := NewCommitteeReaderOrchestrator(
+				WithCommitteeReader(mockRepo),
+				WithMemberSensitiveFields(tt.sensitiveFields),
+			)
+
+			member, _, err := reader.GetMember(ctx, testCommitteeUID, testMemberUID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedEmail, member.Email)
+			assert.Equal(t, tt.expectedJobTitle, member.JobTitle)
+			assert.Equal(t, "John", member.FirstName)
+
+			// the stored member is never redacted
+			stored, _, errGet := mockRepo.GetMember(ctx, testMemberUID)
+			require.NoError(t, errGet)
+			assert.Equal(t, "test@example.com", stored.Email)
+			assert.Equal(t, "Software Engineer", stored.JobTitle)
+		})
+	}
+}
+
 // Helper function to create string pointer (same as in committee_writer_test.go)
+func TestCommitteeReaderOrchestratorListCommittees(t *testing.T) {
+	mockRepo := mock.NewMockRepository()
+
+	public := true
+	private := false
+	votingEnabled := true
+	votingDisabled := false
+
+	tests := []struct {
+		name          string
+		principal     string
+		filter        model.CommitteeFilter
+		expectedNames []string
+	}{
+		{
+			name:          "authenticated caller without filter sees every committee",
+			principal:     "user-123",
+			filter:        model.CommitteeFilter{},
+			expectedNames: []string{"Public Committee", "Private Committee"},
+		},
+		{
+			name:          "public filter returns only public committees",
+			principal:     "user-123",
+			filter:        model.CommitteeFilter{Public: &public},
+			expectedNames: []string{"Public Committee"},
+		},
+		{
+			name:          "private filter returns only private committees",
+			principal:     "user-123",
+			filter:        model.CommitteeFilter{Public: &private},
+			expectedNames: []string{"Private Committee"},
+		},
+		{
+			name:          "unauthenticated caller is forced to public committees",
+			filter:        model.CommitteeFilter{},
+			expectedNames: []string{"Public Committee"},
+		},
+		{
+			name:          "unauthenticated caller requesting private committees still gets public ones",
+			filter:        model.CommitteeFilter{Public: &private},
+			expectedNames: []string{"Public Committee"},
+		},
+		{
+			name:          "label selector returns committees having the label",
+			principal:     "user-123",
+			filter:        model.CommitteeFilter{Labels: map[string]string{"region": "emea"}},
+			expectedNames: []string{"Public Committee"},
+		},
+		{
+			name:          "label selector requires every label to match",
+			principal:     "user-123",
+			filter:        model.CommitteeFilter{Labels: map[string]string{"region": "emea", "tier": "gold"}},
+			expectedNames: []string{},
+		},
+		{
+			name:          "label selector combined with visibility",
+			principal:     "user-123",
+			filter:        model.CommitteeFilter{Public: &private, Labels: map[string]string{"region": "amer"}},
+			expectedNames: []string{"Private Committee"},
+		},
+		{
+			name:          "enable voting filter returns only voting committees",
+			principal:     "user-123",
+			filter:        model.CommitteeFilter{EnableVoting: &votingEnabled},
+			expectedNames: []string{"Public Committee"},
+		},
+		{
+			name:          "disabled voting filter returns only non-voting committees",
+			principal:     "user-123",
+			filter:        model.CommitteeFilter{EnableVoting: &votingDisabled},
+			expectedNames: []string{"Private Committee"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo.ClearAll()
+			mockRepo.AddCommittee(&model.Committee{
+				CommitteeBase: model.CommitteeBase{
+					UID:          uuid.New().String(),
+					Name:         "Public Committee",
+					Public:       true,
+					EnableVoting: true,
+					Labels:       map[string]string{"region": "emea"},
+				},
+			})
+			mockRepo.AddCommittee(&model.Committee{
+				CommitteeBase: model.CommitteeBase{
+					UID:    uuid.New().String(),
+					Name:   "Private Committee",
+					Public: false,
+					Labels: map[string]string{"region": "amer"},
+				},
+			})
+
+			reader := NewCommitteeReaderOrchestrator(
+				WithCommitteeReader(mockRepo),
+			)
+
+			ctx := context.Background()
+			if tt.principal != "" {
+				ctx = context.WithValue(ctx, constants.PrincipalContextID, tt.principal)
+			}
+
+			committees, err := reader.ListCommittees(ctx, tt.filter)
+			require.NoError(t, err)
+
+			names := make([]string, 0, len(committees))
+			for _, committee := range committees {
+				names = append(names, committee.Name)
+			}
+			assert.ElementsMatch(t, tt.expectedNames, names)
+		})
+	}
+}
+
 func readerStringPtr(s string) *string {
 	return &s
 }
+
+func TestCommitteeReaderOrchestratorGetFull(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := mock.NewMockRepository()
+
+	testCommitteeUID := uuid.New().String()
+
+	tests := []struct {
+		name          string
+		setupMock     func()
+		committeeUID  string
+		expectedError bool
+		errorType     error
+	}{
+		{
+			name: "base and settings are returned with their revisions",
+			setupMock: func() {
+				mockRepo.ClearAll()
+				mockRepo.AddCommittee(&model.Committee{
+					CommitteeBase: model.CommitteeBase{
+						UID:  testCommitteeUID,
+						Name: "Test Committee",
+					},
+					CommitteeSettings: &model.CommitteeSettings{
+						UID:                   testCommitteeUID,
+						BusinessEmailRequired: true,
+					},
+				})
+			},
+			committeeUID: testCommitteeUID,
+		},
+		{
+			name: "committee not found",
+			setupMock: func() {
+				mockRepo.ClearAll()
+			},
+			committeeUID:  "nonexistent-committee-uid",
+			expectedError: true,
+			errorType:     errs.NotFound{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			reader := NewCommitteeReaderOrchestrator(
+				WithCommitteeReader(mockRepo),
+			)
+
+			committee, revision, err := reader.GetFull(ctx, tt.committeeUID)
+
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.IsType(t, tt.errorType, err)
+				assert.Nil(t, committee)
+				assert.Equal(t, model.CommitteeRevision{}, revision)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, committee)
+			require.NotNil(t, committee.CommitteeSettings)
+			assert.Equal(t, "Test Committee", committee.Name)
+			assert.True(t, committee.BusinessEmailRequired)
+			assert.Equal(t, uint64(1), revision.Base)
+			assert.Equal(t, uint64(1), revision.Settings)
+		})
+	}
+}
+
+func TestCommitteeReaderOrchestratorGetWithMembers(t *testing.T) {
+	mockRepo := mock.NewMockRepository()
+
+	testCommitteeUID := uuid.New().String()
+
+	setup := func() {
+		mockRepo.ClearAll()
+		mockRepo.AddCommittee(&model.Committee{
+			CommitteeBase: model.CommitteeBase{
+				UID:  testCommitteeUID,
+				Name: "Test Committee",
+			},
+			CommitteeSettings: &model.CommitteeSettings{
+				UID:      testCommitteeUID,
+				Writers:  []string{"writer"},
+				Auditors: []string{"auditor"},
+			},
+		})
+		for i, lastName := range []string{"Evans", "Adams", "Davis", "Clark", "Brown"} {
+			mockRepo.AddCommitteeMember(testCommitteeUID, &model.CommitteeMember{
+				CommitteeMemberBase: model.CommitteeMemberBase{
+					UID:          fmt.Sprintf("member-%d", i),
+					CommitteeUID: testCommitteeUID,
+					Username:     strings.ToLower(lastName),
+					Email:        strings.ToLower(lastName) + "@example.com",
+					FirstName:    "Alex",
+					LastName:     lastName,
+				},
+			})
+		}
+	}
+
+	tests := []struct {
+		name              string
+		principal         string
+		page              model.PageOptions
+		expectedLastNames []string
+		expectedNextToken string
+		expectedEmails    []string
+		expectedErr       error
+	}{
+		{
+			name:              "first page of the members ordered by last name",
+			principal:         "writer",
+			page:              model.PageOptions{PageSize: 2},
+			expectedLastNames: []string{"Adams", "Brown"},
+			expectedNextToken: "2",
+			expectedEmails:    []string{"adams@example.com", "brown@example.com"},
+		},
+		{
+			name:              "last page has no next page token",
+			principal:         "auditor",
+			page:              model.PageOptions{PageSize: 2, PageToken: "4"},
+			expectedLastNames: []string{"Evans"},
+			expectedEmails:    []string{"evans@example.com"},
+		},
+		{
+			name:              "page past the end is empty",
+			principal:         "writer",
+			page:              model.PageOptions{PageSize: 2, PageToken: "10"},
+			expectedLastNames: []string{},
+			expectedEmails:    []string{},
+		},
+		{
+			name:              "unprivileged caller only gets their own email",
+			principal:         "brown",
+			page:              model.PageOptions{PageSize: 3},
+			expectedLastNames: []string{"Adams", "Brown", "Clark"},
+			expectedNextToken: "3",
+			expectedEmails:    []string{"", "brown@example.com", ""},
+		},
+		{
+			name:              "unauthenticated caller does not get the emails",
+			page:              model.PageOptions{PageSize: 2},
+			expectedLastNames: []string{"Adams", "Brown"},
+			expectedNextToken: "2",
+			expectedEmails:    []string{"", ""},
+		},
+		{
+			name:        "invalid page size",
+			principal:   "writer",
+			page:        model.PageOptions{},
+			expectedErr: errs.Validation{},
+		},
+		{
+			name:        "invalid page token",
+			principal:   "writer",
+			page:        model.PageOptions{PageSize: 2, PageToken: "abc"},
+			expectedErr: errs.Validation{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup()
+
+			ctx := context.Background()
+			if tt.principal != "" {
+				ctx = context.WithValue(ctx, constants.PrincipalContextID, tt.principal)
+			}
+
+			reader := NewCommitteeReaderOrchestrator(WithCommitteeReader(mockRepo))
+
+			committee, members, err := reader.GetWithMembers(ctx, testCommitteeUID, tt.page)
+			if tt.expectedErr != nil {
+				require.Error(t, err)
+				assert.IsType(t, tt.expectedErr, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, testCommitteeUID, committee.CommitteeBase.UID)
+			require.NotNil(t, committee.CommitteeSettings)
+			assert.Equal(t, []string{"writer"}, committee.Writers)
+
+			assert.Equal(t, 5, members.TotalCount)
+			assert.Equal(t, tt.expectedNextToken, members.NextPageToken)
+			lastNames := make([]string, 0, len(members.Items))
+			emails := make([]string, 0, len(members.Items))
+			for _, member := range members.Items {
+				lastNames = append(lastNames, member.LastName)
+				emails = append(emails, member.Email)
+			}
+			assert.Equal(t, tt.expectedLastNames, lastNames)
+			assert.Equal(t, tt.expectedEmails, emails)
+		})
+	}
+
+	t.Run("committee not found", func(t *testing.T) {
+		setup()
+
+		reader := NewCommitteeReaderOrchestrator(WithCommitteeReader(mockRepo))
+
+		_, _, err := reader.GetWithMembers(context.Background(), uuid.New().String(), model.PageOptions{PageSize: 2})
+		require.Error(t, err)
+		assert.IsType(t, errs.NotFound{}, err)
+	})
+}
+
+func TestCommitteeReaderOrchestratorGetBaseWithChildCount(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := mock.NewMockRepository()
+
+	parentUID := uuid.New().String()
+	leafUID := uuid.New().String()
+
+	setup := func() {
+		mockRepo.ClearAll()
+		mockRepo.AddCommittee(&model.Committee{
+			CommitteeBase: model.CommitteeBase{
+				UID:  parentUID,
+				Name: "Parent Committee",
+			},
+		})
+		for _, name := range []string{"Child A", "Child B"} {
+			mockRepo.AddCommittee(&model.Committee{
+				CommitteeBase: model.CommitteeBase{
+					UID:       uuid.New().String(),
+					Name:      name,
+					ParentUID: &parentUID,
+				},
+			})
+		}
+		mockRepo.AddCommittee(&model.Committee{
+			CommitteeBase: model.CommitteeBase{
+				UID:  leafUID,
+				Name: "Leaf Committee",
+			},
+		})
+	}
+
+	tests := []struct {
+		name          string
+		committeeUID  string
+		expectedName  string
+		expectedCount int
+		expectedError bool
+		errorType     error
+	}{
+		{
+			name:          "committee with children",
+			committeeUID:  parentUID,
+			expectedName:  "Parent Committee",
+			expectedCount: 2,
+		},
+		{
+			name:          "committee without children",
+			committeeUID:  leafUID,
+			expectedName:  "Leaf Committee",
+			expectedCount: 0,
+		},
+		{
+			name:          "committee not found",
+			committeeUID:  "nonexistent-committee-uid",
+			expectedError: true,
+			errorType:     errs.NotFound{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup()
+
+			reader := NewCommitteeReaderOrchestrator(
+				WithCommitteeReader(mockRepo),
+			)
+
+			base, count, err := reader.GetBaseWithChildCount(ctx, tt.committeeUID)
+
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.IsType(t, tt.errorType, err)
+				assert.Nil(t, base)
+				assert.Zero(t, count)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, base)
+			assert.Equal(t, tt.expectedName, base.Name)
+			assert.Equal(t, tt.expectedCount, count)
+		})
+	}
+}
+
+func TestCommitteeReaderOrchestratorListChildren(t *testing.T) {
+	ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "reader")
+	mockRepo := mock.NewMockRepository()
+
+	parentUID := uuid.New().String()
+	leafUID := uuid.New().String()
+
+	mockRepo.ClearAll()
+	mockRepo.AddCommittee(&model.Committee{CommitteeBase: model.CommitteeBase{UID: parentUID, Name: "Parent Committee"}})
+	mockRepo.AddCommittee(&model.Committee{CommitteeBase: model.CommitteeBase{UID: leafUID, Name: "Leaf Committee", ParentUID: &parentUID}})
+	for _, name := range []string{"Child C", "Child A", "Child B"} {
+		mockRepo.AddCommittee(&model.Committee{
+			CommitteeBase: model.CommitteeBase{
+				UID:       uuid.New().String(),
+				Name:      name,
+				ParentUID: &parentUID,
+			},
+		})
+	}
+
+	reader := NewCommitteeReaderOrchestrator(
+		WithCommitteeReader(mockRepo),
+	)
+
+	names := func(children []*model.CommitteeBase) []string {
+		result := make([]string, 0, len(children))
+		for _, child := range children {
+			result = append(result, child.Name)
+		}
+		return result
+	}
+
+	t.Run("committee with several children", func(t *testing.T) {
+		children, nextPageToken, err := reader.ListChildren(ctx, parentUID, 50, "")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Child A", "Child B", "Child C", "Leaf Committee"}, names(children))
+		assert.Empty(t, nextPageToken)
+	})
+
+	t.Run("leaf committee", func(t *testing.T) {
+		children, nextPageToken, err := reader.ListChildren(ctx, leafUID, 50, "")
+		require.NoError(t, err)
+		assert.Empty(t, children)
+		assert.Empty(t, nextPageToken)
+	})
+
+	t.Run("pagination across children", func(t *testing.T) {
+		var (
+			pages     [][]string
+			pageToken string
+		)
+		for {
+			children, nextPageToken, err := reader.ListChildren(ctx, parentUID, 3, pageToken)
+			require.NoError(t, err)
+			pages = append(pages, names(children))
+			if nextPageToken == "" {
+				break
+			}
+			pageToken = nextPageToken
+		}
+		assert.Equal(t, [][]string{{"Child A", "Child B", "Child C"}, {"Leaf Committee"}}, pages)
+	})
+
+	t.Run("invalid page token", func(t *testing.T) {
+		_, _, err := reader.ListChildren(ctx, parentUID, 3, "not-a-token")
+		require.Error(t, err)
+		assert.IsType(t, errs.Validation{}, err)
+	})
+
+	t.Run("committee not found", func(t *testing.T) {
+		_, _, err := reader.ListChildren(ctx, "nonexistent-committee-uid", 3, "")
+		require.Error(t, err)
+		assert.IsType(t, errs.NotFound{}, err)
+	})
+}
+
+func TestCommitteeReaderOrchestratorGetMembersAsOf(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := mock.NewMockRepository()
+
+	testCommitteeUID := uuid.New().String()
+
+	setup := func() {
+		mockRepo.ClearAll()
+		mockRepo.AddCommittee(&model.Committee{
+			CommitteeBase: model.CommitteeBase{
+				UID:  testCommitteeUID,
+				Name: "Test Committee",
+			},
+		})
+		mockRepo.AddCommitteeMember(testCommitteeUID, &model.CommitteeMember{
+			CommitteeMemberBase: model.CommitteeMemberBase{
+				UID:          "member-2023",
+				CommitteeUID: testCommitteeUID,
+				Email:        "former@example.com",
+				Role:         model.CommitteeMemberRole{Name: "Chair", StartDate: "2023-01-01", EndDate: "2023-12-31"},
+				Voting:       model.CommitteeMemberVotingInfo{Status: "Voting Rep", StartDate: "2023-01-01", EndDate: "2023-12-31"},
+			},
+		})
+		mockRepo.AddCommitteeMember(testCommitteeUID, &model.CommitteeMember{
+			CommitteeMemberBase: model.CommitteeMemberBase{
+				UID:          "member-2024",
+				CommitteeUID: testCommitteeUID,
+				Email:        "current@example.com",
+				Role:         model.CommitteeMemberRole{Name: "Chair", StartDate: "2024-01-01"},
+				Voting:       model.CommitteeMemberVotingInfo{Status: "Voting Rep", StartDate: "2024-03-01"},
+			},
+		})
+	}
+
+	tests := []struct {
+		name          string
+		committeeUID  string
+		date          time.Time
+		expectedError bool
+		errorType     error
+		validate      func(*testing.T, []*model.CommitteeMember)
+	}{
+		{
+			name:         "date within the first member window only",
+			committeeUID: testCommitteeUID,
+			date:         time.Date(2023, 6, 15, 0, 0, 0, 0, time.UTC),
+			validate: func(t *testing.T, members []*model.CommitteeMember) {
+				require.Len(t, members, 1)
+				assert.Equal(t, "member-2023", members[0].UID)
+				assert.Equal(t, "Chair", members[0].Role.Name)
+				assert.Equal(t, "Voting Rep", members[0].Voting.Status)
+			},
+		},
+		{
+			name:         "date within the second member role window but before voting started",
+			committeeUID: testCommitteeUID,
+			date:         time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			validate: func(t *testing.T, members []*model.CommitteeMember) {
+				require.Len(t, members, 1)
+				assert.Equal(t, "member-2024", members[0].UID)
+				assert.Equal(t, "Chair", members[0].Role.Name)
+				assert.Equal(t, "None", members[0].Voting.Status)
+			},
+		},
+		{
+			name:         "date outside every member window",
+			committeeUID: testCommitteeUID,
+			date:         time.Date(2022, 6, 15, 0, 0, 0, 0, time.UTC),
+			validate: func(t *testing.T, members []*model.CommitteeMember) {
+				assert.Empty(t, members)
+			},
+		},
+		{
+			name:          "committee not found",
+			committeeUID:  "nonexistent-committee-uid",
+			date:          time.Date(2023, 6, 15, 0, 0, 0, 0, time.UTC),
+			expectedError: true,
+			errorType:     errs.NotFound{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup()
+
+			reader := NewCommitteeReaderOrchestrator(
+				WithCommitteeReader(mockRepo),
+			)
+
+			members, err := reader.GetMembersAsOf(ctx, tt.committeeUID, tt.date)
+
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.IsType(t, tt.errorType, err)
+				assert.Nil(t, members)
+				return
+			}
+
+			require.NoError(t, err)
+			tt.validate(t, members)
+		})
+	}
+}
+
+func TestCommitteeReaderOrchestratorListExpiringMembers(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := mock.NewMockRepository()
+
+	testCommitteeUID := uuid.New().String()
+	inDays := func(days int) string {
+		return time.Now().UTC().AddDate(0, 0, days).Format(model.MemberDateLayout)
+	}
+
+	setup := func() {
+		mockRepo.ClearAll()
+		mockRepo.AddCommittee(&model.Committee{
+			CommitteeBase: model.CommitteeBase{
+				UID:  testCommitteeUID,
+				Name: "Test Committee",
+			},
+		})
+		mockRepo.AddCommitteeMember(testCommitteeUID, &model.CommitteeMember{
+			CommitteeMemberBase: model.CommitteeMemberBase{
+				UID:          "member-role-expiring",
+				CommitteeUID: testCommitteeUID,
+				Email:        "role@example.com",
+				Role:         model.CommitteeMemberRole{Name: "Chair", StartDate: "2024-01-01", EndDate: inDays(10)},
+			},
+		})
+		mockRepo.AddCommitteeMember(testCommitteeUID, &model.CommitteeMember{
+			CommitteeMemberBase: model.CommitteeMemberBase{
+				UID:          "member-voting-expiring",
+				CommitteeUID: testCommitteeUID,
+				Email:        "voting@example.com",
+				Role:         model.CommitteeMemberRole{Name: "None"},
+				Voting:       model.CommitteeMemberVotingInfo{Status: "Voting Rep", StartDate: "2024-01-01", EndDate: inDays(25)},
+			},
+		})
+		mockRepo.AddCommitteeMember(testCommitteeUID, &model.CommitteeMember{
+			CommitteeMemberBase: model.CommitteeMemberBase{
+				UID:          "member-beyond",
+				CommitteeUID: testCommitteeUID,
+				Email:        "beyond@example.com",
+				Role:         model.CommitteeMemberRole{Name: "Secretary", StartDate: "2024-01-01", EndDate: inDays(120)},
+			},
+		})
+		mockRepo.AddCommitteeMember(testCommitteeUID, &model.CommitteeMember{
+			CommitteeMemberBase: model.CommitteeMemberBase{
+				UID:          "member-lapsed",
+				CommitteeUID: testCommitteeUID,
+				Email:        "lapsed@example.com",
+				Role:         model.CommitteeMemberRole{Name: "Secretary", StartDate: "2023-01-01", EndDate: inDays(-5)},
+			},
+		})
+		mockRepo.AddCommitteeMember(testCommitteeUID, &model.CommitteeMember{
+			CommitteeMemberBase: model.CommitteeMemberBase{
+				UID:          "member-open-ended",
+				CommitteeUID: testCommitteeUID,
+				Email:        "open@example.com",
+				Role:         model.CommitteeMemberRole{Name: "Chair", StartDate: "2024-01-01"},
+				Voting:       model.CommitteeMemberVotingInfo{Status: "Voting Rep", StartDate: "2024-01-01"},
+			},
+		})
+	}
+
+	memberUIDs := func(members []*model.CommitteeMember) []string {
+		uids := make([]string, 0, len(members))
+		for _, member := range members {
+			uids = append(uids, member.UID)
+		}
+		return uids
+	}
+
+	tests := []struct {
+		name          string
+		committeeUID  string
+		within        time.Duration
+		expectedError bool
+		errorType     error
+		validate      func(*testing.T, []*model.CommitteeMember)
+	}{
+		{
+			name:         "role and voting end dates within the window",
+			committeeUID: testCommitteeUID,
+			within:       30 * 24 * time.Hour,
+			validate: func(t *testing.T, members []*model.CommitteeMember) {
+				assert.ElementsMatch(t, []string{"member-role-expiring", "member-voting-expiring"}, memberUIDs(members))
+			},
+		},
+		{
+			name:         "narrow window excludes later end dates",
+			committeeUID: testCommitteeUID,
+			within:       15 * 24 * time.Hour,
+			validate: func(t *testing.T, members []*model.CommitteeMember) {
+				assert.Equal(t, []string{"member-role-expiring"}, memberUIDs(members))
+			},
+		},
+		{
+			name:         "wide window includes later end dates but never open-ended tenure",
+			committeeUID: testCommitteeUID,
+			within:       365 * 24 * time.Hour,
+			validate: func(t *testing.T, members []*model.CommitteeMember) {
+				assert.ElementsMatch(t, []string{"member-role-expiring", "member-voting-expiring", "member-beyond"}, memberUIDs(members))
+			},
+		},
+		{
+			name:          "negative window",
+			committeeUID:  testCommitteeUID,
+			within:        -time.Hour,
+			expectedError: true,
+			errorType:     errs.Validation{},
+		},
+		{
+			name:          "committee not found",
+			committeeUID:  "nonexistent-committee-uid",
+			within:        30 * 24 * time.Hour,
+			expectedError: true,
+			errorType:     errs.NotFound{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup()
+
+			reader := NewCommitteeReaderOrchestrator(
+				WithCommitteeReader(mockRepo),
+			)
+
+			members, err := reader.ListExpiringMembers(ctx, tt.committeeUID, tt.within)
+
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.IsType(t, tt.errorType, err)
+				assert.Nil(t, members)
+				return
+			}
+
+			require.NoError(t, err)
+			tt.validate(t, members)
+		})
+	}
+}
+
+func TestCommitteeReaderOrchestratorGetMemberProjectSummary(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := mock.NewMockRepository()
+
+	setup := func() {
+		mockRepo.ClearAll()
+		for _, committee := range []model.CommitteeBase{
+			{UID: "committee-tsc", ProjectUID: "project-1", Name: "Technical Steering Committee"},
+			{UID: "committee-board", ProjectUID: "project-1", Name: "Board"},
+			{UID: "committee-marketing", ProjectUID: "project-1", Name: "Marketing"},
+			{UID: "committee-other", ProjectUID: "project-2", Name: "Other Project Board"},
+		} {
+			mockRepo.AddCommittee(&model.Committee{CommitteeBase: committee})
+		}
+
+		addMember := func(uid, committeeUID, email, role, voting string) {
+			mockRepo.AddCommitteeMember(committeeUID, &model.CommitteeMember{
+				CommitteeMemberBase: model.CommitteeMemberBase{
+					UID:          uid,
+					CommitteeUID: committeeUID,
+					Email:        email,
+					Role:         model.CommitteeMemberRole{Name: role},
+					Voting:       model.CommitteeMemberVotingInfo{Status: voting},
+				},
+			})
+		}
+		addMember("member-tsc", "committee-tsc", "jane@example.com", "Chair", "Voting Rep")
+		addMember("member-board", "committee-board", " Jane@Example.com", "Director", "Alternate Voting Rep")
+		addMember("member-marketing", "committee-marketing", "john@example.com", "Lead", "Observer")
+		addMember("member-other", "committee-other", "jane@example.com", "Director", "Voting Rep")
+	}
+
+	tests := []struct {
+		name          string
+		projectUID    string
+		email         string
+		expectedError bool
+		errorType     error
+		expected      []*model.CommitteeMembershipSummary
+	}{
+		{
+			name:       "person in several committees of the project",
+			projectUID: "project-1",
+			email:      "JANE@example.com ",
+			expected: []*model.CommitteeMembershipSummary{
+				{CommitteeUID: "committee-board", CommitteeName: "Board", Role: "Director", VotingStatus: "Alternate Voting Rep"},
+				{CommitteeUID: "committee-tsc", CommitteeName: "Technical Steering Committee", Role: "Chair", VotingStatus: "Voting Rep"},
+			},
+		},
+		{
+			name:       "person in no committee of the project",
+			projectUID: "project-1",
+			email:      "nobody@example.com",
+			expected:   []*model.CommitteeMembershipSummary{},
+		},
+		{
+			name:          "missing email",
+			projectUID:    "project-1",
+			email:         "  ",
+			expectedError: true,
+			errorType:     errs.Validation{},
+		},
+		{
+			name:          "missing project UID",
+			email:         "jane@example.com",
+			expectedError: true,
+			errorType:     errs.Validation{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup()
+
+			reader := NewCommitteeReaderOrchestrator(
+				WithCommitteeReader(mockRepo),
+			)
+
+			summaries, err := reader.GetMemberProjectSummary(ctx, tt.projectUID, tt.email)
+
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.IsType(t, tt.errorType, err)
+				assert.Nil(t, summaries)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, summaries)
+		})
+	}
+}
+
+func TestCommitteeReaderOrchestratorListMembersWithAccess(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := mock.NewMockRepository()
+	mockRepo.ClearAll()
+
+	mockRepo.AddCommittee(&model.Committee{
+		CommitteeBase: model.CommitteeBase{UID: "committee-access", Name: "Access Committee"},
+		CommitteeSettings: &model.CommitteeSettings{
+			UID:      "committee-access",
+			Writers:  []string{"writer-user", "chair-writer"},
+			Auditors: []string{"auditor-user"},
+		},
+	})
+	addMember := func(uid, username, role string) {
+		mockRepo.AddCommitteeMember("committee-access", &model.CommitteeMember{
+			CommitteeMemberBase: model.CommitteeMemberBase{
+				UID:          uid,
+				CommitteeUID: "committee-access",
+				Username:     username,
+				Role:         model.CommitteeMemberRole{Name: role},
+			},
+		})
+	}
+	addMember("member-1-writer", "writer-user", "Developer Seat")
+	addMember("member-2-auditor", "auditor-user", "None")
+	addMember("member-3-chair", "chair-user", "Chair")
+	addMember("member-4-chair-writer", "chair-writer", "Chair")
+	addMember("member-5-no-username", "", "None")
+
+	reader := NewCommitteeReaderOrchestrator(
+		WithCommitteeReader(mockRepo),
+	)
+
+	accesses, err := reader.ListMembersWithAccess(ctx, "committee-access")
+	require.NoError(t, err)
+
+	relations := map[string][]string{}
+	for _, access := range accesses {
+		relations[access.Member.UID] = access.Relations
+	}
+	assert.Equal(t, map[string][]string{
+		"member-1-writer":       {constants.RelationMember, constants.RelationWriter},
+		"member-2-auditor":      {constants.RelationMember, constants.RelationAuditor},
+		"member-3-chair":        {constants.RelationMember},
+		"member-4-chair-writer": {constants.RelationMember, constants.RelationWriter},
+		"member-5-no-username":  {},
+	}, relations)
+	assert.Equal(t, "member-1-writer", accesses[0].Member.UID)
+
+	t.Run("missing committee", func(t *testing.T) {
+		_, err := reader.ListMembersWithAccess(ctx, "committee-missing")
+		require.Error(t, err)
+		assert.IsType(t, errs.NotFound{}, err)
+	})
+}
+
+func TestCommitteeReaderOrchestratorListMembersByOrganization(t *testing.T) {
+	mockRepo := mock.NewMockRepository()
+	mockRepo.ClearAll()
+
+	mockRepo.AddCommittee(&model.Committee{
+		CommitteeBase: model.CommitteeBase{UID: "committee-org", Name: "Org Committee"},
+		CommitteeSettings: &model.CommitteeSettings{
+			UID:     "committee-org",
+			Writers: []string{"writer"},
+		},
+	})
+	addMember := func(uid, lastName, username, organization string) {
+		mockRepo.AddCommitteeMember("committee-org", &model.CommitteeMember{
+			CommitteeMemberBase: model.CommitteeMemberBase{
+				UID:          uid,
+				CommitteeUID: "committee-org",
+				LastName:     lastName,
+				Username:     username,
+				Email:        strings.ToLower(lastName) + "@example.com",
+				Organization: model.CommitteeMemberOrganization{Name: organization},
+			},
+		})
+	}
+	addMember("member-1", "Smith", "smith", "IBM")
+	addMember("member-2", "Adams", "adams", "ibm corp")
+	addMember("member-3", "Brown", "brown", "The Linux Foundation")
+	addMember("member-4", "Clark", "clark", "")
+
+	tests := []struct {
+		name           string
+		committeeUID   string
+		organization   string
+		principal      string
+		expectedUIDs   []string
+		expectedEmails []string
+		errorType      error
+	}{
+		{
+			name:           "members in the organization",
+			committeeUID:   "committee-org",
+			organization:   "IBM",
+			principal:      "writer",
+			expectedUIDs:   []string{"member-2", "member-1"},
+			expectedEmails: []string{"adams@example.com", "smith@example.com"},
+		},
+		{
+			name:           "normalized organization name matching",
+			committeeUID:   "committee-org",
+			organization:   " i.b.m. ",
+			principal:      "writer",
+			expectedUIDs:   []string{"member-2", "member-1"},
+			expectedEmails: []string{"adams@example.com", "smith@example.com"},
+		},
+		{
+			name:           "no member in the organization",
+			committeeUID:   "committee-org",
+			organization:   "Acme",
+			principal:      "writer",
+			expectedUIDs:   []string{},
+			expectedEmails: []string{},
+		},
+		{
+			name:           "emails are redacted for other callers except their own",
+			committeeUID:   "committee-org",
+			organization:   "ibm",
+			principal:      "smith",
+			expectedUIDs:   []string{"member-2", "member-1"},
+			expectedEmails: []string{"", "smith@example.com"},
+		},
+		{
+			name:         "missing organization name",
+			committeeUID: "committee-org",
+			organization: "  ",
+			errorType:    errs.Validation{},
+		},
+		{
+			name:         "missing committee",
+			committeeUID: "committee-missing",
+			organization: "IBM",
+			errorType:    errs.NotFound{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.principal != "" {
+				ctx = context.WithValue(ctx, constants.PrincipalContextID, tt.principal)
+			}
+
+			reader := NewCommitteeReaderOrchestrator(
+				WithCommitteeReader(mockRepo),
+			)
+
+			members, err := reader.ListMembersByOrganization(ctx, tt.committeeUID, tt.organization)
+			if tt.errorType != nil {
+				require.Error(t, err)
+				assert.IsType(t, tt.errorType, err)
+				return
+			}
+			require.NoError(t, err)
+
+			uids := []string{}
+			emails := []string{}
+			for _, member := range members {
+				uids = append(uids, member.UID)
+				emails = append(emails, member.Email)
+			}
+			assert.Equal(t, tt.expectedUIDs, uids)
+			assert.Equal(t, tt.expectedEmails, emails)
+		})
+	}
+}
+
+func TestCommitteeReaderOrchestratorGetMemberHistory(t *testing.T) {
+	mockRepo := mock.NewMockRepository()
+	mockRepo.ClearAll()
+
+	mockRepo.AddCommittee(&model.Committee{
+		CommitteeBase: model.CommitteeBase{UID: "committee-history", Name: "History Committee"},
+		CommitteeSettings: &model.CommitteeSettings{
+			UID:     "committee-history",
+			Writers: []string{"writer"},
+		},
+	})
+	mockRepo.AddCommittee(&model.Committee{CommitteeBase: model.CommitteeBase{UID: "committee-other", Name: "Other Committee"}})
+	mockRepo.AddCommitteeMember("committee-history", &model.CommitteeMember{
+		CommitteeMemberBase: model.CommitteeMemberBase{
+			UID:          "member-history",
+			CommitteeUID: "committee-history",
+			Username:     "jdoe",
+			Email:        "jdoe@example.com",
+			Organization: model.CommitteeMemberOrganization{Name: "Acme"},
+			Role:         model.CommitteeMemberRole{Name: "None"},
+		},
+	})
+
+	// Store two more revisions of the member, changing its organization and then its role
+	writer := mock.NewMockCommitteeWriter(mockRepo)
+	for revision, change := range []func(*model.CommitteeMember){
+		func(member *model.CommitteeMember) { member.Organization.Name = "Globex" },
+		func(member *model.CommitteeMember) { member.Role.Name = "Chair" },
+	} {
+		member, _, err := mockRepo.GetMember(context.Background(), "member-history")
+		require.NoError(t, err)
+		change(member)
+		_, err = writer.UpdateMember(context.Background(), member, uint64(revision+1))
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		name              string
+		committeeUID      string
+		memberUID         string
+		limit             int
+		principal         string
+		expectedRevisions []uint64
+		expectedOrgs      []string
+		expectedRoles     []string
+		expectedEmails    []string
+		errorType         error
+	}{
+		{
+			name:              "all revisions newest first",
+			committeeUID:      "committee-history",
+			memberUID:         "member-history",
+			principal:         "writer",
+			expectedRevisions: []uint64{3, 2, 1},
+			expectedOrgs:      []string{"Globex", "Globex", "Acme"},
+			expectedRoles:     []string{"Chair", "None", "None"},
+			expectedEmails:    []string{"jdoe@example.com", "jdoe@example.com", "jdoe@example.com"},
+		},
+		{
+			name:              "limit keeps the newest revisions",
+			committeeUID:      "committee-history",
+			memberUID:         "member-history",
+			limit:             2,
+			principal:         "writer",
+			expectedRevisions: []uint64{3, 2},
+			expectedOrgs:      []string{"Globex", "Globex"},
+			expectedRoles:     []string{"Chair", "None"},
+			expectedEmails:    []string{"jdoe@example.com", "jdoe@example.com"},
+		},
+		{
+			name:              "member reads its own history",
+			committeeUID:      "committee-history",
+			memberUID:         "member-history",
+			principal:         "jdoe",
+			expectedRevisions: []uint64{3, 2, 1},
+			expectedOrgs:      []string{"Globex", "Globex", "Acme"},
+			expectedRoles:     []string{"Chair", "None", "None"},
+			expectedEmails:    []string{"jdoe@example.com", "jdoe@example.com", "jdoe@example.com"},
+		},
+		{
+			name:              "sensitive fields are redacted for other callers",
+			committeeUID:      "committee-history",
+			memberUID:         "member-history",
+			principal:         "someone-else",
+			expectedRevisions: []uint64{3, 2, 1},
+			expectedOrgs:      []string{"Globex", "Globex", "Acme"},
+			expectedRoles:     []string{"Chair", "None", "None"},
+			expectedEmails:    []string{"", "", ""},
+		},
+		{
+			name:         "member of another committee",
+			committeeUID: "committee-other",
+			memberUID:    "member-history",
+			principal:    "writer",
+			errorType:    errs.Validation{},
+		},
+		{
+			name:         "missing member",
+			committeeUID: "committee-history",
+			memberUID:    "member-missing",
+			principal:    "writer",
+			errorType:    errs.NotFound{},
+		},
+		{
+			name:         "missing committee",
+			committeeUID: "committee-missing",
+			memberUID:    "member-history",
+			principal:    "writer",
+			errorType:    errs.NotFound{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, tt.principal)
+
+			reader := NewCommitteeReaderOrchestrator(
+				WithCommitteeReader(mockRepo),
+			)
+
+			revisions, err := reader.GetMemberHistory(ctx, tt.committeeUID, tt.memberUID, tt.limit)
+			if tt.errorType != nil {
+				require.Error(t, err)
+				assert.IsType(t, tt.errorType, err)
+				return
+			}
+			require.NoError(t, err)
+
+			numbers := []uint64{}
+			orgs := []string{}
+			roles := []string{}
+			emails := []string{}
+			for _, revision := range revisions {
+				numbers = append(numbers, revision.Revision)
+				orgs = append(orgs, revision.Member.Organization.Name)
+				roles = append(roles, revision.Member.Role.Name)
+				emails = append(emails, revision.Member.Email)
+			}
+			assert.Equal(t, tt.expectedRevisions, numbers)
+			assert.Equal(t, tt.expectedOrgs, orgs)
+			assert.Equal(t, tt.expectedRoles, roles)
+			assert.Equal(t, tt.expectedEmails, emails)
+		})
+	}
+}
+
+func TestCommitteeReaderOrchestratorExists(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := mock.NewMockRepository()
+	mockRepo.ClearAll()
+	mockRepo.AddCommittee(&model.Committee{CommitteeBase: model.CommitteeBase{UID: "committee-1", Name: "TSC"}})
+
+	orchestrator := NewCommitteeReaderOrchestrator(
+		WithCommitteeReader(mock.NewMockCommitteeReader(mockRepo)),
+	)
+
+	t.Run("existing committee", func(t *testing.T) {
+		exists, err := orchestrator.Exists(ctx, "committee-1")
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("missing committee", func(t *testing.T) {
+		exists, err := orchestrator.Exists(ctx, "committee-missing")
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+}
@@ -35,7 +35,17 @@ type CommitteeDataWriter interface {
 	// UpdateSettings modifies the settings of an existing committee in the storage
 	UpdateSettings(ctx context.Context, settings *model.CommitteeSettings, revision uint64, sync bool) (*model.CommitteeSettings, error)
 	// Delete removes a committee and all its associated data (secondary indices, settings)
+	// It is rejected when other committees reference the committee as their parent
 	Delete(ctx context.Context, uid string, revision uint64, sync bool) error
+	// DeleteCascade removes a committee like Delete, re-parenting its child committees
+	// to the deleted committee's parent, or to none
+	DeleteCascade(ctx context.Context, uid string, revision uint64, sync bool) error
+	// MarkReviewed records the reviewer and the review time on the committee settings
+	MarkReviewed(ctx context.Context, uid, reviewer string, revision uint64, sync bool) (*model.CommitteeSettings, error)
+	// Touch bumps the committee UpdatedAt and republishes its messages without changing any business field
+	Touch(ctx context.Context, uid string, revision uint64, sync bool) (*model.Committee, error)
+	// RecountMembers recomputes the member and voting representative counts from the stored members
+	RecountMembers(ctx context.Context, uid string, sync bool) (*model.CommitteeBase, error)
 }
 
 // CommitteeMemberDataWriter defines the interface for committee member write operations
@@ -44,10 +54,34 @@ type CommitteeMemberDataWriter interface {
 	CreateMember(ctx context.Context, member *model.CommitteeMember, sync bool) (*model.CommitteeMember, error)
 	// UpdateMember modifies an existing committee member in the storage
 	UpdateMember(ctx context.Context, member *model.CommitteeMember, revision uint64, sync bool) (*model.CommitteeMember, error)
+	// PatchMember modifies the fields of an existing committee member set in the patch, leaving the others unchanged
+	PatchMember(ctx context.Context, committeeUID, memberUID string, patch *model.CommitteeMemberPatch, revision uint64, sync bool) (*model.CommitteeMember, error)
+	// SelfUpdateMember modifies the contact fields of the committee member owned by the caller
+	SelfUpdateMember(ctx context.Context, member *model.CommitteeMember, revision uint64, sync bool) (*model.CommitteeMember, error)
 	// DeleteMember removes a committee member
 	DeleteMember(ctx context.Context, uid string, revision uint64, sync bool) error
+	// ValidateMembers runs the member creation checks over a batch without writing or reserving any key
+	ValidateMembers(ctx context.Context, committeeUID string, members []*model.CommitteeMember) ([]*model.CommitteeMemberValidationResult, error)
+	// ReassignOrganization moves the committee members of an organization to another one, or clears it, reporting the outcome per member
+	ReassignOrganization(ctx context.Context, committeeUID, fromOrg, toOrg string, sync bool) ([]*model.CommitteeMemberReassignResult, error)
 }
 
+const (
+	// defaultSSONameMaxAttempts is the number of SSO group names tried before giving up
+	defaultSSONameMaxAttempts = 100
+	// ssoNameRetryWarnThreshold is the number of attempts after which each retry is reported
+	// as naming contention
+	ssoNameRetryWarnThreshold = 10
+	// defaultBatchConcurrency is the number of batch items processed in parallel
+	defaultBatchConcurrency = 10
+	// defaultMaxIndexerTags is the maximum number of tags of an indexer message
+	defaultMaxIndexerTags = 100
+	// defaultMaxIndexerTagLength is the maximum length, in characters, of an indexer message tag
+	defaultMaxIndexerTagLength = 256
+	// defaultCommitteeLockTTL is the time after which a committee lock that was never released expires
+	defaultCommitteeLockTTL = 30 * time.Second
+)
+
 // committeeWriterOrchestratorOption defines a function type for setting options
 type committeeWriterOrchestratorOption func(*committeeWriterOrchestrator)
 
@@ -86,13 +120,201 @@ func WithUserReader(reader port.UserReader) committeeWriterOrchestratorOption {
 	}
 }
 
+// WithSSONameMaxAttempts sets the number of SSO group names tried before giving up
+func WithSSONameMaxAttempts(maxAttempts int) committeeWriterOrchestratorOption {
+	return func(u *committeeWriterOrchestrator) {
+		u.ssoNameMaxAttempts = maxAttempts
+	}
+}
+
+// WithMemberRequiredFields sets the member fields required per committee category
+func WithMemberRequiredFields(requiredFields model.MemberRequiredFields) committeeWriterOrchestratorOption {
+	return func(u *committeeWriterOrchestrator) {
+		u.memberPolicy.RequiredFields = requiredFields
+	}
+}
+
+// WithAppointedByPolicy sets the appointment mechanisms allowed per committee category
+func WithAppointedByPolicy(policy model.AppointedByPolicy) committeeWriterOrchestratorOption {
+	return func(u *committeeWriterOrchestrator) {
+		u.memberPolicy.AppointedBy = policy
+	}
+}
+
+// WithLegacyCategories sets the non canonical committee categories still accepted during the transition
+func WithLegacyCategories(categories []string) committeeWriterOrchestratorOption {
+	return func(u *committeeWriterOrchestrator) {
+		u.legacyCategories = categories
+	}
+}
+
+// WithVotingDisallowedCategories sets the committee categories where voting cannot be enabled
+func WithVotingDisallowedCategories(categories []string) committeeWriterOrchestratorOption {
+	return func(u *committeeWriterOrchestrator) {
+		u.votingDisallowedCategories = categories
+	}
+}
+
+// WithPublicRequiresReview requires public committees to have review enabled
+func WithPublicRequiresReview(enabled bool) committeeWriterOrchestratorOption {
+	return func(u *committeeWriterOrchestrator) {
+		u.publicRequiresReview = enabled
+	}
+}
+
+// WithMinReviewInterval sets the minimum time between two reviews of the same committee,
+// a zero interval disables the check
+func WithMinReviewInterval(interval time.Duration) committeeWriterOrchestratorOption {
+	return func(u *committeeWriterOrchestrator) {
+		u.minReviewInterval = interval
+	}
+}
+
+// WithBatchConcurrency sets the number of batch items processed in parallel
+func WithBatchConcurrency(concurrency int) committeeWriterOrchestratorOption {
+	return func(u *committeeWriterOrchestrator) {
+		u.batchConcurrency = concurrency
+	}
+}
+
+// WithAllowEmptyWriters allows settings updates to remove every writer of a committee
+func WithAllowEmptyWriters(allow bool) committeeWriterOrchestratorOption {
+	return func(u *committeeWriterOrchestrator) {
+		u.allowEmptyWriters = allow
+	}
+}
+
+// WithEmailOrganizationDomainMatch requires the email domain of members of business email committees
+// to match the website domain of their organization
+func WithEmailOrganizationDomainMatch(enabled bool) committeeWriterOrchestratorOption {
+	return func(u *committeeWriterOrchestrator) {
+		u.emailDomainMatch = enabled
+	}
+}
+
+// WithSettingsUpsert lets settings updates create the settings of committees that have none
+func WithSettingsUpsert(enabled bool) committeeWriterOrchestratorOption {
+	return func(u *committeeWriterOrchestrator) {
+		u.settingsUpsert = enabled
+	}
+}
+
+// WithTagLimits sets the maximum number and length of the tags of indexer messages.
+// Zero values keep the defaults.
+func WithTagLimits(limits model.TagLimits) committeeWriterOrchestratorOption {
+	return func(u *committeeWriterOrchestrator) {
+		u.tagLimits = limits
+	}
+}
+
+// WithWebhookNotifier sets the notifier delivering committee and member changes to outbound webhooks
+func WithWebhookNotifier(notifier port.WebhookNotifier) committeeWriterOrchestratorOption {
+	return func(u *committeeWriterOrchestrator) {
+		u.webhookNotifier = notifier
+	}
+}
+
+// WithCommitteeLocker sets the locker serializing the operations mutating the member counts of a committee
+func WithCommitteeLocker(locker port.CommitteeLocker) committeeWriterOrchestratorOption {
+	return func(u *committeeWriterOrchestrator) {
+		u.committeeLocker = locker
+	}
+}
+
 // committeeWriterOrchestrator orchestrates the committee creation process
 type committeeWriterOrchestrator struct {
-	projectRetriever   port.ProjectReader
-	committeeReader    port.CommitteeReader
-	committeeWriter    port.CommitteeWriter
-	committeePublisher port.CommitteePublisher
-	userReader         port.UserReader
+	projectRetriever           port.ProjectReader
+	committeeReader            port.CommitteeReader
+	committeeWriter            port.CommitteeWriter
+	committeePublisher         port.CommitteePublisher
+	webhookNotifier            port.WebhookNotifier
+	committeeLocker            port.CommitteeLocker
+	userReader                 port.UserReader
+	ssoNameMaxAttempts         int
+	memberPolicy               model.MemberPolicy
+	legacyCategories           []string
+	votingDisallowedCategories []string
+	publicRequiresReview       bool
+	minReviewInterval          time.Duration
+	batchConcurrency           int
+	allowEmptyWriters          bool
+	emailDomainMatch           bool
+	tagLimits                  model.TagLimits
+	settingsUpsert             bool
+}
+
+// batchWorkerCount returns the configured batch concurrency, falling back to the default
+func (uc *committeeWriterOrchestrator) batchWorkerCount() int {
+	if uc.batchConcurrency <= 0 {
+		return defaultBatchConcurrency
+	}
+	return uc.batchConcurrency
+}
+
+// indexerTags bounds the tags of an indexer message to the configured limits, falling back to the defaults
+func (uc *committeeWriterOrchestrator) indexerTags(ctx context.Context, tags []string) []string {
+	limits := uc.tagLimits
+	if limits.MaxTags <= 0 {
+		limits.MaxTags = defaultMaxIndexerTags
+	}
+	if limits.MaxTagLength <= 0 {
+		limits.MaxTagLength = defaultMaxIndexerTagLength
+	}
+	return limits.Apply(ctx, tags)
+}
+
+// lockCommittee acquires the advisory lock of the committee, when a locker is configured,
+// and returns the function releasing it
+func (uc *committeeWriterOrchestrator) lockCommittee(ctx context.Context, uid string) (func(), error) {
+	if uc.committeeLocker == nil {
+		return func() {}, nil
+	}
+
+	unlock, errLock := uc.committeeLocker.LockCommittee(ctx, uid, defaultCommitteeLockTTL)
+	if errLock != nil {
+		slog.ErrorContext(ctx, "failed to acquire committee lock",
+			"error", errLock,
+			"committee_uid", uid,
+		)
+		return nil, errLock
+	}
+	return unlock, nil
+}
+
+// notifyWebhooks delivers the event to the outbound webhooks in the background, when configured.
+// Delivery failures are only logged since the change is already stored.
+func (uc *committeeWriterOrchestrator) notifyWebhooks(ctx context.Context, event *model.CommitteeEvent) {
+	if uc.webhookNotifier == nil || event == nil {
+		return
+	}
+
+	// The delivery, including its retries, outlives the request
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		if errNotify := uc.webhookNotifier.Notify(ctx, event); errNotify != nil {
+			slog.ErrorContext(ctx, "failed to notify webhooks",
+				"error", errNotify,
+				"event_type", event.EventType,
+			)
+		}
+	}()
+}
+
+// notifyCommitteeWebhooks builds the committee event for the action and delivers it to the outbound webhooks
+func (uc *committeeWriterOrchestrator) notifyCommitteeWebhooks(ctx context.Context, action model.MessageAction, base *model.CommitteeBase) {
+	if uc.webhookNotifier == nil {
+		return
+	}
+
+	event, errBuild := (&model.CommitteeEvent{}).Build(ctx, model.ResourceCommittee, action, base)
+	if errBuild != nil {
+		slog.ErrorContext(ctx, "failed to build committee webhook event",
+			"error", errBuild,
+			"action", action,
+		)
+		return
+	}
+	uc.notifyWebhooks(ctx, event)
 }
 
 // deleteKeys removes keys by getting their revision and deleting them
@@ -154,14 +376,21 @@ func (uc *committeeWriterOrchestrator) checkReserveSSOName(ctx context.Context,
 		"committee_name", committee.Name,
 	)
 
-	const maxRetries = 100
-	attempts := 0
+	// The SSO group name is built from the project slug, it can't be enabled without one
+	if strings.TrimSpace(slug) == "" {
+		slog.WarnContext(ctx, "SSO group enabled for a project without slug",
+			"project_uid", committee.ProjectUID,
+			"committee_name", committee.Name,
+		)
+		return "", errs.NewValidation("cannot enable SSO group without a project slug")
+	}
 
-	for {
-		attempts++
-		if attempts > maxRetries {
-			return "", errs.NewUnexpected("exceeded maximum retries for SSO name generation")
-		}
+	maxAttempts := uc.ssoNameMaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultSSONameMaxAttempts
+	}
+
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
 
 		errSSOGroupNameBuild := committee.SSOGroupNameBuild(ctx, slug)
 		if errSSOGroupNameBuild != nil {
@@ -179,9 +408,22 @@ func (uc *committeeWriterOrchestrator) checkReserveSSOName(ctx context.Context,
 
 		key, errBySSOGroupName := uc.committeeWriter.UniqueSSOGroupName(ctx, committee)
 		if errors.As(errBySSOGroupName, &errs.Conflict{}) {
+			if attempt > ssoNameRetryWarnThreshold {
+				// beyond the threshold every retry is reported, so operators
+				// can spot naming contention before the ceiling is reached
+				slog.WarnContext(ctx, "SSO group name contention, retry threshold exceeded",
+					"sso_group_name", committee.SSOGroupName,
+					"existing_uid", key,
+					"attempt", attempt,
+					"max_attempts", maxAttempts,
+					"warn_threshold", ssoNameRetryWarnThreshold,
+				)
+				continue
+			}
 			slog.WarnContext(ctx, "SSO group name already exists, retrying with a new name",
 				"sso_group_name", committee.SSOGroupName,
 				"existing_uid", key,
+				"attempt", attempt,
 			)
 			continue
 		}
@@ -192,17 +434,59 @@ func (uc *committeeWriterOrchestrator) checkReserveSSOName(ctx context.Context,
 
 		slog.DebugContext(ctx, "SSO group name is unique, proceeding with creation",
 			"sso_group_name", committee.SSOGroupName,
+			"attempt", attempt,
 		)
 		return key, nil
 	}
 
+	slog.ErrorContext(ctx, "exceeded maximum attempts for SSO group name generation",
+		"project_slug", slug,
+		"committee_name", committee.Name,
+		"attempts", maxAttempts,
+	)
+	return "", errs.NewUnexpected(fmt.Sprintf("exceeded maximum retries for SSO name generation after %d attempts", maxAttempts))
+}
+
+// ssoGroupNameUnchanged reports whether the SSO group name built from the updated committee name
+// is the one the committee already holds, in which case no new name needs to be reserved
+func (uc *committeeWriterOrchestrator) ssoGroupNameUnchanged(ctx context.Context, committee *model.Committee, existing *model.CommitteeBase, slug string) bool {
+	if !existing.SSOGroupEnabled || existing.SSOGroupName == "" || strings.TrimSpace(slug) == "" {
+		return false
+	}
+
+	candidate := &model.Committee{CommitteeBase: model.CommitteeBase{Name: committee.Name}}
+	if errBuild := candidate.SSOGroupNameBuild(ctx, slug); errBuild != nil {
+		return false
+	}
+
+	return candidate.SSOGroupName == existing.SSOGroupName
+}
+
+// indexCommittee publishes the committee indexer message and, once it is published, records the time
+// so the index status shows whether the search record reflects the latest committee changes
+func (uc *committeeWriterOrchestrator) indexCommittee(ctx context.Context, base *model.CommitteeBase, message *model.CommitteeIndexerMessage, sync bool) error {
+	if errPublish := uc.committeePublisher.Indexer(ctx, constants.IndexCommitteeSubject, message, sync); errPublish != nil {
+		return errPublish
+	}
+
+	indexedAt := time.Now()
+	if errRecord := uc.committeeWriter.RecordIndexed(ctx, base.UID, indexedAt); errRecord != nil {
+		// the index status is informational, a stale record only flags a reindex as pending
+		slog.WarnContext(ctx, "failed to record committee index status",
+			"error", errRecord,
+			"committee_uid", base.UID,
+		)
+		return nil
+	}
+	base.LastIndexedAt = &indexedAt
+	return nil
 }
 
 func (uc *committeeWriterOrchestrator) buildIndexerMessage(ctx context.Context, committee any, tags []string) (*model.CommitteeIndexerMessage, error) {
 
 	indexerMessage := model.CommitteeIndexerMessage{
 		Action: model.ActionCreated,
-		Tags:   tags,
+		Tags:   uc.indexerTags(ctx, tags),
 	}
 
 	messageIndexer, errIndexerMessageBuild := indexerMessage.Build(ctx, committee)
@@ -216,33 +500,101 @@ func (uc *committeeWriterOrchestrator) buildIndexerMessage(ctx context.Context,
 	return messageIndexer, nil
 }
 
-func (uc *committeeWriterOrchestrator) buildAccessControlMessage(ctx context.Context, committee *model.Committee) *model.CommitteeAccessMessage {
+// buildAccessControlMessage builds the access control message of the committee.
+// A committee without project is rejected, an empty project reference would corrupt its access relations.
+func (uc *committeeWriterOrchestrator) buildAccessControlMessage(ctx context.Context, committee *model.Committee) (*model.CommitteeAccessMessage, error) {
+
+	if strings.TrimSpace(committee.ProjectUID) == "" {
+		slog.ErrorContext(ctx, "refusing to build access control message for committee without project",
+			"committee_uid", committee.CommitteeBase.UID,
+		)
+		return nil, errs.NewValidation("committee project UID is required to publish access control messages")
+	}
 
 	message := &model.CommitteeAccessMessage{
 		UID:        committee.CommitteeBase.UID,
 		ObjectType: "committee",
 		Public:     committee.Public,
-		// Relations is reserved for future use and is intentionally left empty.
+		// Relations are filled from the settings writers and auditors, when any
 		Relations: map[string][]string{},
 		References: map[string]string{
-			// project is required in the flow
 			constants.RelationProject: committee.ProjectUID,
 		},
 	}
 
-	if committee.CommitteeSettings != nil && len(committee.Writers) > 0 {
-		message.Relations[constants.RelationWriter] = committee.Writers
-	}
-
-	if committee.CommitteeSettings != nil && len(committee.Auditors) > 0 {
-		message.Relations[constants.RelationAuditor] = committee.Auditors
+	if committee.CommitteeSettings != nil {
+		if writers := accessRelationPrincipals(committee.Writers); len(writers) > 0 {
+			message.Relations[constants.RelationWriter] = writers
+		}
+		if auditors := accessRelationPrincipals(committee.Auditors); len(auditors) > 0 {
+			message.Relations[constants.RelationAuditor] = auditors
+		}
 	}
 
 	slog.DebugContext(ctx, "building access control message",
 		"message", message,
 	)
 
-	return message
+	return message, nil
+}
+
+// accessRelationPrincipals returns the principals of an access relation without blank or duplicated entries,
+// keeping their original order
+func accessRelationPrincipals(principals []string) []string {
+	var (
+		result []string
+		seen   = make(map[string]struct{}, len(principals))
+	)
+	for _, principal := range principals {
+		principal = strings.TrimSpace(principal)
+		if principal == "" {
+			continue
+		}
+		if _, ok := seen[principal]; ok {
+			continue
+		}
+		seen[principal] = struct{}{}
+		result = append(result, principal)
+	}
+	return result
+}
+
+// releaseStaleKeys deletes the lookup keys left behind by an update once the base has been written.
+// Keys that are empty or were reserved again by the same update are skipped, so a key the stored
+// committee relies on is never released.
+func (uc *committeeWriterOrchestrator) releaseStaleKeys(ctx context.Context, staleKeys, newKeys []string) {
+	reserved := make(map[string]struct{}, len(newKeys))
+	for _, key := range newKeys {
+		reserved[key] = struct{}{}
+	}
+
+	keys := make([]string, 0, len(staleKeys))
+	for _, key := range staleKeys {
+		if key == "" {
+			continue
+		}
+		if _, ok := reserved[key]; ok {
+			slog.WarnContext(ctx, "stale key is still reserved by the update, keeping it",
+				"key", key,
+			)
+			continue
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return
+	}
+
+	slog.DebugContext(ctx, "cleaning up stale keys",
+		"keys_count", len(keys),
+	)
+	go func() {
+		// Cleanup stale keys in a separate goroutine
+		// new context to avoid blocking the main flow
+		ctxCleanup, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+		uc.deleteKeys(ctxCleanup, keys, false)
+	}()
 }
 
 func (uc *committeeWriterOrchestrator) rebuildCommitteeNameIndex(ctx context.Context, newNameKey string, existing *model.CommitteeBase) string {
@@ -288,7 +640,11 @@ func (uc *committeeWriterOrchestrator) mergeCommitteeData(ctx context.Context, e
 	updated.CommitteeBase.UpdatedAt = time.Now()
 
 	// Log SSO group name update if applicable
-	if existing.Name != updated.Name && updated.SSOGroupEnabled {
+	switch {
+	case !updated.SSOGroupEnabled:
+		// SSO group integration disabled, the reserved name is released
+		ssoGroupName = ""
+	case existing.Name != updated.Name || !existing.SSOGroupEnabled:
 		slog.DebugContext(ctx, "SSO group name updated",
 			"old_sso_name", existing.SSOGroupName,
 			"new_sso_name", updated.SSOGroupName,
@@ -307,6 +663,36 @@ func (uc *committeeWriterOrchestrator) Create(ctx context.Context, committee *mo
 		"sync", sync,
 	)
 
+	if errCategory := committee.ValidateCategory(uc.legacyCategories); errCategory != nil {
+		slog.WarnContext(ctx, "invalid committee category",
+			"error", errCategory,
+			"category", committee.Category,
+		)
+		return nil, errCategory
+	}
+
+	if errLabels := committee.ValidateLabels(); errLabels != nil {
+		slog.WarnContext(ctx, "invalid committee labels",
+			"error", errLabels,
+		)
+		return nil, errLabels
+	}
+
+	if errVoting := committee.ValidateVoting(uc.votingDisallowedCategories); errVoting != nil {
+		slog.WarnContext(ctx, "voting not permitted for the committee category",
+			"error", errVoting,
+			"category", committee.Category,
+		)
+		return nil, errVoting
+	}
+
+	if errReview := committee.ValidateReviewPolicy(uc.publicRequiresReview); errReview != nil {
+		slog.WarnContext(ctx, "public committee does not require review",
+			"error", errReview,
+		)
+		return nil, errReview
+	}
+
 	// Set committee identifiers and timestamps
 	now := time.Now()
 	committee.CommitteeBase.UID = uuid.New().String()
@@ -390,6 +776,20 @@ func (uc *committeeWriterOrchestrator) Create(ctx context.Context, committee *mo
 		keys = append(keys, uniqueSSOName)
 	}
 
+	// Index the committee under its parent (if specified)
+	if committee.ParentUID != nil && *committee.ParentUID != "" {
+		parentKey, errIndexParent := uc.committeeWriter.IndexParent(ctx, committee)
+		if errIndexParent != nil {
+			slog.ErrorContext(ctx, "failed to index committee under its parent",
+				"error", errIndexParent,
+				"parent_uid", *committee.ParentUID,
+			)
+			rollbackRequired = true
+			return nil, errIndexParent
+		}
+		keys = append(keys, parentKey)
+	}
+
 	// Create the committee and settings (if applicable)
 	errCreate := uc.committeeWriter.Create(ctx, committee)
 	if errCreate != nil {
@@ -423,12 +823,18 @@ func (uc *committeeWriterOrchestrator) Create(ctx context.Context, committee *mo
 		localMessage := message
 
 		messages = append(messages, func() error {
+			if localSubject == constants.IndexCommitteeSubject {
+				return uc.indexCommittee(ctx, &committee.CommitteeBase, localMessage, sync)
+			}
 			return uc.committeePublisher.Indexer(ctx, localSubject, localMessage, sync)
 		})
 	}
 
 	// Publish access control message for the committee
-	accessControlMessage := uc.buildAccessControlMessage(ctx, committee)
+	accessControlMessage, errBuildAccessMessage := uc.buildAccessControlMessage(ctx, committee)
+	if errBuildAccessMessage != nil {
+		return nil, errBuildAccessMessage
+	}
 	messages = append(messages, func() error {
 		return uc.committeePublisher.Access(ctx, constants.UpdateAccessCommitteeSubject, accessControlMessage, sync)
 	})
@@ -446,6 +852,8 @@ func (uc *committeeWriterOrchestrator) Create(ctx context.Context, committee *mo
 		"committee_uid", committee.CommitteeBase.UID,
 	)
 
+	uc.notifyCommitteeWebhooks(ctx, model.ActionCreated, &committee.CommitteeBase)
+
 	return committee, nil
 }
 
@@ -460,30 +868,60 @@ func (uc *committeeWriterOrchestrator) Update(ctx context.Context, committee *mo
 		"sync", sync,
 	)
 
+	if errCategory := committee.ValidateCategory(uc.legacyCategories); errCategory != nil {
+		slog.WarnContext(ctx, "invalid committee category",
+			"error", errCategory,
+			"committee_uid", committee.CommitteeBase.UID,
+			"category", committee.Category,
+		)
+		return nil, errCategory
+	}
+
+	if errLabels := committee.ValidateLabels(); errLabels != nil {
+		slog.WarnContext(ctx, "invalid committee labels",
+			"error", errLabels,
+			"committee_uid", committee.CommitteeBase.UID,
+		)
+		return nil, errLabels
+	}
+
+	if errVoting := committee.ValidateVoting(uc.votingDisallowedCategories); errVoting != nil {
+		slog.WarnContext(ctx, "voting not permitted for the committee category",
+			"error", errVoting,
+			"committee_uid", committee.CommitteeBase.UID,
+			"category", committee.Category,
+		)
+		return nil, errVoting
+	}
+
+	if errReview := committee.ValidateReviewPolicy(uc.publicRequiresReview); errReview != nil {
+		slog.WarnContext(ctx, "public committee does not require review",
+			"error", errReview,
+			"committee_uid", committee.CommitteeBase.UID,
+		)
+		return nil, errReview
+	}
+
 	// For rollback purposes and cleanup
+	// The new keys are reserved before the base is written and the stale ones are only released after,
+	// so the name and SSO group name the stored committee uses are never left unreserved
 	var (
 		staleKeys        []string
 		newKeys          []string
 		rollbackRequired bool
-		updateSucceeded  bool
+		baseWritten      bool
 	)
 	defer func() {
-		if err := recover(); err != nil || rollbackRequired {
+		errRecover := recover()
+		if baseWritten {
+			// The stored committee already uses the new keys, they are kept even if a later step failed
+			uc.releaseStaleKeys(ctx, staleKeys, newKeys)
+			return
+		}
+		if errRecover != nil || rollbackRequired {
 			// Rollback new keys
 			uc.deleteKeys(ctx, newKeys, true)
 		}
-		if updateSucceeded && len(staleKeys) > 0 {
-			slog.DebugContext(ctx, "cleaning up stale keys",
-				"keys_count", len(staleKeys),
-			)
-			go func() {
-				// Cleanup stale keys in a separate goroutine
-				// new context to avoid blocking the main flow
-				ctxCleanup, cancel := context.WithTimeout(context.Background(), time.Second*10)
-				defer cancel()
-				uc.deleteKeys(ctxCleanup, staleKeys, false)
-			}()
-		}
 	}()
 
 	// Step 1: Retrieve existing data from the repository
@@ -547,7 +985,13 @@ func (uc *committeeWriterOrchestrator) Update(ctx context.Context, committee *mo
 			staleKeys = append(staleKeys, oldNameKey)
 		}
 		// Step 3.1: Handle SSO Group Name changes (if name changed)
-		if committee.SSOGroupEnabled {
+		if committee.SSOGroupEnabled && uc.ssoGroupNameUnchanged(ctx, committee, existing, slug) {
+			slog.DebugContext(ctx, "new committee name builds the same SSO group name, keeping the reservation",
+				"committee_uid", existing.UID,
+				"sso_group_name", existing.SSOGroupName,
+			)
+			committee.SSOGroupName = existing.SSOGroupName
+		} else if committee.SSOGroupEnabled {
 			newSSOKey, errSSOChange := uc.checkReserveSSOName(ctx, committee, slug)
 			if errSSOChange != nil {
 				rollbackRequired = true
@@ -567,6 +1011,28 @@ func (uc *committeeWriterOrchestrator) Update(ctx context.Context, committee *mo
 
 	}
 
+	// Step 3.2: Handle SSO Group integration being disabled or re-enabled
+	switch {
+	case !committee.SSOGroupEnabled && existing.SSOGroupName != "":
+		slog.DebugContext(ctx, "SSO group disabled, releasing the SSO group name",
+			"committee_uid", existing.UID,
+			"sso_group_name", existing.SSOGroupName,
+		)
+		staleKeys = append(staleKeys, fmt.Sprintf(constants.KVLookupSSOGroupNamePrefix, existing.SSOGroupName))
+	case committee.SSOGroupEnabled && !existing.SSOGroupEnabled && existing.Name == committee.Name:
+		// Name changes already reserved a new SSO group name in Step 3.1
+		committee.SSOGroupName = ""
+		newSSOKey, errSSOEnable := uc.checkReserveSSOName(ctx, committee, slug)
+		if errSSOEnable != nil {
+			rollbackRequired = true
+			return nil, errSSOEnable
+		}
+		newKeys = append(newKeys, newSSOKey)
+		if existing.SSOGroupName != "" {
+			staleKeys = append(staleKeys, fmt.Sprintf(constants.KVLookupSSOGroupNamePrefix, existing.SSOGroupName))
+		}
+	}
+
 	// Step 4: Validate parent change
 	if (existing.ParentUID == nil && committee.ParentUID != nil) ||
 		(existing.ParentUID != nil && committee.ParentUID == nil) ||
@@ -587,6 +1053,22 @@ func (uc *committeeWriterOrchestrator) Update(ctx context.Context, committee *mo
 				"parent_name", parent.Name,
 				"revision", parentRevision,
 			)
+
+			newParentKey, errIndexParent := uc.committeeWriter.IndexParent(ctx, committee)
+			if errIndexParent != nil {
+				slog.ErrorContext(ctx, "failed to index committee under its new parent",
+					"error", errIndexParent,
+					"parent_uid", *committee.ParentUID,
+				)
+				rollbackRequired = true
+				return nil, errIndexParent
+			}
+			newKeys = append(newKeys, newParentKey)
+		}
+
+		// Save old parent key for cleanup
+		if existing.ParentUID != nil && *existing.ParentUID != "" {
+			staleKeys = append(staleKeys, fmt.Sprintf(constants.KVLookupParentPrefix, *existing.ParentUID)+existing.UID)
 		}
 	}
 
@@ -594,7 +1076,9 @@ func (uc *committeeWriterOrchestrator) Update(ctx context.Context, committee *mo
 	uc.mergeCommitteeData(ctx, existing, committee)
 
 	// Step 6: Update the committee in storage
-	errUpdate := uc.committeeWriter.UpdateBase(ctx, committee, revision)
+	// Only the base is handed to the writer, settings have their own revision and
+	// are only written through UpdateSettings, so a concurrent settings change is never clobbered
+	errUpdate := uc.committeeWriter.UpdateBase(ctx, &model.Committee{CommitteeBase: committee.CommitteeBase}, revision)
 	if errUpdate != nil {
 		slog.ErrorContext(ctx, "failed to update committee",
 			"error", errUpdate,
@@ -603,6 +1087,7 @@ func (uc *committeeWriterOrchestrator) Update(ctx context.Context, committee *mo
 		rollbackRequired = true
 		return nil, errUpdate
 	}
+	baseWritten = true
 
 	slog.DebugContext(ctx, "committee updated successfully",
 		"committee_uid", committee.CommitteeBase.UID,
@@ -640,11 +1125,14 @@ func (uc *committeeWriterOrchestrator) Update(ctx context.Context, committee *mo
 		CommitteeBase:     committee.CommitteeBase,
 		CommitteeSettings: settings,
 	}
-	accessControlMessage := uc.buildAccessControlMessage(ctx, fullCommittee)
+	accessControlMessage, errBuildAccessMessage := uc.buildAccessControlMessage(ctx, fullCommittee)
+	if errBuildAccessMessage != nil {
+		return nil, errBuildAccessMessage
+	}
 	// Publish both messages
 	messages := []func() error{
 		func() error {
-			return uc.committeePublisher.Indexer(ctx, constants.IndexCommitteeSubject, messageIndexer, sync)
+			return uc.indexCommittee(ctx, &committee.CommitteeBase, messageIndexer, sync)
 		},
 		func() error {
 			return uc.committeePublisher.Access(ctx, constants.UpdateAccessCommitteeSubject, accessControlMessage, sync)
@@ -666,8 +1154,8 @@ func (uc *committeeWriterOrchestrator) Update(ctx context.Context, committee *mo
 		"stale_keys_count", len(staleKeys),
 	)
 
-	// Mark update as successful for defer cleanup
-	updateSucceeded = true
+	uc.notifyCommitteeWebhooks(ctx, model.ActionUpdated, &committee.CommitteeBase)
+
 	return committee, nil
 }
 
@@ -680,13 +1168,32 @@ func (uc *committeeWriterOrchestrator) UpdateSettings(ctx context.Context, setti
 	)
 
 	// Step 1: Retrieve existing settings from the repository to verify they exist
+	// In upsert mode, a committee without settings gets them created, expecting the zero revision
+	// returned for missing settings
+	createSettings := false
 	existingSettings, existingRevision, errGet := uc.committeeReader.GetSettings(ctx, settings.UID)
 	if errGet != nil {
-		slog.ErrorContext(ctx, "failed to retrieve existing committee settings",
-			"error", errGet,
+		var notFoundErr errs.NotFound
+		if !uc.settingsUpsert || !errors.As(errGet, &notFoundErr) {
+			slog.ErrorContext(ctx, "failed to retrieve existing committee settings",
+				"error", errGet,
+				"committee_uid", settings.UID,
+			)
+			return nil, errGet
+		}
+		if _, _, errBase := uc.committeeReader.GetBase(ctx, settings.UID); errBase != nil {
+			slog.ErrorContext(ctx, "failed to retrieve committee for settings upsert",
+				"error", errBase,
+				"committee_uid", settings.UID,
+			)
+			return nil, errBase
+		}
+		slog.InfoContext(ctx, "committee has no settings, creating them",
 			"committee_uid", settings.UID,
 		)
-		return nil, errGet
+		createSettings = true
+		existingSettings = &model.CommitteeSettings{UID: settings.UID, CreatedAt: time.Now().UTC()}
+		existingRevision = 0
 	}
 
 	// Verify revision matches to ensure optimistic locking
@@ -704,14 +1211,28 @@ func (uc *committeeWriterOrchestrator) UpdateSettings(ctx context.Context, setti
 		"business_email_required", existingSettings.BusinessEmailRequired,
 	)
 
+	// Step 1.1: Keep at least one writer, a committee without writers can't be managed anymore
+	if len(settings.Writers) == 0 && len(existingSettings.Writers) > 0 && !uc.allowEmptyWriters {
+		slog.WarnContext(ctx, "settings update would remove every committee writer",
+			"committee_uid", settings.UID,
+			"existing_writers_count", len(existingSettings.Writers),
+		)
+		return nil, errs.NewValidation("at least one committee writer must remain")
+	}
+
 	// Step 2: Merge existing data with updated fields
 	// Preserve readonly fields
 	settings.UID = existingSettings.UID
 	settings.CreatedAt = existingSettings.CreatedAt
 	settings.UpdatedAt = time.Now().UTC()
 
-	// Step 3: Update the committee settings in storage
-	errUpdate := uc.committeeWriter.UpdateSetting(ctx, settings, revision)
+	// Step 3: Update the committee settings in storage, or create them when missing
+	var errUpdate error
+	if createSettings {
+		errUpdate = uc.committeeWriter.CreateSetting(ctx, settings)
+	} else {
+		errUpdate = uc.committeeWriter.UpdateSetting(ctx, settings, revision)
+	}
 	if errUpdate != nil {
 		slog.ErrorContext(ctx, "failed to update committee settings",
 			"error", errUpdate,
@@ -746,7 +1267,10 @@ func (uc *committeeWriterOrchestrator) UpdateSettings(ctx context.Context, setti
 	}
 
 	// Build and publish access control message
-	accessControlMessage := uc.buildAccessControlMessage(ctx, committee)
+	accessControlMessage, errBuildAccessMessage := uc.buildAccessControlMessage(ctx, committee)
+	if errBuildAccessMessage != nil {
+		return nil, errBuildAccessMessage
+	}
 	messages := []func() error{
 		func() error {
 			return uc.committeePublisher.Indexer(ctx, constants.IndexCommitteeSettingsSubject, messageIndexer, sync)
@@ -769,11 +1293,361 @@ func (uc *committeeWriterOrchestrator) UpdateSettings(ctx context.Context, setti
 	return settings, nil
 }
 
-// Delete orchestrates the committee deletion process
+// MarkReviewed sets the last review time and reviewer on the committee settings.
+// A review within the minimum review interval of the previous one is rejected as an accidental double review.
+func (uc *committeeWriterOrchestrator) MarkReviewed(ctx context.Context, uid, reviewer string, revision uint64, sync bool) (*model.CommitteeSettings, error) {
+	slog.DebugContext(ctx, "executing mark committee reviewed use case",
+		"committee_uid", uid,
+		"revision", revision,
+		"sync", sync,
+	)
+
+	// Step 1: Retrieve the existing settings
+	existingSettings, existingRevision, errGet := uc.committeeReader.GetSettings(ctx, uid)
+	if errGet != nil {
+		slog.ErrorContext(ctx, "failed to retrieve existing committee settings",
+			"error", errGet,
+			"committee_uid", uid,
+		)
+		return nil, errGet
+	}
+
+	if existingRevision != revision {
+		slog.WarnContext(ctx, "revision mismatch during committee review",
+			"expected_revision", revision,
+			"current_revision", existingRevision,
+			"committee_uid", uid,
+		)
+		return nil, errs.NewConflict("committee settings have been modified by another process")
+	}
+
+	// Step 2: Reject a review too close to the previous one
+	now := time.Now().UTC()
+	if uc.minReviewInterval > 0 && existingSettings.LastReviewedAt != nil && *existingSettings.LastReviewedAt != "" {
+		lastReviewedAt, errParse := time.Parse(time.RFC3339, *existingSettings.LastReviewedAt)
+		if errParse != nil {
+			// an unreadable timestamp can't prove a recent review, the new one replaces it
+			slog.WarnContext(ctx, "invalid last reviewed at timestamp, skipping review interval check",
+				"error", errParse,
+				"committee_uid", uid,
+			)
+		} else if sinceLastReview := now.Sub(lastReviewedAt); sinceLastReview < uc.minReviewInterval {
+			slog.WarnContext(ctx, "committee reviewed within the minimum review interval",
+				"committee_uid", uid,
+				"last_reviewed_at", *existingSettings.LastReviewedAt,
+				"min_review_interval", uc.minReviewInterval,
+			)
+			return nil, errs.NewValidation(fmt.Sprintf("committee was already reviewed at %s, the next review is allowed after %s",
+				*existingSettings.LastReviewedAt, lastReviewedAt.Add(uc.minReviewInterval).Format(time.RFC3339)))
+		}
+	}
+
+	// Step 3: Record the review through the settings update
+	reviewedAt := now.Format(time.RFC3339)
+	settings := *existingSettings
+	settings.LastReviewedAt = &reviewedAt
+	settings.LastReviewedBy = &reviewer
+
+	return uc.UpdateSettings(ctx, &settings, revision, sync)
+}
+
+// Touch forces a republish of the committee indexer and access control messages.
+// Only UpdatedAt is changed, which is useful to force a reindex after fixing search configuration.
+func (uc *committeeWriterOrchestrator) Touch(ctx context.Context, uid string, revision uint64, sync bool) (*model.Committee, error) {
+	slog.DebugContext(ctx, "executing touch committee use case",
+		"committee_uid", uid,
+		"revision", revision,
+		"sync", sync,
+	)
+
+	// Step 1: Retrieve existing committee data
+	existing, existingRevision, errGet := uc.committeeReader.GetBase(ctx, uid)
+	if errGet != nil {
+		slog.ErrorContext(ctx, "failed to retrieve existing committee for touch",
+			"error", errGet,
+			"committee_uid", uid,
+		)
+		return nil, errGet
+	}
+
+	// Verify revision matches to ensure optimistic locking
+	if existingRevision != revision {
+		slog.WarnContext(ctx, "revision mismatch during touch",
+			"expected_revision", revision,
+			"current_revision", existingRevision,
+			"committee_uid", uid,
+		)
+		return nil, errs.NewConflict("committee has been modified by another process")
+	}
+
+	settings, _, errGetSettings := uc.committeeReader.GetSettings(ctx, uid)
+	if errGetSettings != nil {
+		var notFoundErr errs.NotFound
+		if !errors.As(errGetSettings, &notFoundErr) {
+			slog.ErrorContext(ctx, "failed to retrieve committee settings for touch",
+				"error", errGetSettings,
+				"committee_uid", uid,
+			)
+			return nil, errGetSettings
+		}
+	}
+	// send message with empty settings if not found
+	if settings == nil {
+		settings = &model.CommitteeSettings{}
+	}
+
+	// Step 2: Bump UpdatedAt only
+	existing.UpdatedAt = time.Now()
+	committee := &model.Committee{CommitteeBase: *existing, CommitteeSettings: settings}
+
+	// Settings are only read for the access message, the writer gets the base alone
+	errUpdate := uc.committeeWriter.UpdateBase(ctx, &model.Committee{CommitteeBase: committee.CommitteeBase}, revision)
+	if errUpdate != nil {
+		slog.ErrorContext(ctx, "failed to touch committee",
+			"error", errUpdate,
+			"committee_uid", uid,
+		)
+		return nil, errUpdate
+	}
+
+	// Step 3: Republish indexer and access control messages
+	messages := []func() error{}
+	for subject, data := range map[string]any{
+		constants.IndexCommitteeSubject:         committee.CommitteeBase,
+		constants.IndexCommitteeSettingsSubject: committee.CommitteeSettings,
+	} {
+		message, errBuildIndexerMessage := uc.buildIndexerMessage(ctx, data, committee.Tags())
+		if errBuildIndexerMessage != nil {
+			return nil, errs.NewUnexpected("failed to build indexer message", errBuildIndexerMessage)
+		}
+
+		localSubject := subject
+		localMessage := message
+
+		messages = append(messages, func() error {
+			if localSubject == constants.IndexCommitteeSubject {
+				return uc.indexCommittee(ctx, &committee.CommitteeBase, localMessage, sync)
+			}
+			return uc.committeePublisher.Indexer(ctx, localSubject, localMessage, sync)
+		})
+	}
+
+	accessControlMessage, errBuildAccessMessage := uc.buildAccessControlMessage(ctx, committee)
+	if errBuildAccessMessage != nil {
+		return nil, errBuildAccessMessage
+	}
+	messages = append(messages, func() error {
+		return uc.committeePublisher.Access(ctx, constants.UpdateAccessCommitteeSubject, accessControlMessage, sync)
+	})
+
+	errPublishingMessage := concurrent.NewWorkerPool(len(messages)).Run(ctx, messages...)
+	if errPublishingMessage != nil {
+		slog.ErrorContext(ctx, "failed to republish committee messages",
+			"error", errPublishingMessage,
+			"committee_uid", uid,
+		)
+		return nil, errPublishingMessage
+	}
+
+	slog.DebugContext(ctx, "committee touched successfully",
+		"committee_uid", uid,
+	)
+
+	return committee, nil
+}
+
+// RecountMembers recomputes TotalMembers and TotalVotingRepos from the members currently stored,
+// persists the committee base and republishes its indexer message, fixing drifted counts
+// without a full reindex. Recounts of the same committee are serialized by the committee lock,
+// and the current revision is used, so a concurrent update of the base makes it fail with a conflict.
+func (uc *committeeWriterOrchestrator) RecountMembers(ctx context.Context, uid string, sync bool) (*model.CommitteeBase, error) {
+	slog.DebugContext(ctx, "executing recount committee members use case",
+		"committee_uid", uid,
+		"sync", sync,
+	)
+
+	// Hold the committee lock until the counts are persisted and republished
+	unlock, errLock := uc.lockCommittee(ctx, uid)
+	if errLock != nil {
+		return nil, errLock
+	}
+	defer unlock()
+
+	// Step 1: Retrieve the committee base and its current revision
+	base, revision, errGet := uc.committeeReader.GetBase(ctx, uid)
+	if errGet != nil {
+		slog.ErrorContext(ctx, "failed to retrieve committee for member recount",
+			"error", errGet,
+			"committee_uid", uid,
+		)
+		return nil, errGet
+	}
+
+	// Step 2: Count the stored members
+	members, errList := uc.committeeReader.ListMembers(ctx, uid)
+	if errList != nil {
+		slog.ErrorContext(ctx, "failed to list committee members for recount",
+			"error", errList,
+			"committee_uid", uid,
+		)
+		return nil, errList
+	}
+
+	votingRepos := 0
+	for _, member := range members {
+		if member.Voting.Status == model.MemberVotingStatusVotingRep {
+			votingRepos++
+		}
+	}
+
+	slog.InfoContext(ctx, "committee member counts recomputed",
+		"committee_uid", uid,
+		"previous_total_members", base.TotalMembers,
+		"total_members", len(members),
+		"previous_total_voting_repos", base.TotalVotingRepos,
+		"total_voting_repos", votingRepos,
+	)
+
+	// Step 3: Persist the recomputed counts
+	base.TotalMembers = len(members)
+	base.TotalVotingRepos = votingRepos
+	base.UpdatedAt = time.Now()
+	committee := &model.Committee{CommitteeBase: *base}
+
+	if errUpdate := uc.committeeWriter.UpdateBase(ctx, committee, revision); errUpdate != nil {
+		slog.ErrorContext(ctx, "failed to persist recomputed member counts",
+			"error", errUpdate,
+			"committee_uid", uid,
+		)
+		return nil, errUpdate
+	}
+
+	// Step 4: Republish the committee indexer message with the new counts
+	message, errBuild := uc.buildIndexerMessage(ctx, committee.CommitteeBase, committee.Tags())
+	if errBuild != nil {
+		return nil, errBuild
+	}
+	if errPublish := uc.indexCommittee(ctx, &committee.CommitteeBase, message, sync); errPublish != nil {
+		slog.ErrorContext(ctx, "failed to republish committee after member recount",
+			"error", errPublish,
+			"committee_uid", uid,
+		)
+		return nil, errPublish
+	}
+
+	return &committee.CommitteeBase, nil
+}
+
+// childCommittees returns the UIDs of the committees that currently have the given committee as parent.
+// Index entries pointing to committees that no longer exist, or that moved to another parent,
+// are returned as stale keys.
+func (uc *committeeWriterOrchestrator) childCommittees(ctx context.Context, parentUID string) ([]string, []string, error) {
+
+	childUIDs, errList := uc.committeeReader.ListChildren(ctx, parentUID)
+	if errList != nil {
+		slog.ErrorContext(ctx, "failed to list child committees",
+			"error", errList,
+			"committee_uid", parentUID,
+		)
+		return nil, nil, errList
+	}
+
+	var (
+		children  []string
+		staleKeys []string
+	)
+	for _, childUID := range childUIDs {
+		child, _, errGet := uc.committeeReader.GetBase(ctx, childUID)
+		if errGet != nil {
+			var notFoundErr errs.NotFound
+			if !errors.As(errGet, &notFoundErr) {
+				return nil, nil, errGet
+			}
+		}
+		if child == nil || child.ParentUID == nil || *child.ParentUID != parentUID {
+			staleKeys = append(staleKeys, fmt.Sprintf(constants.KVLookupParentPrefix, parentUID)+childUID)
+			continue
+		}
+		children = append(children, childUID)
+	}
+
+	return children, staleKeys, nil
+}
+
+// reparentChild moves a child committee to the given parent, or to none when the parent is nil,
+// and republishes its indexer and access control messages
+func (uc *committeeWriterOrchestrator) reparentChild(ctx context.Context, childUID string, parentUID *string, sync bool) error {
+
+	child, childRevision, errGet := uc.committeeReader.GetBase(ctx, childUID)
+	if errGet != nil {
+		return errGet
+	}
+
+	child.ParentUID = parentUID
+	child.UpdatedAt = time.Now()
+	committee := &model.Committee{CommitteeBase: *child}
+
+	// Index the child under its new parent before moving it, so it is never left unindexed
+	if parentUID != nil && *parentUID != "" {
+		if _, errIndexParent := uc.committeeWriter.IndexParent(ctx, committee); errIndexParent != nil {
+			return errIndexParent
+		}
+	}
+
+	errUpdate := uc.committeeWriter.UpdateBase(ctx, committee, childRevision)
+	if errUpdate != nil {
+		return errUpdate
+	}
+
+	settings, _, errGetSettings := uc.committeeReader.GetSettings(ctx, childUID)
+	if errGetSettings != nil {
+		var notFoundErr errs.NotFound
+		if !errors.As(errGetSettings, &notFoundErr) {
+			return errGetSettings
+		}
+	}
+	// send message with empty settings if not found
+	if settings == nil {
+		settings = &model.CommitteeSettings{}
+	}
+	committee.CommitteeSettings = settings
+
+	messageIndexer, errBuildIndexerMessage := uc.buildIndexerMessage(ctx, committee.CommitteeBase, committee.Tags())
+	if errBuildIndexerMessage != nil {
+		return errBuildIndexerMessage
+	}
+	accessControlMessage, errBuildAccessMessage := uc.buildAccessControlMessage(ctx, committee)
+	if errBuildAccessMessage != nil {
+		return errBuildAccessMessage
+	}
+
+	messages := []func() error{
+		func() error {
+			return uc.indexCommittee(ctx, &committee.CommitteeBase, messageIndexer, sync)
+		},
+		func() error {
+			return uc.committeePublisher.Access(ctx, constants.UpdateAccessCommitteeSubject, accessControlMessage, sync)
+		},
+	}
+
+	return concurrent.NewWorkerPool(len(messages)).Run(ctx, messages...)
+}
+
+// Delete orchestrates the committee deletion process, rejecting it when the committee has child committees
 func (uc *committeeWriterOrchestrator) Delete(ctx context.Context, uid string, revision uint64, sync bool) error {
+	return uc.delete(ctx, uid, revision, false, sync)
+}
+
+// DeleteCascade orchestrates the committee deletion process, re-parenting its child committees
+func (uc *committeeWriterOrchestrator) DeleteCascade(ctx context.Context, uid string, revision uint64, sync bool) error {
+	return uc.delete(ctx, uid, revision, true, sync)
+}
+
+func (uc *committeeWriterOrchestrator) delete(ctx context.Context, uid string, revision uint64, cascade bool, sync bool) error {
 	slog.DebugContext(ctx, "executing delete committee use case",
 		"committee_uid", uid,
 		"revision", revision,
+		"cascade", cascade,
 		"sync", sync,
 	)
 
@@ -804,6 +1678,35 @@ func (uc *committeeWriterOrchestrator) Delete(ctx context.Context, uid string, r
 		"sso_group_name", existing.SSOGroupName,
 	)
 
+	// Step 1.1: Check child committees, re-parenting them on cascade
+	children, staleParentKeys, errChildren := uc.childCommittees(ctx, uid)
+	if errChildren != nil {
+		return errChildren
+	}
+	if len(children) > 0 && !cascade {
+		slog.WarnContext(ctx, "committee has child committees, deletion rejected",
+			"committee_uid", uid,
+			"children_count", len(children),
+		)
+		return errs.NewConflict("committee has child committees")
+	}
+	for _, childUID := range children {
+		errReparent := uc.reparentChild(ctx, childUID, existing.ParentUID, sync)
+		if errReparent != nil {
+			slog.ErrorContext(ctx, "failed to re-parent child committee",
+				"error", errReparent,
+				"committee_uid", uid,
+				"child_uid", childUID,
+			)
+			return errReparent
+		}
+		staleParentKeys = append(staleParentKeys, fmt.Sprintf(constants.KVLookupParentPrefix, uid)+childUID)
+		slog.DebugContext(ctx, "child committee re-parented",
+			"committee_uid", uid,
+			"child_uid", childUID,
+		)
+	}
+
 	// Step 2: Build list of secondary indices to delete
 	var indicesToDelete []string
 
@@ -818,6 +1721,12 @@ func (uc *committeeWriterOrchestrator) Delete(ctx context.Context, uid string, r
 		indicesToDelete = append(indicesToDelete, ssoIndexKey)
 	}
 
+	// Build parent index keys, for the committee itself and its former children
+	if existing.ParentUID != nil && *existing.ParentUID != "" {
+		indicesToDelete = append(indicesToDelete, fmt.Sprintf(constants.KVLookupParentPrefix, *existing.ParentUID)+uid)
+	}
+	indicesToDelete = append(indicesToDelete, staleParentKeys...)
+
 	slog.DebugContext(ctx, "secondary indices identified for deletion",
 		"committee_uid", uid,
 		"indices_count", len(indicesToDelete),
@@ -866,6 +1775,12 @@ func (uc *committeeWriterOrchestrator) Delete(ctx context.Context, uid string, r
 			)
 			continue
 		}
+		// the deleted revision identifies the deletion, the settings share it with the base
+		objectType := model.IndexedObjectCommittee
+		if subject == constants.IndexCommitteeSettingsSubject {
+			objectType = model.IndexedObjectCommitteeSettings
+		}
+		message.SetDedupID(objectType, uid, revision)
 
 		localSubject := subject
 		localMessage := message
@@ -895,6 +1810,8 @@ func (uc *committeeWriterOrchestrator) Delete(ctx context.Context, uid string, r
 		"indices_deleted", len(indicesToDelete),
 	)
 
+	uc.notifyCommitteeWebhooks(ctx, model.ActionDeleted, existing)
+
 	return nil
 }
 
@@ -6,6 +6,9 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -14,7 +17,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/model"
+	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/port"
 	"github.com/linuxfoundation/lfx-v2-committee-service/internal/infrastructure/mock"
+	"github.com/linuxfoundation/lfx-v2-committee-service/pkg/constants"
 	errs "github.com/linuxfoundation/lfx-v2-committee-service/pkg/errors"
 )
 
@@ -68,6 +73,11 @@ func (w *TestMockCommitteeWriter) UpdateSetting(ctx context.Context, settings *m
 	return mockWriter.UpdateSetting(ctx, settings, revision)
 }
 
+func (w *TestMockCommitteeWriter) CreateSetting(ctx context.Context, settings *model.CommitteeSettings) error {
+	mockWriter := mock.NewMockCommitteeWriter(w.mock)
+	return mockWriter.CreateSetting(ctx, settings)
+}
+
 func (w *TestMockCommitteeWriter) UpdateSettings(ctx context.Context, settings *model.CommitteeSettings, revision uint64) (*model.CommitteeSettings, error) {
 	// Call the underlying UpdateSetting method
 	err := w.UpdateSetting(ctx, settings, revision)
@@ -78,28 +88,10 @@ func (w *TestMockCommitteeWriter) UpdateSettings(ctx context.Context, settings *
 	return settings, nil
 }
 
-// UniqueNameProject reserves a unique name/project combination
+// UniqueNameProject reserves a unique name/project combination, atomically in the mock repository
 func (w *TestMockCommitteeWriter) UniqueNameProject(ctx context.Context, committee *model.Committee) (string, error) {
-	nameProjectKey := committee.BuildIndexKey(ctx)
-
-	// Use the mock's existing logic but invert the result for proper reservation behavior
 	mockWriter := mock.NewMockCommitteeWriter(w.mock)
-	existingUID, err := mockWriter.UniqueNameProject(ctx, committee)
-
-	// If we get a conflict error, that means it already exists - return the conflict
-	if err != nil {
-		var conflictErr errs.Conflict
-		if errors.As(err, &conflictErr) {
-			return existingUID, err
-		}
-		// If it's a "not found" error, that means it's unique - we can reserve it
-		reservationID := uuid.New().String()
-		w.reservations[nameProjectKey] = reservationID
-		return reservationID, nil
-	}
-
-	// Should not reach here with the current mock implementation
-	return existingUID, err
+	return mockWriter.UniqueNameProject(ctx, committee)
 }
 
 // UniqueSSOGroupName reserves a unique SSO group name
@@ -125,7 +117,18 @@ func (w *TestMockCommitteeWriter) UniqueSSOGroupName(ctx context.Context, commit
 	return existingUID, err
 }
 
+// IndexParent records the committee as a child of its parent
+func (w *TestMockCommitteeWriter) IndexParent(ctx context.Context, committee *model.Committee) (string, error) {
+	mockWriter := mock.NewMockCommitteeWriter(w.mock)
+	return mockWriter.IndexParent(ctx, committee)
+}
+
 // CommitteeMemberWriter interface methods
+func (w *TestMockCommitteeWriter) RecordIndexed(ctx context.Context, uid string, indexedAt time.Time) error {
+	mockWriter := mock.NewMockCommitteeWriter(w.mock)
+	return mockWriter.RecordIndexed(ctx, uid, indexedAt)
+}
+
 func (w *TestMockCommitteeWriter) CreateMember(ctx context.Context, member *model.CommitteeMember) error {
 	mockWriter := mock.NewMockCommitteeWriter(w.mock)
 	return mockWriter.CreateMember(ctx, member)
@@ -146,6 +149,11 @@ func (w *TestMockCommitteeWriter) UniqueMember(ctx context.Context, member *mode
 	return mockWriter.UniqueMember(ctx, member)
 }
 
+func (w *TestMockCommitteeWriter) MemberIndexKey(ctx context.Context, member *model.CommitteeMember) (string, error) {
+	mockWriter := mock.NewMockCommitteeWriter(w.mock)
+	return mockWriter.MemberIndexKey(ctx, member)
+}
+
 func TestCommitteeWriterOrchestrator_Create(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -164,7 +172,7 @@ func TestCommitteeWriterOrchestrator_Create(t *testing.T) {
 				CommitteeBase: model.CommitteeBase{
 					ProjectUID:      "project-1",
 					Name:            "Test Committee",
-					Category:        "governance",
+					Category:        "Board",
 					Description:     "A test committee",
 					EnableVoting:    true,
 					SSOGroupEnabled: false,
@@ -196,7 +204,7 @@ func TestCommitteeWriterOrchestrator_Create(t *testing.T) {
 				CommitteeBase: model.CommitteeBase{
 					ProjectUID:      "project-1",
 					Name:            "SSO Committee",
-					Category:        "technical",
+					Category:        "Technical Steering Committee",
 					Description:     "Committee with SSO",
 					EnableVoting:    true,
 					SSOGroupEnabled: true,
@@ -217,6 +225,27 @@ func TestCommitteeWriterOrchestrator_Create(t *testing.T) {
 				assert.Equal(t, 1, mockRepo.GetCommitteeCount())
 			},
 		},
+		{
+			name: "SSO group rejected when the project has no slug",
+			setupMock: func(mockRepo *mock.MockRepository) {
+				mockRepo.ClearAll()
+				mockRepo.AddProject("project-1", "", "Test Project")
+			},
+			inputCommittee: &model.Committee{
+				CommitteeBase: model.CommitteeBase{
+					ProjectUID:      "project-1",
+					Name:            "SSO Committee",
+					Category:        "Technical Steering Committee",
+					SSOGroupEnabled: true,
+				},
+				CommitteeSettings: &model.CommitteeSettings{},
+			},
+			expectedError: errs.Validation{},
+			validate: func(t *testing.T, result *model.Committee, mockRepo *mock.MockRepository) {
+				assert.Nil(t, result)
+				assert.Equal(t, 0, mockRepo.GetCommitteeCount())
+			},
+		},
 		{
 			name: "successful committee creation with parent committee",
 			setupMock: func(mockRepo *mock.MockRepository) {
@@ -229,7 +258,7 @@ func TestCommitteeWriterOrchestrator_Create(t *testing.T) {
 						UID:        "parent-committee-1",
 						ProjectUID: "project-1",
 						Name:       "Parent Committee",
-						Category:   "governance",
+						Category:   "Board",
 						CreatedAt:  time.Now().Add(-24 * time.Hour),
 						UpdatedAt:  time.Now(),
 					},
@@ -245,7 +274,7 @@ func TestCommitteeWriterOrchestrator_Create(t *testing.T) {
 				CommitteeBase: model.CommitteeBase{
 					ProjectUID:      "project-1",
 					Name:            "Child Committee",
-					Category:        "technical",
+					Category:        "Technical Steering Committee",
 					Description:     "Child committee",
 					ParentUID:       stringPtr("parent-committee-1"),
 					EnableVoting:    false,
@@ -275,7 +304,7 @@ func TestCommitteeWriterOrchestrator_Create(t *testing.T) {
 				CommitteeBase: model.CommitteeBase{
 					ProjectUID:      "nonexistent-project",
 					Name:            "Test Committee",
-					Category:        "governance",
+					Category:        "Board",
 					EnableVoting:    true,
 					SSOGroupEnabled: false,
 				},
@@ -298,7 +327,7 @@ func TestCommitteeWriterOrchestrator_Create(t *testing.T) {
 				CommitteeBase: model.CommitteeBase{
 					ProjectUID:      "project-1",
 					Name:            "Child Committee",
-					Category:        "technical",
+					Category:        "Technical Steering Committee",
 					ParentUID:       stringPtr("nonexistent-parent"),
 					EnableVoting:    false,
 					SSOGroupEnabled: false,
@@ -323,7 +352,7 @@ func TestCommitteeWriterOrchestrator_Create(t *testing.T) {
 						UID:        "existing-committee",
 						ProjectUID: "project-1",
 						Name:       "Existing Committee",
-						Category:   "governance",
+						Category:   "Board",
 						CreatedAt:  time.Now().Add(-24 * time.Hour),
 						UpdatedAt:  time.Now(),
 					},
@@ -339,7 +368,7 @@ func TestCommitteeWriterOrchestrator_Create(t *testing.T) {
 				CommitteeBase: model.CommitteeBase{
 					ProjectUID:      "project-1",
 					Name:            "Existing Committee", // Same name as existing
-					Category:        "technical",
+					Category:        "Technical Steering Committee",
 					EnableVoting:    true,
 					SSOGroupEnabled: false,
 				},
@@ -363,7 +392,7 @@ func TestCommitteeWriterOrchestrator_Create(t *testing.T) {
 						UID:             "existing-sso-committee",
 						ProjectUID:      "project-1",
 						Name:            "Existing SSO Committee",
-						Category:        "governance",
+						Category:        "Board",
 						SSOGroupEnabled: true,
 						SSOGroupName:    "project-1-existing-sso-committee",
 						CreatedAt:       time.Now().Add(-24 * time.Hour),
@@ -381,7 +410,7 @@ func TestCommitteeWriterOrchestrator_Create(t *testing.T) {
 				CommitteeBase: model.CommitteeBase{
 					ProjectUID:      "project-1",
 					Name:            "New SSO Committee",
-					Category:        "technical",
+					Category:        "Technical Steering Committee",
 					EnableVoting:    true,
 					SSOGroupEnabled: true,
 				},
@@ -432,6 +461,350 @@ func TestCommitteeWriterOrchestrator_Create(t *testing.T) {
 	}
 }
 
+func TestCommitteeWriterOrchestrator_Category(t *testing.T) {
+	testCases := []struct {
+		name          string
+		category      string
+		options       []committeeWriterOrchestratorOption
+		expectedError error
+	}{
+		{
+			name:     "canonical category is accepted",
+			category: "Technical Steering Committee",
+		},
+		{
+			name:          "unknown category is rejected",
+			category:      "governance",
+			expectedError: errs.Validation{},
+		},
+		{
+			name:     "whitelisted legacy category is accepted",
+			category: "governance",
+			options:  []committeeWriterOrchestratorOption{WithLegacyCategories([]string{"governance"})},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockRepo := mock.NewMockRepository()
+			mockRepo.ClearAll()
+			mockRepo.AddProject("project-1", "test-project", "Test Project")
+
+			options := append([]committeeWriterOrchestratorOption{
+				WithCommitteeRetriever(mock.NewMockCommitteeReader(mockRepo)),
+				WithCommitteeWriter(NewTestMockCommitteeWriter(mockRepo)),
+				WithProjectRetriever(mock.NewMockProjectRetriever(mockRepo)),
+				WithCommitteePublisher(mock.NewMockCommitteePublisher()),
+			}, tc.options...)
+			orchestrator := NewCommitteeWriterOrchestrator(options...)
+
+			created, err := orchestrator.Create(ctx, &model.Committee{
+				CommitteeBase: model.CommitteeBase{
+					ProjectUID: "project-1",
+					Name:       "Category Committee",
+					Category:   tc.category,
+				},
+				CommitteeSettings: &model.CommitteeSettings{},
+			}, false)
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.IsType(t, tc.expectedError, err)
+				assert.Equal(t, 0, mockRepo.GetCommitteeCount())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.category, created.Category)
+
+			// switching to an unknown category on update is rejected as well
+			_, revision, err := mockRepo.GetBase(ctx, created.CommitteeBase.UID)
+			require.NoError(t, err)
+			update := &model.Committee{CommitteeBase: created.CommitteeBase}
+			update.Category = "not-a-category"
+			_, err = orchestrator.Update(ctx, update, revision, false)
+			require.Error(t, err)
+			assert.IsType(t, errs.Validation{}, err)
+		})
+	}
+}
+
+func TestCommitteeWriterOrchestrator_VotingCategories(t *testing.T) {
+	testCases := []struct {
+		name          string
+		category      string
+		expectedError error
+	}{
+		{
+			name:     "voting is allowed on a technical steering committee",
+			category: "Technical Steering Committee",
+		},
+		{
+			name:          "voting is rejected on a mailing list",
+			category:      "Marketing Mailing List",
+			expectedError: errs.Validation{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockRepo := mock.NewMockRepository()
+			mockRepo.ClearAll()
+			mockRepo.AddProject("project-1", "test-project", "Test Project")
+
+			orchestrator := NewCommitteeWriterOrchestrator(
+				WithCommitteeRetriever(mock.NewMockCommitteeReader(mockRepo)),
+				WithCommitteeWriter(NewTestMockCommitteeWriter(mockRepo)),
+				WithProjectRetriever(mock.NewMockProjectRetriever(mockRepo)),
+				WithCommitteePublisher(mock.NewMockCommitteePublisher()),
+				WithVotingDisallowedCategories([]string{"Marketing Mailing List", "Technical Mailing List"}),
+			)
+
+			created, err := orchestrator.Create(ctx, &model.Committee{
+				CommitteeBase: model.CommitteeBase{
+					ProjectUID:   "project-1",
+					Name:         "Voting Committee",
+					Category:     tc.category,
+					EnableVoting: true,
+				},
+				CommitteeSettings: &model.CommitteeSettings{},
+			}, false)
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.IsType(t, tc.expectedError, err)
+				assert.Equal(t, 0, mockRepo.GetCommitteeCount())
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, created.EnableVoting)
+
+			// moving a voting committee to a category without voting is rejected on update
+			_, revision, err := mockRepo.GetBase(ctx, created.CommitteeBase.UID)
+			require.NoError(t, err)
+			update := &model.Committee{CommitteeBase: created.CommitteeBase}
+			update.Category = "Technical Mailing List"
+			_, err = orchestrator.Update(ctx, update, revision, false)
+			require.Error(t, err)
+			assert.IsType(t, errs.Validation{}, err)
+
+			// the same category is accepted once voting is disabled
+			update.EnableVoting = false
+			_, err = orchestrator.Update(ctx, update, revision, false)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCommitteeWriterOrchestrator_PublicRequiresReview(t *testing.T) {
+	testCases := []struct {
+		name           string
+		policyEnabled  bool
+		requiresReview bool
+		expectedError  error
+	}{
+		{
+			name: "policy disabled accepts a public committee without review",
+		},
+		{
+			name:           "policy enabled accepts a public committee with review",
+			policyEnabled:  true,
+			requiresReview: true,
+		},
+		{
+			name:          "policy enabled rejects a public committee without review",
+			policyEnabled: true,
+			expectedError: errs.Validation{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockRepo := mock.NewMockRepository()
+			mockRepo.ClearAll()
+			mockRepo.AddProject("project-1", "test-project", "Test Project")
+
+			orchestrator := NewCommitteeWriterOrchestrator(
+				WithCommitteeRetriever(mock.NewMockCommitteeReader(mockRepo)),
+				WithCommitteeWriter(NewTestMockCommitteeWriter(mockRepo)),
+				WithProjectRetriever(mock.NewMockProjectRetriever(mockRepo)),
+				WithCommitteePublisher(mock.NewMockCommitteePublisher()),
+				WithPublicRequiresReview(tc.policyEnabled),
+			)
+
+			created, err := orchestrator.Create(ctx, &model.Committee{
+				CommitteeBase: model.CommitteeBase{
+					ProjectUID:     "project-1",
+					Name:           "Public Committee",
+					Category:       "Technical Steering Committee",
+					Public:         true,
+					RequiresReview: tc.requiresReview,
+				},
+				CommitteeSettings: &model.CommitteeSettings{},
+			}, false)
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.IsType(t, tc.expectedError, err)
+				assert.Equal(t, 0, mockRepo.GetCommitteeCount())
+				return
+			}
+			require.NoError(t, err)
+
+			// turning review off on the public committee is only rejected when the policy is enabled
+			_, revision, err := mockRepo.GetBase(ctx, created.CommitteeBase.UID)
+			require.NoError(t, err)
+			update := &model.Committee{CommitteeBase: created.CommitteeBase}
+			update.RequiresReview = false
+			_, err = orchestrator.Update(ctx, update, revision, false)
+			if tc.policyEnabled {
+				require.Error(t, err)
+				assert.IsType(t, errs.Validation{}, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCommitteeWriterOrchestrator_Labels(t *testing.T) {
+	testCases := []struct {
+		name          string
+		labels        map[string]string
+		expectedError error
+	}{
+		{
+			name:   "labels are stored with the committee",
+			labels: map[string]string{"region": "emea", "tier": "gold"},
+		},
+		{
+			name:          "invalid label key is rejected",
+			labels:        map[string]string{"region code": "emea"},
+			expectedError: errs.Validation{},
+		},
+		{
+			name:          "invalid label value is rejected",
+			labels:        map[string]string{"region": "emea/west"},
+			expectedError: errs.Validation{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockRepo := mock.NewMockRepository()
+			mockRepo.ClearAll()
+			mockRepo.AddProject("project-1", "test-project", "Test Project")
+
+			orchestrator := NewCommitteeWriterOrchestrator(
+				WithCommitteeRetriever(mock.NewMockCommitteeReader(mockRepo)),
+				WithCommitteeWriter(NewTestMockCommitteeWriter(mockRepo)),
+				WithProjectRetriever(mock.NewMockProjectRetriever(mockRepo)),
+				WithCommitteePublisher(mock.NewMockCommitteePublisher()),
+			)
+
+			created, err := orchestrator.Create(ctx, &model.Committee{
+				CommitteeBase: model.CommitteeBase{
+					ProjectUID: "project-1",
+					Name:       "Labels Committee",
+					Category:   "Board",
+					Labels:     tc.labels,
+				},
+				CommitteeSettings: &model.CommitteeSettings{},
+			}, false)
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.IsType(t, tc.expectedError, err)
+				assert.Equal(t, 0, mockRepo.GetCommitteeCount())
+				return
+			}
+			require.NoError(t, err)
+
+			stored, revision, err := mockRepo.GetBase(ctx, created.CommitteeBase.UID)
+			require.NoError(t, err)
+			assert.Equal(t, tc.labels, stored.Labels)
+
+			// invalid labels are rejected on update as well
+			update := &model.Committee{CommitteeBase: *stored}
+			update.Labels = map[string]string{"-region": "emea"}
+			_, err = orchestrator.Update(ctx, update, revision, false)
+			require.Error(t, err)
+			assert.IsType(t, errs.Validation{}, err)
+		})
+	}
+}
+
+func TestCommitteeWriterOrchestrator_WebhookNotifications(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := mock.NewMockRepository()
+	mockRepo.ClearAll()
+	mockRepo.AddProject("project-1", "test-project", "Test Project")
+	notifier := mock.NewMockWebhookNotifier()
+
+	orchestrator := NewCommitteeWriterOrchestrator(
+		WithCommitteeRetriever(mock.NewMockCommitteeReader(mockRepo)),
+		WithCommitteeWriter(NewTestMockCommitteeWriter(mockRepo)),
+		WithProjectRetriever(mock.NewMockProjectRetriever(mockRepo)),
+		WithCommitteePublisher(mock.NewMockCommitteePublisher()),
+		WithWebhookNotifier(notifier),
+	)
+
+	waitForEvents := func(expected ...string) {
+		t.Helper()
+		assert.Eventually(t, func() bool {
+			return len(notifier.EventTypes()) == len(expected)
+		}, time.Second, 10*time.Millisecond)
+		assert.Equal(t, expected, notifier.EventTypes())
+	}
+
+	created, err := orchestrator.Create(ctx, &model.Committee{
+		CommitteeBase: model.CommitteeBase{
+			ProjectUID: "project-1",
+			Name:       "Webhook Committee",
+			Category:   "Board",
+		},
+		CommitteeSettings: &model.CommitteeSettings{},
+	}, false)
+	require.NoError(t, err)
+	waitForEvents("committee.created")
+
+	event := notifier.Events()[0]
+	assert.Equal(t, constants.CommitteeCreatedSubject, event.Subject)
+	base, ok := event.Data.(*model.CommitteeBase)
+	require.True(t, ok)
+	assert.Equal(t, created.CommitteeBase.UID, base.UID)
+
+	stored, revision, err := mockRepo.GetBase(ctx, created.CommitteeBase.UID)
+	require.NoError(t, err)
+	update := &model.Committee{CommitteeBase: *stored}
+	update.Description = "updated description"
+	_, err = orchestrator.Update(ctx, update, revision, false)
+	require.NoError(t, err)
+	waitForEvents("committee.created", "committee.updated")
+
+	_, revision, err = mockRepo.GetBase(ctx, created.CommitteeBase.UID)
+	require.NoError(t, err)
+	err = orchestrator.Delete(ctx, created.CommitteeBase.UID, revision, false)
+	require.NoError(t, err)
+	waitForEvents("committee.created", "committee.updated", "committee.deleted")
+
+	// a failed change does not notify the webhooks
+	_, err = orchestrator.Create(ctx, &model.Committee{
+		CommitteeBase: model.CommitteeBase{
+			ProjectUID: "project-1",
+			Name:       "Invalid Committee",
+			Category:   "not-a-category",
+		},
+	}, false)
+	require.Error(t, err)
+	assert.Never(t, func() bool {
+		return len(notifier.EventTypes()) > 3
+	}, 50*time.Millisecond, 10*time.Millisecond)
+}
+
 func TestCommitteeWriterOrchestrator_buildIndexerMessage(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -544,6 +917,29 @@ func TestCommitteeWriterOrchestrator_buildAccessControlMessage(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "blank and duplicated principals are dropped",
+			committee: &model.Committee{
+				CommitteeBase: model.CommitteeBase{
+					UID:        "committee-4",
+					ProjectUID: "project-4",
+				},
+				CommitteeSettings: &model.CommitteeSettings{
+					Writers:  []string{" writer@example.com", "writer@example.com", ""},
+					Auditors: []string{"  "},
+				},
+			},
+			expected: &model.CommitteeAccessMessage{
+				UID:        "committee-4",
+				ObjectType: "committee",
+				Relations: map[string][]string{
+					"writer": {"writer@example.com"},
+				},
+				References: map[string]string{
+					"project": "project-4",
+				},
+			},
+		},
 		{
 			name: "committee with nil settings should work fine",
 			committee: &model.Committee{
@@ -574,14 +970,63 @@ func TestCommitteeWriterOrchestrator_buildAccessControlMessage(t *testing.T) {
 			ctx := context.Background()
 
 			// Execute
-			result := orchestrator.buildAccessControlMessage(ctx, tc.committee)
+			result, err := orchestrator.buildAccessControlMessage(ctx, tc.committee)
 
 			// Validate
+			require.NoError(t, err)
 			assert.Equal(t, tc.expected, result)
 		})
 	}
 }
 
+func TestCommitteeWriterOrchestrator_AccessMessageRequiresProject(t *testing.T) {
+	t.Run("building the message without project fails", func(t *testing.T) {
+		orchestrator := &committeeWriterOrchestrator{}
+
+		result, err := orchestrator.buildAccessControlMessage(context.Background(), &model.Committee{
+			CommitteeBase: model.CommitteeBase{UID: "committee-no-project", ProjectUID: "  "},
+		})
+		require.Error(t, err)
+		assert.IsType(t, errs.Validation{}, err)
+		assert.Contains(t, err.Error(), "project UID is required")
+		assert.Nil(t, result)
+	})
+
+	t.Run("settings update of a committee without project publishes nothing", func(t *testing.T) {
+		mockRepo := mock.NewMockRepository()
+		mockRepo.ClearAll()
+		mockRepo.AddCommittee(&model.Committee{
+			CommitteeBase: model.CommitteeBase{
+				UID:      "committee-no-project",
+				Name:     "Orphan Committee",
+				Category: "Board",
+			},
+			CommitteeSettings: &model.CommitteeSettings{
+				UID:     "committee-no-project",
+				Writers: []string{"writer@example.com"},
+			},
+		})
+		publisher := newRecordingCommitteePublisher()
+
+		orchestrator := NewCommitteeWriterOrchestrator(
+			WithCommitteeRetriever(mock.NewMockCommitteeReader(mockRepo)),
+			WithCommitteeWriter(NewTestMockCommitteeWriter(mockRepo)),
+			WithProjectRetriever(mock.NewMockProjectRetriever(mockRepo)),
+			WithCommitteePublisher(publisher),
+		)
+
+		result, err := orchestrator.UpdateSettings(context.Background(), &model.CommitteeSettings{
+			UID:     "committee-no-project",
+			Writers: []string{"writer@example.com"},
+		}, 1, false)
+		require.Error(t, err)
+		assert.IsType(t, errs.Validation{}, err)
+		assert.Nil(t, result)
+		assert.Empty(t, publisher.access)
+		assert.Empty(t, publisher.indexer)
+	})
+}
+
 func TestCommitteeWriterOrchestrator_checkReserveSSOName(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -642,6 +1087,19 @@ func TestCommitteeWriterOrchestrator_checkReserveSSOName(t *testing.T) {
 				assert.Contains(t, committee.SSOGroupName, "test-project")
 			},
 		},
+		{
+			name: "empty project slug is rejected",
+			setupMock: func(mockRepo *mock.MockRepository) {
+				mockRepo.ClearAll()
+			},
+			committee: &model.Committee{
+				CommitteeBase: model.CommitteeBase{
+					Name: "New Committee",
+				},
+			},
+			slug:          "",
+			expectedError: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -663,6 +1121,7 @@ func TestCommitteeWriterOrchestrator_checkReserveSSOName(t *testing.T) {
 			// Validate
 			if tc.expectedError {
 				assert.Error(t, err)
+				assert.IsType(t, errs.Validation{}, err)
 				assert.Empty(t, key)
 			} else {
 				assert.NoError(t, err)
@@ -673,37 +1132,190 @@ func TestCommitteeWriterOrchestrator_checkReserveSSOName(t *testing.T) {
 	}
 }
 
-func TestCommitteeWriterOrchestrator_rollback(t *testing.T) {
+// contendedSSOCommitteeWriter reports every SSO group name as taken and counts the attempts
+type contendedSSOCommitteeWriter struct {
+	*TestMockCommitteeWriter
+	attempts int
+}
+
+func (w *contendedSSOCommitteeWriter) UniqueSSOGroupName(ctx context.Context, committee *model.Committee) (string, error) {
+	w.attempts++
+	return "existing-committee", errs.NewConflict("SSO group name already exists")
+}
+
+func TestCommitteeWriterOrchestrator_checkReserveSSOName_MaxAttempts(t *testing.T) {
 	testCases := []struct {
-		name         string
-		setupMock    func(*mock.MockRepository)
-		keys         []string
-		validateMock func(t *testing.T, mockRepo *mock.MockRepository)
+		name             string
+		maxAttempts      int
+		expectedAttempts int
 	}{
 		{
-			name: "successful rollback with existing committees",
-			setupMock: func(mockRepo *mock.MockRepository) {
-				mockRepo.ClearAll()
-				// Add committees that should be rolled back
-				committee1 := &model.Committee{
-					CommitteeBase: model.CommitteeBase{
-						UID:        "committee-to-rollback-1",
-						ProjectUID: "project-1",
-						Name:       "Committee 1",
-						CreatedAt:  time.Now(),
-						UpdatedAt:  time.Now(),
-					},
-					CommitteeSettings: &model.CommitteeSettings{
-						UID:       "committee-to-rollback-1",
-						CreatedAt: time.Now(),
-						UpdatedAt: time.Now(),
-					},
-				}
-				committee2 := &model.Committee{
-					CommitteeBase: model.CommitteeBase{
-						UID:        "committee-to-rollback-2",
-						ProjectUID: "project-1",
-						Name:       "Committee 2",
+			name:             "configured ceiling is honored",
+			maxAttempts:      3,
+			expectedAttempts: 3,
+		},
+		{
+			name:             "ceiling beyond the warning threshold",
+			maxAttempts:      ssoNameRetryWarnThreshold + 5,
+			expectedAttempts: ssoNameRetryWarnThreshold + 5,
+		},
+		{
+			name:             "default ceiling when not configured",
+			maxAttempts:      0,
+			expectedAttempts: defaultSSONameMaxAttempts,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			committeeWriter := &contendedSSOCommitteeWriter{
+				TestMockCommitteeWriter: NewTestMockCommitteeWriter(mock.NewMockRepository()),
+			}
+			orchestrator := NewCommitteeWriterOrchestrator(
+				WithCommitteeWriter(committeeWriter),
+				WithSSONameMaxAttempts(tc.maxAttempts),
+			).(*committeeWriterOrchestrator)
+
+			committee := &model.Committee{
+				CommitteeBase: model.CommitteeBase{
+					Name: "Hot Committee",
+				},
+			}
+
+			key, err := orchestrator.checkReserveSSOName(context.Background(), committee, "test-project")
+
+			require.Error(t, err)
+			assert.Empty(t, key)
+			assert.IsType(t, errs.Unexpected{}, err)
+			assert.Contains(t, err.Error(), fmt.Sprintf("after %d attempts", tc.expectedAttempts))
+			assert.Equal(t, tc.expectedAttempts, committeeWriter.attempts)
+		})
+	}
+}
+
+func TestCommitteeWriterOrchestrator_AccessRelations(t *testing.T) {
+	writers := []string{"writer1@example.com", "writer2@example.com"}
+	auditors := []string{"auditor@example.com"}
+
+	testCases := []struct {
+		name    string
+		execute func(ctx context.Context, orchestrator CommitteeWriter) error
+	}{
+		{
+			name: "create",
+			execute: func(ctx context.Context, orchestrator CommitteeWriter) error {
+				_, err := orchestrator.Create(ctx, &model.Committee{
+					CommitteeBase: model.CommitteeBase{
+						ProjectUID: "project-1",
+						Name:       "New Committee",
+						Category:   "Board",
+					},
+					CommitteeSettings: &model.CommitteeSettings{
+						Writers:  writers,
+						Auditors: auditors,
+					},
+				}, false)
+				return err
+			},
+		},
+		{
+			name: "update",
+			execute: func(ctx context.Context, orchestrator CommitteeWriter) error {
+				_, err := orchestrator.Update(ctx, &model.Committee{
+					CommitteeBase: model.CommitteeBase{
+						UID:        "committee-1",
+						ProjectUID: "project-1",
+						Name:       "Existing Committee",
+						Category:   "Technical Steering Committee",
+					},
+				}, uint64(1), false)
+				return err
+			},
+		},
+		{
+			name: "update settings",
+			execute: func(ctx context.Context, orchestrator CommitteeWriter) error {
+				_, err := orchestrator.UpdateSettings(ctx, &model.CommitteeSettings{
+					UID:      "committee-1",
+					Writers:  writers,
+					Auditors: auditors,
+				}, uint64(1), false)
+				return err
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := mock.NewMockRepository()
+			mockRepo.ClearAll()
+			mockRepo.AddProject("project-1", "test-project", "Test Project")
+			mockRepo.AddCommittee(&model.Committee{
+				CommitteeBase: model.CommitteeBase{
+					UID:        "committee-1",
+					ProjectUID: "project-1",
+					Name:       "Existing Committee",
+					Category:   "Board",
+				},
+				CommitteeSettings: &model.CommitteeSettings{
+					UID:      "committee-1",
+					Writers:  writers,
+					Auditors: auditors,
+				},
+			})
+
+			publisher := newRecordingCommitteePublisher()
+			orchestrator := NewCommitteeWriterOrchestrator(
+				WithCommitteeRetriever(mock.NewMockCommitteeReader(mockRepo)),
+				WithCommitteeWriter(NewTestMockCommitteeWriter(mockRepo)),
+				WithProjectRetriever(mock.NewMockProjectRetriever(mockRepo)),
+				WithCommitteePublisher(publisher),
+			)
+
+			require.NoError(t, tc.execute(context.Background(), orchestrator))
+
+			accessMessages := publisher.messages[constants.UpdateAccessCommitteeSubject]
+			require.Len(t, accessMessages, 1)
+			accessMessage, ok := accessMessages[0].(*model.CommitteeAccessMessage)
+			require.True(t, ok)
+			assert.Equal(t, writers, accessMessage.Relations[constants.RelationWriter])
+			assert.Equal(t, auditors, accessMessage.Relations[constants.RelationAuditor])
+			assert.Equal(t, "project-1", accessMessage.References[constants.RelationProject])
+		})
+	}
+}
+
+func TestCommitteeWriterOrchestrator_rollback(t *testing.T) {
+	testCases := []struct {
+		name         string
+		setupMock    func(*mock.MockRepository)
+		keys         []string
+		validateMock func(t *testing.T, mockRepo *mock.MockRepository)
+	}{
+		{
+			name: "successful rollback with existing committees",
+			setupMock: func(mockRepo *mock.MockRepository) {
+				mockRepo.ClearAll()
+				// Add committees that should be rolled back
+				committee1 := &model.Committee{
+					CommitteeBase: model.CommitteeBase{
+						UID:        "committee-to-rollback-1",
+						ProjectUID: "project-1",
+						Name:       "Committee 1",
+						CreatedAt:  time.Now(),
+						UpdatedAt:  time.Now(),
+					},
+					CommitteeSettings: &model.CommitteeSettings{
+						UID:       "committee-to-rollback-1",
+						CreatedAt: time.Now(),
+						UpdatedAt: time.Now(),
+					},
+				}
+				committee2 := &model.Committee{
+					CommitteeBase: model.CommitteeBase{
+						UID:        "committee-to-rollback-2",
+						ProjectUID: "project-1",
+						Name:       "Committee 2",
 						CreatedAt:  time.Now(),
 						UpdatedAt:  time.Now(),
 					},
@@ -851,6 +1463,413 @@ func (p *MockCommitteePublisherWithError) Event(ctx context.Context, subject str
 	return nil
 }
 
+// recordingCommitteePublisher is a mock publisher that records the subjects it was asked to publish
+type recordingCommitteePublisher struct {
+	mu       sync.Mutex
+	indexer  []string
+	access   []string
+	events   []string
+	messages map[string][]any
+}
+
+func newRecordingCommitteePublisher() *recordingCommitteePublisher {
+	return &recordingCommitteePublisher{messages: make(map[string][]any)}
+}
+
+func (p *recordingCommitteePublisher) Indexer(ctx context.Context, subject string, message any, sync bool) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.indexer = append(p.indexer, subject)
+	p.messages[subject] = append(p.messages[subject], message)
+	return nil
+}
+
+func (p *recordingCommitteePublisher) Access(ctx context.Context, subject string, message any, sync bool) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.access = append(p.access, subject)
+	p.messages[subject] = append(p.messages[subject], message)
+	return nil
+}
+
+func (p *recordingCommitteePublisher) Event(ctx context.Context, subject string, event any, sync bool) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = append(p.events, subject)
+	p.messages[subject] = append(p.messages[subject], event)
+	return nil
+}
+
+// failingIndexerPublisher is a recording publisher whose indexer messages fail to publish
+type failingIndexerPublisher struct {
+	*recordingCommitteePublisher
+}
+
+func (p *failingIndexerPublisher) Indexer(ctx context.Context, subject string, message any, sync bool) error {
+	return errs.NewServiceUnavailable("indexer unavailable")
+}
+
+func TestCommitteeWriterOrchestrator_IndexStatus(t *testing.T) {
+	mockRepo := mock.NewMockRepository()
+
+	addCommittee := func() time.Time {
+		updatedAt := time.Now().Add(-time.Hour)
+		mockRepo.AddCommittee(&model.Committee{
+			CommitteeBase: model.CommitteeBase{
+				UID:        "committee-indexed",
+				ProjectUID: "project-1",
+				Name:       "Indexed Committee",
+				Category:   "Technical Steering Committee",
+				UpdatedAt:  updatedAt,
+			},
+			CommitteeSettings: &model.CommitteeSettings{UID: "committee-indexed"},
+		})
+		return updatedAt
+	}
+
+	t.Run("successful publish records the index timestamp", func(t *testing.T) {
+		mockRepo.ClearAll()
+		addCommittee()
+
+		orchestrator := NewCommitteeWriterOrchestrator(
+			WithCommitteeRetriever(mock.NewMockCommitteeReader(mockRepo)),
+			WithCommitteeWriter(NewTestMockCommitteeWriter(mockRepo)),
+			WithProjectRetriever(mock.NewMockProjectRetriever(mockRepo)),
+			WithCommitteePublisher(newRecordingCommitteePublisher()),
+		)
+
+		result, err := orchestrator.Touch(context.Background(), "committee-indexed", 1, false)
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		require.NotNil(t, result.LastIndexedAt)
+		assert.False(t, result.LastIndexedAt.Before(result.CommitteeBase.UpdatedAt))
+		assert.Equal(t, model.IndexStatusSynced, result.IndexStatus())
+
+		lastIndexed, err := mockRepo.GetLastIndexed(context.Background(), "committee-indexed")
+		require.NoError(t, err)
+		require.NotNil(t, lastIndexed)
+		assert.True(t, lastIndexed.Equal(*result.LastIndexedAt))
+	})
+
+	t.Run("failed publish leaves the index timestamp stale", func(t *testing.T) {
+		mockRepo.ClearAll()
+		addCommittee()
+
+		orchestrator := NewCommitteeWriterOrchestrator(
+			WithCommitteeRetriever(mock.NewMockCommitteeReader(mockRepo)),
+			WithCommitteeWriter(NewTestMockCommitteeWriter(mockRepo)),
+			WithProjectRetriever(mock.NewMockProjectRetriever(mockRepo)),
+			WithCommitteePublisher(&failingIndexerPublisher{newRecordingCommitteePublisher()}),
+		)
+
+		_, err := orchestrator.Touch(context.Background(), "committee-indexed", 1, false)
+		require.Error(t, err)
+
+		lastIndexed, err := mockRepo.GetLastIndexed(context.Background(), "committee-indexed")
+		require.NoError(t, err)
+		assert.Nil(t, lastIndexed)
+
+		base, _, err := mockRepo.GetBase(context.Background(), "committee-indexed")
+		require.NoError(t, err)
+		assert.Equal(t, model.IndexStatusPending, base.IndexStatus())
+	})
+}
+
+func TestCommitteeWriterOrchestrator_Touch(t *testing.T) {
+	mockRepo := mock.NewMockRepository()
+
+	t.Run("touch republishes messages and bumps UpdatedAt", func(t *testing.T) {
+		mockRepo.ClearAll()
+		updatedAt := time.Now().Add(-24 * time.Hour)
+		mockRepo.AddCommittee(&model.Committee{
+			CommitteeBase: model.CommitteeBase{
+				UID:         "committee-touch",
+				ProjectUID:  "project-1",
+				Name:        "Touch Committee",
+				Category:    "Technical Steering Committee",
+				Description: "unchanged description",
+				UpdatedAt:   updatedAt,
+			},
+			CommitteeSettings: &model.CommitteeSettings{
+				UID:     "committee-touch",
+				Writers: []string{"writer1"},
+			},
+		})
+
+		publisher := newRecordingCommitteePublisher()
+		orchestrator := NewCommitteeWriterOrchestrator(
+			WithCommitteeRetriever(mock.NewMockCommitteeReader(mockRepo)),
+			WithCommitteeWriter(NewTestMockCommitteeWriter(mockRepo)),
+			WithProjectRetriever(mock.NewMockProjectRetriever(mockRepo)),
+			WithCommitteePublisher(publisher),
+		)
+
+		result, err := orchestrator.Touch(context.Background(), "committee-touch", 1, false)
+		require.NoError(t, err)
+		require.NotNil(t, result)
+
+		assert.True(t, result.CommitteeBase.UpdatedAt.After(updatedAt))
+		assert.Equal(t, "Touch Committee", result.Name)
+		assert.Equal(t, "unchanged description", result.Description)
+		assert.ElementsMatch(t, []string{constants.IndexCommitteeSubject, constants.IndexCommitteeSettingsSubject}, publisher.indexer)
+		assert.Equal(t, []string{constants.UpdateAccessCommitteeSubject}, publisher.access)
+	})
+
+	t.Run("touch with stale revision is rejected", func(t *testing.T) {
+		mockRepo.ClearAll()
+		mockRepo.AddCommittee(&model.Committee{
+			CommitteeBase: model.CommitteeBase{UID: "committee-touch", Name: "Touch Committee"},
+		})
+
+		publisher := newRecordingCommitteePublisher()
+		orchestrator := NewCommitteeWriterOrchestrator(
+			WithCommitteeRetriever(mock.NewMockCommitteeReader(mockRepo)),
+			WithCommitteeWriter(NewTestMockCommitteeWriter(mockRepo)),
+			WithCommitteePublisher(publisher),
+		)
+
+		result, err := orchestrator.Touch(context.Background(), "committee-touch", 2, false)
+		require.Error(t, err)
+		assert.IsType(t, errs.Conflict{}, err)
+		assert.Nil(t, result)
+		assert.Empty(t, publisher.indexer)
+		assert.Empty(t, publisher.access)
+	})
+
+	t.Run("touch on missing committee returns not found", func(t *testing.T) {
+		mockRepo.ClearAll()
+
+		orchestrator := NewCommitteeWriterOrchestrator(
+			WithCommitteeRetriever(mock.NewMockCommitteeReader(mockRepo)),
+			WithCommitteeWriter(NewTestMockCommitteeWriter(mockRepo)),
+			WithCommitteePublisher(newRecordingCommitteePublisher()),
+		)
+
+		_, err := orchestrator.Touch(context.Background(), "missing", 1, false)
+		require.Error(t, err)
+		assert.IsType(t, errs.NotFound{}, err)
+	})
+}
+
+func TestCommitteeWriterOrchestrator_Create_ConcurrentSameName(t *testing.T) {
+	mockRepo := mock.NewMockRepository()
+	mockRepo.ClearAll()
+	mockRepo.AddProject("project-1", "test-project", "Test Project")
+
+	orchestrator := NewCommitteeWriterOrchestrator(
+		WithCommitteeRetriever(mock.NewMockCommitteeReader(mockRepo)),
+		WithCommitteeWriter(NewTestMockCommitteeWriter(mockRepo)),
+		WithProjectRetriever(mock.NewMockProjectRetriever(mockRepo)),
+		WithCommitteePublisher(newRecordingCommitteePublisher()),
+	)
+
+	const attempts = 10
+	var (
+		wg        sync.WaitGroup
+		errsMu    sync.Mutex
+		results   []error
+		startLine = make(chan struct{})
+	)
+	for i := 0; i < attempts; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-startLine
+			_, err := orchestrator.Create(context.Background(), &model.Committee{
+				CommitteeBase: model.CommitteeBase{
+					ProjectUID: "project-1",
+					Name:       "Concurrent Committee",
+					Category:   "Board",
+				},
+				CommitteeSettings: &model.CommitteeSettings{},
+			}, false)
+			errsMu.Lock()
+			results = append(results, err)
+			errsMu.Unlock()
+		}()
+	}
+	close(startLine)
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range results {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		assert.IsType(t, errs.Conflict{}, err)
+	}
+	assert.Equal(t, 1, succeeded, "exactly one concurrent create must win the name reservation")
+	assert.Equal(t, 1, mockRepo.GetCommitteeCount())
+}
+
+func TestCommitteeWriterOrchestrator_RecountMembers(t *testing.T) {
+	mockRepo := mock.NewMockRepository()
+
+	t.Run("drifted counts are recomputed from the stored members", func(t *testing.T) {
+		mockRepo.ClearAll()
+		mockRepo.AddCommittee(&model.Committee{
+			CommitteeBase: model.CommitteeBase{
+				UID:              "committee-recount",
+				ProjectUID:       "project-1",
+				Name:             "Recount Committee",
+				TotalMembers:     42,
+				TotalVotingRepos: 7,
+			},
+		})
+		for i, votingStatus := range []string{"Voting Rep", "Voting Rep", "Observer"} {
+			mockRepo.AddCommitteeMember("committee-recount", &model.CommitteeMember{
+				CommitteeMemberBase: model.CommitteeMemberBase{
+					UID:          fmt.Sprintf("member-%d", i),
+					CommitteeUID: "committee-recount",
+					Email:        fmt.Sprintf("member-%d@example.com", i),
+					Voting:       model.CommitteeMemberVotingInfo{Status: votingStatus},
+				},
+			})
+		}
+
+		publisher := newRecordingCommitteePublisher()
+		orchestrator := NewCommitteeWriterOrchestrator(
+			WithCommitteeRetriever(mock.NewMockCommitteeReader(mockRepo)),
+			WithCommitteeWriter(NewTestMockCommitteeWriter(mockRepo)),
+			WithCommitteePublisher(publisher),
+		)
+
+		result, err := orchestrator.RecountMembers(context.Background(), "committee-recount", false)
+		require.NoError(t, err)
+		assert.Equal(t, 3, result.TotalMembers)
+		assert.Equal(t, 2, result.TotalVotingRepos)
+
+		stored, _, err := mockRepo.GetBase(context.Background(), "committee-recount")
+		require.NoError(t, err)
+		assert.Equal(t, 3, stored.TotalMembers)
+		assert.Equal(t, 2, stored.TotalVotingRepos)
+
+		require.Len(t, publisher.messages[constants.IndexCommitteeSubject], 1)
+		message, ok := publisher.messages[constants.IndexCommitteeSubject][0].(*model.CommitteeIndexerMessage)
+		require.True(t, ok)
+		data, ok := message.Data.(map[string]any)
+		require.True(t, ok)
+		assert.Equal(t, float64(3), data["total_members"])
+		assert.Equal(t, float64(2), data["total_voting_repos"])
+	})
+
+	t.Run("missing committee returns not found", func(t *testing.T) {
+		mockRepo.ClearAll()
+
+		publisher := newRecordingCommitteePublisher()
+		orchestrator := NewCommitteeWriterOrchestrator(
+			WithCommitteeRetriever(mock.NewMockCommitteeReader(mockRepo)),
+			WithCommitteeWriter(NewTestMockCommitteeWriter(mockRepo)),
+			WithCommitteePublisher(publisher),
+		)
+
+		_, err := orchestrator.RecountMembers(context.Background(), "missing", false)
+		require.Error(t, err)
+		assert.IsType(t, errs.NotFound{}, err)
+		assert.Empty(t, publisher.indexer)
+	})
+}
+
+// recountOverlapTracker records how many recounts are between reading the committee base and persisting it
+type recountOverlapTracker struct {
+	mu        sync.Mutex
+	active    int
+	maxActive int
+}
+
+func (r *recountOverlapTracker) enter() {
+	r.mu.Lock()
+	r.active++
+	if r.active > r.maxActive {
+		r.maxActive = r.active
+	}
+	r.mu.Unlock()
+	// Widen the window between the read and the write
+	time.Sleep(20 * time.Millisecond)
+}
+
+func (r *recountOverlapTracker) exit() {
+	r.mu.Lock()
+	r.active--
+	r.mu.Unlock()
+}
+
+type overlapTrackingReader struct {
+	port.CommitteeReader
+	tracker *recountOverlapTracker
+}
+
+func (r *overlapTrackingReader) GetBase(ctx context.Context, uid string) (*model.CommitteeBase, uint64, error) {
+	r.tracker.enter()
+	return r.CommitteeReader.GetBase(ctx, uid)
+}
+
+type overlapTrackingWriter struct {
+	*TestMockCommitteeWriter
+	tracker *recountOverlapTracker
+}
+
+func (w *overlapTrackingWriter) UpdateBase(ctx context.Context, committee *model.Committee, revision uint64) error {
+	defer w.tracker.exit()
+	return w.TestMockCommitteeWriter.UpdateBase(ctx, committee, revision)
+}
+
+func TestCommitteeWriterOrchestrator_RecountMembers_Lock(t *testing.T) {
+	mockRepo := mock.NewMockRepository()
+	mockRepo.ClearAll()
+	mockRepo.AddCommittee(&model.Committee{
+		CommitteeBase: model.CommitteeBase{
+			UID:              "committee-locked",
+			ProjectUID:       "project-1",
+			Name:             "Locked Committee",
+			TotalMembers:     10,
+			TotalVotingRepos: 10,
+		},
+	})
+	for i, votingStatus := range []string{"Voting Rep", "Observer"} {
+		mockRepo.AddCommitteeMember("committee-locked", &model.CommitteeMember{
+			CommitteeMemberBase: model.CommitteeMemberBase{
+				UID:          fmt.Sprintf("member-%d", i),
+				CommitteeUID: "committee-locked",
+				Email:        fmt.Sprintf("member-%d@example.com", i),
+				Voting:       model.CommitteeMemberVotingInfo{Status: votingStatus},
+			},
+		})
+	}
+
+	tracker := &recountOverlapTracker{}
+	publisher := newRecordingCommitteePublisher()
+	orchestrator := NewCommitteeWriterOrchestrator(
+		WithCommitteeRetriever(&overlapTrackingReader{CommitteeReader: mock.NewMockCommitteeReader(mockRepo), tracker: tracker}),
+		WithCommitteeWriter(&overlapTrackingWriter{TestMockCommitteeWriter: NewTestMockCommitteeWriter(mockRepo), tracker: tracker}),
+		WithCommitteePublisher(publisher),
+		WithCommitteeLocker(mock.NewMockCommitteeLocker()),
+	)
+
+	var wg sync.WaitGroup
+	results := make([]error, 2)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, results[i] = orchestrator.RecountMembers(context.Background(), "committee-locked", false)
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range results {
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, tracker.maxActive, "concurrent recounts of the same committee must be serialized")
+
+	stored, _, err := mockRepo.GetBase(context.Background(), "committee-locked")
+	require.NoError(t, err)
+	assert.Equal(t, 2, stored.TotalMembers)
+	assert.Equal(t, 1, stored.TotalVotingRepos)
+	assert.Len(t, publisher.messages[constants.IndexCommitteeSubject], 2)
+}
+
 func TestCommitteeWriterOrchestrator_Create_PublishingErrors(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -906,7 +1925,7 @@ func TestCommitteeWriterOrchestrator_Create_PublishingErrors(t *testing.T) {
 				CommitteeBase: model.CommitteeBase{
 					ProjectUID:      "project-1",
 					Name:            "Test Committee",
-					Category:        "governance",
+					Category:        "Board",
 					EnableVoting:    true,
 					SSOGroupEnabled: false,
 				},
@@ -953,7 +1972,7 @@ func TestCommitteeWriterOrchestrator_Update(t *testing.T) {
 						UID:         "committee-1",
 						ProjectUID:  "project-1",
 						Name:        "Original Committee",
-						Category:    "governance",
+						Category:    "Board",
 						Description: "Original description",
 					},
 				}
@@ -965,7 +1984,7 @@ func TestCommitteeWriterOrchestrator_Update(t *testing.T) {
 					UID:         "committee-1",
 					ProjectUID:  "project-1",
 					Name:        "Updated Committee",
-					Category:    "technical",
+					Category:    "Technical Steering Committee",
 					Description: "Updated description",
 				},
 			},
@@ -973,7 +1992,7 @@ func TestCommitteeWriterOrchestrator_Update(t *testing.T) {
 			expectError: false,
 			validateResult: func(t *testing.T, result *model.Committee) {
 				assert.Equal(t, "Updated Committee", result.Name)
-				assert.Equal(t, "technical", result.Category)
+				assert.Equal(t, "Technical Steering Committee", result.Category)
 				assert.Equal(t, "Updated description", result.Description)
 			},
 		},
@@ -987,7 +2006,7 @@ func TestCommitteeWriterOrchestrator_Update(t *testing.T) {
 					UID:        "nonexistent-committee",
 					ProjectUID: "project-1",
 					Name:       "Updated Committee",
-					Category:   "governance",
+					Category:   "Board",
 				},
 			},
 			revision:    uint64(1),
@@ -1005,7 +2024,7 @@ func TestCommitteeWriterOrchestrator_Update(t *testing.T) {
 						UID:        "committee-1",
 						ProjectUID: "project-1",
 						Name:       "Original Committee",
-						Category:   "governance",
+						Category:   "Board",
 					},
 				}
 				mockRepo.AddCommittee(committee)
@@ -1016,7 +2035,7 @@ func TestCommitteeWriterOrchestrator_Update(t *testing.T) {
 					UID:        "committee-1",
 					ProjectUID: "project-1",
 					Name:       "Updated Committee",
-					Category:   "governance",
+					Category:   "Board",
 				},
 			},
 			revision:    uint64(2), // Trying to update with wrong revision
@@ -1035,7 +2054,7 @@ func TestCommitteeWriterOrchestrator_Update(t *testing.T) {
 						UID:        "committee-1",
 						ProjectUID: "project-1",
 						Name:       "Test Committee",
-						Category:   "governance",
+						Category:   "Board",
 					},
 				}
 				mockRepo.AddCommittee(committee)
@@ -1046,7 +2065,7 @@ func TestCommitteeWriterOrchestrator_Update(t *testing.T) {
 					UID:        "committee-1",
 					ProjectUID: "project-2", // Changing project
 					Name:       "Test Committee",
-					Category:   "governance",
+					Category:   "Board",
 				},
 			},
 			revision:    uint64(1),
@@ -1067,7 +2086,7 @@ func TestCommitteeWriterOrchestrator_Update(t *testing.T) {
 						UID:        "committee-1",
 						ProjectUID: "project-1",
 						Name:       "Test Committee",
-						Category:   "governance",
+						Category:   "Board",
 					},
 				}
 				mockRepo.AddCommittee(committee)
@@ -1078,7 +2097,7 @@ func TestCommitteeWriterOrchestrator_Update(t *testing.T) {
 					UID:        "committee-1",
 					ProjectUID: "nonexistent-project",
 					Name:       "Test Committee",
-					Category:   "governance",
+					Category:   "Board",
 				},
 			},
 			revision:    uint64(1),
@@ -1096,7 +2115,7 @@ func TestCommitteeWriterOrchestrator_Update(t *testing.T) {
 						UID:        "committee-1",
 						ProjectUID: "project-1",
 						Name:       "Original Name",
-						Category:   "governance",
+						Category:   "Board",
 					},
 				}
 				mockRepo.AddCommittee(committee)
@@ -1107,7 +2126,7 @@ func TestCommitteeWriterOrchestrator_Update(t *testing.T) {
 					UID:        "committee-1",
 					ProjectUID: "project-1",
 					Name:       "New Unique Name",
-					Category:   "governance",
+					Category:   "Board",
 				},
 			},
 			revision:    uint64(1),
@@ -1128,7 +2147,7 @@ func TestCommitteeWriterOrchestrator_Update(t *testing.T) {
 						UID:        "committee-1",
 						ProjectUID: "project-1",
 						Name:       "Original Name",
-						Category:   "governance",
+						Category:   "Board",
 					},
 				}
 				mockRepo.AddCommittee(committee1)
@@ -1139,7 +2158,7 @@ func TestCommitteeWriterOrchestrator_Update(t *testing.T) {
 						UID:        "committee-2",
 						ProjectUID: "project-1",
 						Name:       "Conflicting Name",
-						Category:   "technical",
+						Category:   "Technical Steering Committee",
 					},
 				}
 				mockRepo.AddCommittee(committee2)
@@ -1151,7 +2170,7 @@ func TestCommitteeWriterOrchestrator_Update(t *testing.T) {
 					UID:        "committee-1",
 					ProjectUID: "project-1",
 					Name:       "Conflicting Name", // This name already exists
-					Category:   "governance",
+					Category:   "Board",
 				},
 			},
 			revision:    uint64(1),
@@ -1170,7 +2189,7 @@ func TestCommitteeWriterOrchestrator_Update(t *testing.T) {
 						UID:        "parent-committee",
 						ProjectUID: "project-1",
 						Name:       "Parent Committee",
-						Category:   "governance",
+						Category:   "Board",
 					},
 				}
 				mockRepo.AddCommittee(parentCommittee)
@@ -1181,7 +2200,7 @@ func TestCommitteeWriterOrchestrator_Update(t *testing.T) {
 						UID:        "child-committee",
 						ProjectUID: "project-1",
 						Name:       "Child Committee",
-						Category:   "technical",
+						Category:   "Technical Steering Committee",
 					},
 				}
 				mockRepo.AddCommittee(childCommittee)
@@ -1193,7 +2212,7 @@ func TestCommitteeWriterOrchestrator_Update(t *testing.T) {
 					UID:        "child-committee",
 					ProjectUID: "project-1",
 					Name:       "Child Committee",
-					Category:   "technical",
+					Category:   "Technical Steering Committee",
 					ParentUID:  func() *string { s := "parent-committee"; return &s }(),
 				},
 			},
@@ -1215,7 +2234,7 @@ func TestCommitteeWriterOrchestrator_Update(t *testing.T) {
 						UID:        "committee-1",
 						ProjectUID: "project-1",
 						Name:       "Test Committee",
-						Category:   "governance",
+						Category:   "Board",
 					},
 				}
 				mockRepo.AddCommittee(committee)
@@ -1226,7 +2245,7 @@ func TestCommitteeWriterOrchestrator_Update(t *testing.T) {
 					UID:        "committee-1",
 					ProjectUID: "project-1",
 					Name:       "Test Committee",
-					Category:   "governance",
+					Category:   "Board",
 					ParentUID:  func() *string { s := "nonexistent-parent"; return &s }(),
 				},
 			},
@@ -1327,7 +2346,7 @@ func TestCommitteeWriterOrchestrator_Update_SSO_Scenarios(t *testing.T) {
 						UID:             "committee-1",
 						ProjectUID:      "project-1",
 						Name:            "SSO Committee",
-						Category:        "governance",
+						Category:        "Board",
 						SSOGroupEnabled: true,
 						SSOGroupName:    "test-project-sso-committee",
 					},
@@ -1339,15 +2358,15 @@ func TestCommitteeWriterOrchestrator_Update_SSO_Scenarios(t *testing.T) {
 				CommitteeBase: model.CommitteeBase{
 					UID:             "committee-1",
 					ProjectUID:      "project-1",
-					Name:            "SSO Committee", // Same name
-					Category:        "technical",     // Different category
+					Name:            "SSO Committee",                // Same name
+					Category:        "Technical Steering Committee", // Different category
 					SSOGroupEnabled: true,
 				},
 			},
 			expectError: false,
 			validateResult: func(t *testing.T, result *model.Committee) {
 				assert.Equal(t, "SSO Committee", result.Name)
-				assert.Equal(t, "technical", result.Category)
+				assert.Equal(t, "Technical Steering Committee", result.Category)
 				assert.True(t, result.SSOGroupEnabled)
 				assert.Equal(t, "test-project-sso-committee", result.SSOGroupName) // Should remain unchanged
 			},
@@ -1408,6 +2427,441 @@ func TestCommitteeWriterOrchestrator_Update_SSO_Scenarios(t *testing.T) {
 	}
 }
 
+// lookupRevisionReader resolves the revision of lookup keys, which the mock repository does not store
+type lookupRevisionReader struct {
+	port.CommitteeReader
+}
+
+func (r *lookupRevisionReader) GetRevision(ctx context.Context, uid string) (uint64, error) {
+	if strings.HasPrefix(uid, "lookup/") {
+		return 1, nil
+	}
+	return r.CommitteeReader.GetRevision(ctx, uid)
+}
+
+// keyRecordingCommitteeWriter records the lookup keys deleted by the orchestrator
+type keyRecordingCommitteeWriter struct {
+	*TestMockCommitteeWriter
+	mu          sync.Mutex
+	deletedKeys []string
+}
+
+func (w *keyRecordingCommitteeWriter) Delete(ctx context.Context, uid string, revision uint64) error {
+	if strings.HasPrefix(uid, "lookup/") {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		w.deletedKeys = append(w.deletedKeys, uid)
+		return nil
+	}
+	return w.TestMockCommitteeWriter.Delete(ctx, uid, revision)
+}
+
+func (w *keyRecordingCommitteeWriter) deleted() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([]string{}, w.deletedKeys...)
+}
+
+func TestCommitteeWriterOrchestrator_Update_SSO_Toggle(t *testing.T) {
+	tests := []struct {
+		name                string
+		existingSSOEnabled  bool
+		existingSSOName     string
+		updateSSOEnabled    bool
+		expectedSSOName     string
+		expectedDeletedKeys []string
+	}{
+		{
+			name:                "disabling SSO releases the group name",
+			existingSSOEnabled:  true,
+			existingSSOName:     "test-project-sso-committee",
+			updateSSOEnabled:    false,
+			expectedSSOName:     "",
+			expectedDeletedKeys: []string{fmt.Sprintf(constants.KVLookupSSOGroupNamePrefix, "test-project-sso-committee")},
+		},
+		{
+			name:               "re-enabling SSO reserves a new group name",
+			existingSSOEnabled: false,
+			existingSSOName:    "",
+			updateSSOEnabled:   true,
+			expectedSSOName:    "test-project-sso-committee",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := mock.NewMockRepository()
+			mockRepo.ClearAll()
+			mockRepo.AddProject("project-1", "test-project", "Test Project")
+			mockRepo.AddCommittee(&model.Committee{
+				CommitteeBase: model.CommitteeBase{
+					UID:             "committee-1",
+					ProjectUID:      "project-1",
+					Name:            "SSO Committee",
+					Category:        "Board",
+					SSOGroupEnabled: tc.existingSSOEnabled,
+					SSOGroupName:    tc.existingSSOName,
+				},
+			})
+
+			committeeWriter := &keyRecordingCommitteeWriter{TestMockCommitteeWriter: NewTestMockCommitteeWriter(mockRepo)}
+			orchestrator := NewCommitteeWriterOrchestrator(
+				WithCommitteeRetriever(&lookupRevisionReader{CommitteeReader: mock.NewMockCommitteeReader(mockRepo)}),
+				WithCommitteeWriter(committeeWriter),
+				WithProjectRetriever(mock.NewMockProjectRetriever(mockRepo)),
+				WithCommitteePublisher(mock.NewMockCommitteePublisher()),
+			)
+
+			ctx := context.Background()
+			result, err := orchestrator.Update(ctx, &model.Committee{
+				CommitteeBase: model.CommitteeBase{
+					UID:             "committee-1",
+					ProjectUID:      "project-1",
+					Name:            "SSO Committee",
+					Category:        "Board",
+					SSOGroupEnabled: tc.updateSSOEnabled,
+				},
+			}, uint64(1), false)
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, tc.updateSSOEnabled, result.SSOGroupEnabled)
+			assert.Equal(t, tc.expectedSSOName, result.SSOGroupName)
+
+			stored, _, errGet := mockRepo.GetBase(ctx, "committee-1")
+			require.NoError(t, errGet)
+			assert.Equal(t, tc.expectedSSOName, stored.SSOGroupName)
+
+			if tc.updateSSOEnabled {
+				_, reserved := committeeWriter.reservations["sso:"+tc.expectedSSOName]
+				assert.True(t, reserved, "Expected the new SSO group name to be reserved")
+			}
+
+			// Stale keys are cleaned up asynchronously
+			assert.Eventually(t, func() bool {
+				return len(committeeWriter.deleted()) == len(tc.expectedDeletedKeys)
+			}, time.Second, 10*time.Millisecond)
+			for _, key := range tc.expectedDeletedKeys {
+				assert.Contains(t, committeeWriter.deleted(), key)
+			}
+		})
+	}
+}
+
+func TestCommitteeWriterOrchestrator_Update_RenameSSOReservation(t *testing.T) {
+	tests := []struct {
+		name            string
+		newName         string
+		expectedSSOName string
+		expectReserved  bool
+	}{
+		{
+			name:            "rename building the same SSO group name skips the reservation",
+			newName:         "sso committee",
+			expectedSSOName: "test-project-sso-committee",
+			expectReserved:  false,
+		},
+		{
+			name:            "rename building a different SSO group name reserves it",
+			newName:         "Security Committee",
+			expectedSSOName: "test-project-security-committee",
+			expectReserved:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := mock.NewMockRepository()
+			mockRepo.ClearAll()
+			mockRepo.AddProject("project-1", "test-project", "Test Project")
+			mockRepo.AddCommittee(&model.Committee{
+				CommitteeBase: model.CommitteeBase{
+					UID:             "committee-1",
+					ProjectUID:      "project-1",
+					Name:            "SSO Committee",
+					Category:        "Board",
+					SSOGroupEnabled: true,
+					SSOGroupName:    "test-project-sso-committee",
+				},
+			})
+
+			committeeWriter := &keyRecordingCommitteeWriter{TestMockCommitteeWriter: NewTestMockCommitteeWriter(mockRepo)}
+			orchestrator := NewCommitteeWriterOrchestrator(
+				WithCommitteeRetriever(&lookupRevisionReader{CommitteeReader: mock.NewMockCommitteeReader(mockRepo)}),
+				WithCommitteeWriter(committeeWriter),
+				WithProjectRetriever(mock.NewMockProjectRetriever(mockRepo)),
+				WithCommitteePublisher(mock.NewMockCommitteePublisher()),
+			)
+
+			ctx := context.Background()
+			result, err := orchestrator.Update(ctx, &model.Committee{
+				CommitteeBase: model.CommitteeBase{
+					UID:             "committee-1",
+					ProjectUID:      "project-1",
+					Name:            tc.newName,
+					Category:        "Board",
+					SSOGroupEnabled: true,
+				},
+			}, uint64(1), false)
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, tc.expectedSSOName, result.SSOGroupName)
+
+			ssoReservations := 0
+			for key := range committeeWriter.reservations {
+				if strings.HasPrefix(key, "sso:") {
+					ssoReservations++
+				}
+			}
+			if tc.expectReserved {
+				assert.Equal(t, 1, ssoReservations)
+				_, reserved := committeeWriter.reservations["sso:"+tc.expectedSSOName]
+				assert.True(t, reserved, "Expected the new SSO group name to be reserved")
+			} else {
+				assert.Zero(t, ssoReservations)
+			}
+
+			stored, _, errGet := mockRepo.GetBase(ctx, "committee-1")
+			require.NoError(t, errGet)
+			assert.Equal(t, tc.expectedSSOName, stored.SSOGroupName)
+		})
+	}
+}
+
+// lookupKeyCommitteeWriter reserves the name and SSO group name under lookup keys the way the storage does,
+// optionally failing the base write
+type lookupKeyCommitteeWriter struct {
+	*keyRecordingCommitteeWriter
+	updateBaseErr error
+}
+
+func (w *lookupKeyCommitteeWriter) UniqueNameProject(ctx context.Context, committee *model.Committee) (string, error) {
+	return fmt.Sprintf(constants.KVLookupPrefix, committee.BuildIndexKey(ctx)), nil
+}
+
+func (w *lookupKeyCommitteeWriter) UniqueSSOGroupName(ctx context.Context, committee *model.Committee) (string, error) {
+	return fmt.Sprintf(constants.KVLookupSSOGroupNamePrefix, committee.SSOGroupName), nil
+}
+
+func (w *lookupKeyCommitteeWriter) UpdateBase(ctx context.Context, committee *model.Committee, revision uint64) error {
+	if w.updateBaseErr != nil {
+		return w.updateBaseErr
+	}
+	return w.keyRecordingCommitteeWriter.UpdateBase(ctx, committee, revision)
+}
+
+// failingSettingsReader fails to read the committee settings, which happens after the base is written
+type failingSettingsReader struct {
+	port.CommitteeReader
+	err error
+}
+
+func (r *failingSettingsReader) GetSettings(ctx context.Context, uid string) (*model.CommitteeSettings, uint64, error) {
+	if r.err != nil {
+		return nil, 0, r.err
+	}
+	return r.CommitteeReader.GetSettings(ctx, uid)
+}
+
+func TestCommitteeWriterOrchestrator_Update_RenameKeyOrdering(t *testing.T) {
+	existingBase := model.CommitteeBase{
+		UID:             "committee-1",
+		ProjectUID:      "project-1",
+		Name:            "SSO Committee",
+		Category:        "Board",
+		SSOGroupEnabled: true,
+		SSOGroupName:    "test-project-sso-committee",
+	}
+	renamed := &model.Committee{CommitteeBase: model.CommitteeBase{
+		UID:             "committee-1",
+		ProjectUID:      "project-1",
+		Name:            "Security Committee",
+		Category:        "Board",
+		SSOGroupEnabled: true,
+	}}
+
+	ctx := context.Background()
+	oldNameKey := fmt.Sprintf(constants.KVLookupPrefix, (&model.Committee{CommitteeBase: existingBase}).BuildIndexKey(ctx))
+	newNameKey := fmt.Sprintf(constants.KVLookupPrefix, renamed.BuildIndexKey(ctx))
+	oldSSOKey := fmt.Sprintf(constants.KVLookupSSOGroupNamePrefix, "test-project-sso-committee")
+	newSSOKey := fmt.Sprintf(constants.KVLookupSSOGroupNamePrefix, "test-project-security-committee")
+
+	tests := []struct {
+		name                string
+		updateBaseErr       error
+		settingsErr         error
+		expectedStoredName  string
+		expectedDeletedKeys []string
+	}{
+		{
+			name:                "failure after the base write keeps the new keys and releases only the old ones",
+			settingsErr:         errs.NewUnexpected("settings unavailable"),
+			expectedStoredName:  "Security Committee",
+			expectedDeletedKeys: []string{oldNameKey, oldSSOKey},
+		},
+		{
+			name:                "failure writing the base rolls back the new keys and keeps the old ones",
+			updateBaseErr:       errs.NewUnexpected("storage unavailable"),
+			expectedStoredName:  "SSO Committee",
+			expectedDeletedKeys: []string{newNameKey, newSSOKey},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := mock.NewMockRepository()
+			mockRepo.ClearAll()
+			mockRepo.AddProject("project-1", "test-project", "Test Project")
+			mockRepo.AddCommittee(&model.Committee{CommitteeBase: existingBase})
+
+			committeeWriter := &lookupKeyCommitteeWriter{
+				keyRecordingCommitteeWriter: &keyRecordingCommitteeWriter{TestMockCommitteeWriter: NewTestMockCommitteeWriter(mockRepo)},
+				updateBaseErr:               tc.updateBaseErr,
+			}
+			orchestrator := NewCommitteeWriterOrchestrator(
+				WithCommitteeRetriever(&failingSettingsReader{
+					CommitteeReader: &lookupRevisionReader{CommitteeReader: mock.NewMockCommitteeReader(mockRepo)},
+					err:             tc.settingsErr,
+				}),
+				WithCommitteeWriter(committeeWriter),
+				WithProjectRetriever(mock.NewMockProjectRetriever(mockRepo)),
+				WithCommitteePublisher(mock.NewMockCommitteePublisher()),
+			)
+
+			update := *renamed
+			result, err := orchestrator.Update(ctx, &update, uint64(1), false)
+			require.Error(t, err)
+			assert.Nil(t, result)
+
+			stored, _, errGet := mockRepo.GetBase(ctx, "committee-1")
+			require.NoError(t, errGet)
+			assert.Equal(t, tc.expectedStoredName, stored.Name)
+
+			// Stale keys are cleaned up asynchronously
+			assert.Eventually(t, func() bool {
+				return len(committeeWriter.deleted()) == len(tc.expectedDeletedKeys)
+			}, time.Second, 10*time.Millisecond)
+			assert.ElementsMatch(t, tc.expectedDeletedKeys, committeeWriter.deleted())
+		})
+	}
+}
+
+// settingsGuardCommitteeWriter records every settings write and the settings handed to UpdateBase
+type settingsGuardCommitteeWriter struct {
+	*TestMockCommitteeWriter
+	settingsWrites     int
+	updateBaseSettings []*model.CommitteeSettings
+}
+
+func (w *settingsGuardCommitteeWriter) UpdateBase(ctx context.Context, committee *model.Committee, revision uint64) error {
+	w.updateBaseSettings = append(w.updateBaseSettings, committee.CommitteeSettings)
+	return w.TestMockCommitteeWriter.UpdateBase(ctx, committee, revision)
+}
+
+func (w *settingsGuardCommitteeWriter) UpdateSetting(ctx context.Context, settings *model.CommitteeSettings, revision uint64) error {
+	w.settingsWrites++
+	return w.TestMockCommitteeWriter.UpdateSetting(ctx, settings, revision)
+}
+
+func (w *settingsGuardCommitteeWriter) UpdateSettings(ctx context.Context, settings *model.CommitteeSettings, revision uint64) (*model.CommitteeSettings, error) {
+	w.settingsWrites++
+	return w.TestMockCommitteeWriter.UpdateSettings(ctx, settings, revision)
+}
+
+// concurrentSettingsReader changes the committee settings right before they are read,
+// simulating a settings update landing between the base write and the message publishing
+type concurrentSettingsReader struct {
+	port.CommitteeReader
+	change func(ctx context.Context)
+}
+
+func (r *concurrentSettingsReader) GetSettings(ctx context.Context, uid string) (*model.CommitteeSettings, uint64, error) {
+	if r.change != nil {
+		r.change(ctx)
+		r.change = nil
+	}
+	return r.CommitteeReader.GetSettings(ctx, uid)
+}
+
+func TestCommitteeWriterOrchestrator_UpdateBase_LeavesSettingsUntouched(t *testing.T) {
+	ctx := context.Background()
+
+	mockRepo := mock.NewMockRepository()
+	mockRepo.ClearAll()
+	mockRepo.AddProject("project-1", "test-project", "Test Project")
+	mockRepo.AddCommittee(&model.Committee{
+		CommitteeBase: model.CommitteeBase{
+			UID:        "committee-1",
+			ProjectUID: "project-1",
+			Name:       "Test Committee",
+			Category:   "Board",
+		},
+		CommitteeSettings: &model.CommitteeSettings{
+			UID:     "committee-1",
+			Writers: []string{"writer@example.com"},
+		},
+	})
+
+	// The settings change concurrently, after the base was read by Update
+	concurrentSettings := &model.CommitteeSettings{
+		UID:                   "committee-1",
+		BusinessEmailRequired: true,
+		Writers:               []string{"new-writer@example.com"},
+	}
+	reader := &concurrentSettingsReader{
+		CommitteeReader: mock.NewMockCommitteeReader(mockRepo),
+		change: func(ctx context.Context) {
+			require.NoError(t, mock.NewMockCommitteeWriter(mockRepo).UpdateSetting(ctx, concurrentSettings, 1))
+		},
+	}
+	writer := &settingsGuardCommitteeWriter{TestMockCommitteeWriter: NewTestMockCommitteeWriter(mockRepo)}
+	publisher := newRecordingCommitteePublisher()
+
+	orchestrator := NewCommitteeWriterOrchestrator(
+		WithCommitteeRetriever(reader),
+		WithCommitteeWriter(writer),
+		WithProjectRetriever(mock.NewMockProjectRetriever(mockRepo)),
+		WithCommitteePublisher(publisher),
+	)
+
+	// The payload carries stale settings, which must never reach the storage
+	result, err := orchestrator.Update(ctx, &model.Committee{
+		CommitteeBase: model.CommitteeBase{
+			UID:        "committee-1",
+			ProjectUID: "project-1",
+			Name:       "Test Committee",
+			Category:   "Technical Steering Committee",
+		},
+		CommitteeSettings: &model.CommitteeSettings{
+			UID:     "committee-1",
+			Writers: []string{"stale-writer@example.com"},
+		},
+	}, uint64(1), false)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "Technical Steering Committee", result.Category)
+
+	// UpdateBase never receives settings and no settings write happens
+	assert.Zero(t, writer.settingsWrites)
+	require.Len(t, writer.updateBaseSettings, 1)
+	assert.Nil(t, writer.updateBaseSettings[0])
+
+	// The concurrent settings change is preserved along with its revision
+	settings, settingsRevision, errSettings := mockRepo.GetSettings(ctx, "committee-1")
+	require.NoError(t, errSettings)
+	assert.True(t, settings.BusinessEmailRequired)
+	assert.Equal(t, []string{"new-writer@example.com"}, settings.Writers)
+	assert.Equal(t, uint64(1), settingsRevision)
+
+	// The access message is built from the settings read after the base write
+	accessMessages := publisher.messages[constants.UpdateAccessCommitteeSubject]
+	require.Len(t, accessMessages, 1)
+	accessMessage, ok := accessMessages[0].(*model.CommitteeAccessMessage)
+	require.True(t, ok)
+	assert.Equal(t, []string{"new-writer@example.com"}, accessMessage.Relations[constants.RelationWriter])
+}
+
 func TestCommitteeWriterOrchestrator_Update_PublishingErrors(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -1454,7 +2908,7 @@ func TestCommitteeWriterOrchestrator_Update_PublishingErrors(t *testing.T) {
 					UID:        "committee-1",
 					ProjectUID: "project-1",
 					Name:       "Original Committee",
-					Category:   "governance",
+					Category:   "Board",
 				},
 			}
 			mockRepo.AddCommittee(existingCommittee)
@@ -1481,7 +2935,7 @@ func TestCommitteeWriterOrchestrator_Update_PublishingErrors(t *testing.T) {
 					UID:        "committee-1",
 					ProjectUID: "project-1",
 					Name:       "Updated Committee",
-					Category:   "technical",
+					Category:   "Technical Steering Committee",
 				},
 			}
 
@@ -1494,7 +2948,7 @@ func TestCommitteeWriterOrchestrator_Update_PublishingErrors(t *testing.T) {
 				assert.NoError(t, err)
 				assert.NotNil(t, result)
 				assert.Equal(t, "Updated Committee", result.Name)
-				assert.Equal(t, "technical", result.Category)
+				assert.Equal(t, "Technical Steering Committee", result.Category)
 			} else {
 				assert.Error(t, err)
 				assert.Nil(t, result)
@@ -1549,7 +3003,7 @@ func TestCommitteeWriterOrchestrator_UpdateSettings_PublishingErrors(t *testing.
 					UID:        "committee-1",
 					ProjectUID: "project-1",
 					Name:       "Test Committee",
-					Category:   "governance",
+					Category:   "Board",
 				},
 				CommitteeSettings: &model.CommitteeSettings{
 					UID:                   "committee-1",
@@ -1606,6 +3060,261 @@ func TestCommitteeWriterOrchestrator_UpdateSettings_PublishingErrors(t *testing.
 	}
 }
 
+func TestCommitteeWriterOrchestrator_UpdateSettings_LastWriter(t *testing.T) {
+	tests := []struct {
+		name              string
+		writers           []string
+		auditors          []string
+		allowEmptyWriters bool
+		expectError       bool
+	}{
+		{
+			name:        "clearing every writer is rejected",
+			writers:     []string{},
+			auditors:    []string{"auditor@example.com"},
+			expectError: true,
+		},
+		{
+			name:     "reducing to one writer is allowed",
+			writers:  []string{"writer-1@example.com"},
+			auditors: []string{"auditor@example.com"},
+		},
+		{
+			name:              "override permits clearing every writer",
+			writers:           []string{},
+			allowEmptyWriters: true,
+		},
+		{
+			name:    "auditors can be cleared",
+			writers: []string{"writer-1@example.com"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockRepository()
+			mockRepo.ClearAll()
+			mockRepo.AddCommittee(&model.Committee{
+				CommitteeBase: model.CommitteeBase{
+					UID:        "committee-writers",
+					ProjectUID: "project-1",
+					Name:       "Writers Committee",
+					Category:   "Board",
+				},
+				CommitteeSettings: &model.CommitteeSettings{
+					UID:      "committee-writers",
+					Writers:  []string{"writer-1@example.com", "writer-2@example.com"},
+					Auditors: []string{"auditor@example.com"},
+				},
+			})
+
+			orchestrator := NewCommitteeWriterOrchestrator(
+				WithCommitteeRetriever(mock.NewMockCommitteeReader(mockRepo)),
+				WithCommitteeWriter(NewTestMockCommitteeWriter(mockRepo)),
+				WithProjectRetriever(mock.NewMockProjectRetriever(mockRepo)),
+				WithCommitteePublisher(newRecordingCommitteePublisher()),
+				WithAllowEmptyWriters(tt.allowEmptyWriters),
+			)
+
+			result, err := orchestrator.UpdateSettings(context.Background(), &model.CommitteeSettings{
+				UID:      "committee-writers",
+				Writers:  tt.writers,
+				Auditors: tt.auditors,
+			}, 1, false)
+
+			if tt.expectError {
+				require.Error(t, err)
+				assert.IsType(t, errs.Validation{}, err)
+				assert.Nil(t, result)
+
+				stored, _, errGet := mockRepo.GetSettings(context.Background(), "committee-writers")
+				require.NoError(t, errGet)
+				assert.Len(t, stored.Writers, 2)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, tt.writers, result.Writers)
+			assert.Equal(t, tt.auditors, result.Auditors)
+		})
+	}
+}
+
+func TestCommitteeWriterOrchestrator_UpdateSettings_Upsert(t *testing.T) {
+	tests := []struct {
+		name              string
+		settingsMissing   bool
+		upsert            bool
+		revision          uint64
+		expectedErrorType error
+	}{
+		{
+			name:     "existing settings are updated",
+			upsert:   true,
+			revision: 1,
+		},
+		{
+			name:            "missing settings are created in upsert mode",
+			settingsMissing: true,
+			upsert:          true,
+			revision:        0,
+		},
+		{
+			name:              "missing settings are not found without upsert mode",
+			settingsMissing:   true,
+			revision:          0,
+			expectedErrorType: errs.NotFound{},
+		},
+		{
+			name:              "upsert expects the zero revision",
+			settingsMissing:   true,
+			upsert:            true,
+			revision:          3,
+			expectedErrorType: errs.Conflict{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockRepository()
+			mockRepo.ClearAll()
+			createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			mockRepo.AddCommittee(&model.Committee{
+				CommitteeBase: model.CommitteeBase{
+					UID:        "committee-upsert",
+					ProjectUID: "project-1",
+					Name:       "Upsert Committee",
+					Category:   "Board",
+				},
+				CommitteeSettings: &model.CommitteeSettings{
+					UID:       "committee-upsert",
+					Writers:   []string{"writer-1@example.com"},
+					CreatedAt: createdAt,
+				},
+			})
+			if tt.settingsMissing {
+				mockRepo.RemoveCommitteeSettings("committee-upsert")
+			}
+
+			orchestrator := NewCommitteeWriterOrchestrator(
+				WithCommitteeRetriever(mock.NewMockCommitteeReader(mockRepo)),
+				WithCommitteeWriter(NewTestMockCommitteeWriter(mockRepo)),
+				WithProjectRetriever(mock.NewMockProjectRetriever(mockRepo)),
+				WithCommitteePublisher(newRecordingCommitteePublisher()),
+				WithSettingsUpsert(tt.upsert),
+			)
+
+			result, err := orchestrator.UpdateSettings(context.Background(), &model.CommitteeSettings{
+				UID:                   "committee-upsert",
+				BusinessEmailRequired: true,
+				Writers:               []string{"writer-2@example.com"},
+			}, tt.revision, false)
+
+			if tt.expectedErrorType != nil {
+				require.Error(t, err)
+				assert.IsType(t, tt.expectedErrorType, err)
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, "committee-upsert", result.UID)
+			assert.True(t, result.BusinessEmailRequired)
+			assert.False(t, result.UpdatedAt.IsZero())
+			if tt.settingsMissing {
+				assert.False(t, result.CreatedAt.IsZero())
+			} else {
+				assert.Equal(t, createdAt, result.CreatedAt)
+			}
+
+			stored, _, errGet := mockRepo.GetSettings(context.Background(), "committee-upsert")
+			require.NoError(t, errGet)
+			assert.Equal(t, []string{"writer-2@example.com"}, stored.Writers)
+		})
+	}
+}
+
+func TestCommitteeWriterOrchestrator_MarkReviewed(t *testing.T) {
+	tests := []struct {
+		name           string
+		lastReviewedAt *string
+		minInterval    time.Duration
+		expectError    bool
+	}{
+		{
+			name:        "first review is allowed",
+			minInterval: time.Hour,
+		},
+		{
+			name:           "review after the interval is allowed",
+			lastReviewedAt: stringPtr(time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339)),
+			minInterval:    time.Hour,
+		},
+		{
+			name:           "review within the interval is rejected",
+			lastReviewedAt: stringPtr(time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)),
+			minInterval:    time.Hour,
+			expectError:    true,
+		},
+		{
+			name:           "zero interval disables the check",
+			lastReviewedAt: stringPtr(time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockRepository()
+			mockRepo.ClearAll()
+			mockRepo.AddCommittee(&model.Committee{
+				CommitteeBase: model.CommitteeBase{
+					UID:            "committee-reviewed",
+					ProjectUID:     "project-1",
+					Name:           "Reviewed Committee",
+					Category:       "Board",
+					RequiresReview: true,
+				},
+				CommitteeSettings: &model.CommitteeSettings{
+					UID:            "committee-reviewed",
+					Writers:        []string{"writer@example.com"},
+					LastReviewedAt: tt.lastReviewedAt,
+				},
+			})
+
+			orchestrator := NewCommitteeWriterOrchestrator(
+				WithCommitteeRetriever(mock.NewMockCommitteeReader(mockRepo)),
+				WithCommitteeWriter(NewTestMockCommitteeWriter(mockRepo)),
+				WithProjectRetriever(mock.NewMockProjectRetriever(mockRepo)),
+				WithCommitteePublisher(newRecordingCommitteePublisher()),
+				WithMinReviewInterval(tt.minInterval),
+			)
+
+			result, err := orchestrator.MarkReviewed(context.Background(), "committee-reviewed", "reviewer-1", 1, false)
+			if tt.expectError {
+				require.Error(t, err)
+				assert.IsType(t, errs.Validation{}, err)
+				assert.Nil(t, result)
+
+				settings, _, errGet := mockRepo.GetSettings(context.Background(), "committee-reviewed")
+				require.NoError(t, errGet)
+				assert.Equal(t, tt.lastReviewedAt, settings.LastReviewedAt)
+				assert.Nil(t, settings.LastReviewedBy)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			require.NotNil(t, result.LastReviewedAt)
+			reviewedAt, errParse := time.Parse(time.RFC3339, *result.LastReviewedAt)
+			require.NoError(t, errParse)
+			assert.WithinDuration(t, time.Now(), reviewedAt, time.Minute)
+			assert.Equal(t, stringPtr("reviewer-1"), result.LastReviewedBy)
+			assert.Equal(t, []string{"writer@example.com"}, result.Writers)
+		})
+	}
+}
 func TestCommitteeWriterOrchestrator_Delete(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -1626,7 +3335,7 @@ func TestCommitteeWriterOrchestrator_Delete(t *testing.T) {
 						UID:             "committee-1",
 						ProjectUID:      "project-1",
 						Name:            "Test Committee",
-						Category:        "governance",
+						Category:        "Board",
 						Description:     "Test description",
 						SSOGroupEnabled: false,
 					},
@@ -1664,7 +3373,7 @@ func TestCommitteeWriterOrchestrator_Delete(t *testing.T) {
 						UID:             "committee-1",
 						ProjectUID:      "project-1",
 						Name:            "Test Committee",
-						Category:        "governance",
+						Category:        "Board",
 						Description:     "Test description",
 						SSOGroupEnabled: true,
 						SSOGroupName:    "test-project-test-committee",
@@ -1704,7 +3413,7 @@ func TestCommitteeWriterOrchestrator_Delete(t *testing.T) {
 						UID:        "parent-committee",
 						ProjectUID: "project-1",
 						Name:       "Parent Committee",
-						Category:   "governance",
+						Category:   "Board",
 					},
 				}
 				mockRepo.AddCommittee(parentCommittee)
@@ -1715,7 +3424,7 @@ func TestCommitteeWriterOrchestrator_Delete(t *testing.T) {
 						UID:        "committee-1",
 						ProjectUID: "project-1",
 						Name:       "Child Committee",
-						Category:   "technical",
+						Category:   "Technical Steering Committee",
 						ParentUID:  stringPtr("parent-committee"),
 					},
 					CommitteeSettings: &model.CommitteeSettings{
@@ -1761,7 +3470,7 @@ func TestCommitteeWriterOrchestrator_Delete(t *testing.T) {
 						UID:        "committee-1",
 						ProjectUID: "project-1",
 						Name:       "Test Committee",
-						Category:   "governance",
+						Category:   "Board",
 					},
 				}
 				mockRepo.AddCommittee(committee)
@@ -1783,7 +3492,7 @@ func TestCommitteeWriterOrchestrator_Delete(t *testing.T) {
 						UID:        "committee-1",
 						ProjectUID: "project-1",
 						Name:       "Test Committee",
-						Category:   "governance",
+						Category:   "Board",
 					},
 					// No settings
 					CommitteeSettings: nil,
@@ -1864,6 +3573,102 @@ func TestCommitteeWriterOrchestrator_Delete(t *testing.T) {
 	}
 }
 
+func TestCommitteeWriterOrchestrator_Delete_ChildCommittees(t *testing.T) {
+	grandparentUID := "committee-grandparent"
+
+	testCases := []struct {
+		name              string
+		parentOfDeleted   *string
+		cascade           bool
+		expectError       bool
+		expectedChildLink *string
+	}{
+		{
+			name:        "deletion blocked when committee has children",
+			cascade:     false,
+			expectError: true,
+		},
+		{
+			name:              "cascade re-parents children to the deleted committee parent",
+			parentOfDeleted:   &grandparentUID,
+			cascade:           true,
+			expectedChildLink: &grandparentUID,
+		},
+		{
+			name:              "cascade re-parents children to none when the deleted committee is a root",
+			cascade:           true,
+			expectedChildLink: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := mock.NewMockRepository()
+			mockRepo.ClearAll()
+
+			parentUID := "committee-parent"
+			if tc.parentOfDeleted != nil {
+				mockRepo.AddCommittee(&model.Committee{
+					CommitteeBase:     model.CommitteeBase{UID: grandparentUID, ProjectUID: "project-1", Name: "Grandparent"},
+					CommitteeSettings: &model.CommitteeSettings{UID: grandparentUID},
+				})
+			}
+			mockRepo.AddCommittee(&model.Committee{
+				CommitteeBase:     model.CommitteeBase{UID: parentUID, ProjectUID: "project-1", Name: "Parent", ParentUID: tc.parentOfDeleted},
+				CommitteeSettings: &model.CommitteeSettings{UID: parentUID},
+			})
+			for _, childUID := range []string{"committee-child-1", "committee-child-2"} {
+				mockRepo.AddCommittee(&model.Committee{
+					CommitteeBase:     model.CommitteeBase{UID: childUID, ProjectUID: "project-1", Name: childUID, ParentUID: &parentUID},
+					CommitteeSettings: &model.CommitteeSettings{UID: childUID},
+				})
+			}
+
+			orchestrator := NewCommitteeWriterOrchestrator(
+				WithCommitteeRetriever(mock.NewMockCommitteeReader(mockRepo)),
+				WithCommitteeWriter(NewTestMockCommitteeWriter(mockRepo)),
+				WithProjectRetriever(mock.NewMockProjectRetriever(mockRepo)),
+				WithCommitteePublisher(mock.NewMockCommitteePublisher()),
+			)
+
+			ctx := context.Background()
+			var err error
+			if tc.cascade {
+				err = orchestrator.DeleteCascade(ctx, parentUID, uint64(1), false)
+			} else {
+				err = orchestrator.Delete(ctx, parentUID, uint64(1), false)
+			}
+
+			if tc.expectError {
+				require.Error(t, err)
+				var conflictErr errs.Conflict
+				assert.True(t, errors.As(err, &conflictErr), "Expected Conflict error")
+				assert.Contains(t, err.Error(), "committee has child committees")
+
+				// The parent and its children are left untouched
+				_, _, errGet := mockRepo.GetBase(ctx, parentUID)
+				assert.NoError(t, errGet)
+				children, errChildren := mockRepo.ListChildren(ctx, parentUID)
+				require.NoError(t, errChildren)
+				assert.Len(t, children, 2)
+				return
+			}
+
+			require.NoError(t, err)
+
+			_, _, errGet := mockRepo.GetBase(ctx, parentUID)
+			var notFoundErr errs.NotFound
+			assert.True(t, errors.As(errGet, &notFoundErr), "Parent committee should be deleted")
+
+			for _, childUID := range []string{"committee-child-1", "committee-child-2"} {
+				child, _, errChild := mockRepo.GetBase(ctx, childUID)
+				require.NoError(t, errChild)
+				assert.Equal(t, tc.expectedChildLink, child.ParentUID)
+			}
+		})
+	}
+}
+
 func TestCommitteeWriterOrchestrator_Delete_PublishingErrors(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -1910,7 +3715,7 @@ func TestCommitteeWriterOrchestrator_Delete_PublishingErrors(t *testing.T) {
 					UID:             "committee-1",
 					ProjectUID:      "project-1",
 					Name:            "Test Committee",
-					Category:        "governance",
+					Category:        "Board",
 					SSOGroupEnabled: true,
 					SSOGroupName:    "test-project-test-committee",
 				},
@@ -1992,7 +3797,7 @@ func TestCommitteeWriterOrchestrator_Delete_EdgeCases(t *testing.T) {
 						UID:        "committee-1",
 						ProjectUID: "project-1",
 						Name:       "Test Committee",
-						Category:   "governance",
+						Category:   "Board",
 					},
 				}
 				mockRepo.AddCommittee(committee)
@@ -2013,7 +3818,7 @@ func TestCommitteeWriterOrchestrator_Delete_EdgeCases(t *testing.T) {
 						UID:             "committee-1",
 						ProjectUID:      "project-1",
 						Name:            "Test Committee",
-						Category:        "governance",
+						Category:        "Board",
 						SSOGroupEnabled: true,
 						SSOGroupName:    "test-project-test-committee",
 					},
@@ -0,0 +1,117 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/port"
+)
+
+// projectAttribute identifies a cached project attribute
+type projectAttribute string
+
+const (
+	projectAttributeName projectAttribute = "name"
+	projectAttributeSlug projectAttribute = "slug"
+)
+
+// cachedProjectValue is a project attribute and the time it stops being valid
+type cachedProjectValue struct {
+	value     string
+	expiresAt time.Time
+}
+
+// projectReaderCache is a TTL cache in front of a ProjectReader, keyed by project UID
+type projectReaderCache struct {
+	reader port.ProjectReader
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]map[projectAttribute]cachedProjectValue
+}
+
+// Name returns the project name, from the cache when the entry is still valid
+func (c *projectReaderCache) Name(ctx context.Context, uid string) (string, error) {
+	return c.get(ctx, uid, projectAttributeName, c.reader.Name)
+}
+
+// Slug returns the project slug, from the cache when the entry is still valid
+func (c *projectReaderCache) Slug(ctx context.Context, uid string) (string, error) {
+	return c.get(ctx, uid, projectAttributeSlug, c.reader.Slug)
+}
+
+func (c *projectReaderCache) get(ctx context.Context, uid string, attribute projectAttribute, fetch func(ctx context.Context, uid string) (string, error)) (string, error) {
+	if value, ok := c.lookup(uid, attribute); ok {
+		slog.DebugContext(ctx, "project attribute served from cache",
+			"project_uid", uid,
+			"attribute", attribute,
+		)
+		return value, nil
+	}
+
+	value, err := fetch(ctx, uid)
+	if err != nil {
+		// the upstream state is unknown, don't keep serving values for this project
+		c.invalidate(uid)
+		return "", err
+	}
+
+	c.store(uid, attribute, value)
+
+	return value, nil
+}
+
+func (c *projectReaderCache) lookup(uid string, attribute projectAttribute) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cached, ok := c.entries[uid][attribute]
+	if !ok {
+		return "", false
+	}
+	if !c.now().Before(cached.expiresAt) {
+		delete(c.entries[uid], attribute)
+		return "", false
+	}
+	return cached.value, true
+}
+
+func (c *projectReaderCache) store(uid string, attribute projectAttribute, value string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.entries[uid] == nil {
+		c.entries[uid] = make(map[projectAttribute]cachedProjectValue)
+	}
+	c.entries[uid][attribute] = cachedProjectValue{
+		value:     value,
+		expiresAt: c.now().Add(c.ttl),
+	}
+}
+
+func (c *projectReaderCache) invalidate(uid string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, uid)
+}
+
+// NewCachedProjectReader wraps the project reader with a TTL cache keyed by project UID.
+// A non-positive TTL bypasses the cache and returns the reader unchanged.
+func NewCachedProjectReader(reader port.ProjectReader, ttl time.Duration) port.ProjectReader {
+	if ttl <= 0 {
+		return reader
+	}
+	return &projectReaderCache{
+		reader:  reader,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]map[projectAttribute]cachedProjectValue),
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errs "github.com/linuxfoundation/lfx-v2-committee-service/pkg/errors"
+)
+
+// countingProjectReader counts the upstream calls and serves configurable values
+type countingProjectReader struct {
+	names map[string]string
+	slugs map[string]string
+	err   error
+	calls int
+}
+
+func (r *countingProjectReader) Name(ctx context.Context, uid string) (string, error) {
+	r.calls++
+	if r.err != nil {
+		return "", r.err
+	}
+	return r.names[uid], nil
+}
+
+func (r *countingProjectReader) Slug(ctx context.Context, uid string) (string, error) {
+	r.calls++
+	if r.err != nil {
+		return "", r.err
+	}
+	return r.slugs[uid], nil
+}
+
+func newTestProjectReaderCache(reader *countingProjectReader, ttl time.Duration, now *time.Time) *projectReaderCache {
+	cache, ok := NewCachedProjectReader(reader, ttl).(*projectReaderCache)
+	if !ok {
+		panic("expected a cached project reader")
+	}
+	cache.now = func() time.Time { return *now }
+	return cache
+}
+
+func TestProjectReaderCache(t *testing.T) {
+	ctx := context.Background()
+	ttl := time.Minute
+
+	t.Run("second lookup within TTL hits the cache", func(t *testing.T) {
+		now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		reader := &countingProjectReader{
+			names: map[string]string{"project-1": "Project One"},
+			slugs: map[string]string{"project-1": "project-one"},
+		}
+		cache := newTestProjectReaderCache(reader, ttl, &now)
+
+		for i := 0; i < 2; i++ {
+			name, err := cache.Name(ctx, "project-1")
+			require.NoError(t, err)
+			assert.Equal(t, "Project One", name)
+
+			slug, err := cache.Slug(ctx, "project-1")
+			require.NoError(t, err)
+			assert.Equal(t, "project-one", slug)
+
+			now = now.Add(ttl / 4)
+		}
+
+		assert.Equal(t, 2, reader.calls)
+	})
+
+	t.Run("expired entry is fetched again", func(t *testing.T) {
+		now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		reader := &countingProjectReader{slugs: map[string]string{"project-1": "project-one"}}
+		cache := newTestProjectReaderCache(reader, ttl, &now)
+
+		_, err := cache.Slug(ctx, "project-1")
+		require.NoError(t, err)
+
+		reader.slugs["project-1"] = "project-renamed"
+		now = now.Add(ttl)
+
+		slug, err := cache.Slug(ctx, "project-1")
+		require.NoError(t, err)
+		assert.Equal(t, "project-renamed", slug)
+		assert.Equal(t, 2, reader.calls)
+	})
+
+	t.Run("upstream error invalidates the project entries", func(t *testing.T) {
+		now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		reader := &countingProjectReader{
+			names: map[string]string{"project-1": "Project One"},
+			slugs: map[string]string{"project-1": "project-one"},
+		}
+		cache := newTestProjectReaderCache(reader, ttl, &now)
+
+		_, err := cache.Name(ctx, "project-1")
+		require.NoError(t, err)
+
+		reader.err = errs.NewServiceUnavailable("project service unavailable")
+		_, err = cache.Slug(ctx, "project-1")
+		require.Error(t, err)
+		assert.IsType(t, errs.ServiceUnavailable{}, err)
+
+		// the cached name was dropped along with the failed lookup
+		reader.err = nil
+		_, err = cache.Name(ctx, "project-1")
+		require.NoError(t, err)
+		assert.Equal(t, 3, reader.calls)
+	})
+
+	t.Run("entries are keyed by project UID", func(t *testing.T) {
+		now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		reader := &countingProjectReader{slugs: map[string]string{"project-1": "one", "project-2": "two"}}
+		cache := newTestProjectReaderCache(reader, ttl, &now)
+
+		slug1, err := cache.Slug(ctx, "project-1")
+		require.NoError(t, err)
+		slug2, err := cache.Slug(ctx, "project-2")
+		require.NoError(t, err)
+
+		assert.Equal(t, "one", slug1)
+		assert.Equal(t, "two", slug2)
+		assert.Equal(t, 2, reader.calls)
+	})
+
+	t.Run("non-positive TTL bypasses the cache", func(t *testing.T) {
+		reader := &countingProjectReader{slugs: map[string]string{"project-1": "project-one"}}
+		cached := NewCachedProjectReader(reader, 0)
+		assert.Same(t, reader, cached)
+	})
+}
@@ -0,0 +1,240 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/model"
+)
+
+// slowOperationTracker reports the orchestrator operations lasting longer than the threshold
+type slowOperationTracker struct {
+	threshold time.Duration
+	now       func() time.Time
+	slowCount atomic.Uint64
+}
+
+// observe logs a slow operation warning when the operation started at start exceeded the threshold
+func (t *slowOperationTracker) observe(ctx context.Context, operation string, start time.Time, attrs ...any) {
+	elapsed := t.now().Sub(start)
+	if elapsed <= t.threshold {
+		return
+	}
+
+	count := t.slowCount.Add(1)
+	args := append([]any{
+		"operation", operation,
+		"duration", elapsed,
+		"threshold", t.threshold,
+		"slow_operations_total", count,
+	}, attrs...)
+	slog.WarnContext(ctx, "slow committee operation", args...)
+}
+
+// slowOperationLoggingWriter decorates a CommitteeWriter with slow operation logging
+type slowOperationLoggingWriter struct {
+	*slowOperationTracker
+	next CommitteeWriter
+}
+
+// NewSlowOperationLoggingWriter wraps the writer so operations exceeding the threshold are logged.
+// A non-positive threshold returns the writer unchanged.
+func NewSlowOperationLoggingWriter(writer CommitteeWriter, threshold time.Duration) CommitteeWriter {
+	if threshold <= 0 {
+		return writer
+	}
+	return &slowOperationLoggingWriter{
+		slowOperationTracker: &slowOperationTracker{threshold: threshold, now: time.Now},
+		next:                 writer,
+	}
+}
+
+func (w *slowOperationLoggingWriter) Create(ctx context.Context, committee *model.Committee, sync bool) (*model.Committee, error) {
+	start := w.now()
+	result, err := w.next.Create(ctx, committee, sync)
+	w.observe(ctx, "create", start, "committee_uid", committee.CommitteeBase.UID)
+	return result, err
+}
+
+func (w *slowOperationLoggingWriter) Update(ctx context.Context, committee *model.Committee, revision uint64, sync bool) (*model.Committee, error) {
+	defer w.observe(ctx, "update", w.now(), "committee_uid", committee.CommitteeBase.UID)
+	return w.next.Update(ctx, committee, revision, sync)
+}
+
+func (w *slowOperationLoggingWriter) UpdateSettings(ctx context.Context, settings *model.CommitteeSettings, revision uint64, sync bool) (*model.CommitteeSettings, error) {
+	defer w.observe(ctx, "update_settings", w.now(), "committee_uid", settings.UID)
+	return w.next.UpdateSettings(ctx, settings, revision, sync)
+}
+
+func (w *slowOperationLoggingWriter) Delete(ctx context.Context, uid string, revision uint64, sync bool) error {
+	defer w.observe(ctx, "delete", w.now(), "committee_uid", uid)
+	return w.next.Delete(ctx, uid, revision, sync)
+}
+
+func (w *slowOperationLoggingWriter) DeleteCascade(ctx context.Context, uid string, revision uint64, sync bool) error {
+	defer w.observe(ctx, "delete_cascade", w.now(), "committee_uid", uid)
+	return w.next.DeleteCascade(ctx, uid, revision, sync)
+}
+
+func (w *slowOperationLoggingWriter) MarkReviewed(ctx context.Context, uid, reviewer string, revision uint64, sync bool) (*model.CommitteeSettings, error) {
+	defer w.observe(ctx, "mark_reviewed", w.now(), "committee_uid", uid)
+	return w.next.MarkReviewed(ctx, uid, reviewer, revision, sync)
+}
+
+func (w *slowOperationLoggingWriter) Touch(ctx context.Context, uid string, revision uint64, sync bool) (*model.Committee, error) {
+	defer w.observe(ctx, "touch", w.now(), "committee_uid", uid)
+	return w.next.Touch(ctx, uid, revision, sync)
+}
+
+func (w *slowOperationLoggingWriter) RecountMembers(ctx context.Context, uid string, sync bool) (*model.CommitteeBase, error) {
+	defer w.observe(ctx, "recount_members", w.now(), "committee_uid", uid)
+	return w.next.RecountMembers(ctx, uid, sync)
+}
+
+func (w *slowOperationLoggingWriter) CreateMember(ctx context.Context, member *model.CommitteeMember, sync bool) (*model.CommitteeMember, error) {
+	defer w.observe(ctx, "create_member", w.now(), "committee_uid", member.CommitteeUID)
+	return w.next.CreateMember(ctx, member, sync)
+}
+
+func (w *slowOperationLoggingWriter) UpdateMember(ctx context.Context, member *model.CommitteeMember, revision uint64, sync bool) (*model.CommitteeMember, error) {
+	defer w.observe(ctx, "update_member", w.now(), "committee_uid", member.CommitteeUID, "member_uid", member.UID)
+	return w.next.UpdateMember(ctx, member, revision, sync)
+}
+
+func (w *slowOperationLoggingWriter) PatchMember(ctx context.Context, committeeUID, memberUID string, patch *model.CommitteeMemberPatch, revision uint64, sync bool) (*model.CommitteeMember, error) {
+	defer w.observe(ctx, "patch_member", w.now(), "committee_uid", committeeUID, "member_uid", memberUID)
+	return w.next.PatchMember(ctx, committeeUID, memberUID, patch, revision, sync)
+}
+
+func (w *slowOperationLoggingWriter) SelfUpdateMember(ctx context.Context, member *model.CommitteeMember, revision uint64, sync bool) (*model.CommitteeMember, error) {
+	defer w.observe(ctx, "self_update_member", w.now(), "committee_uid", member.CommitteeUID, "member_uid", member.UID)
+	return w.next.SelfUpdateMember(ctx, member, revision, sync)
+}
+
+func (w *slowOperationLoggingWriter) DeleteMember(ctx context.Context, uid string, revision uint64, sync bool) error {
+	defer w.observe(ctx, "delete_member", w.now(), "member_uid", uid)
+	return w.next.DeleteMember(ctx, uid, revision, sync)
+}
+
+func (w *slowOperationLoggingWriter) ValidateMembers(ctx context.Context, committeeUID string, members []*model.CommitteeMember) ([]*model.CommitteeMemberValidationResult, error) {
+	defer w.observe(ctx, "validate_members", w.now(), "committee_uid", committeeUID, "members", len(members))
+	return w.next.ValidateMembers(ctx, committeeUID, members)
+}
+
+func (w *slowOperationLoggingWriter) ReassignOrganization(ctx context.Context, committeeUID, fromOrg, toOrg string, sync bool) ([]*model.CommitteeMemberReassignResult, error) {
+	defer w.observe(ctx, "reassign_organization", w.now(), "committee_uid", committeeUID)
+	return w.next.ReassignOrganization(ctx, committeeUID, fromOrg, toOrg, sync)
+}
+
+// slowOperationLoggingReader decorates a CommitteeReader with slow operation logging
+type slowOperationLoggingReader struct {
+	*slowOperationTracker
+	next CommitteeReader
+}
+
+// NewSlowOperationLoggingReader wraps the reader so operations exceeding the threshold are logged.
+// A non-positive threshold returns the reader unchanged.
+func NewSlowOperationLoggingReader(reader CommitteeReader, threshold time.Duration) CommitteeReader {
+	if threshold <= 0 {
+		return reader
+	}
+	return &slowOperationLoggingReader{
+		slowOperationTracker: &slowOperationTracker{threshold: threshold, now: time.Now},
+		next:                 reader,
+	}
+}
+
+func (r *slowOperationLoggingReader) GetBase(ctx context.Context, uid string) (*model.CommitteeBase, uint64, error) {
+	defer r.observe(ctx, "get_base", r.now(), "committee_uid", uid)
+	return r.next.GetBase(ctx, uid)
+}
+
+func (r *slowOperationLoggingReader) Exists(ctx context.Context, uid string) (bool, error) {
+	defer r.observe(ctx, "exists", r.now(), "committee_uid", uid)
+	return r.next.Exists(ctx, uid)
+}
+
+func (r *slowOperationLoggingReader) GetSettings(ctx context.Context, uid string) (*model.CommitteeSettings, uint64, error) {
+	defer r.observe(ctx, "get_settings", r.now(), "committee_uid", uid)
+	return r.next.GetSettings(ctx, uid)
+}
+
+func (r *slowOperationLoggingReader) GetSettingsOrDefault(ctx context.Context, uid string) (*model.CommitteeSettings, uint64, error) {
+	defer r.observe(ctx, "get_settings_or_default", r.now(), "committee_uid", uid)
+	return r.next.GetSettingsOrDefault(ctx, uid)
+}
+
+func (r *slowOperationLoggingReader) GetFull(ctx context.Context, uid string) (*model.Committee, model.CommitteeRevision, error) {
+	defer r.observe(ctx, "get_full", r.now(), "committee_uid", uid)
+	return r.next.GetFull(ctx, uid)
+}
+
+func (r *slowOperationLoggingReader) GetWithMembers(ctx context.Context, uid string, memberPage model.PageOptions) (*model.Committee, *model.PageResult[*model.CommitteeMember], error) {
+	defer r.observe(ctx, "get_with_members", r.now(), "committee_uid", uid)
+	return r.next.GetWithMembers(ctx, uid, memberPage)
+}
+
+func (r *slowOperationLoggingReader) GetBaseWithChildCount(ctx context.Context, uid string) (*model.CommitteeBase, int, error) {
+	defer r.observe(ctx, "get_base_with_child_count", r.now(), "committee_uid", uid)
+	return r.next.GetBaseWithChildCount(ctx, uid)
+}
+
+func (r *slowOperationLoggingReader) GetBaseAttributeValue(ctx context.Context, uid string, attributeName string) (any, error) {
+	defer r.observe(ctx, "get_base_attribute_value", r.now(), "committee_uid", uid, "attribute", attributeName)
+	return r.next.GetBaseAttributeValue(ctx, uid, attributeName)
+}
+
+func (r *slowOperationLoggingReader) ListCommittees(ctx context.Context, filter model.CommitteeFilter) ([]*model.CommitteeBase, error) {
+	defer r.observe(ctx, "list_committees", r.now())
+	return r.next.ListCommittees(ctx, filter)
+}
+
+func (r *slowOperationLoggingReader) ListChildren(ctx context.Context, uid string, pageSize int, pageToken string) ([]*model.CommitteeBase, string, error) {
+	defer r.observe(ctx, "list_children", r.now(), "committee_uid", uid)
+	return r.next.ListChildren(ctx, uid, pageSize, pageToken)
+}
+
+func (r *slowOperationLoggingReader) GetMember(ctx context.Context, committeeUID, memberUID string) (*model.CommitteeMember, uint64, error) {
+	defer r.observe(ctx, "get_member", r.now(), "committee_uid", committeeUID, "member_uid", memberUID)
+	return r.next.GetMember(ctx, committeeUID, memberUID)
+}
+
+func (r *slowOperationLoggingReader) ListMembers(ctx context.Context, committeeUID string) ([]*model.CommitteeMember, error) {
+	defer r.observe(ctx, "list_members", r.now(), "committee_uid", committeeUID)
+	return r.next.ListMembers(ctx, committeeUID)
+}
+
+func (r *slowOperationLoggingReader) GetMembersAsOf(ctx context.Context, committeeUID string, date time.Time) ([]*model.CommitteeMember, error) {
+	defer r.observe(ctx, "get_members_as_of", r.now(), "committee_uid", committeeUID)
+	return r.next.GetMembersAsOf(ctx, committeeUID, date)
+}
+
+func (r *slowOperationLoggingReader) ListExpiringMembers(ctx context.Context, committeeUID string, within time.Duration) ([]*model.CommitteeMember, error) {
+	defer r.observe(ctx, "list_expiring_members", r.now(), "committee_uid", committeeUID)
+	return r.next.ListExpiringMembers(ctx, committeeUID, within)
+}
+
+func (r *slowOperationLoggingReader) GetMemberProjectSummary(ctx context.Context, projectUID, email string) ([]*model.CommitteeMembershipSummary, error) {
+	defer r.observe(ctx, "get_member_project_summary", r.now(), "project_uid", projectUID)
+	return r.next.GetMemberProjectSummary(ctx, projectUID, email)
+}
+
+func (r *slowOperationLoggingReader) ListMembersWithAccess(ctx context.Context, committeeUID string) ([]*model.CommitteeMemberAccess, error) {
+	defer r.observe(ctx, "list_members_with_access", r.now(), "committee_uid", committeeUID)
+	return r.next.ListMembersWithAccess(ctx, committeeUID)
+}
+
+func (r *slowOperationLoggingReader) ListMembersByOrganization(ctx context.Context, committeeUID, organizationName string) ([]*model.CommitteeMember, error) {
+	defer r.observe(ctx, "list_members_by_organization", r.now(), "committee_uid", committeeUID)
+	return r.next.ListMembersByOrganization(ctx, committeeUID, organizationName)
+}
+
+func (r *slowOperationLoggingReader) GetMemberHistory(ctx context.Context, committeeUID, memberUID string, limit int) ([]*model.CommitteeMemberRevision, error) {
+	defer r.observe(ctx, "get_member_history", r.now(), "committee_uid", committeeUID, "member_uid", memberUID)
+	return r.next.GetMemberHistory(ctx, committeeUID, memberUID, limit)
+}
@@ -0,0 +1,91 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/model"
+)
+
+// clockAdvancingReader is a CommitteeReader whose GetBase advances the fake clock by its duration.
+// Methods not overridden panic through the embedded nil interface.
+type clockAdvancingReader struct {
+	CommitteeReader
+	now      *time.Time
+	duration time.Duration
+}
+
+func (r *clockAdvancingReader) GetBase(ctx context.Context, uid string) (*model.CommitteeBase, uint64, error) {
+	*r.now = r.now.Add(r.duration)
+	return &model.CommitteeBase{UID: uid}, 1, nil
+}
+
+// captureLogs redirects the default logger to a buffer for the duration of the test
+func captureLogs(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	previous := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelWarn})))
+	t.Cleanup(func() { slog.SetDefault(previous) })
+	return &buf
+}
+
+func TestSlowOperationLoggingReader(t *testing.T) {
+	ctx := context.Background()
+	threshold := 500 * time.Millisecond
+
+	tests := []struct {
+		name       string
+		duration   time.Duration
+		expectSlow bool
+	}{
+		{
+			name:       "operation exceeding the threshold is logged",
+			duration:   2 * time.Second,
+			expectSlow: true,
+		},
+		{
+			name:     "fast operation is not logged",
+			duration: 100 * time.Millisecond,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logs := captureLogs(t)
+			now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+			reader, ok := NewSlowOperationLoggingReader(&clockAdvancingReader{now: &now, duration: tt.duration}, threshold).(*slowOperationLoggingReader)
+			require.True(t, ok)
+			reader.now = func() time.Time { return now }
+
+			_, _, err := reader.GetBase(ctx, "committee-1")
+			require.NoError(t, err)
+
+			if !tt.expectSlow {
+				assert.Empty(t, logs.String())
+				assert.Zero(t, reader.slowCount.Load())
+				return
+			}
+			assert.Contains(t, logs.String(), "slow committee operation")
+			assert.Contains(t, logs.String(), "operation=get_base")
+			assert.Contains(t, logs.String(), "committee_uid=committee-1")
+			assert.Contains(t, logs.String(), "duration=2s")
+			assert.Equal(t, uint64(1), reader.slowCount.Load())
+		})
+	}
+}
+
+func TestNewSlowOperationLoggingReader_Disabled(t *testing.T) {
+	reader := &clockAdvancingReader{}
+	assert.Same(t, reader, NewSlowOperationLoggingReader(reader, 0))
+}
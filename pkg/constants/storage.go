@@ -23,5 +23,15 @@ const (
 	// KVLookupSSOGroupNamePrefix is the prefix for SSO group name lookup keys in the KV store.
 	KVLookupSSOGroupNamePrefix = "lookup/committee-sso-groups/%s"
 
+	// KVLookupParentPrefix is the prefix for the child committee lookup keys of a parent committee in the KV store.
+	// The child committee UID is appended to the prefix to build the key.
+	KVLookupParentPrefix = "lookup/committee-parents/%s/"
+
+	// KVLookupIndexStatusPrefix is the prefix for the keys holding the last successful index publish of a committee.
+	KVLookupIndexStatusPrefix = "lookup/committee-index-status/%s"
+
+	// KVLookupCommitteeLockPrefix is the prefix for the advisory lock keys of committees in the KV store.
+	KVLookupCommitteeLockPrefix = "lookup/committee-locks/%s"
+
 	KVSlugPrefix = "slug/"
 )
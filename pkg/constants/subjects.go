@@ -59,6 +59,18 @@ const (
 
 // Event subjects emitted by the committee service for general consumption by any service
 const (
+	// CommitteeCreatedSubject is the subject for committee creation events.
+	// The subject is of the form: lfx.committee-api.committee.created
+	CommitteeCreatedSubject = "lfx.committee-api.committee.created"
+
+	// CommitteeDeletedSubject is the subject for committee deletion events.
+	// The subject is of the form: lfx.committee-api.committee.deleted
+	CommitteeDeletedSubject = "lfx.committee-api.committee.deleted"
+
+	// CommitteeUpdatedSubject is the subject for committee update events.
+	// The subject is of the form: lfx.committee-api.committee.updated
+	CommitteeUpdatedSubject = "lfx.committee-api.committee.updated"
+
 	// CommitteeMemberCreatedSubject is the subject for committee member creation events.
 	// The subject is of the form: lfx.committee-api.member_created
 	CommitteeMemberCreatedSubject = "lfx.committee-api.committee_member.created"
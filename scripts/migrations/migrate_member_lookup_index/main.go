@@ -0,0 +1,298 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/model"
+	"github.com/linuxfoundation/lfx-v2-committee-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-committee-service/pkg/redaction"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+var (
+	natsURL              = flag.String("nats-url", getEnvOrDefault("NATS_URL", "nats://localhost:4222"), "NATS server URL")
+	membersBucketName    = flag.String("members-bucket-name", constants.KVBucketNameCommitteeMembers, "NATS KV bucket name for committee members")
+	committeesBucketName = flag.String("committees-bucket-name", constants.KVBucketNameCommittees, "NATS KV bucket name for committees")
+	uniquenessScope      = flag.String("scope", getEnvOrDefault("MEMBER_UNIQUENESS_SCOPE", string(model.MemberUniquenessScopeCommittee)), "Member uniqueness scope: committee or project")
+	dryRun               = flag.Bool("dry-run", false, "Preview changes without applying them")
+	debug                = flag.Bool("debug", false, "Enable debug logging")
+)
+
+// recordResult is the outcome of backfilling the lookup key of a single member
+type recordResult int
+
+const (
+	// resultCreated means the lookup key was created (or would be, in dry-run mode)
+	resultCreated recordResult = iota
+	// resultExisting means the lookup key already points to the member
+	resultExisting
+	// resultMissingEmail means the member has no email, so no lookup key can be built
+	resultMissingEmail
+	// resultConflict means the lookup key already points to another member
+	resultConflict
+)
+
+type migrationStats struct {
+	Total        int
+	Created      int
+	Existing     int
+	MissingEmail int
+	Conflicts    int
+	Failed       int
+}
+
+func (s *migrationStats) record(result recordResult) {
+	switch result {
+	case resultCreated:
+		s.Created++
+	case resultExisting:
+		s.Existing++
+	case resultMissingEmail:
+		s.MissingEmail++
+	case resultConflict:
+		s.Conflicts++
+	}
+}
+
+func main() {
+	flag.Parse()
+
+	// Initialize structured logging after parsing flags
+	logLevel := slog.LevelInfo
+	if *debug {
+		logLevel = slog.LevelDebug
+	}
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+		Level: logLevel,
+	}))
+	slog.SetDefault(logger)
+
+	if err := run(); err != nil {
+		log.Fatalf("migration failed: %v", err)
+	}
+}
+
+func run() error {
+	ctx := context.Background()
+
+	scope := model.MemberUniquenessScope(*uniquenessScope)
+	if scope != model.MemberUniquenessScopeCommittee && scope != model.MemberUniquenessScopeProject {
+		return fmt.Errorf("invalid member uniqueness scope %q, expected %q or %q",
+			scope, model.MemberUniquenessScopeCommittee, model.MemberUniquenessScopeProject)
+	}
+
+	slog.InfoContext(ctx, "Starting member lookup index migration",
+		"nats_url", *natsURL,
+		"members_bucket", *membersBucketName,
+		"committees_bucket", *committeesBucketName,
+		"scope", scope,
+		"dry_run", *dryRun,
+	)
+
+	// Create NATS connection
+	nc, err := nats.Connect(*natsURL,
+		nats.Timeout(10*time.Second),
+		nats.MaxReconnects(3),
+		nats.ReconnectWait(2*time.Second),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+	defer nc.Close()
+
+	slog.InfoContext(ctx, "Connected to NATS", "url", nc.ConnectedUrl())
+
+	// Create JetStream context
+	js, err := jetstream.New(nc)
+	if err != nil {
+		return fmt.Errorf("failed to create JetStream context: %w", err)
+	}
+
+	// Get KeyValueStores for the buckets
+	membersKV, err := js.KeyValue(ctx, *membersBucketName)
+	if err != nil {
+		return fmt.Errorf("failed to get KV store for bucket %s: %w", *membersBucketName, err)
+	}
+	committeesKV, err := js.KeyValue(ctx, *committeesBucketName)
+	if err != nil {
+		return fmt.Errorf("failed to get KV store for bucket %s: %w", *committeesBucketName, err)
+	}
+
+	// List all keys in the members bucket
+	slog.InfoContext(ctx, "Listing all keys in bucket", "bucket", *membersBucketName)
+	keys, err := membersKV.ListKeys(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list keys: %w", err)
+	}
+
+	// Collect all member UIDs
+	var memberUIDs []string
+	for key := range keys.Keys() {
+		// Skip lookup keys
+		if strings.HasPrefix(key, "lookup/") {
+			continue
+		}
+		memberUIDs = append(memberUIDs, key)
+	}
+
+	slog.InfoContext(ctx, "Found committee member records", "count", len(memberUIDs))
+
+	if *dryRun {
+		slog.InfoContext(ctx, "DRY RUN MODE - No changes will be made")
+	}
+
+	// Process each committee member record
+	stats := &migrationStats{Total: len(memberUIDs)}
+	startTime := time.Now()
+
+	for i, uid := range memberUIDs {
+		result, err := processRecord(ctx, membersKV, committeesKV, uid, scope, *dryRun)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to process record",
+				"uid", uid,
+				"error", err,
+			)
+			stats.Failed++
+		} else {
+			stats.record(result)
+		}
+
+		// Log progress every 10 records
+		if (i+1)%10 == 0 {
+			slog.InfoContext(ctx, "Migration progress",
+				"processed", i+1,
+				"total", stats.Total,
+				"created", stats.Created,
+				"existing", stats.Existing,
+				"missing_email", stats.MissingEmail,
+				"conflicts", stats.Conflicts,
+				"failed", stats.Failed,
+			)
+		}
+	}
+
+	duration := time.Since(startTime)
+
+	// Print summary
+	fmt.Println("\n" + strings.Repeat("=", 50))
+	fmt.Println("Migration Complete!")
+	fmt.Println(strings.Repeat("=", 50))
+	fmt.Printf("Total records:    %d\n", stats.Total)
+	fmt.Printf("Created:          %d\n", stats.Created)
+	fmt.Printf("Existing:         %d (already indexed)\n", stats.Existing)
+	fmt.Printf("Missing email:    %d (skipped)\n", stats.MissingEmail)
+	fmt.Printf("Conflicts:        %d (key owned by another member)\n", stats.Conflicts)
+	fmt.Printf("Failed:           %d\n", stats.Failed)
+	fmt.Printf("Duration:         %.2fs\n", duration.Seconds())
+	if duration.Seconds() > 0 {
+		rate := float64(stats.Total) / duration.Seconds()
+		fmt.Printf("Rate:             %.1f rec/sec\n", rate)
+	}
+	fmt.Println(strings.Repeat("=", 50))
+
+	if stats.Failed > 0 {
+		return fmt.Errorf("%d records failed to migrate", stats.Failed)
+	}
+
+	return nil
+}
+
+// processRecord backfills the lookup key of a single member, using the same key scheme as the service
+func processRecord(ctx context.Context, membersKV, committeesKV jetstream.KeyValue, uid string, scope model.MemberUniquenessScope, dryRun bool) (recordResult, error) {
+	entry, err := membersKV.Get(ctx, uid)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get entry: %w", err)
+	}
+
+	var member model.CommitteeMember
+	if err := json.Unmarshal(entry.Value(), &member); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal member: %w", err)
+	}
+
+	if strings.TrimSpace(member.Email) == "" {
+		slog.WarnContext(ctx, "member has no email, skipping",
+			"uid", uid,
+			"committee_uid", member.CommitteeUID,
+		)
+		return resultMissingEmail, nil
+	}
+
+	// The project scope hashes the project UID, older records might not carry it
+	if scope == model.MemberUniquenessScopeProject && member.ProjectUID == "" {
+		committeeEntry, errCommittee := committeesKV.Get(ctx, member.CommitteeUID)
+		if errCommittee != nil {
+			return 0, fmt.Errorf("failed to get committee %s: %w", member.CommitteeUID, errCommittee)
+		}
+		var committee model.CommitteeBase
+		if err := json.Unmarshal(committeeEntry.Value(), &committee); err != nil {
+			return 0, fmt.Errorf("failed to unmarshal committee %s: %w", member.CommitteeUID, err)
+		}
+		member.ProjectUID = committee.ProjectUID
+	}
+
+	lookupKey := fmt.Sprintf(constants.KVLookupMemberPrefix, member.BuildScopedIndexKey(ctx, scope))
+
+	existing, err := membersKV.Get(ctx, lookupKey)
+	switch {
+	case err == nil:
+		if string(existing.Value()) == uid {
+			slog.DebugContext(ctx, "lookup key already exists",
+				"uid", uid,
+				"key", lookupKey,
+			)
+			return resultExisting, nil
+		}
+		slog.WarnContext(ctx, "lookup key is owned by another member",
+			"uid", uid,
+			"owner_uid", string(existing.Value()),
+			"email", redaction.RedactEmail(member.Email),
+			"key", lookupKey,
+		)
+		return resultConflict, nil
+	case !errors.Is(err, jetstream.ErrKeyNotFound):
+		return 0, fmt.Errorf("failed to get lookup key: %w", err)
+	}
+
+	if dryRun {
+		slog.InfoContext(ctx, "[DRY RUN] would create lookup key",
+			"uid", uid,
+			"key", lookupKey,
+		)
+		return resultCreated, nil
+	}
+
+	if _, err := membersKV.Create(ctx, lookupKey, []byte(uid)); err != nil {
+		if errors.Is(err, jetstream.ErrKeyExists) {
+			// Created concurrently, most likely by the service itself
+			return resultConflict, nil
+		}
+		return 0, fmt.Errorf("failed to create lookup key: %w", err)
+	}
+
+	slog.DebugContext(ctx, "successfully created lookup key",
+		"uid", uid,
+		"key", lookupKey,
+	)
+
+	return resultCreated, nil
+}
+
+func getEnvOrDefault(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
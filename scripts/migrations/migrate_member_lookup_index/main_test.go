@@ -0,0 +1,207 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-committee-service/internal/domain/model"
+	"github.com/linuxfoundation/lfx-v2-committee-service/pkg/constants"
+)
+
+// fakeKeyValueEntry is an in-memory implementation of jetstream.KeyValueEntry
+type fakeKeyValueEntry struct {
+	key      string
+	value    []byte
+	revision uint64
+}
+
+func (e *fakeKeyValueEntry) Bucket() string                  { return "" }
+func (e *fakeKeyValueEntry) Key() string                     { return e.key }
+func (e *fakeKeyValueEntry) Value() []byte                   { return e.value }
+func (e *fakeKeyValueEntry) Revision() uint64                { return e.revision }
+func (e *fakeKeyValueEntry) Created() time.Time              { return time.Time{} }
+func (e *fakeKeyValueEntry) Delta() uint64                   { return 0 }
+func (e *fakeKeyValueEntry) Operation() jetstream.KeyValueOp { return jetstream.KeyValuePut }
+
+// fakeKeyValue is an in-memory jetstream.KeyValue covering the operations used by the migration.
+// Methods not overridden panic through the embedded nil interface.
+type fakeKeyValue struct {
+	jetstream.KeyValue
+
+	entries  map[string]*fakeKeyValueEntry
+	revision uint64
+}
+
+func newFakeKeyValue() *fakeKeyValue {
+	return &fakeKeyValue{entries: make(map[string]*fakeKeyValueEntry)}
+}
+
+func (kv *fakeKeyValue) Get(ctx context.Context, key string) (jetstream.KeyValueEntry, error) {
+	entry, ok := kv.entries[key]
+	if !ok {
+		return nil, jetstream.ErrKeyNotFound
+	}
+	return entry, nil
+}
+
+func (kv *fakeKeyValue) Create(ctx context.Context, key string, value []byte, opts ...jetstream.KVCreateOpt) (uint64, error) {
+	if _, ok := kv.entries[key]; ok {
+		return 0, jetstream.ErrKeyExists
+	}
+	kv.revision++
+	kv.entries[key] = &fakeKeyValueEntry{key: key, value: value, revision: kv.revision}
+	return kv.revision, nil
+}
+
+func (kv *fakeKeyValue) putJSON(t *testing.T, key string, value any) {
+	data, err := json.Marshal(value)
+	require.NoError(t, err)
+	kv.revision++
+	kv.entries[key] = &fakeKeyValueEntry{key: key, value: data, revision: kv.revision}
+}
+
+func TestProcessRecord(t *testing.T) {
+	ctx := context.Background()
+
+	member := &model.CommitteeMember{
+		CommitteeMemberBase: model.CommitteeMemberBase{
+			UID:          "member-1",
+			CommitteeUID: "committee-1",
+			Email:        "Jane.Doe@example.com",
+		},
+	}
+	committeeKey := fmt.Sprintf(constants.KVLookupMemberPrefix, member.BuildScopedIndexKey(ctx, model.MemberUniquenessScopeCommittee))
+
+	projectMember := *member
+	projectMember.ProjectUID = "project-1"
+	projectKey := fmt.Sprintf(constants.KVLookupMemberPrefix, projectMember.BuildScopedIndexKey(ctx, model.MemberUniquenessScopeProject))
+
+	tests := []struct {
+		name           string
+		setup          func(t *testing.T, members, committees *fakeKeyValue)
+		scope          model.MemberUniquenessScope
+		dryRun         bool
+		expectedResult recordResult
+		expectedKey    string
+		expectCreated  bool
+		expectError    bool
+	}{
+		{
+			name: "lookup key is backfilled",
+			setup: func(t *testing.T, members, committees *fakeKeyValue) {
+				members.putJSON(t, "member-1", member)
+			},
+			scope:          model.MemberUniquenessScopeCommittee,
+			expectedResult: resultCreated,
+			expectedKey:    committeeKey,
+			expectCreated:  true,
+		},
+		{
+			name: "dry run does not write the lookup key",
+			setup: func(t *testing.T, members, committees *fakeKeyValue) {
+				members.putJSON(t, "member-1", member)
+			},
+			scope:          model.MemberUniquenessScopeCommittee,
+			dryRun:         true,
+			expectedResult: resultCreated,
+			expectedKey:    committeeKey,
+			expectCreated:  false,
+		},
+		{
+			name: "existing lookup key is left untouched",
+			setup: func(t *testing.T, members, committees *fakeKeyValue) {
+				members.putJSON(t, "member-1", member)
+				_, err := members.Create(ctx, committeeKey, []byte("member-1"))
+				require.NoError(t, err)
+			},
+			scope:          model.MemberUniquenessScopeCommittee,
+			expectedResult: resultExisting,
+			expectedKey:    committeeKey,
+			expectCreated:  true,
+		},
+		{
+			name: "lookup key owned by another member is reported as conflict",
+			setup: func(t *testing.T, members, committees *fakeKeyValue) {
+				members.putJSON(t, "member-1", member)
+				_, err := members.Create(ctx, committeeKey, []byte("member-2"))
+				require.NoError(t, err)
+			},
+			scope:          model.MemberUniquenessScopeCommittee,
+			expectedResult: resultConflict,
+		},
+		{
+			name: "member without email is skipped",
+			setup: func(t *testing.T, members, committees *fakeKeyValue) {
+				noEmail := *member
+				noEmail.Email = "  "
+				members.putJSON(t, "member-1", &noEmail)
+			},
+			scope:          model.MemberUniquenessScopeCommittee,
+			expectedResult: resultMissingEmail,
+		},
+		{
+			name: "project scope resolves the project from the committee",
+			setup: func(t *testing.T, members, committees *fakeKeyValue) {
+				members.putJSON(t, "member-1", member)
+				committees.putJSON(t, "committee-1", &model.CommitteeBase{UID: "committee-1", ProjectUID: "project-1"})
+			},
+			scope:          model.MemberUniquenessScopeProject,
+			expectedResult: resultCreated,
+			expectedKey:    projectKey,
+			expectCreated:  true,
+		},
+		{
+			name:        "missing member record fails",
+			setup:       func(t *testing.T, members, committees *fakeKeyValue) {},
+			scope:       model.MemberUniquenessScopeCommittee,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			members := newFakeKeyValue()
+			committees := newFakeKeyValue()
+			tt.setup(t, members, committees)
+
+			result, err := processRecord(ctx, members, committees, "member-1", tt.scope, tt.dryRun)
+
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, result)
+			if tt.expectedKey != "" {
+				entry, ok := members.entries[tt.expectedKey]
+				assert.Equal(t, tt.expectCreated, ok)
+				if ok {
+					assert.Equal(t, "member-1", string(entry.value))
+				}
+			}
+		})
+	}
+}
+
+func TestMigrationStats_Record(t *testing.T) {
+	stats := &migrationStats{}
+	for _, result := range []recordResult{resultCreated, resultCreated, resultExisting, resultMissingEmail, resultConflict} {
+		stats.record(result)
+	}
+
+	assert.Equal(t, 2, stats.Created)
+	assert.Equal(t, 1, stats.Existing)
+	assert.Equal(t, 1, stats.MissingEmail)
+	assert.Equal(t, 1, stats.Conflicts)
+}